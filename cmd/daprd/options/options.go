@@ -31,6 +31,7 @@ import (
 	injectorconsts "github.com/dapr/dapr/pkg/injector/consts"
 	"github.com/dapr/dapr/pkg/metrics"
 	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/placement/hashing"
 	"github.com/dapr/dapr/pkg/runtime"
 	"github.com/dapr/dapr/pkg/security/consts"
 	"github.com/dapr/kit/logger"
@@ -67,6 +68,7 @@ type Options struct {
 	DaprGracefulShutdownSeconds   int
 	DaprBlockShutdownDuration     *time.Duration
 	ActorsService                 string
+	ActorsPlacementWeight         uint
 	RemindersService              string
 	SchedulerAddress              []string
 	SchedulerJobStreams           uint
@@ -173,6 +175,7 @@ func New(origArgs []string) (*Options, error) {
 	fs.StringSliceVar(&opts.SchedulerAddress, "scheduler-host-address", nil, "Addresses of the Scheduler service instance(s), as comma separated host:port pairs")
 	fs.UintVar(&opts.SchedulerJobStreams, "scheduler-job-streams", 3, "The number of active job streams to connect to the Scheduler service")
 	fs.StringVar(&opts.ActorsService, "actors-service", "", "Type and address of the actors service, in the format 'type:address'")
+	fs.UintVar(&opts.ActorsPlacementWeight, "placement-host-weight", 1, "Relative capacity of this runtime used by the Placement service to assign it a proportional share of the actors, between 1 and "+strconv.Itoa(hashing.MaxWeight))
 	fs.StringVar(&opts.RemindersService, "reminders-service", "", "Type and address of the reminders service, in the format 'type:address'")
 
 	// Add flags for logger and metrics
@@ -234,6 +237,10 @@ func New(origArgs []string) (*Options, error) {
 		}
 	}
 
+	if opts.ActorsPlacementWeight < 1 || opts.ActorsPlacementWeight > hashing.MaxWeight {
+		return nil, fmt.Errorf("invalid value for 'placement-host-weight' option: must be between 1 and %d", hashing.MaxWeight)
+	}
+
 	opts.TrustAnchors = []byte(os.Getenv(consts.TrustAnchorsEnvVar))
 
 	if !fs.Changed("control-plane-namespace") {
@@ -349,3 +349,24 @@ func TestControlPlaneEnvVar(t *testing.T) {
 		assert.EqualValues(t, "flag-namespace", opts.ControlPlaneNamespace)
 	})
 }
+
+func TestPlacementHostWeight(t *testing.T) {
+	t.Run("default weight", func(t *testing.T) {
+		opts, err := New([]string{})
+		require.NoError(t, err)
+		assert.Equal(t, uint(1), opts.ActorsPlacementWeight)
+	})
+
+	t.Run("valid weight", func(t *testing.T) {
+		opts, err := New([]string{"--placement-host-weight", "100"})
+		require.NoError(t, err)
+		assert.Equal(t, uint(100), opts.ActorsPlacementWeight)
+	})
+
+	t.Run("weight out of range", func(t *testing.T) {
+		for _, w := range []string{"0", "101", "4000000000"} {
+			_, err := New([]string{"--placement-host-weight", w})
+			require.Error(t, err, w)
+		}
+	})
+}
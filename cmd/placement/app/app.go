@@ -82,6 +82,7 @@ func Run() {
 		Peers:             opts.RaftPeers,
 		LogStorePath:      opts.RaftLogStorePath,
 		ReplicationFactor: int64(opts.ReplicationFactor),
+		LoadBoundFactor:   opts.LoadBoundFactor,
 		// TODO: fix types
 		//nolint:gosec
 		MinAPILevel: uint32(opts.MinAPILevel),
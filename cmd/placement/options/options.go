@@ -75,6 +75,7 @@ type Options struct {
 	Mode             string
 
 	ReplicationFactor int
+	LoadBoundFactor   float64
 
 	KeepAliveTime      time.Duration
 	KeepAliveTimeout   time.Duration
@@ -122,6 +123,7 @@ func New(origArgs []string) (*Options, error) {
 	fs.IntVar(&opts.MaxAPILevel, "max-api-level", 10, "If set to >= 0, causes the reported 'api-level' in the cluster to never exceed this value")
 	fs.IntVar(&opts.MinAPILevel, "min-api-level", 0, "Enforces a minimum 'api-level' in the cluster")
 	fs.IntVar(&opts.ReplicationFactor, "replicationFactor", defaultReplicationFactor, "sets the replication factor for actor distribution on virtual nodes")
+	fs.Float64Var(&opts.LoadBoundFactor, "load-bound-factor", 0, "If greater than 1, enables bounded-load actor placement: actors of a host whose load exceeds its weighted share \nof the total load by this factor are placed on the next host in the ring. \nSet to 0 to disable")
 	fs.DurationVar(&opts.KeepAliveTime, "keepalive-time", keepAliveTimeDefault, "sets the interval at which the placement service sends keepalive pings to daprd \non the gRPC stream to check if the connection is still alive. \nLower values will lead to shorter actor rebalancing time in case of pod loss/restart, \nbut higher network traffic during normal operation. \nAccepts values between 1 and 10 seconds")
 	fs.DurationVar(&opts.KeepAliveTimeout, "keepalive-timeout", keepAliveTimeoutDefault, "sets the timeout period for daprd to respond to the placement service's keepalive pings \nbefore the placement service closes the connection. \nLower values will lead to shorter actor rebalancing time in case of pod loss/restart, \nbut higher network traffic during normal operation. \nAccepts values between 1 and 10 seconds")
 	fs.DurationVar(&opts.DisseminateTimeout, "disseminate-timeout", disseminateTimeoutDefault, "sets the timeout period for dissemination to be delayed after actor membership change \nso as to avoid excessive dissemination during multiple pod restarts. \nHigher values will reduce the frequency of dissemination, but delay the table dissemination. \nAccepts values between 1 and 3 seconds")
@@ -185,5 +187,9 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("invalid value for disseminate-timeout: value should be between %s and %s, got %s", disseminateTimeoutMin, disseminateTimeoutMax, o.DisseminateTimeout)
 	}
 
+	if o.LoadBoundFactor != 0 && o.LoadBoundFactor <= 1 {
+		return fmt.Errorf("invalid value for load-bound-factor: value should be 0 or greater than 1, got %v", o.LoadBoundFactor)
+	}
+
 	return nil
 }
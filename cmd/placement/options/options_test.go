@@ -139,6 +139,11 @@ func TestValidateFlags(t *testing.T) {
 			"disseminate-timeout",
 			"6s",
 		},
+		{
+			"load-bound-factor too low",
+			"load-bound-factor",
+			"0.8",
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -108,6 +108,18 @@ func Run() {
 	cfg.Port = opts.Port
 	cfg.ListenAddress = opts.ListenAddress
 	cfg.Mode = modes.DaprMode(opts.Mode)
+	cfg.JWKSPort = opts.JWKSPort
+	cfg.JWKSListenAddress = opts.JWKSListenAddress
+	cfg.JWTIssuer = opts.JWTIssuer
+	cfg.JWTTTL = opts.JWTTTL
+	cfg.RootKeyPath = filepath.Join(opts.IssuerCredentialsPath, config.DefaultRootKeyFilename)
+	cfg.RotationStatePath = filepath.Join(opts.IssuerCredentialsPath, config.DefaultRotationStateFilename)
+	cfg.RotationEnabled = opts.CARotation
+	cfg.IssuerTTL = opts.IssuerTTL
+	cfg.IssuerRotateBefore = opts.IssuerRotateBefore
+	cfg.RootRolloverBefore = opts.RootRolloverBefore
+	cfg.AnchorPropagationPeriod = opts.AnchorPropagationPeriod
+	cfg.DenyListPath = opts.DenyListFile
 
 	// We use runner manager inception here since we want the inner manager to be
 	// restarted when the CA server needs to be restarted because of file events.
@@ -16,6 +16,7 @@ package options
 import (
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/pflag"
 	"k8s.io/client-go/util/homedir"
@@ -46,6 +47,17 @@ type Options struct {
 	Logger                logger.Options
 	Metrics               *metrics.FlagOptions
 	Mode                  string
+	JWKSPort              int
+	JWKSListenAddress     string
+	JWTIssuer             string
+	JWTTTL                time.Duration
+
+	CARotation              bool
+	IssuerTTL               time.Duration
+	IssuerRotateBefore      time.Duration
+	RootRolloverBefore      time.Duration
+	AnchorPropagationPeriod time.Duration
+	DenyListFile            string
 
 	RootCAFilename     string
 	IssuerCertFilename string
@@ -84,6 +96,16 @@ func New(origArgs []string) *Options {
 	fs.StringVar(&opts.ListenAddress, "listen-address", "", "The listen address for the sentry server")
 	fs.IntVar(&opts.HealthzPort, "healthz-port", 8080, "The port for the healthz server to listen on")
 	fs.StringVar(&opts.HealthzListenAddress, "healthz-listen-address", "", "The listening address for the healthz server")
+	fs.IntVar(&opts.JWKSPort, "jwks-port", 0, "The port for the JWKS server to listen on, publishing the keys which verify JWT-SVIDs. Disabled if 0")
+	fs.StringVar(&opts.JWKSListenAddress, "jwks-listen-address", "", "The listen address for the JWKS server")
+	fs.StringVar(&opts.JWTIssuer, "jwt-issuer", "", "The issuer (iss claim) of JWT-SVIDs. If set, an OpenID Provider configuration is also served by the JWKS server")
+	fs.DurationVar(&opts.JWTTTL, "jwt-ttl", config.DefaultJWTTTL, "The lifetime of JWT-SVIDs")
+	fs.BoolVar(&opts.CARotation, "ca-rotation", true, "Automatically rotate the issuer and roll over the root of trust bundles generated by Sentry")
+	fs.DurationVar(&opts.IssuerTTL, "ca-issuer-ttl", config.DefaultIssuerTTL, "The lifetime of issuer certificates minted on rotation")
+	fs.DurationVar(&opts.IssuerRotateBefore, "ca-issuer-rotate-before", config.DefaultIssuerRotateBefore, "The remaining lifetime of the issuer certificate at which it is rotated")
+	fs.DurationVar(&opts.RootRolloverBefore, "ca-root-rollover-before", config.DefaultRootRolloverBefore, "The remaining lifetime of the root certificate at which a new trust anchor is published")
+	fs.DurationVar(&opts.AnchorPropagationPeriod, "ca-anchor-propagation-period", config.DefaultAnchorPropagationPeriod, "The time a new trust anchor is published for before it is used for signing")
+	fs.StringVar(&opts.DenyListFile, "deny-list-file", "", "Path to a YAML file listing the app identities (appIDs, as <namespace>/<app-id>) and certificate serial numbers (serials) which are denied. Watched for changes")
 	fs.StringVar(&opts.Mode, "mode", string(modes.StandaloneMode), "Runtime mode for Dapr Sentry")
 
 	if home := homedir.HomeDir(); home != "" {
@@ -109,5 +131,18 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("invalid mode: %s", o.Mode)
 	}
 
+	if o.JWTTTL <= 0 {
+		return fmt.Errorf("invalid jwt-ttl: %s", o.JWTTTL)
+	}
+
+	if o.CARotation {
+		if o.IssuerTTL <= o.IssuerRotateBefore {
+			return fmt.Errorf("ca-issuer-ttl (%s) must be greater than ca-issuer-rotate-before (%s)", o.IssuerTTL, o.IssuerRotateBefore)
+		}
+		if o.RootRolloverBefore <= o.AnchorPropagationPeriod {
+			return fmt.Errorf("ca-root-rollover-before (%s) must be greater than ca-anchor-propagation-period (%s)", o.RootRolloverBefore, o.AnchorPropagationPeriod)
+		}
+	}
+
 	return nil
 }
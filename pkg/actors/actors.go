@@ -63,6 +63,7 @@ type Options struct {
 	Namespace          string
 	Port               int
 	PlacementAddresses []string
+	PlacementWeight    uint32
 	SchedulerReminders bool
 	HealthEndpoint     string
 	Resiliency         resiliency.Provider
@@ -106,6 +107,7 @@ type actors struct {
 	namespace          string
 	port               int
 	placementAddresses []string
+	placementWeight    uint32
 	schedulerReminders bool
 	healthEndpoint     string
 	resiliency         resiliency.Provider
@@ -154,6 +156,7 @@ func New(opts Options) Interface {
 		namespace:          opts.Namespace,
 		port:               opts.Port,
 		placementAddresses: opts.PlacementAddresses,
+		placementWeight:    opts.PlacementWeight,
 		schedulerReminders: opts.SchedulerReminders,
 		healthEndpoint:     opts.HealthEndpoint,
 		resiliency:         opts.Resiliency,
@@ -197,6 +200,7 @@ func (a *actors) Init(opts InitOptions) error {
 	a.placement, err = placement.New(placement.Options{
 		AppID:     a.appID,
 		Addresses: a.placementAddresses,
+		Weight:    a.placementWeight,
 		Security:  a.security,
 		Table:     a.table,
 		Namespace: a.namespace,
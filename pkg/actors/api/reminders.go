@@ -220,6 +220,9 @@ func (req DeleteReminderRequest) Key() string {
 
 type ListRemindersRequest struct {
 	ActorType string
+	// ActorIDPrefix, if set, only lists the reminders of actors whose ID
+	// starts with it.
+	ActorIDPrefix string
 }
 
 // DeleteTimerRequest is a request object for deleting a timer.
@@ -144,6 +144,7 @@ func (c *Client) Run(ctx context.Context) error {
 						return ctx.Err()
 					case actorTypes := <-c.sendQueue:
 						c.baseHost.Entities = actorTypes
+						c.baseHost.Load = c.load()
 						if err := c.client.Send(c.baseHost); err != nil {
 							return err
 						}
@@ -285,6 +286,16 @@ func (c *Client) Recv(ctx context.Context) (*v1pb.PlacementOrder, error) {
 	}
 }
 
+// load returns the number of active actors in this runtime, which is reported
+// to placement for bounded-load placement.
+func (c *Client) load() int64 {
+	var load int64
+	for _, n := range c.table.Len() {
+		load += int64(n)
+	}
+	return load
+}
+
 func (c *Client) Send(ctx context.Context, actorTypes []string) error {
 	select {
 	case <-ctx.Done():
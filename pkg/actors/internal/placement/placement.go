@@ -66,6 +66,8 @@ type Options struct {
 	Hostname  string
 	Port      int
 	Addresses []string
+	// Weight is the relative capacity of this runtime reported to placement.
+	Weight uint32
 
 	Scheduler schedclient.Reloader
 	APILevel  *apilevel.APILevel
@@ -121,6 +123,7 @@ func New(opts Options) (Interface, error) {
 			Id:        opts.AppID,
 			ApiLevel:  20,
 			Namespace: opts.Namespace,
+			Weight:    opts.Weight,
 		},
 	})
 	if err != nil {
@@ -223,7 +226,13 @@ func (p *placement) LookupActor(ctx context.Context, req *api.LookupActorRequest
 		return nil, messages.ErrActorNoAddress
 	}
 
-	host, err := table.GetHost(req.ActorID)
+	var host *hashing.Host
+	var err error
+	if p.hashTable.LoadBoundFactor > 1 {
+		host, err = table.GetLeastHost(req.ActorID)
+	} else {
+		host, err = table.GetHost(req.ActorID)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -312,14 +321,17 @@ func (p *placement) handleUpdateOperation(ctx context.Context, in *v1pb.Placemen
 		loadMap := make(map[string]*hashing.Host, len(v.GetLoadMap()))
 		for lk, lv := range v.GetLoadMap() {
 			loadMap[lk] = hashing.NewHost(lv.GetName(), lv.GetId(), lv.GetLoad(), lv.GetPort())
+			loadMap[lk].Weight = lv.GetWeight()
 		}
 
 		entries[k] = hashing.NewFromExisting(loadMap, in.GetReplicationFactor(), p.virtualNodesCache)
+		entries[k].SetLoadBoundFactor(in.GetLoadBoundFactor())
 	}
 
 	clear(p.hashTable.Entries)
 	p.hashTable.Version = in.GetVersion()
 	p.hashTable.Entries = entries
+	p.hashTable.LoadBoundFactor = in.GetLoadBoundFactor()
 
 	if p.reminders != nil {
 		p.reminders.DrainRebalancedReminders()
@@ -16,6 +16,7 @@ package scheduler
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -252,6 +253,8 @@ func (s *scheduler) List(ctx context.Context, req *api.ListRemindersRequest) ([]
 				Type: &schedulerv1pb.JobTargetMetadata_Actor{
 					Actor: &schedulerv1pb.TargetActorReminder{
 						Type: req.ActorType,
+						// Jobs are listed by key prefix, so the ID is a prefix too.
+						Id: req.ActorIDPrefix,
 					},
 				},
 			},
@@ -260,24 +263,28 @@ func (s *scheduler) List(ctx context.Context, req *api.ListRemindersRequest) ([]
 	if err != nil {
 		return nil, err
 	}
-	reminders := make([]*api.Reminder, len(resp.GetJobs()))
-	for i, named := range resp.GetJobs() {
+	reminders := make([]*api.Reminder, 0, len(resp.GetJobs()))
+	for _, named := range resp.GetJobs() {
 		actor := named.GetMetadata().GetTarget().GetActor()
 		if actor == nil {
 			log.Warnf("Skipping reminder job %s with unsupported target type %s", named.GetName(), named.GetMetadata().GetTarget().String())
 			continue
 		}
 
+		if !strings.HasPrefix(actor.GetId(), req.ActorIDPrefix) {
+			continue
+		}
+
 		job := named.GetJob()
 
-		reminders[i] = &api.Reminder{
+		reminders = append(reminders, &api.Reminder{
 			Name:      named.GetName(),
 			ActorID:   actor.GetId(),
 			ActorType: actor.GetType(),
 			Data:      job.GetData(),
 			Period:    api.NewSchedulerReminderPeriod(job.GetSchedule(), job.GetRepeats()),
 			DueTime:   job.GetDueTime(),
-		}
+		})
 	}
 	return reminders, nil
 }
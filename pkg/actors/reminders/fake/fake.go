@@ -23,6 +23,7 @@ type Fake struct {
 	getFn    func(ctx context.Context, req *api.GetReminderRequest) (*api.Reminder, error)
 	createFn func(ctx context.Context, req *api.CreateReminderRequest) error
 	deleteFn func(ctx context.Context, req *api.DeleteReminderRequest) error
+	listFn   func(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error)
 }
 
 func New() *Fake {
@@ -36,6 +37,9 @@ func New() *Fake {
 		deleteFn: func(ctx context.Context, req *api.DeleteReminderRequest) error {
 			return nil
 		},
+		listFn: func(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error) {
+			return nil, nil
+		},
 	}
 }
 
@@ -54,6 +58,11 @@ func (f *Fake) WithDelete(fn func(ctx context.Context, req *api.DeleteReminderRe
 	return f
 }
 
+func (f *Fake) WithList(fn func(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error)) *Fake {
+	f.listFn = fn
+	return f
+}
+
 func (f *Fake) Get(ctx context.Context, req *api.GetReminderRequest) (*api.Reminder, error) {
 	return f.getFn(ctx, req)
 }
@@ -65,3 +74,7 @@ func (f *Fake) Create(ctx context.Context, req *api.CreateReminderRequest) error
 func (f *Fake) Delete(ctx context.Context, req *api.DeleteReminderRequest) error {
 	return f.deleteFn(ctx, req)
 }
+
+func (f *Fake) List(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error) {
+	return f.listFn(ctx, req)
+}
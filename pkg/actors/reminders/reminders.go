@@ -34,6 +34,9 @@ type Interface interface {
 
 	// Delete deletes an actor reminder.
 	Delete(ctx context.Context, req *api.DeleteReminderRequest) error
+
+	// List lists the reminders of an actor type.
+	List(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error)
 }
 
 type Options struct {
@@ -76,3 +79,11 @@ func (r *reminders) Delete(ctx context.Context, req *api.DeleteReminderRequest)
 
 	return r.storage.Delete(ctx, req)
 }
+
+func (r *reminders) List(ctx context.Context, req *api.ListRemindersRequest) ([]*api.Reminder, error) {
+	if !r.table.IsActorTypeHosted(req.ActorType) {
+		return nil, ErrReminderOpActorNotHosted
+	}
+
+	return r.storage.List(ctx, req)
+}
@@ -15,6 +15,7 @@ package fake
 
 import (
 	"context"
+	"io"
 
 	"github.com/dapr/dapr/pkg/actors/api"
 	commonv1 "github.com/dapr/dapr/pkg/proto/common/v1"
@@ -25,6 +26,8 @@ type Fake struct {
 	callFn         func(context.Context, *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error)
 	callReminderFn func(context.Context, *api.Reminder) error
 	callStreamFn   func(ctx context.Context, req *internalv1pb.InternalInvokeRequest, stream chan<- *internalv1pb.InternalInvokeResponse) error
+
+	callStreamBodyFn func(ctx context.Context, req *internalv1pb.InternalInvokeRequest, body io.Reader, stream chan<- *internalv1pb.InternalInvokeResponse) error
 }
 
 func New() *Fake {
@@ -40,6 +43,9 @@ func New() *Fake {
 		callStreamFn: func(ctx context.Context, req *internalv1pb.InternalInvokeRequest, stream chan<- *internalv1pb.InternalInvokeResponse) error {
 			return nil
 		},
+		callStreamBodyFn: func(ctx context.Context, req *internalv1pb.InternalInvokeRequest, body io.Reader, stream chan<- *internalv1pb.InternalInvokeResponse) error {
+			return nil
+		},
 	}
 }
 
@@ -58,6 +64,11 @@ func (f *Fake) WithCallStreamFn(fn func(ctx context.Context, req *internalv1pb.I
 	return f
 }
 
+func (f *Fake) WithCallStreamBodyFn(fn func(ctx context.Context, req *internalv1pb.InternalInvokeRequest, body io.Reader, stream chan<- *internalv1pb.InternalInvokeResponse) error) *Fake {
+	f.callStreamBodyFn = fn
+	return f
+}
+
 func (f *Fake) Call(ctx context.Context, req *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
 	return f.callFn(ctx, req)
 }
@@ -69,3 +80,7 @@ func (f *Fake) CallReminder(ctx context.Context, reminder *api.Reminder) error {
 func (f *Fake) CallStream(ctx context.Context, req *internalv1pb.InternalInvokeRequest, stream chan<- *internalv1pb.InternalInvokeResponse) error {
 	return f.callStreamFn(ctx, req, stream)
 }
+
+func (f *Fake) CallStreamBody(ctx context.Context, req *internalv1pb.InternalInvokeRequest, body io.Reader, stream chan<- *internalv1pb.InternalInvokeResponse) error {
+	return f.callStreamBodyFn(ctx, req, body, stream)
+}
@@ -31,9 +31,12 @@ import (
 	"github.com/dapr/dapr/pkg/actors/internal/placement"
 	"github.com/dapr/dapr/pkg/actors/reminders"
 	"github.com/dapr/dapr/pkg/actors/table"
+	"github.com/dapr/dapr/pkg/actors/targets"
 	"github.com/dapr/dapr/pkg/api/grpc/manager"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagutils "github.com/dapr/dapr/pkg/diagnostics/utils"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 )
@@ -42,6 +45,7 @@ type Interface interface {
 	Call(ctx context.Context, req *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error)
 	CallReminder(ctx context.Context, reminder *api.Reminder) error
 	CallStream(ctx context.Context, req *internalv1pb.InternalInvokeRequest, stream chan<- *internalv1pb.InternalInvokeResponse) error
+	CallStreamBody(ctx context.Context, req *internalv1pb.InternalInvokeRequest, body io.Reader, stream chan<- *internalv1pb.InternalInvokeResponse) error
 }
 
 type Options struct {
@@ -139,6 +143,25 @@ func (r *router) CallStream(ctx context.Context, req *internalv1pb.InternalInvok
 	return err
 }
 
+// CallStreamBody invokes an actor method, streaming the request data from
+// body and the response to stream. As the request body can't be replayed, the
+// call is not retried.
+func (r *router) CallStreamBody(ctx context.Context, req *internalv1pb.InternalInvokeRequest, body io.Reader, stream chan<- *internalv1pb.InternalInvokeResponse) error {
+	err := r.callStreamBody(ctx, req, body, stream)
+	// Suppress EOF errors as this simply means the stream is closing.
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+
+	// Don't bubble perminant errors up to the caller to interfere with top level
+	// retries.
+	if _, ok := err.(*backoff.PermanentError); ok {
+		err = errors.Unwrap(err)
+	}
+
+	return err
+}
+
 func (r *router) callReminder(ctx context.Context, req *api.Reminder) error {
 	if !req.SkipLock {
 		var cancel context.CancelFunc
@@ -374,3 +397,138 @@ func (r *router) callRemoteActorStream(ctx context.Context,
 		}
 	}
 }
+
+func (r *router) callStreamBody(ctx context.Context, req *internalv1pb.InternalInvokeRequest, body io.Reader, stream chan<- *internalv1pb.InternalInvokeResponse) error {
+	ctx, pcancel, err := r.placement.Lock(ctx)
+	if err != nil {
+		return backoff.Permanent(err)
+	}
+	defer pcancel()
+
+	lar, err := r.placement.LookupActor(ctx, &api.LookupActorRequest{
+		ActorType: req.GetActor().GetActorType(),
+		ActorID:   req.GetActor().GetActorId(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !lar.Local {
+		// If this is a dapr-dapr call and the actor didn't pass the local check
+		// above, it means it has been moved in the meantime
+		if _, ok := req.GetMetadata()["X-Dapr-Remote"]; ok {
+			return backoff.Permanent(errors.New("remote actor moved"))
+		}
+
+		return r.callRemoteActorStreamBody(ctx, lar, req, body, stream)
+	}
+
+	target, err := r.table.GetOrCreate(req.GetActor().GetActorType(), req.GetActor().GetActorId())
+	if err != nil {
+		return err
+	}
+
+	streamer, ok := target.(targets.BodyStreamer)
+	if !ok {
+		return backoff.Permanent(fmt.Errorf("actor type %s does not support streaming request bodies", req.GetActor().GetActorType()))
+	}
+
+	return streamer.InvokeStreamBody(ctx, req, body, stream)
+}
+
+func (r *router) callRemoteActorStreamBody(ctx context.Context,
+	lar *api.LookupActorResponse,
+	req *internalv1pb.InternalInvokeRequest,
+	body io.Reader,
+	stream chan<- *internalv1pb.InternalInvokeResponse,
+) error {
+	conn, cancel, err := r.grpc.GetGRPCConnection(ctx, lar.Address, lar.AppID, r.namespace)
+	if err != nil {
+		return err
+	}
+	defer cancel(false)
+
+	span := diagutils.SpanFromContext(ctx)
+	ctx = diag.SpanContextToGRPCMetadata(ctx, span.SpanContext())
+	client := internalv1pb.NewServiceInvocationClient(conn)
+
+	ctx, ccancel := context.WithCancel(ctx)
+	defer ccancel()
+
+	rstream, err := client.CallActorStreamBody(ctx, r.callOptions...)
+	if err != nil {
+		return err
+	}
+
+	// Send the request body while the response is being received, as the actor
+	// may respond before it has read the whole request.
+	sendErr := make(chan error, 1)
+	go func() {
+		sendErr <- sendStreamBody(rstream, req, body)
+	}()
+
+	for {
+		resp, err := rstream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				// The response is complete, so report any error sending the
+				// request.
+				return <-sendErr
+			}
+			return err
+		}
+
+		select {
+		case stream <- resp:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// sendStreamBody sends the request to the stream, followed by the request data
+// read from body in chunks.
+func sendStreamBody(rstream internalv1pb.ServiceInvocation_CallActorStreamBodyClient, req *internalv1pb.InternalInvokeRequest, body io.Reader) error {
+	err := rstream.Send(&internalv1pb.InternalInvokeRequestStream{Request: req})
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+
+	if body != nil {
+		buf := invokev1.BufPool.Get().(*[]byte)
+		defer invokev1.BufPool.Put(buf)
+
+		var seq uint64
+		for {
+			n, rerr := body.Read(*buf)
+			if n > 0 {
+				err = rstream.Send(&internalv1pb.InternalInvokeRequestStream{
+					Payload: &commonv1pb.StreamPayload{
+						Data: (*buf)[:n],
+						Seq:  seq,
+					},
+				})
+				if errors.Is(err, io.EOF) {
+					// The error is returned by Recv on the receiving side.
+					return nil
+				}
+				if err != nil {
+					return fmt.Errorf("error sending request data: %w", err)
+				}
+				seq++
+			}
+			if errors.Is(rerr, io.EOF) {
+				break
+			}
+			if rerr != nil {
+				return fmt.Errorf("failed to read request data: %w", rerr)
+			}
+		}
+	}
+
+	if err = rstream.CloseSend(); err != nil {
+		return fmt.Errorf("failed to close the send direction of the stream: %w", err)
+	}
+
+	return nil
+}
@@ -18,12 +18,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 	"sync/atomic"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
+	"google.golang.org/protobuf/types/known/anypb"
 	"k8s.io/utils/clock"
 
 	"github.com/dapr/dapr/pkg/actors/api"
@@ -67,6 +69,35 @@ func (a *app) InvokeMethod(ctx context.Context, req *internalv1pb.InternalInvoke
 }
 
 func (a *app) doInvokeMethod(ctx context.Context, req *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
+	imRes, err := a.invokeApp(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer imRes.Close()
+
+	// Get the protobuf
+	res, err := imRes.ProtoWithData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response data: %w", err)
+	}
+
+	// The .NET SDK indicates Actor failure via a header instead of a bad response
+	if _, ok := res.GetHeaders()["X-Daprerrorresponseheader"]; ok {
+		return res, actorerrors.NewActorError(res)
+	}
+
+	// Allow stopping a recurring reminder or timer
+	if v := res.GetHeaders()["X-Daprremindercancel"]; v != nil && len(v.GetValues()) > 0 && strings.IsTruthy(v.GetValues()[0]) {
+		return res, actorerrors.ErrReminderCanceled
+	}
+
+	return res, nil
+}
+
+// invokeApp sends the actor method invocation to the app. If body is not nil,
+// the data of the request is read from it rather than from the request
+// message.
+func (a *app) invokeApp(ctx context.Context, req *internalv1pb.InternalInvokeRequest, body io.Reader) (*invokev1.InvokeMethodResponse, error) {
 	a.idleAt.Store(ptr.Of(a.clock.Now().Add(a.idleTimeout)))
 	a.idlerQueue.Enqueue(a)
 
@@ -76,6 +107,10 @@ func (a *app) doInvokeMethod(ctx context.Context, req *internalv1pb.InternalInvo
 	}
 	defer imReq.Close()
 
+	if body != nil {
+		imReq.WithRawData(body)
+	}
+
 	// Replace method to actors method.
 	msg := imReq.Message()
 	originalMethod := msg.GetMethod()
@@ -97,7 +132,12 @@ func (a *app) doInvokeMethod(ctx context.Context, req *internalv1pb.InternalInvo
 		return nil, fmt.Errorf("app channel for actor type %s is nil", a.actorType)
 	}
 
-	policyDef := a.resiliency.ActorPostLockPolicy(a.actorType, a.actorID)
+	// A streamed request body can't be replayed, so the request is sent as-is
+	// without applying the resiliency policy.
+	var policyDef *resiliency.PolicyDefinition
+	if body == nil {
+		policyDef = a.resiliency.ActorPostLockPolicy(a.actorType, a.actorID)
+	}
 
 	// If the request can be retried, we need to enable replaying
 	if policyDef != nil && policyDef.HasRetries() {
@@ -119,34 +159,19 @@ func (a *app) doInvokeMethod(ctx context.Context, req *internalv1pb.InternalInvo
 	if imRes == nil {
 		return nil, errors.New("error from actor service: response object is nil")
 	}
-	defer imRes.Close()
 
 	if imRes.Status().GetCode() == http.StatusNotFound {
+		imRes.Close()
 		return nil, backoff.Permanent(fmt.Errorf("actor method not found: %s", msg.GetMethod()))
 	}
 
 	if imRes.Status().GetCode() != http.StatusOK {
+		defer imRes.Close()
 		respData, _ := imRes.RawDataFull()
 		return nil, fmt.Errorf("error from actor service: (%d) %s", imRes.Status().GetCode(), string(respData))
 	}
 
-	// Get the protobuf
-	res, err := imRes.ProtoWithData()
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response data: %w", err)
-	}
-
-	// The .NET SDK indicates Actor failure via a header instead of a bad response
-	if _, ok := res.GetHeaders()["X-Daprerrorresponseheader"]; ok {
-		return res, actorerrors.NewActorError(res)
-	}
-
-	// Allow stopping a recurring reminder or timer
-	if v := res.GetHeaders()["X-Daprremindercancel"]; v != nil && len(v.GetValues()) > 0 && strings.IsTruthy(v.GetValues()[0]) {
-		return res, actorerrors.ErrReminderCanceled
-	}
-
-	return res, nil
+	return imRes, nil
 }
 
 func (a *app) InvokeReminder(ctx context.Context, reminder *api.Reminder) error {
@@ -274,6 +299,82 @@ func (a *app) ScheduledTime() time.Time {
 	return *a.idleAt.Load()
 }
 
-func (a *app) InvokeStream(context.Context, *internalv1pb.InternalInvokeRequest, chan<- *internalv1pb.InternalInvokeResponse) error {
-	return errors.New("not implemented")
+// InvokeStream invokes the actor method, sending the response to the stream.
+// The first message of the stream contains the status and headers of the
+// response, and the following messages each contain a chunk of the response
+// data. The actor is locked until the whole response has been sent.
+func (a *app) InvokeStream(ctx context.Context, req *internalv1pb.InternalInvokeRequest, stream chan<- *internalv1pb.InternalInvokeResponse) error {
+	return a.InvokeStreamBody(ctx, req, nil, stream)
+}
+
+// InvokeStreamBody is like InvokeStream, but the request data is read from
+// body. If body is nil, the data of the request message is used.
+func (a *app) InvokeStreamBody(ctx context.Context, req *internalv1pb.InternalInvokeRequest, body io.Reader, stream chan<- *internalv1pb.InternalInvokeResponse) error {
+	ctx, cancel, err := a.lock.LockRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	imRes, err := a.invokeApp(ctx, req, body)
+	if err != nil {
+		return err
+	}
+	defer imRes.Close()
+
+	// The .NET SDK indicates Actor failure via a header instead of a bad response
+	if _, ok := imRes.Headers()["X-Daprerrorresponseheader"]; ok {
+		res, err := imRes.ProtoWithData()
+		if err != nil {
+			return fmt.Errorf("failed to read response data: %w", err)
+		}
+		return actorerrors.NewActorError(res)
+	}
+
+	send := func(res *internalv1pb.InternalInvokeResponse) error {
+		select {
+		case stream <- res:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	err = send(&internalv1pb.InternalInvokeResponse{
+		Status:  imRes.Status(),
+		Headers: imRes.Headers(),
+		Message: &commonv1pb.InvokeResponse{
+			ContentType: imRes.ContentType(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	r := imRes.RawData()
+	if r == nil {
+		return nil
+	}
+
+	for {
+		// Each chunk is sent to the stream, so the buffer can't be re-used.
+		buf := make([]byte, invokev1.StreamBufferSize)
+		n, err := r.Read(buf)
+		if n > 0 {
+			serr := send(&internalv1pb.InternalInvokeResponse{
+				Message: &commonv1pb.InvokeResponse{
+					Data: &anypb.Any{Value: buf[:n]},
+				},
+			})
+			if serr != nil {
+				return serr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read response data: %w", err)
+		}
+	}
 }
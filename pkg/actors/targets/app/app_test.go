@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/actors/internal/reentrancystore"
+	"github.com/dapr/dapr/pkg/actors/targets"
+	"github.com/dapr/dapr/pkg/channel/fake"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+func Test_InvokeStream(t *testing.T) {
+	respData := bytes.Repeat([]byte("a"), invokev1.StreamBufferSize*2+10)
+
+	var calls atomic.Int32
+	fact := New(Options{
+		ActorType:   "myactor",
+		Reentrancy:  reentrancystore.New(),
+		Resiliency:  resiliency.NoOp{},
+		IdleTimeout: time.Second * 10,
+		AppChannel: fake.New().WithInvokeMethod(func(_ context.Context, req *invokev1.InvokeMethodRequest, _ string) (*invokev1.InvokeMethodResponse, error) {
+			calls.Add(1)
+			switch req.Message().GetMethod() {
+			case "actors/myactor/foo/method/echo":
+				assert.Equal(t, "PUT", req.Message().GetHttpExtension().GetVerb().String())
+				data, err := req.RawDataFull()
+				if err != nil {
+					return nil, err
+				}
+				return invokev1.NewInvokeMethodResponse(http.StatusOK, "", nil).
+					WithRawDataBytes(append(data, respData...)).
+					WithContentType("text/plain"), nil
+			case "actors/myactor/foo/method/fail":
+				return invokev1.NewInvokeMethodResponse(http.StatusInternalServerError, "", nil).
+					WithRawDataString("boom"), nil
+			default:
+				return invokev1.NewInvokeMethodResponse(http.StatusOK, "", nil), nil
+			}
+		}),
+	})
+	t.Cleanup(func() { require.NoError(t, fact.HaltAll(context.Background())) })
+
+	streamer, ok := fact.GetOrCreate("foo").(targets.BodyStreamer)
+	require.True(t, ok)
+
+	t.Run("response is streamed in chunks", func(t *testing.T) {
+		req := internalv1pb.NewInternalInvokeRequest("echo").WithActor("myactor", "foo")
+
+		stream := make(chan *internalv1pb.InternalInvokeResponse, 10)
+		require.NoError(t, streamer.InvokeStreamBody(t.Context(), req, strings.NewReader("hello"), stream))
+		close(stream)
+
+		first := <-stream
+		assert.Equal(t, int32(http.StatusOK), first.GetStatus().GetCode())
+		assert.Equal(t, "text/plain", first.GetMessage().GetContentType())
+		assert.Empty(t, first.GetMessage().GetData().GetValue())
+
+		var got []byte
+		var chunks int
+		for res := range stream {
+			assert.LessOrEqual(t, len(res.GetMessage().GetData().GetValue()), invokev1.StreamBufferSize)
+			got = append(got, res.GetMessage().GetData().GetValue()...)
+			chunks++
+		}
+		assert.Equal(t, 3, chunks)
+		assert.Equal(t, append([]byte("hello"), respData...), got)
+	})
+
+	t.Run("actor is locked until the response has been sent", func(t *testing.T) {
+		calls.Store(0)
+		req := internalv1pb.NewInternalInvokeRequest("echo").WithActor("myactor", "foo")
+
+		stream := make(chan *internalv1pb.InternalInvokeResponse)
+		errCh := make(chan error, 2)
+		go func() {
+			errCh <- streamer.InvokeStreamBody(t.Context(), req, nil, stream)
+		}()
+		<-stream
+
+		go func() {
+			_, err := fact.GetOrCreate("foo").InvokeMethod(t.Context(),
+				internalv1pb.NewInternalInvokeRequest("echo").WithActor("myactor", "foo"),
+			)
+			errCh <- err
+		}()
+
+		time.Sleep(time.Millisecond * 100)
+		assert.Equal(t, int32(1), calls.Load())
+
+		for range 3 {
+			<-stream
+		}
+		require.NoError(t, <-errCh)
+		require.NoError(t, <-errCh)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("error from the actor is returned", func(t *testing.T) {
+		req := internalv1pb.NewInternalInvokeRequest("fail").WithActor("myactor", "foo")
+
+		stream := make(chan *internalv1pb.InternalInvokeResponse, 1)
+		err := streamer.InvokeStreamBody(t.Context(), req, nil, stream)
+		require.ErrorContains(t, err, "error from actor service: (500) boom")
+		assert.Empty(t, stream)
+	})
+}
@@ -15,6 +15,7 @@ package targets
 
 import (
 	"context"
+	"io"
 
 	"github.com/dapr/dapr/pkg/actors/api"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
@@ -32,6 +33,13 @@ type Interface interface {
 	Deactivate(context.Context) error
 }
 
+// BodyStreamer is implemented by targets which can stream the body of a
+// method invocation request, rather than requiring it to be buffered in the
+// request message.
+type BodyStreamer interface {
+	InvokeStreamBody(ctx context.Context, req *internalv1pb.InternalInvokeRequest, body io.Reader, stream chan<- *internalv1pb.InternalInvokeResponse) error
+}
+
 type Factory interface {
 	GetOrCreate(string) Interface
 	Exists(string) bool
@@ -0,0 +1,128 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package index
+
+import (
+	"context"
+	"sync"
+
+	"github.com/dapr/dapr/pkg/actors"
+	"github.com/dapr/dapr/pkg/actors/internal/placement"
+	"github.com/dapr/dapr/pkg/actors/state"
+	"github.com/dapr/dapr/pkg/actors/targets"
+	"github.com/dapr/dapr/pkg/actors/targets/workflow/lock"
+)
+
+var indexCache = &sync.Pool{
+	New: func() any {
+		return &index{
+			lock: lock.New(),
+		}
+	},
+}
+
+type Options struct {
+	Actors actors.Interface
+
+	ActorType string
+}
+
+type factory struct {
+	actorType string
+
+	actorState state.Interface
+	placement  placement.Interface
+
+	table sync.Map
+	lock  sync.Mutex
+}
+
+func New(ctx context.Context, opts Options) (targets.Factory, error) {
+	astate, err := opts.Actors.State(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	placement, err := opts.Actors.Placement(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &factory{
+		actorType:  opts.ActorType,
+		actorState: astate,
+		placement:  placement,
+	}, nil
+}
+
+func (f *factory) GetOrCreate(actorID string) targets.Interface {
+	i, ok := f.table.Load(actorID)
+	if !ok {
+		newIndex := f.initIndex(indexCache.Get(), actorID)
+		var loaded bool
+		i, loaded = f.table.LoadOrStore(actorID, newIndex)
+		if loaded {
+			indexCache.Put(newIndex)
+		}
+	}
+
+	return i.(*index)
+}
+
+func (f *factory) initIndex(i any, actorID string) *index {
+	idx := i.(*index)
+
+	idx.factory = f
+	idx.actorID = actorID
+	// Reset the cached shard to force a reload from the state store
+	idx.shard = nil
+
+	return idx
+}
+
+func (f *factory) HaltAll(ctx context.Context) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.table.Range(func(key, val any) bool {
+		val.(*index).Deactivate(ctx)
+		return true
+	})
+	f.table.Clear()
+	return nil
+}
+
+func (f *factory) HaltNonHosted(ctx context.Context) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.table.Range(func(key, val any) bool {
+		if !f.placement.IsActorHosted(ctx, f.actorType, key.(string)) {
+			val.(*index).Deactivate(ctx)
+		}
+		return true
+	})
+	return nil
+}
+
+func (f *factory) Exists(actorID string) bool {
+	_, ok := f.table.Load(actorID)
+	return ok
+}
+
+func (f *factory) Len() int {
+	var count int
+	f.table.Range(func(_, _ any) bool { count++; return true })
+	return count
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package index
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	actorapi "github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/targets/workflow/lock"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.actors.targets.workflow.index")
+
+// index is the actor which owns a single shard of the workflow instance
+// index. Using an actor gives turn-based concurrency over the shard, so that
+// workflow actors spread across replicas can update it safely.
+type index struct {
+	*factory
+
+	actorID string
+	lock    *lock.Lock
+
+	shard *wfenginestate.Index
+}
+
+func (i *index) InvokeMethod(ctx context.Context, req *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error) {
+	unlock, err := i.lock.ContextLock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	shard, err := i.loadShard(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := req.GetMessage().GetData().GetValue()
+
+	var resData []byte
+	switch req.GetMessage().GetMethod() {
+	case todo.UpsertWorkflowIndexEntryMethod:
+		var entry internalsv1pb.WorkflowIndexEntry
+		if err = proto.Unmarshal(data, &entry); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal workflow index entry: %w", err)
+		}
+		if shard.Upsert(&entry) {
+			err = i.saveShard(ctx, shard)
+		}
+
+	case todo.DeleteWorkflowIndexEntryMethod:
+		if shard.Delete(string(data)) {
+			err = i.saveShard(ctx, shard)
+		}
+
+	case todo.QueryWorkflowIndexMethod:
+		var query internalsv1pb.WorkflowIndexQuery
+		if err = proto.Unmarshal(data, &query); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal workflow index query: %w", err)
+		}
+		resData, err = proto.Marshal(&internalsv1pb.WorkflowIndex{
+			Entries: shard.Query(&query),
+		})
+
+	default:
+		err = errors.New("unknown method: " + req.GetMessage().GetMethod())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &internalsv1pb.InternalInvokeResponse{
+		Status: &internalsv1pb.Status{
+			Code: http.StatusOK,
+		},
+		Message: &commonv1pb.InvokeResponse{
+			Data: &anypb.Any{
+				Value: resData,
+			},
+		},
+	}, nil
+}
+
+func (i *index) loadShard(ctx context.Context) (*wfenginestate.Index, error) {
+	if i.shard != nil {
+		return i.shard, nil
+	}
+
+	log.Debugf("Workflow index actor '%s': loading index shard", i.actorID)
+	shard, err := wfenginestate.LoadIndex(ctx, i.actorState, i.actorType, i.actorID)
+	if err != nil {
+		return nil, err
+	}
+	i.shard = shard
+	return shard, nil
+}
+
+func (i *index) saveShard(ctx context.Context, shard *wfenginestate.Index) error {
+	req, err := shard.GetSaveRequest()
+	if err != nil {
+		return err
+	}
+
+	if err = i.actorState.TransactionalStateOperation(ctx, true, req, false); err != nil {
+		// Drop the cached shard so that the next call reloads the persisted
+		// state rather than building on top of an unsaved change.
+		i.shard = nil
+		return err
+	}
+
+	return nil
+}
+
+func (i *index) InvokeReminder(ctx context.Context, reminder *actorapi.Reminder) error {
+	return errors.New("reminders are not implemented")
+}
+
+func (i *index) InvokeTimer(ctx context.Context, reminder *actorapi.Reminder) error {
+	return errors.New("timers are not implemented")
+}
+
+func (i *index) InvokeStream(ctx context.Context, req *internalsv1pb.InternalInvokeRequest, stream chan<- *internalsv1pb.InternalInvokeResponse) error {
+	return errors.New("not implemented")
+}
+
+func (i *index) Deactivate(ctx context.Context) error {
+	unlock, err := i.lock.ContextLock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	i.table.Delete(i.actorID)
+	i.shard = nil
+	indexCache.Put(i)
+	log.Debugf("Workflow index actor '%s': deactivated", i.actorID)
+	return nil
+}
+
+func (i *index) Key() string {
+	return i.actorType + actorapi.DaprSeparator + i.actorID
+}
+
+func (i *index) Type() string {
+	return i.actorType
+}
+
+func (i *index) ID() string {
+	return i.actorID
+}
@@ -46,6 +46,7 @@ type Options struct {
 	AppID             string
 	WorkflowActorType string
 	ActivityActorType string
+	IndexActorType    string
 	ReminderInterval  *time.Duration
 
 	Resiliency         resiliency.Provider
@@ -60,6 +61,7 @@ type factory struct {
 	appID             string
 	actorType         string
 	activityActorType string
+	indexActorType    string
 
 	resiliency       resiliency.Provider
 	router           router.Interface
@@ -108,6 +110,7 @@ func New(ctx context.Context, opts Options) (targets.Factory, error) {
 		appID:              opts.AppID,
 		actorType:          opts.WorkflowActorType,
 		activityActorType:  opts.ActivityActorType,
+		indexActorType:     opts.IndexActorType,
 		resiliency:         opts.Resiliency,
 		router:             router,
 		reminders:          reminders,
@@ -160,6 +163,7 @@ func (f *factory) initOrchestrator(o any, actorID string) *orchestrator {
 	or.state = nil
 	or.rstate = nil
 	or.ometa = nil
+	or.indexed = nil
 
 	return or
 }
@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+// importWorkflowInstance saves the state of an exported workflow instance as
+// the state of this workflow actor. As this runs in the actor's turn, the
+// instance can't be created or imported concurrently; the import fails if the
+// instance already exists with a different state. An instance which exists
+// with the imported state was saved by an import which failed to create its
+// reminders, so the import succeeds and the reminders are created again.
+func (o *orchestrator) importWorkflowInstance(ctx context.Context, request []byte) error {
+	var workflowState backend.WorkflowState
+	if err := proto.Unmarshal(request, &workflowState); err != nil {
+		return fmt.Errorf("failed to unmarshal workflow state: %w", err)
+	}
+
+	state, ometa, err := o.loadInternalState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if state != nil && proto.Equal(state.ToWorkflowState(), &workflowState) {
+		log.Debugf("Workflow actor '%s': instance was already imported with the same state", o.actorID)
+		return nil
+	}
+	if state != nil || ometa != nil {
+		return status.Errorf(codes.AlreadyExists, "workflow '%s' already exists", o.actorID)
+	}
+
+	newState := wfenginestate.NewState(wfenginestate.Options{
+		AppID:             o.appID,
+		WorkflowActorType: o.actorType,
+		ActivityActorType: o.activityActorType,
+	})
+	newState.FromWorkflowState(&workflowState)
+
+	if err = o.saveInternalState(ctx, newState); err != nil {
+		return fmt.Errorf("failed to save workflow state: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+)
+
+func Test_importWorkflowInstance(t *testing.T) {
+	imported := &backend.WorkflowState{
+		Inbox:   []*protos.HistoryEvent{{EventId: 1}},
+		History: []*protos.HistoryEvent{{EventId: 0}},
+	}
+	data, err := proto.Marshal(imported)
+	require.NoError(t, err)
+
+	existing := func(ws *backend.WorkflowState) *orchestrator {
+		state := wfenginestate.NewState(wfenginestate.Options{
+			AppID:             "appID",
+			WorkflowActorType: "workflow",
+			ActivityActorType: "activity",
+		})
+		state.FromWorkflowState(ws)
+		return &orchestrator{
+			factory: &factory{appID: "appID", actorType: "workflow", activityActorType: "activity"},
+			actorID: "abc",
+			state:   state,
+		}
+	}
+
+	t.Run("instance with the same state is imported again", func(t *testing.T) {
+		o := existing(imported)
+		require.NoError(t, o.importWorkflowInstance(t.Context(), data))
+	})
+
+	t.Run("instance with a different state already exists", func(t *testing.T) {
+		o := existing(&backend.WorkflowState{
+			History: []*protos.HistoryEvent{{EventId: 0}, {EventId: 1}},
+		})
+		err := o.importWorkflowInstance(t.Context(), data)
+		require.Error(t, err)
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orchestrator
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	wfenginestate "github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+)
+
+// updateIndex sends the current metadata of this workflow to its workflow
+// index shard, if it changed since the last update. As the last updated time
+// changes on every save, the index is updated on every save, so that it can
+// be filtered on the last updated time. The index is best effort: a failed
+// update is logged and retried on the next save.
+func (o *orchestrator) updateIndex(ctx context.Context) {
+	if o.indexActorType == "" || o.ometa == nil {
+		return
+	}
+
+	entry := &internalsv1pb.WorkflowIndexEntry{
+		InstanceId:    o.actorID,
+		Name:          o.ometa.GetName(),
+		RuntimeStatus: int32(o.ometa.GetRuntimeStatus()),
+		CreatedAt:     o.ometa.GetCreatedAt(),
+		LastUpdatedAt: o.ometa.GetLastUpdatedAt(),
+	}
+	if proto.Equal(o.indexed, entry) {
+		return
+	}
+
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		log.Warnf("Workflow actor '%s': failed to marshal workflow index entry: %s", o.actorID, err)
+		return
+	}
+
+	req := internalsv1pb.NewInternalInvokeRequest(todo.UpsertWorkflowIndexEntryMethod).
+		WithActor(o.indexActorType, wfenginestate.IndexShardID(o.actorID)).
+		WithData(data).
+		WithContentType(invokev1.ProtobufContentType)
+	if _, err = o.router.Call(ctx, req); err != nil {
+		log.Warnf("Workflow actor '%s': failed to update workflow index: %s", o.actorID, err)
+		return
+	}
+
+	o.indexed = entry
+}
+
+// removeFromIndex deletes this workflow from its workflow index shard.
+func (o *orchestrator) removeFromIndex(ctx context.Context) {
+	if o.indexActorType == "" {
+		return
+	}
+
+	req := internalsv1pb.NewInternalInvokeRequest(todo.DeleteWorkflowIndexEntryMethod).
+		WithActor(o.indexActorType, wfenginestate.IndexShardID(o.actorID)).
+		WithData([]byte(o.actorID)).
+		WithContentType(invokev1.OctetStreamContentType)
+	if _, err := o.router.Call(ctx, req); err != nil {
+		log.Warnf("Workflow actor '%s': failed to remove workflow from index: %s", o.actorID, err)
+		return
+	}
+
+	o.indexed = nil
+}
@@ -90,6 +90,9 @@ func (o *orchestrator) executeMethod(ctx context.Context, methodName string, req
 	case todo.RerunWorkflowInstance:
 		return nil, backoff.Permanent(o.rerunWorkflowInstanceRequest(ctx, request))
 
+	case todo.ImportWorkflowInstanceMethod:
+		return nil, backoff.Permanent(o.importWorkflowInstance(ctx, request))
+
 	default:
 		return nil, fmt.Errorf("no such method: %s", methodName)
 	}
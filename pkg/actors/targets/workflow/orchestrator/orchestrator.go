@@ -41,6 +41,7 @@ type orchestrator struct {
 	rstate           *backend.OrchestrationRuntimeState
 	ometa            *backend.OrchestrationMetadata
 	ometaBroadcaster *broadcaster.Broadcaster[*backend.OrchestrationMetadata]
+	indexed          *internalsv1pb.WorkflowIndexEntry
 
 	activityResultAwaited atomic.Bool
 	completed             atomic.Bool
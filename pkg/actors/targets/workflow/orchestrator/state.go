@@ -74,6 +74,7 @@ func (o *orchestrator) saveInternalState(ctx context.Context, state *wfenginesta
 	o.state = state
 	o.rstate = runtimestate.NewOrchestrationRuntimeState(o.actorID, state.CustomStatus, state.History)
 	o.setOrchestrationMetadata(o.rstate, o.getExecutionStartedEvent(state))
+	o.updateIndex(ctx)
 	return nil
 }
 
@@ -97,6 +98,7 @@ func (o *orchestrator) cleanupWorkflowStateInternal(ctx context.Context, state *
 		return err
 	}
 
+	o.removeFromIndex(ctx)
 	o.cleanup()
 
 	return nil
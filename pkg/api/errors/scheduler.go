@@ -80,6 +80,18 @@ func SchedulerListJobs(metadata map[string]string, err error) error {
 		Build()
 }
 
+func SchedulerListJobsContinuationToken(metadata map[string]string) error {
+	return kiterrors.NewBuilder(
+		codes.InvalidArgument,
+		http.StatusBadRequest,
+		"invalid continuation token for listing jobs",
+		"",
+		string(errorcodes.SchedulerListToken.Category),
+	).
+		WithErrorInfo(errorcodes.SchedulerListToken.Code, metadata).
+		Build()
+}
+
 func SchedulerDeleteJob(metadata map[string]string, err error) error {
 	return kiterrors.NewBuilder(
 		codes.Internal,
@@ -153,6 +153,50 @@ func (s *StateStoreError) QueryFailed(detail string) error {
 	)
 }
 
+/**** Re-encryption API ****/
+
+func (s *StateStoreError) NotEncrypted() error {
+	return s.build(
+		errors.NewBuilder(
+			codes.FailedPrecondition,
+			http.StatusBadRequest,
+			fmt.Sprintf("state store %s is not configured with encryption keys", s.name),
+			errorcodes.StateStoreNotEncrypted.Code,
+			string(errorcodes.StateStoreNotEncrypted.Category),
+		),
+		errorcodes.StateStoreNotEncrypted.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) ReEncryptKeysRequired(reason string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.FailedPrecondition,
+			http.StatusBadRequest,
+			fmt.Sprintf("state store %s can't enumerate the keys to re-encrypt (%s): the keys to re-encrypt must be given in the request", s.name, reason),
+			errorcodes.StateStoreQueryNotSupported.Code,
+			string(errorcodes.StateStoreQueryNotSupported.Category),
+		),
+		errorcodes.StateStoreQueryNotSupported.GrpcCode,
+		nil,
+	)
+}
+
+func (s *StateStoreError) ReEncryptFailed(detail string) error {
+	return s.build(
+		errors.NewBuilder(
+			codes.Internal,
+			http.StatusInternalServerError,
+			fmt.Sprintf("state store %s re-encryption failed: %s", s.name, detail),
+			errorcodes.StateReEncrypt.Code,
+			string(errorcodes.StateReEncrypt.Category),
+		),
+		errorcodes.StateReEncrypt.GrpcCode,
+		nil,
+	)
+}
+
 func (s *StateStoreError) build(err *errors.ErrorBuilder, errCode string, metadata map[string]string) error {
 	if !s.skipResourceInfo {
 		err = err.WithResourceInfo("state", s.name, "", "")
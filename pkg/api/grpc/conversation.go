@@ -0,0 +1,24 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+// ConverseStreamAlpha2 converses with a LLM component, streaming the chunks
+// of the response to the caller as they are generated.
+func (a *api) ConverseStreamAlpha2(req *runtimev1pb.ConversationRequestAlpha2, stream runtimev1pb.Dapr_ConverseStreamAlpha2Server) error { //nolint:nosnakecase
+	return a.Universal.ConverseStreamAlpha2(stream.Context(), req, stream.Send)
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"io"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -138,53 +139,7 @@ func (a *api) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStr
 	a.wg.Add(1)
 	go func() {
 		defer a.wg.Done()
-
-		var (
-			expectSeq uint64
-			readSeq   uint64
-			payload   *commonv1pb.StreamPayload
-			readErr   error
-		)
-		for {
-			if ctx.Err() != nil {
-				pw.CloseWithError(ctx.Err())
-				return
-			}
-
-			// Get the payload from the chunk that was previously read
-			payload = chunk.GetPayload()
-			if payload != nil {
-				readSeq, readErr = messaging.ReadChunk(payload, pw)
-				if readErr != nil {
-					pw.CloseWithError(readErr)
-					return
-				}
-
-				// Check if the sequence number is greater than the previous
-				if readSeq != expectSeq {
-					pw.CloseWithError(fmt.Errorf("invalid sequence number received: %d (expected: %d)", readSeq, expectSeq))
-					return
-				}
-				expectSeq++
-			}
-
-			// Read the next chunk
-			readErr = stream.RecvMsg(chunk)
-			if errors.Is(readErr, io.EOF) {
-				// Receiving an io.EOF signifies that the client has stopped sending data over the pipe, so we can stop reading
-				break
-			} else if readErr != nil {
-				pw.CloseWithError(fmt.Errorf("error receiving message: %w", readErr))
-				return
-			}
-
-			if chunk.GetRequest().GetMetadata() != nil || chunk.GetRequest().GetMessage() != nil {
-				pw.CloseWithError(errors.New("request metadata found in non-leading chunk"))
-				return
-			}
-		}
-
-		pw.Close()
+		readRequestStream(ctx, chunk, stream, pw)
 	}()
 
 	// Submit the request to the app
@@ -358,9 +313,63 @@ func (a *api) CallActorStream(req *internalv1pb.InternalInvokeRequest, stream in
 	).Run(stream.Context())
 }
 
+// CallActorStreamBody is a variant of CallActorStream in which the request
+// data is streamed in chunks following the leading request message.
+func (a *api) CallActorStreamBody(stream internalv1pb.ServiceInvocation_CallActorStreamBodyServer) error { //nolint:nosnakecase
+	router, err := a.ActorRouter(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	chunk := &internalv1pb.InternalInvokeRequestStream{}
+	if err = stream.RecvMsg(chunk); err != nil {
+		return err
+	}
+	req := chunk.GetRequest()
+	if req.GetMessage() == nil || req.GetActor() == nil {
+		return status.Errorf(codes.InvalidArgument, messages.ErrInternalInvokeRequest, "request does not contain the required fields in the leading chunk")
+	}
+
+	if req.Metadata == nil {
+		req.Metadata = make(map[string]*internalv1pb.ListStringValue)
+	}
+	req.Metadata["X-Dapr-Remote"] = &internalv1pb.ListStringValue{Values: []string{"true"}}
+
+	ctx := stream.Context()
+
+	// The request data is written to the pipe chunk-by-chunk as it is received.
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		readRequestStream(ctx, chunk, stream, pw)
+	}()
+
+	ch := make(chan *internalv1pb.InternalInvokeResponse)
+
+	return concurrency.NewRunnerManager(
+		func(ctx context.Context) error {
+			for {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case val := <-ch:
+					if err := stream.Send(val); err != nil {
+						return err
+					}
+				}
+			}
+		},
+		func(ctx context.Context) error {
+			return router.CallStreamBody(ctx, req, pr, ch)
+		},
+	).Run(ctx)
+}
+
 // Used by CallLocal and CallLocalStream to check the request against the access control list
 func (a *api) callLocalValidateACL(ctx context.Context, req *invokev1.InvokeMethodRequest) error {
-	if a.accessControlList != nil {
+	if accessControlList := a.accessControlList.Load(); accessControlList != nil {
 		// An access control policy has been specified for the app. Apply the policies.
 		operation := req.Message().GetMethod()
 		var httpVerb commonv1pb.HTTPExtension_Verb //nolint:nosnakecase
@@ -372,7 +381,7 @@ func (a *api) callLocalValidateACL(ctx context.Context, req *invokev1.InvokeMeth
 				httpVerb = httpExt.GetVerb()
 			}
 		}
-		callAllowed, errMsg := acl.ApplyAccessControlPolicies(ctx, operation, httpVerb, appProtocolIsHTTP, a.accessControlList)
+		callAllowed, errMsg := acl.ApplyAccessControlPolicies(ctx, operation, httpVerb, appProtocolIsHTTP, accessControlList)
 
 		if !callAllowed {
 			return status.Error(codes.PermissionDenied, errMsg)
@@ -403,3 +412,55 @@ func (a *api) callLocalRecordRequest(req *internalv1pb.InternalInvokeRequest) (c
 
 	return
 }
+
+// readRequestStream writes the payloads of the leading chunk and of the
+// following chunks received from the stream to pw, until the sender closes the
+// send direction of the stream.
+func readRequestStream(ctx context.Context, chunk *internalv1pb.InternalInvokeRequestStream, stream grpc.ServerStream, pw *io.PipeWriter) {
+	var (
+		expectSeq uint64
+		readSeq   uint64
+		payload   *commonv1pb.StreamPayload
+		readErr   error
+	)
+	for {
+		if ctx.Err() != nil {
+			pw.CloseWithError(ctx.Err())
+			return
+		}
+
+		// Get the payload from the chunk that was previously read
+		payload = chunk.GetPayload()
+		if payload != nil {
+			readSeq, readErr = messaging.ReadChunk(payload, pw)
+			if readErr != nil {
+				pw.CloseWithError(readErr)
+				return
+			}
+
+			// Check if the sequence number is greater than the previous
+			if readSeq != expectSeq {
+				pw.CloseWithError(fmt.Errorf("invalid sequence number received: %d (expected: %d)", readSeq, expectSeq))
+				return
+			}
+			expectSeq++
+		}
+
+		// Read the next chunk
+		readErr = stream.RecvMsg(chunk)
+		if errors.Is(readErr, io.EOF) {
+			// Receiving an io.EOF signifies that the client has stopped sending data over the pipe, so we can stop reading
+			break
+		} else if readErr != nil {
+			pw.CloseWithError(fmt.Errorf("error receiving message: %w", readErr))
+			return
+		}
+
+		if chunk.GetRequest().GetMetadata() != nil || chunk.GetRequest().GetMessage() != nil {
+			pw.CloseWithError(errors.New("request metadata found in non-leading chunk"))
+			return
+		}
+	}
+
+	pw.Close()
+}
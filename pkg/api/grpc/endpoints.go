@@ -17,6 +17,7 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"sync/atomic"
 
 	"google.golang.org/grpc"
 
@@ -40,6 +41,7 @@ var endpoints = map[string][]string{
 	},
 	"state.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/QueryStateAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ReEncryptStateAlpha1",
 	},
 	"publish.v1": {
 		daprRuntimePrefix + "v1.Dapr/PublishEvent",
@@ -104,6 +106,8 @@ var endpoints = map[string][]string{
 		daprRuntimePrefix + "v1.Dapr/PurgeWorkflowAlpha1",
 		daprRuntimePrefix + "v1.Dapr/PauseWorkflowAlpha1",
 		daprRuntimePrefix + "v1.Dapr/ResumeWorkflowAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ExportWorkflowsAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ImportWorkflowsAlpha1",
 	},
 	"workflows.v1beta1": {
 		daprRuntimePrefix + "v1.Dapr/StartWorkflowBeta1",
@@ -113,11 +117,13 @@ var endpoints = map[string][]string{
 		daprRuntimePrefix + "v1.Dapr/PurgeWorkflowBeta1",
 		daprRuntimePrefix + "v1.Dapr/PauseWorkflowBeta1",
 		daprRuntimePrefix + "v1.Dapr/ResumeWorkflowBeta1",
+		daprRuntimePrefix + "v1.Dapr/ListWorkflowsBeta1",
 	},
 	"jobs.v1alpha1": {
 		daprRuntimePrefix + "v1.Dapr/ScheduleJobAlpha1",
 		daprRuntimePrefix + "v1.Dapr/DeleteJobAlpha1",
 		daprRuntimePrefix + "v1.Dapr/GetJobAlpha1",
+		daprRuntimePrefix + "v1.Dapr/ListJobsAlpha1",
 	},
 	"shutdown.v1": {
 		daprRuntimePrefix + "v1.Dapr/Shutdown",
@@ -130,7 +136,52 @@ var endpoints = map[string][]string{
 	},
 	"conversation.v1alpha2": {
 		daprRuntimePrefix + "v1.Dapr/ConverseAlpha2",
+		daprRuntimePrefix + "v1.Dapr/ConverseStreamAlpha2",
+		daprRuntimePrefix + "v1.Dapr/GetConversationContextAlpha2",
+		daprRuntimePrefix + "v1.Dapr/DeleteConversationContextAlpha2",
 	},
+	"identity.v1alpha1": {
+		daprRuntimePrefix + "v1.Dapr/GetWorkloadJWTAlpha1",
+	},
+}
+
+// apiEndpointsMiddlewares are the middlewares compiled from an API spec.
+type apiEndpointsMiddlewares struct {
+	spec   *config.APISpec
+	unary  grpc.UnaryServerInterceptor
+	stream grpc.StreamServerInterceptor
+}
+
+// Returns the middlewares (unary and stream) for supporting an API allowlist
+// which may be swapped while the server is running. The middlewares are only
+// compiled again when the API spec has been swapped.
+func setReloadableAPIEndpointsMiddlewares(spec *config.Reloadable[config.APISpec]) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	var current atomic.Pointer[apiEndpointsMiddlewares]
+	load := func() *apiEndpointsMiddlewares {
+		s := spec.Load()
+		if m := current.Load(); m != nil && m.spec == s {
+			return m
+		}
+		m := &apiEndpointsMiddlewares{spec: s}
+		if s != nil {
+			m.unary, m.stream = setAPIEndpointsMiddlewares(s.Allowed, s.Denied)
+		}
+		current.Store(m)
+		return m
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if m := load(); m.unary != nil {
+				return m.unary(ctx, req, info, handler)
+			}
+			return handler(ctx, req)
+		},
+		func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if m := load(); m.stream != nil {
+				return m.stream(srv, stream, info, handler)
+			}
+			return handler(srv, stream)
+		}
 }
 
 // Returns the middlewares (unary and stream) for supporting API allowlist
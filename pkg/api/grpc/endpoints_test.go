@@ -459,3 +459,52 @@ func TestSetAPIEndpointsMiddleware(t *testing.T) {
 		}
 	})
 }
+
+func TestSetReloadableAPIEndpointsMiddleware(t *testing.T) {
+	spec := config.NewReloadable[config.APISpec](nil)
+	tm := testMiddleware(setReloadableAPIEndpointsMiddlewares(spec))
+
+	t.Run("no spec, all endpoints allowed", func(t *testing.T) {
+		for _, v := range endpoints {
+			for _, e := range v {
+				tm(t, e, false)
+			}
+		}
+	})
+
+	t.Run("spec swapped, only state.v1 endpoints allowed", func(t *testing.T) {
+		spec.Store(&config.APISpec{
+			Allowed: []config.APIAccessRule{
+				{
+					Name:     "state",
+					Version:  "v1",
+					Protocol: "grpc",
+				},
+			},
+		})
+
+		for k, v := range endpoints {
+			for _, e := range v {
+				tm(t, e, k != "state.v1")
+			}
+		}
+	})
+
+	t.Run("spec swapped, state.v1 endpoints denied", func(t *testing.T) {
+		spec.Store(&config.APISpec{
+			Denied: []config.APIAccessRule{
+				{
+					Name:     "state",
+					Version:  "v1",
+					Protocol: "grpc",
+				},
+			},
+		})
+
+		for k, v := range endpoints {
+			for _, e := range v {
+				tm(t, e, k == "state.v1")
+			}
+		}
+	})
+}
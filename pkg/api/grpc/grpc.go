@@ -95,7 +95,7 @@ type api struct {
 	outbox                outbox.Outbox
 	sendToOutputBindingFn func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
 	tracingSpec           config.TracingSpec
-	accessControlList     *config.AccessControlList
+	accessControlList     *config.Reloadable[config.AccessControlList]
 	processor             *processor.Processor
 	wg                    sync.WaitGroup
 
@@ -114,7 +114,7 @@ type APIOpts struct {
 	DirectMessaging       invokev1.DirectMessaging
 	SendToOutputBindingFn func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
 	TracingSpec           config.TracingSpec
-	AccessControlList     *config.AccessControlList
+	AccessControlList     *config.Reloadable[config.AccessControlList]
 	Processor             *processor.Processor
 }
 
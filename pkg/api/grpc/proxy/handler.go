@@ -151,8 +151,13 @@ func (s *handler) handler(srv any, serverStream grpc.ServerStream) error {
 		iter := counter.Add(1)
 
 		// If we're using streams, we need to replace ctx with serverStream.Context(), as ctx is canceled when this method returns
+		// The fallback target set by the circuit breaker is preserved
 		if isStream {
+			fallback := resiliency.GetFallback(ctx)
 			ctx = serverStream.Context()
+			if fallback != "" {
+				ctx = resiliency.WithFallback(ctx, fallback)
+			}
 		}
 
 		// We require that the director's returned context inherits from the server stream's context (directly or through ctx)
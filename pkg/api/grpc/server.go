@@ -64,7 +64,7 @@ type Options struct {
 	Config         ServerConfig
 	TracingSpec    config.TracingSpec
 	MetricSpec     config.MetricSpec
-	APISpec        config.APISpec
+	APISpec        *config.Reloadable[config.APISpec]
 	Proxy          messaging.Proxy
 	WorkflowEngine wfengine.Interface
 	Healthz        healthz.Healthz
@@ -91,7 +91,7 @@ type server struct {
 	infoLogger     logger.Logger
 	grpcServerOpts []grpcGo.ServerOption
 	authToken      string
-	apiSpec        config.APISpec
+	apiSpec        *config.Reloadable[config.APISpec]
 	proxy          messaging.Proxy
 	workflowEngine wfengine.Interface
 	sec            security.Handler
@@ -283,13 +283,15 @@ func (s *server) getMiddlewareOptions() []grpcGo.ServerOption {
 
 	intr = append(intr, metadata.SetMetadataInContextUnary)
 
-	if len(s.apiSpec.Allowed) > 0 || len(s.apiSpec.Denied) > 0 {
-		s.logger.Info("Enabled API access list on gRPC server")
-		unary, stream := setAPIEndpointsMiddlewares(s.apiSpec.Allowed, s.apiSpec.Denied)
-		if unary != nil && stream != nil {
-			intr = append(intr, unary)
-			intrStream = append(intrStream, stream)
+	// The API access list middlewares are always installed when an API spec is
+	// given, as the spec may be updated while the server is running.
+	if s.apiSpec != nil {
+		if spec := s.apiSpec.Load(); spec != nil && (len(spec.Allowed) > 0 || len(spec.Denied) > 0) {
+			s.logger.Info("Enabled API access list on gRPC server")
 		}
+		unary, stream := setReloadableAPIEndpointsMiddlewares(s.apiSpec)
+		intr = append(intr, unary)
+		intrStream = append(intrStream, stream)
 	}
 
 	if s.authToken != "" {
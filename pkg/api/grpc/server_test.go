@@ -65,7 +65,7 @@ func TestGetMiddlewareOptions(t *testing.T) {
 				SamplingRate: "0",
 			},
 			logger: logger.NewLogger("dapr.runtime.grpc.test"),
-			apiSpec: config.APISpec{
+			apiSpec: config.NewReloadable(&config.APISpec{
 				Allowed: []config.APIAccessRule{
 					{
 						Name:     "state",
@@ -73,7 +73,7 @@ func TestGetMiddlewareOptions(t *testing.T) {
 						Protocol: "grpc",
 					},
 				},
-			},
+			}),
 		}
 
 		serverOption := fakeServer.getMiddlewareOptions()
@@ -0,0 +1,52 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"errors"
+	"io"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+// ExportWorkflowsAlpha1 streams the workflow instances matching the request,
+// so they can be imported into another state store with ImportWorkflowsAlpha1.
+func (a *api) ExportWorkflowsAlpha1(in *runtimev1pb.ExportWorkflowsRequest, stream runtimev1pb.Dapr_ExportWorkflowsAlpha1Server) error {
+	return a.Universal.ExportWorkflows(stream.Context(), in, stream.Send)
+}
+
+// ImportWorkflowsAlpha1 imports the workflow instances sent on the stream.
+// Instances which already exist are skipped.
+func (a *api) ImportWorkflowsAlpha1(stream runtimev1pb.Dapr_ImportWorkflowsAlpha1Server) error {
+	res := &runtimev1pb.ImportWorkflowsResponse{}
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(res)
+		}
+		if err != nil {
+			return err
+		}
+
+		imported, err := a.Universal.ImportWorkflow(stream.Context(), req.GetWorkflow())
+		if err != nil {
+			return err
+		}
+		if imported {
+			res.Imported++
+		} else {
+			res.SkippedInstanceIds = append(res.SkippedInstanceIds, req.GetWorkflow().GetInstanceId())
+		}
+	}
+}
@@ -106,6 +106,21 @@ func (a *api) constructActorEndpoints() []endpoints.Endpoint {
 				Name: "InvokeActor",
 			},
 		},
+		{
+			Methods: []string{http.MethodGet, http.MethodPost, http.MethodDelete, http.MethodPut},
+			Route:   "actors/{actorType}/{actorId}/stream/{method}",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupActors,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: appendActorInvocationSpanAttributesFn,
+				MethodName:           methodNameFn,
+			},
+			Handler: a.onDirectActorStream,
+			Settings: endpoints.EndpointSettings{
+				Name: "InvokeActorStream",
+			},
+		},
 		{
 			Methods: []string{http.MethodGet},
 			Route:   "actors/{actorType}/{actorId}/state/{key}",
@@ -361,7 +376,7 @@ func (a *api) onDirectActorMessage(w http.ResponseWriter, r *http.Request) {
 	verb := strings.ToUpper(r.Method)
 	method := chi.URLParamFromCtx(ctx, methodParam)
 
-	// Actor invocation doesn't support streaming, so we need to read the entire reqBody
+	// This endpoint buffers the entire reqBody; onDirectActorStream streams it instead
 	reqBody, err := io.ReadAll(r.Body)
 	if err != nil {
 		msg := messages.ErrBadRequest.WithFormat("failed to read body: " + err.Error())
@@ -387,26 +402,7 @@ func (a *api) onDirectActorMessage(w http.ResponseWriter, r *http.Request) {
 		return router.Call(ctx, req)
 	})
 	if err != nil {
-		if merr, ok := err.(messages.APIError); ok {
-			respondWithError(w, merr)
-			log.Debug(merr)
-			return
-		}
-
-		actorErr, isActorError := actorerrors.As(err)
-		if !isActorError {
-			msg := messages.ErrActorInvoke.WithFormat(err)
-			respondWithError(w, msg)
-			log.Debug(msg)
-			return
-		}
-		// Use Add to ensure headers are appended and not replaced
-		h := w.Header()
-		invokev1.InternalMetadataToHTTPHeader(ctx, actorErr.Headers(), h.Add)
-		h.Set(headerContentType, actorErr.ContentType())
-
-		// Construct response
-		respondWithData(w, actorErr.StatusCode(), actorErr.Body())
+		respondWithActorInvokeError(ctx, w, err)
 		return
 	}
 
@@ -432,6 +428,111 @@ func (a *api) onDirectActorMessage(w http.ResponseWriter, r *http.Request) {
 	respondWithData(w, statusCode, res.GetMessage().GetData().GetValue())
 }
 
+// Route: "v1.0-alpha1/actors/{actorType}/{actorId}/stream/{method}"
+// Variant of onDirectActorMessage which streams the request and response
+// bodies to and from the actor, rather than buffering them.
+func (a *api) onDirectActorStream(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	router, err := a.universal.ActorRouter(ctx)
+	if err != nil {
+		respondWithError(w, err)
+		return
+	}
+
+	actorType := chi.URLParamFromCtx(ctx, actorTypeParam)
+	actorID := chi.URLParamFromCtx(ctx, actorIDParam)
+	verb := strings.ToUpper(r.Method)
+	method := chi.URLParamFromCtx(ctx, methodParam)
+
+	r.Header.Add("Dapr-API-Call", "true")
+	req := internalsv1pb.NewInternalInvokeRequest(method).
+		WithActor(actorType, actorID).
+		WithHTTPExtension(verb, r.URL.RawQuery).
+		WithContentType(r.Header.Get("content-type")).
+		// Save headers to internal metadata
+		WithHTTPHeaders(r.Header)
+
+	ch := make(chan *internalsv1pb.InternalInvokeResponse)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- router.CallStreamBody(ctx, req, r.Body, ch)
+	}()
+
+	flusher, _ := w.(http.Flusher)
+	var wroteHeader bool
+	for {
+		select {
+		case res := <-ch:
+			if !wroteHeader {
+				// Use Add to ensure headers are appended and not replaced
+				h := w.Header()
+				invokev1.InternalMetadataToHTTPHeader(ctx, res.GetHeaders(), h.Add)
+				h.Set(headerContentType, res.GetMessage().GetContentType())
+
+				statusCode := int(res.GetStatus().GetCode())
+				if !res.IsHTTPResponse() {
+					//nolint:gosec
+					statusCode = invokev1.HTTPStatusFromCode(codes.Code(statusCode))
+				}
+				w.WriteHeader(statusCode)
+				wroteHeader = true
+			}
+
+			if data := res.GetMessage().GetData().GetValue(); len(data) > 0 {
+				if _, err = w.Write(data); err != nil {
+					log.Debugf("Failed to write actor response: %v", err)
+					cancel()
+					<-errCh
+					return
+				}
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+
+		case err = <-errCh:
+			switch {
+			case err != nil && !wroteHeader:
+				respondWithActorInvokeError(ctx, w, err)
+			case err != nil:
+				// The status code has already been sent, so the client detects the
+				// failure from the truncated stream.
+				log.Errorf("Failed to stream actor response: %v", err)
+			case !wroteHeader:
+				respondWithEmpty(w)
+			}
+			return
+		}
+	}
+}
+
+// respondWithActorInvokeError responds with the error of an actor invocation.
+// Errors returned by the actor are sent to the caller as-is.
+func respondWithActorInvokeError(ctx context.Context, w http.ResponseWriter, err error) {
+	if merr, ok := err.(messages.APIError); ok {
+		respondWithError(w, merr)
+		log.Debug(merr)
+		return
+	}
+
+	actorErr, isActorError := actorerrors.As(err)
+	if !isActorError {
+		msg := messages.ErrActorInvoke.WithFormat(err)
+		respondWithError(w, msg)
+		log.Debug(msg)
+		return
+	}
+	// Use Add to ensure headers are appended and not replaced
+	h := w.Header()
+	invokev1.InternalMetadataToHTTPHeader(ctx, actorErr.Headers(), h.Add)
+	h.Set(headerContentType, actorErr.ContentType())
+
+	// Construct response
+	respondWithData(w, actorErr.StatusCode(), actorErr.Body())
+}
+
 func (a *api) onGetActorState(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -14,14 +14,20 @@ limitations under the License.
 package http
 
 import (
+	"io"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/messages"
+	"github.com/dapr/dapr/pkg/messages/errorcodes"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 )
 
+const eventStreamContentType = "text/event-stream"
+
 func (a *api) constructConversationEndpoints() []endpoints.Endpoint {
 	return []endpoints.Endpoint{
 		{
@@ -52,6 +58,48 @@ func (a *api) constructConversationEndpoints() []endpoints.Endpoint {
 				Name: "Converse",
 			},
 		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "conversation/{name}/converse/stream",
+			Version: apiVersionV1alpha2,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupConversation,
+				Version:              endpoints.EndpointGroupVersion1alpha2,
+				AppendSpanAttributes: nil,
+			},
+			Handler: a.onConverseStreamAlpha2,
+			Settings: endpoints.EndpointSettings{
+				Name: "ConverseStream",
+			},
+		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "conversation/{name}/contexts/{contextId}",
+			Version: apiVersionV1alpha2,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupConversation,
+				Version:              endpoints.EndpointGroupVersion1alpha2,
+				AppendSpanAttributes: nil,
+			},
+			Handler: a.onGetConversationContextAlpha2(),
+			Settings: endpoints.EndpointSettings{
+				Name: "GetConversationContext",
+			},
+		},
+		{
+			Methods: []string{http.MethodDelete},
+			Route:   "conversation/{name}/contexts/{contextId}",
+			Version: apiVersionV1alpha2,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupConversation,
+				Version:              endpoints.EndpointGroupVersion1alpha2,
+				AppendSpanAttributes: nil,
+			},
+			Handler: a.onDeleteConversationContextAlpha2(),
+			Settings: endpoints.EndpointSettings{
+				Name: "DeleteConversationContext",
+			},
+		},
 	}
 }
 
@@ -78,3 +126,109 @@ func (a *api) onConverseAlpha2() http.HandlerFunc {
 		},
 	)
 }
+
+func (a *api) onGetConversationContextAlpha2() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.GetConversationContextAlpha2,
+		UniversalHTTPHandlerOpts[*runtimev1pb.GetConversationContextRequestAlpha2, *runtimev1pb.GetConversationContextResponseAlpha2]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.GetConversationContextRequestAlpha2) (*runtimev1pb.GetConversationContextRequestAlpha2, error) {
+				in.Name = chi.URLParam(r, nameParam)
+				in.ContextId = chi.URLParam(r, contextIDParam)
+				return in, nil
+			},
+		},
+	)
+}
+
+func (a *api) onDeleteConversationContextAlpha2() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.DeleteConversationContextAlpha2,
+		UniversalHTTPHandlerOpts[*runtimev1pb.DeleteConversationContextRequestAlpha2, *runtimev1pb.DeleteConversationContextResponseAlpha2]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.DeleteConversationContextRequestAlpha2) (*runtimev1pb.DeleteConversationContextRequestAlpha2, error) {
+				in.Name = chi.URLParam(r, nameParam)
+				in.ContextId = chi.URLParam(r, contextIDParam)
+				return in, nil
+			},
+			OutModifier: func(out *runtimev1pb.DeleteConversationContextResponseAlpha2) (any, error) {
+				// Nullify the response so status code is 204
+				return nil, nil
+			},
+		},
+	)
+}
+
+// Route: POST "conversation/{name}/converse/stream"
+// The response is a stream of server-sent events, each holding a JSON
+// ConversationStreamResponseAlpha2. Errors which occur once the stream has
+// started are sent as an "error" event.
+func (a *api) onConverseStreamAlpha2(w http.ResponseWriter, r *http.Request) {
+	in := &runtimev1pb.ConversationRequestAlpha2{}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		msg := messages.ErrBodyRead.WithFormat(err)
+		respondWithError(w, msg)
+		log.Debug(msg)
+		return
+	}
+	if len(body) > 0 {
+		err = protojson.UnmarshalOptions{DiscardUnknown: true, AllowPartial: true}.Unmarshal(body, in)
+		if err != nil {
+			msg := messages.ErrMalformedRequest.WithFormat(err)
+			respondWithError(w, msg)
+			log.Debug(msg)
+			return
+		}
+	}
+	in.Name = chi.URLParam(r, nameParam)
+
+	flusher, _ := w.(http.Flusher)
+	var wroteHeader bool
+	writeEvent := func(event string, data []byte) error {
+		if !wroteHeader {
+			w.Header().Set(headerContentType, eventStreamContentType)
+			w.Header().Set("Cache-Control", "no-cache")
+			w.WriteHeader(http.StatusOK)
+			wroteHeader = true
+		}
+		var b []byte
+		if event != "" {
+			b = append(b, "event: "+event+"\n"...)
+		}
+		b = append(b, "data: "...)
+		b = append(b, data...)
+		b = append(b, "\n\n"...)
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	}
+
+	err = a.universal.ConverseStreamAlpha2(r.Context(), in, func(res *runtimev1pb.ConversationStreamResponseAlpha2) error {
+		b, err := protojson.Marshal(res)
+		if err != nil {
+			return err
+		}
+		return writeEvent("", b)
+	})
+	switch {
+	case err != nil && !wroteHeader:
+		respondWithError(w, err)
+	case err != nil:
+		// The status code has already been sent, so the error is sent to the
+		// client as an event instead.
+		var data []byte
+		if apiErr, ok := err.(messages.APIError); ok {
+			data = apiErr.JSONErrorValue()
+		} else {
+			data = NewErrorResponse(errorcodes.CommonGeneric, err.Error()).JSONErrorValue()
+		}
+		if wErr := writeEvent("error", data); wErr != nil {
+			log.Debugf("Failed to send conversation stream error: %v", wErr)
+		}
+	}
+}
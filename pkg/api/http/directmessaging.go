@@ -386,8 +386,8 @@ func (a *api) isHTTPEndpoint(appID string) bool {
 // It returns the baseURL if found.
 func (a *api) getBaseURL(targetAppID string) string {
 	endpoint, ok := a.universal.CompStore().GetHTTPEndpoint(targetAppID)
-	if ok && endpoint.Name == targetAppID {
-		return endpoint.Spec.BaseURL
+	if urls := endpoint.AllBaseURLs(); ok && endpoint.Name == targetAppID && len(urls) > 0 {
+		return urls[0]
 	}
 	return ""
 }
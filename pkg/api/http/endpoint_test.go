@@ -454,7 +454,7 @@ func TestAPIAllowlist(t *testing.T) {
 
 	t.Run("allowlist router handler mismatch protocol, all handlers exist", func(t *testing.T) {
 		s := server{
-			apiSpec: config.APISpec{
+			apiSpec: config.NewReloadable(&config.APISpec{
 				Allowed: []config.APIAccessRule{
 					{
 						Name:     "state",
@@ -462,7 +462,7 @@ func TestAPIAllowlist(t *testing.T) {
 						Protocol: "grpc",
 					},
 				},
-			},
+			}),
 		}
 
 		a := &api{}
@@ -482,7 +482,7 @@ func TestAPIAllowlist(t *testing.T) {
 
 	t.Run("denylist router handler mismatch protocol, all handlers exist", func(t *testing.T) {
 		s := server{
-			apiSpec: config.APISpec{
+			apiSpec: config.NewReloadable(&config.APISpec{
 				Denied: []config.APIAccessRule{
 					{
 						Name:     "state",
@@ -490,7 +490,7 @@ func TestAPIAllowlist(t *testing.T) {
 						Protocol: "grpc",
 					},
 				},
-			},
+			}),
 		}
 
 		a := &api{}
@@ -508,9 +508,9 @@ func TestAPIAllowlist(t *testing.T) {
 		}
 	})
 
-	t.Run("router handler rules applied, only allowed handlers exist", func(t *testing.T) {
+	t.Run("router handler rules applied, only allowed handlers are served", func(t *testing.T) {
 		s := server{
-			apiSpec: config.APISpec{
+			apiSpec: config.NewReloadable(&config.APISpec{
 				Allowed: []config.APIAccessRule{
 					{
 						Version:  "v1",
@@ -518,7 +518,7 @@ func TestAPIAllowlist(t *testing.T) {
 						Protocol: "http",
 					},
 				},
-			},
+			}),
 		}
 
 		a := &api{}
@@ -531,12 +531,13 @@ func TestAPIAllowlist(t *testing.T) {
 			path := fmt.Sprintf("/%s/%s", e.Version, e.Route)
 			for _, m := range e.Methods {
 				ok := router.Match(chi.NewRouteContext(), m, path)
+				assert.True(t, ok)
+			}
 
-				if strings.Index(e.Route, "state") == 0 {
-					assert.True(t, ok)
-				} else {
-					assert.False(t, ok)
-				}
+			if strings.Index(e.Route, "state") == 0 {
+				assert.True(t, s.isEndpointAllowed(e))
+			} else {
+				assert.False(t, s.isEndpointAllowed(e))
 			}
 		}
 	})
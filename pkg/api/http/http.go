@@ -97,6 +97,7 @@ const (
 	secretStoreNameParam     = "secretStoreName"
 	secretNameParam          = "key"
 	nameParam                = "name"
+	contextIDParam           = "contextId"
 	workflowComponent        = "workflowComponent"
 	workflowName             = "workflowName"
 	instanceID               = "instanceID"
@@ -159,6 +160,7 @@ func NewAPI(opts APIOpts) API {
 	api.endpoints = append(api.endpoints, api.constructWorkflowEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructJobsEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructConversationEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructIdentityEndpoints()...)
 
 	api.publicEndpoints = append(api.publicEndpoints, metadataEndpoints...)
 	api.publicEndpoints = append(api.publicEndpoints, healthEndpoints...)
@@ -255,6 +257,20 @@ func (a *api) constructStateEndpoints() []endpoints.Endpoint {
 				Name: "QueryStateAlpha1",
 			},
 		},
+		{
+			Methods: []string{nethttp.MethodPost},
+			Route:   "state/{storeName}/reencrypt",
+			Version: apiVersionV1alpha1,
+			Group: &endpoints.EndpointGroup{
+				Name:                 endpoints.EndpointGroupState,
+				Version:              endpoints.EndpointGroupVersion1alpha1,
+				AppendSpanAttributes: appendStateSpanAttributes,
+			},
+			Handler: a.onReEncryptStateHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "ReEncryptStateAlpha1",
+			},
+		},
 	}
 }
 
@@ -1696,3 +1712,30 @@ func (a *api) onQueryStateHandler() nethttp.HandlerFunc {
 		},
 	)
 }
+
+func (a *api) onReEncryptStateHandler() nethttp.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.ReEncryptStateAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.ReEncryptStateRequest, *runtimev1pb.ReEncryptStateResponse]{
+			// The optional body lists the keys to re-encrypt.
+			InModifier: func(r *nethttp.Request, in *runtimev1pb.ReEncryptStateRequest) (*runtimev1pb.ReEncryptStateRequest, error) {
+				in.StoreName = chi.URLParam(r, storeNameParam)
+				in.Metadata = getMetadataFromRequest(r)
+
+				qs := r.URL.Query()
+				if ps := qs.Get("pageSize"); ps != "" {
+					pageSize, err := strconv.ParseUint(ps, 10, 32)
+					if err != nil {
+						return nil, messages.ErrBadRequest.WithFormat("invalid pageSize: " + err.Error())
+					}
+					pageSize32 := uint32(pageSize)
+					in.PageSize = &pageSize32
+				}
+				if ct := qs.Get("continuationToken"); ct != "" {
+					in.ContinuationToken = &ct
+				}
+				return in, nil
+			},
+		},
+	)
+}
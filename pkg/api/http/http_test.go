@@ -34,6 +34,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	apiextensionsV1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -73,16 +76,19 @@ import (
 	"github.com/dapr/dapr/pkg/middleware"
 	middlewarehttp "github.com/dapr/dapr/pkg/middleware/http"
 	outboxfake "github.com/dapr/dapr/pkg/outbox/fake"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	runtimePubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	backendactors "github.com/dapr/dapr/pkg/runtime/wfengine/backends/actors"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/fake"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	testtrace "github.com/dapr/dapr/pkg/testing/trace"
 	"github.com/dapr/dapr/utils"
+	"github.com/dapr/durabletask-go/api/protos"
 	"github.com/dapr/kit/logger"
 	"github.com/dapr/kit/ptr"
 )
@@ -1538,6 +1544,61 @@ func TestV1ActorEndpoints(t *testing.T) {
 		assert.Equal(t, "ERR_ACTOR_INVOKE_METHOD", resp.ErrorBody["errorCode"])
 	})
 
+	t.Run("Direct Message Stream - streams request and response", func(t *testing.T) {
+		apiPath := "v1.0-alpha1/actors/fakeActorType/fakeActorID/stream/method1"
+
+		actors.WithRouter(func(context.Context) (router.Interface, error) {
+			return routerfake.New().WithCallStreamBodyFn(func(ctx context.Context, req *internalsv1pb.InternalInvokeRequest, body io.Reader, stream chan<- *internalsv1pb.InternalInvokeResponse) error {
+				assert.Equal(t, "method1", req.GetMessage().GetMethod())
+				assert.Empty(t, req.GetMessage().GetData().GetValue())
+				data, err := io.ReadAll(body)
+				if err != nil {
+					return err
+				}
+
+				stream <- &internalsv1pb.InternalInvokeResponse{
+					Status: &internalsv1pb.Status{Code: nethttp.StatusOK},
+					Headers: map[string]*internalsv1pb.ListStringValue{
+						"X-Foo": {Values: []string{"bar"}},
+					},
+					Message: &commonv1pb.InvokeResponse{ContentType: "text/plain"},
+				}
+				for _, chunk := range [][]byte{data, []byte("-chunk")} {
+					stream <- &internalsv1pb.InternalInvokeResponse{
+						Message: &commonv1pb.InvokeResponse{Data: &anypb.Any{Value: chunk}},
+					}
+				}
+				return nil
+			}), nil
+		})
+
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, []byte("fakeData"), nil)
+
+		// assert
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "fakeData-chunk", string(resp.RawBody))
+		assert.Equal(t, "text/plain", resp.ContentType)
+		assert.Equal(t, "bar", resp.RawHeader.Get("X-Foo"))
+	})
+
+	t.Run("Direct Message Stream - 500 for actor call failure", func(t *testing.T) {
+		apiPath := "v1.0-alpha1/actors/fakeActorType/fakeActorID/stream/method1"
+
+		actors.WithRouter(func(context.Context) (router.Interface, error) {
+			return routerfake.New().WithCallStreamBodyFn(func(context.Context, *internalsv1pb.InternalInvokeRequest, io.Reader, chan<- *internalsv1pb.InternalInvokeResponse) error {
+				return errors.New("UPSTREAM_ERROR")
+			}), nil
+		})
+
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, []byte("fakeData"), nil)
+
+		// assert
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_ACTOR_INVOKE_METHOD", resp.ErrorBody["errorCode"])
+	})
+
 	fakeServer.Shutdown()
 }
 
@@ -1653,7 +1714,7 @@ func TestV1MetadataEndpoint(t *testing.T) {
 		assert.Equal(t, 204, resp.StatusCode)
 	})
 
-	const expectedBody = `{"id":"xyz","runtimeVersion":"edge","extended":{"daprRuntimeVersion":"edge","foo":"bar","test":"value"},"subscriptions":[{"pubsubname":"test","topic":"topic","rules":[{"path":"path"}],"deadLetterTopic":"dead","type":"PROGRAMMATIC"}],"httpEndpoints":[{"name":"MockHTTPEndpoint"}],"appConnectionProperties":{"port":5000,"protocol":"http","channelAddress":"1.2.3.4","maxConcurrency":10,"health":{"healthCheckPath":"/healthz","healthProbeInterval":"10s","healthProbeTimeout":"5s","healthThreshold":3}},"actorRuntime":{"runtimeStatus":"INITIALIZING","hostReady":false},"components":[{"name":"MockComponent1Name","type":"mock.component1Type","version":"v1.0","capabilities":["mock.feat.MockComponent1Name"]},{"name":"MockComponent2Name","type":"mock.component2Type","version":"v1.0","capabilities":["mock.feat.MockComponent2Name"]}]}`
+	const expectedBody = `{"id":"xyz","runtimeVersion":"edge","extended":{"daprRuntimeVersion":"edge","foo":"bar","test":"value"},"subscriptions":[{"pubsubname":"test","topic":"topic","rules":[{"path":"path"}],"deadLetterTopic":"dead","type":"PROGRAMMATIC"}],"httpEndpoints":[{"name":"MockHTTPEndpoint","baseUrls":[{"url":"api.test.com","healthy":true}]}],"appConnectionProperties":{"port":5000,"protocol":"http","channelAddress":"1.2.3.4","maxConcurrency":10,"health":{"healthCheckPath":"/healthz","healthProbeInterval":"10s","healthProbeTimeout":"5s","healthThreshold":3}},"actorRuntime":{"runtimeStatus":"INITIALIZING","hostReady":false},"components":[{"name":"MockComponent1Name","type":"mock.component1Type","version":"v1.0","capabilities":["mock.feat.MockComponent1Name"]},{"name":"MockComponent2Name","type":"mock.component2Type","version":"v1.0","capabilities":["mock.feat.MockComponent2Name"]}]}`
 
 	t.Run("Get Metadata", func(t *testing.T) {
 		var called atomic.Int64
@@ -2594,6 +2655,71 @@ func TestV1Workflow(t *testing.T) {
 		assert.Nil(t, resp.ErrorBody)
 	})
 
+	/////////////////////
+	// LIST API TESTS ///
+	/////////////////////
+
+	t.Run("List with valid api call", func(t *testing.T) {
+		created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		var got *backendactors.ListInstancesRequest
+		wf.WithListInstances(func(_ context.Context, req *backendactors.ListInstancesRequest) (*backendactors.ListInstancesResponse, error) {
+			got = req
+			return &backendactors.ListInstancesResponse{
+				Instances: []*internalsv1pb.WorkflowIndexEntry{{
+					InstanceId:    "instance1",
+					Name:          "wf1",
+					CreatedAt:     timestamppb.New(created),
+					LastUpdatedAt: timestamppb.New(created),
+					RuntimeStatus: int32(protos.OrchestrationStatus_ORCHESTRATION_STATUS_RUNNING),
+				}},
+				ContinuationToken: "next",
+			}, nil
+		})
+
+		apiPath := "v1.0-beta1/workflows/dapr?workflowName=wf1&runtimeStatus=RUNNING,completed&pageSize=1&continuationToken=prev&createdTimeFrom=2025-01-01T00:00:00Z"
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+		require.Equal(t, 200, resp.StatusCode, string(resp.RawBody))
+
+		require.NotNil(t, got)
+		assert.Equal(t, "wf1", got.Query.GetName())
+		assert.Equal(t, []int32{
+			int32(protos.OrchestrationStatus_ORCHESTRATION_STATUS_RUNNING),
+			int32(protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED),
+		}, got.Query.GetRuntimeStatuses())
+		assert.True(t, created.Equal(got.Query.GetCreatedFrom().AsTime()))
+		assert.Equal(t, uint32(1), got.PageSize)
+		assert.Equal(t, "prev", got.ContinuationToken)
+
+		var res runtimev1pb.ListWorkflowsResponse
+		require.NoError(t, protojson.Unmarshal(resp.RawBody, &res))
+		require.Len(t, res.GetWorkflows(), 1)
+		assert.Equal(t, "instance1", res.GetWorkflows()[0].GetInstanceId())
+		assert.Equal(t, "wf1", res.GetWorkflows()[0].GetWorkflowName())
+		assert.Equal(t, "RUNNING", res.GetWorkflows()[0].GetRuntimeStatus())
+		assert.Equal(t, "next", res.GetContinuationToken())
+	})
+
+	t.Run("List with unknown workflow component", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0-beta1/workflows/other", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_WORKFLOW_COMPONENT_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("List with invalid continuation token", func(t *testing.T) {
+		wf.WithListInstances(func(context.Context, *backendactors.ListInstancesRequest) (*backendactors.ListInstancesResponse, error) {
+			return nil, backendactors.ErrInvalidContinuationToken
+		})
+
+		resp := fakeServer.DoRequest("GET", "v1.0-beta1/workflows/dapr?continuationToken=bad", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_CONTINUATION_TOKEN_INVALID", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("List with invalid page size", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0-beta1/workflows/dapr?pageSize=-1", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+	})
+
 	/////////////////////
 	// PURGE API TESTS //
 	/////////////////////
@@ -2829,7 +2955,7 @@ func (f *fakeHTTPServer) getRouter(endpoints []endpoints.Endpoint, apiAuth bool)
 	for _, e := range endpoints {
 		path := fmt.Sprintf("/%s/%s", e.Version, e.Route)
 
-		srv.handle(e, path, r, false)
+		srv.handle(e, path, r, false, nethttp.NotFoundHandler())
 	}
 	return r
 }
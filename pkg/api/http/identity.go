@@ -0,0 +1,55 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+var endpointGroupIdentityV1Alpha1 = &endpoints.EndpointGroup{
+	Name:                 endpoints.EndpointGroupIdentity,
+	Version:              endpoints.EndpointGroupVersion1alpha1,
+	AppendSpanAttributes: nil, // TODO
+}
+
+func (a *api) constructIdentityEndpoints() []endpoints.Endpoint {
+	return []endpoints.Endpoint{
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "identity/jwt",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupIdentityV1Alpha1,
+			Handler: a.onGetWorkloadJWTAlpha1(),
+			Settings: endpoints.EndpointSettings{
+				Name: "GetWorkloadJWT",
+			},
+		},
+	}
+}
+
+func (a *api) onGetWorkloadJWTAlpha1() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.GetWorkloadJWTAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.GetWorkloadJWTRequest, *runtimev1pb.GetWorkloadJWTResponse]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.GetWorkloadJWTRequest) (*runtimev1pb.GetWorkloadJWTRequest, error) {
+				in.Audience = r.URL.Query().Get("audience")
+				return in, nil
+			},
+		},
+	)
+}
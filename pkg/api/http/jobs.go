@@ -15,12 +15,14 @@ package http
 
 import (
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/go-chi/chi/v5"
 
 	apierrors "github.com/dapr/dapr/pkg/api/errors"
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
+	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/messages/errorcodes"
 	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
@@ -64,6 +66,16 @@ func (a *api) constructJobsEndpoints() []endpoints.Endpoint {
 				Name: "GetJob",
 			},
 		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "jobs",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupJobsV1Alpha1,
+			Handler: a.onListJobsHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "ListJobs",
+			},
+		},
 	}
 }
 
@@ -130,3 +142,30 @@ func (a *api) onGetJobHandler() http.HandlerFunc {
 		},
 	)
 }
+
+func (a *api) onListJobsHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.ListJobsAlpha1,
+		UniversalHTTPHandlerOpts[*runtimev1pb.ListJobsRequest, *runtimev1pb.ListJobsResponse]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.ListJobsRequest) (*runtimev1pb.ListJobsRequest, error) {
+				qs := r.URL.Query()
+				if prefix := qs.Get("namePrefix"); prefix != "" {
+					in.NamePrefix = &prefix
+				}
+				if ps := qs.Get("pageSize"); ps != "" {
+					pageSize, err := strconv.ParseUint(ps, 10, 32)
+					if err != nil {
+						return nil, messages.ErrBadRequest.WithFormat("invalid pageSize: " + err.Error())
+					}
+					pageSize32 := uint32(pageSize)
+					in.PageSize = &pageSize32
+				}
+				if ct := qs.Get("continuationToken"); ct != "" {
+					in.ContinuationToken = &ct
+				}
+				return in, nil
+			},
+		},
+	)
+}
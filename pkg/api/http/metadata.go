@@ -69,7 +69,6 @@ func (a *api) onGetMetadata() http.HandlerFunc {
 					// We can embed the proto object directly only for as long as the protojson key is == json key
 					ActiveActorsCount:    out.GetActiveActorsCount(), //nolint:staticcheck
 					RegisteredComponents: out.GetRegisteredComponents(),
-					HTTPEndpoints:        out.GetHttpEndpoints(),
 					RuntimeVersion:       out.GetRuntimeVersion(),
 					EnabledFeatures:      out.GetEnabledFeatures(),
 					//nolint:protogetter
@@ -93,6 +92,28 @@ func (a *api) onGetMetadata() http.HandlerFunc {
 					}
 				}
 
+				// Copy the HTTP endpoints into a custom struct, as the protojson keys of
+				// their base URLs differ from the json keys
+				if len(out.GetHttpEndpoints()) > 0 {
+					endpoints := make([]metadataResponseHTTPEndpoint, len(out.GetHttpEndpoints()))
+					for i, v := range out.GetHttpEndpoints() {
+						endpoints[i] = metadataResponseHTTPEndpoint{
+							Name:                  v.GetName(),
+							LoadBalancingStrategy: v.GetLoadBalancingStrategy(),
+						}
+						if len(v.GetBaseUrls()) > 0 {
+							endpoints[i].BaseURLs = make([]metadataResponseHTTPEndpointBaseURL, len(v.GetBaseUrls()))
+							for j, u := range v.GetBaseUrls() {
+								endpoints[i].BaseURLs[j] = metadataResponseHTTPEndpointBaseURL{
+									URL:     u.GetUrl(),
+									Healthy: u.GetHealthy(),
+								}
+							}
+						}
+					}
+					res.HTTPEndpoints = endpoints
+				}
+
 				// Copy the subscriptions into a custom struct
 				if len(out.GetSubscriptions()) > 0 {
 					subs := make([]metadataResponsePubsubSubscription, len(out.GetSubscriptions()))
@@ -167,7 +188,7 @@ type metadataResponse struct {
 	RegisteredComponents    []*runtimev1pb.RegisteredComponents     `json:"components,omitempty"`
 	Extended                map[string]string                       `json:"extended,omitempty"`
 	Subscriptions           []metadataResponsePubsubSubscription    `json:"subscriptions,omitempty"`
-	HTTPEndpoints           []*runtimev1pb.MetadataHTTPEndpoint     `json:"httpEndpoints,omitempty"`
+	HTTPEndpoints           []metadataResponseHTTPEndpoint          `json:"httpEndpoints,omitempty"`
 	AppConnectionProperties metadataResponseAppConnectionProperties `json:"appConnectionProperties,omitempty"`
 	ActorRuntime            metadataActorRuntime                    `json:"actorRuntime,omitempty"`
 	Scheduler               *runtimev1pb.MetadataScheduler          `json:"scheduler,omitempty"`
@@ -180,6 +201,17 @@ type metadataActorRuntime struct {
 	Placement    string                           `json:"placement,omitempty"`
 }
 
+type metadataResponseHTTPEndpoint struct {
+	Name                  string                                `json:"name"`
+	BaseURLs              []metadataResponseHTTPEndpointBaseURL `json:"baseUrls,omitempty"`
+	LoadBalancingStrategy string                                `json:"loadBalancingStrategy,omitempty"`
+}
+
+type metadataResponseHTTPEndpointBaseURL struct {
+	URL     string `json:"url"`
+	Healthy bool   `json:"healthy"`
+}
+
 type metadataResponsePubsubSubscription struct {
 	PubsubName      string                                   `json:"pubsubname"`
 	Topic           string                                   `json:"topic"`
@@ -30,6 +30,7 @@ import (
 
 const (
 	jsonContentTypeHeader = "application/json"
+	ndjsonContentType     = "application/x-ndjson"
 	etagHeader            = "ETag"
 	metadataPrefix        = "metadata."
 	headerContentType     = "content-type"
@@ -26,6 +26,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	// Import pprof that automatically registers itself in the default server mux.
@@ -67,7 +68,8 @@ type server struct {
 	metricSpec         config.MetricSpec
 	middleware         middleware.HTTP
 	api                API
-	apiSpec            config.APISpec
+	apiSpec            *config.Reloadable[config.APISpec]
+	apiAccessRules     atomic.Pointer[apiAccessRules]
 	servers            []*http.Server
 	profilingListeners []net.Listener
 	wg                 sync.WaitGroup
@@ -80,7 +82,7 @@ type NewServerOpts struct {
 	TracingSpec config.TracingSpec
 	MetricSpec  config.MetricSpec
 	Middleware  middleware.HTTP
-	APISpec     config.APISpec
+	APISpec     *config.Reloadable[config.APISpec]
 }
 
 // NewServer returns a new HTTP server.
@@ -415,21 +417,68 @@ func (s *server) unespaceRequestParametersInContext(chiCtx *chi.Context) (err er
 func (s *server) setupRoutes(r chi.Router, endpoints []endpoints.Endpoint) {
 	parameterFinder, _ := regexp.Compile("/{.*}")
 
-	// Build the API allowlist and denylist
-	allowedAPIs := s.apiSpec.Allowed.GetRulesByProtocol(config.APIAccessRuleProtocolHTTP)
-	deniedAPIs := s.apiSpec.Denied.GetRulesByProtocol(config.APIAccessRuleProtocolHTTP)
-
-	for _, e := range endpoints {
-		if !e.IsAllowed(allowedAPIs, deniedAPIs) {
-			continue
+	// The API allowlist and denylist are checked on each request rather than
+	// when routes are registered, so they can be updated while the server is
+	// running. Requests for endpoints which are not allowed are handled as if
+	// the route didn't exist, meaning they are sent to the fallback handler if
+	// there is one.
+	var fallback http.Handler
+	notFound := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fallback != nil {
+			fallback.ServeHTTP(w, r)
+			return
 		}
+		http.NotFound(w, r)
+	})
 
+	for _, e := range endpoints {
 		path := "/" + e.Version + "/" + e.Route
-		s.handle(
+		handler := s.handle(
 			e, path, r,
 			parameterFinder.MatchString(path),
+			notFound,
 		)
+		if e.Settings.IsFallback {
+			fallback = handler
+		}
+	}
+}
+
+// apiAccessRules are the HTTP API access rules compiled from an API spec.
+type apiAccessRules struct {
+	spec    *config.APISpec
+	allowed map[string]struct{}
+	denied  map[string]struct{}
+}
+
+// isEndpointAllowed returns true if the endpoint is allowed by the current API
+// spec. Rules are only compiled again when the API spec has been swapped.
+func (s *server) isEndpointAllowed(e endpoints.Endpoint) bool {
+	spec := s.apiSpec.Load()
+
+	rules := s.apiAccessRules.Load()
+	if rules == nil || rules.spec != spec {
+		rules = &apiAccessRules{spec: spec}
+		if spec != nil {
+			rules.allowed = spec.Allowed.GetRulesByProtocol(config.APIAccessRuleProtocolHTTP)
+			rules.denied = spec.Denied.GetRulesByProtocol(config.APIAccessRuleProtocolHTTP)
+		}
+		s.apiAccessRules.Store(rules)
 	}
+
+	return e.IsAllowed(rules.allowed, rules.denied)
+}
+
+// apiAccessHandler serves the request with next if the endpoint is allowed
+// by the API spec, or with notFound otherwise.
+func (s *server) apiAccessHandler(e endpoints.Endpoint, next http.Handler, notFound http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.isEndpointAllowed(e) {
+			notFound.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
 }
 
 // Add information about the route in the context's value.
@@ -446,8 +495,8 @@ func (s *server) addEndpointCtx(e endpoints.Endpoint, next http.Handler) http.Ha
 	})
 }
 
-func (s *server) handle(e endpoints.Endpoint, path string, r chi.Router, unescapeParameters bool) {
-	handler := e.Handler
+func (s *server) handle(e endpoints.Endpoint, path string, r chi.Router, unescapeParameters bool, notFound http.Handler) http.Handler {
+	var handler http.Handler = e.Handler
 
 	if unescapeParameters {
 		handler = s.unescapeRequestParametersHandler(handler)
@@ -455,6 +504,12 @@ func (s *server) handle(e endpoints.Endpoint, path string, r chi.Router, unescap
 
 	handler = s.addEndpointCtx(e, handler)
 
+	// The fallback handler must not fall back on itself.
+	if e.Settings.IsFallback {
+		notFound = http.NotFoundHandler()
+	}
+	handler = s.apiAccessHandler(e, handler, notFound)
+
 	// If no method is defined, match any method
 	if len(e.Methods) == 0 {
 		r.Handle(path, handler)
@@ -466,7 +521,9 @@ func (s *server) handle(e endpoints.Endpoint, path string, r chi.Router, unescap
 
 	// Set as fallback method
 	if e.Settings.IsFallback {
-		r.NotFound(handler)
-		r.MethodNotAllowed(handler)
+		r.NotFound(handler.ServeHTTP)
+		r.MethodNotAllowed(handler.ServeHTTP)
 	}
+
+	return handler
 }
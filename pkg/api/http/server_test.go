@@ -448,7 +448,7 @@ func TestClose(t *testing.T) {
 			TracingSpec: config.TracingSpec{},
 			MetricSpec:  config.MetricSpec{},
 			Middleware:  func(n http.Handler) http.Handler { return n },
-			APISpec:     config.APISpec{},
+			APISpec:     config.NewReloadable(&config.APISpec{}),
 		})
 		require.NoError(t, server.StartNonBlocking())
 		dapr_testing.WaitForListeningAddress(t, 5*time.Second, fmt.Sprintf("127.0.0.1:%d", port))
@@ -474,7 +474,7 @@ func TestClose(t *testing.T) {
 			TracingSpec: config.TracingSpec{},
 			MetricSpec:  config.MetricSpec{},
 			Middleware:  func(n http.Handler) http.Handler { return n },
-			APISpec:     config.APISpec{},
+			APISpec:     config.NewReloadable(&config.APISpec{}),
 		})
 		require.NoError(t, server.StartNonBlocking())
 		dapr_testing.WaitForListeningAddress(t, 5*time.Second, fmt.Sprintf("127.0.0.1:%d", port))
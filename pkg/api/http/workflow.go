@@ -14,15 +14,24 @@ limitations under the License.
 package http
 
 import (
+	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/dapr/dapr/pkg/api/http/endpoints"
 	"github.com/dapr/dapr/pkg/messages"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/kit/ptr"
 )
 
 var (
@@ -78,6 +87,16 @@ func (a *api) constructWorkflowEndpoints() []endpoints.Endpoint {
 				Name: "GetWorkflow",
 			},
 		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "workflows/{workflowComponent}",
+			Version: apiVersionV1beta1,
+			Group:   endpointGroupWorkflowV1Beta1,
+			Handler: a.onListWorkflowsHandler(),
+			Settings: endpoints.EndpointSettings{
+				Name: "ListWorkflows",
+			},
+		},
 		{
 			Methods: []string{http.MethodPost},
 			Route:   "workflows/{workflowComponent}/{instanceID}/raiseEvent/{eventName}",
@@ -258,6 +277,26 @@ func (a *api) constructWorkflowEndpoints() []endpoints.Endpoint {
 				Name: "PurgeWorkflow",
 			},
 		},
+		{
+			Methods: []string{http.MethodGet},
+			Route:   "workflows/{workflowComponent}/export",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupWorkflowV1Alpha1,
+			Handler: a.onExportWorkflowsHandler,
+			Settings: endpoints.EndpointSettings{
+				Name: "ExportWorkflows",
+			},
+		},
+		{
+			Methods: []string{http.MethodPost},
+			Route:   "workflows/{workflowComponent}/import",
+			Version: apiVersionV1alpha1,
+			Group:   endpointGroupWorkflowV1Alpha1,
+			Handler: a.onImportWorkflowsHandler,
+			Settings: endpoints.EndpointSettings{
+				Name: "ImportWorkflows",
+			},
+		},
 	}
 }
 
@@ -304,6 +343,155 @@ func (a *api) onGetWorkflowHandler() http.HandlerFunc {
 		})
 }
 
+// Route: GET "workflows/{workflowComponent}?workflowName={workflowName}&runtimeStatus={status}&pageSize={pageSize}&continuationToken={token}"
+// Time range filters are passed as RFC3339 timestamps in the createdTimeFrom,
+// createdTimeTo, lastUpdatedTimeFrom and lastUpdatedTimeTo query parameters.
+func (a *api) onListWorkflowsHandler() http.HandlerFunc {
+	return UniversalHTTPHandler(
+		a.universal.ListWorkflows,
+		UniversalHTTPHandlerOpts[*runtimev1pb.ListWorkflowsRequest, *runtimev1pb.ListWorkflowsResponse]{
+			SkipInputBody: true,
+			InModifier: func(r *http.Request, in *runtimev1pb.ListWorkflowsRequest) (*runtimev1pb.ListWorkflowsRequest, error) {
+				qs := r.URL.Query()
+				in.WorkflowComponent = chi.URLParam(r, workflowComponent)
+				if qs.Has(workflowName) {
+					in.WorkflowName = ptr.Of(qs.Get(workflowName))
+				}
+				for _, s := range qs["runtimeStatus"] {
+					in.RuntimeStatus = append(in.RuntimeStatus, strings.Split(s, ",")...)
+				}
+
+				var err error
+				if in.CreatedTimeFrom, err = workflowTimeQueryParam(qs, "createdTimeFrom"); err != nil {
+					return nil, err
+				}
+				if in.CreatedTimeTo, err = workflowTimeQueryParam(qs, "createdTimeTo"); err != nil {
+					return nil, err
+				}
+				if in.LastUpdatedTimeFrom, err = workflowTimeQueryParam(qs, "lastUpdatedTimeFrom"); err != nil {
+					return nil, err
+				}
+				if in.LastUpdatedTimeTo, err = workflowTimeQueryParam(qs, "lastUpdatedTimeTo"); err != nil {
+					return nil, err
+				}
+
+				if ps := qs.Get("pageSize"); ps != "" {
+					pageSize, err := strconv.ParseUint(ps, 10, 32)
+					if err != nil {
+						return nil, messages.ErrBadRequest.WithFormat("invalid pageSize: " + err.Error())
+					}
+					in.PageSize = ptr.Of(uint32(pageSize))
+				}
+				if ct := qs.Get("continuationToken"); ct != "" {
+					in.ContinuationToken = &ct
+				}
+				return in, nil
+			},
+		})
+}
+
+// Route: GET "workflows/{workflowComponent}/export?workflowName={workflowName}&runtimeStatus={status}"
+// The response body is a stream of newline-delimited JSON ExportedWorkflow
+// records, which can be sent as-is to the import endpoint.
+func (a *api) onExportWorkflowsHandler(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	in := &runtimev1pb.ExportWorkflowsRequest{
+		WorkflowComponent: chi.URLParam(r, workflowComponent),
+	}
+	if qs.Has(workflowName) {
+		in.WorkflowName = ptr.Of(qs.Get(workflowName))
+	}
+	for _, s := range qs["runtimeStatus"] {
+		in.RuntimeStatus = append(in.RuntimeStatus, strings.Split(s, ",")...)
+	}
+
+	flusher, _ := w.(http.Flusher)
+	var wroteHeader bool
+	err := a.universal.ExportWorkflows(r.Context(), in, func(wf *runtimev1pb.ExportedWorkflow) error {
+		b, err := protojson.Marshal(wf)
+		if err != nil {
+			return err
+		}
+		if !wroteHeader {
+			w.Header().Set(headerContentType, ndjsonContentType)
+			w.WriteHeader(http.StatusOK)
+			wroteHeader = true
+		}
+		if _, err = w.Write(append(b, '\n')); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+	switch {
+	case err != nil && !wroteHeader:
+		respondWithError(w, err)
+	case err != nil:
+		// The status code has already been sent, so the client detects the
+		// failure from the truncated stream.
+		log.Errorf("Failed to export workflows: %v", err)
+	case !wroteHeader:
+		w.Header().Set(headerContentType, ndjsonContentType)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// Route: POST "workflows/{workflowComponent}/import"
+// The request body is a stream of JSON ExportedWorkflow records, as returned
+// by the export endpoint.
+func (a *api) onImportWorkflowsHandler(w http.ResponseWriter, r *http.Request) {
+	res := &runtimev1pb.ImportWorkflowsResponse{}
+	dec := json.NewDecoder(r.Body)
+	for {
+		var raw json.RawMessage
+		err := dec.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			respondWithError(w, messages.ErrBodyRead.WithFormat(err))
+			return
+		}
+
+		wf := &runtimev1pb.ExportedWorkflow{}
+		if err = protojson.Unmarshal(raw, wf); err != nil {
+			err = messages.ErrInvalidWorkflowImport.WithFormat(err)
+			log.Debug(err)
+			respondWithError(w, err)
+			return
+		}
+
+		imported, err := a.universal.ImportWorkflow(r.Context(), wf)
+		if err != nil {
+			respondWithError(w, err)
+			return
+		}
+		if imported {
+			res.Imported++
+		} else {
+			res.SkippedInstanceIds = append(res.SkippedInstanceIds, wf.GetInstanceId())
+		}
+	}
+
+	respondWithProto(w, res, http.StatusOK, true)
+}
+
+// workflowTimeQueryParam parses the RFC3339 timestamp in the given query
+// parameter, returning nil if the parameter is not set.
+func workflowTimeQueryParam(qs url.Values, name string) (*timestamppb.Timestamp, error) {
+	v := qs.Get(name)
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, messages.ErrBadRequest.WithFormat("invalid " + name + ": " + err.Error())
+	}
+	return timestamppb.New(t), nil
+}
+
 // Route: POST "workflows/{workflowComponent}/{instanceID}/terminate"
 func (a *api) onTerminateWorkflowHandler() http.HandlerFunc {
 	return UniversalHTTPHandler(
@@ -28,6 +28,7 @@ import (
 	"github.com/dapr/dapr/pkg/messages"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
 	kmeta "github.com/dapr/kit/metadata"
 )
 
@@ -112,11 +113,9 @@ func (a *Universal) ConverseAlpha1(ctx context.Context, req *runtimev1pb.Convers
 	request.ConversationContext = req.GetContextID()
 	request.Temperature = req.GetTemperature()
 
-	// do call
-	start := time.Now()
-	policyRunner := resiliency.NewRunner[*conversation.Response](ctx,
-		a.resiliency.ComponentOutboundPolicy(req.GetName(), resiliency.Conversation),
-	)
+	if err = a.checkConversationSidecarBudget(); err != nil {
+		return &runtimev1pb.ConversationResponse{}, err //nolint:staticcheck
+	}
 
 	// transform v1 proto -> v2 component request
 	toolsv2 := []llms.Tool{}
@@ -128,13 +127,7 @@ func (a *Universal) ConverseAlpha1(ctx context.Context, req *runtimev1pb.Convers
 		Temperature:         request.Temperature,
 	}
 
-	resp, err := policyRunner(func(ctx context.Context) (*conversation.Response, error) {
-		rResp, rErr := component.Converse(ctx, requestv2)
-		return rResp, rErr
-	})
-	elapsed := diag.ElapsedSince(start)
-	diag.DefaultComponentMonitoring.ConversationInvoked(ctx, req.GetName(), err == nil, elapsed)
-
+	resp, _, err := a.converseComponent(ctx, req.GetName(), component, requestv2)
 	if err != nil {
 		err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
 		a.logger.Debug(err)
@@ -179,12 +172,14 @@ func (a *Universal) ConverseAlpha1(ctx context.Context, req *runtimev1pb.Convers
 	return response, nil
 }
 
-func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.ConversationRequestAlpha2) (*runtimev1pb.ConversationResponseAlpha2, error) {
+// prepareConverseAlpha2 returns the conversation component and the component
+// request of the given Alpha2 request, along with the PII scrubber.
+func (a *Universal) prepareConverseAlpha2(req *runtimev1pb.ConversationRequestAlpha2) (conversation.Conversation, *conversation.Request, piiscrubber.Scrubber, error) {
 	component, ok := a.compStore.GetConversation(req.GetName())
 	if !ok {
 		err := messages.ErrConversationNotFound.WithFormat(req.GetName())
 		a.logger.Debug(err)
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	// Log component type for debugging
@@ -197,7 +192,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 	var err error
 	err = kmeta.DecodeMetadata(req.GetMetadata(), request)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	if request.Message == nil {
@@ -207,7 +202,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 	if len(req.GetInputs()) == 0 {
 		err = messages.ErrConversationMissingInputs.WithFormat(req.GetName())
 		a.logger.Debug(err)
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	var scrubber piiscrubber.Scrubber
@@ -215,7 +210,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 	if err != nil {
 		err = messages.ErrConversationMissingInputs.WithFormat(req.GetName())
 		a.logger.Debug(err)
-		return &runtimev1pb.ConversationResponseAlpha2{}, err
+		return nil, nil, nil, err
 	}
 
 	var llmMessages []*llms.MessageContent
@@ -229,7 +224,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 			if message.GetMessageTypes() == nil {
 				err = messages.ErrConversationInvalidParams.WithFormat(req.GetName(), errors.New("message type cannot be nil"))
 				a.logger.Debug(err)
-				return nil, err
+				return nil, nil, nil, err
 			}
 
 			// Openai allows roles to be passed in; however,
@@ -246,7 +241,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 						if err != nil {
 							err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
 							a.logger.Debug(err)
-							return &runtimev1pb.ConversationResponseAlpha2{}, err
+							return nil, nil, nil, err
 						}
 						text = scrubbed[0]
 					}
@@ -273,7 +268,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 						if err != nil {
 							err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
 							a.logger.Debug(err)
-							return &runtimev1pb.ConversationResponseAlpha2{}, err
+							return nil, nil, nil, err
 						}
 						text = scrubbed[0]
 					}
@@ -298,7 +293,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 						if err != nil {
 							err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
 							a.logger.Debug(err)
-							return &runtimev1pb.ConversationResponseAlpha2{}, err
+							return nil, nil, nil, err
 						}
 						text = scrubbed[0]
 					}
@@ -323,7 +318,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 						if err != nil {
 							err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
 							a.logger.Debug(err)
-							return &runtimev1pb.ConversationResponseAlpha2{}, err
+							return nil, nil, nil, err
 						}
 						text = scrubbed[0]
 					}
@@ -341,7 +336,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 					if tool.ToolTypes == nil {
 						err = messages.ErrConversationInvalidParams.WithFormat(req.GetName(), errors.New("tool types cannot be nil"))
 						a.logger.Debug(err)
-						return nil, err
+						return nil, nil, nil, err
 					}
 					toolCall := llms.ToolCall{
 						ID:   tool.GetId(),
@@ -377,7 +372,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 						if err != nil {
 							err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
 							a.logger.Debug(err)
-							return &runtimev1pb.ConversationResponseAlpha2{}, err
+							return nil, nil, nil, err
 						}
 						text = scrubbed[0]
 					}
@@ -407,7 +402,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 			default:
 				err = messages.ErrConversationInvalidParams.WithFormat(req.GetName())
 				a.logger.Debug(err)
-				return nil, err
+				return nil, nil, nil, err
 			}
 			llmMessages = append(llmMessages, &langchainMsg)
 		}
@@ -443,7 +438,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 		if len(tools) == 0 {
 			err = messages.ErrConversationInvalidParams.WithFormat(req.GetName(), "tool choice must be 'auto', 'none', 'required', or a specific tool name matching the tools available to be used")
 			a.logger.Debug(err)
-			return nil, err
+			return nil, nil, nil, err
 		}
 	default:
 		// user chose a specific tool name that we must validate.
@@ -462,7 +457,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 			if !toolNameFound {
 				err = messages.ErrConversationInvalidParams.WithFormat(req.GetName(), "tool choice not found. Must be 'auto', 'none', 'required', or a specific tool name matching the tools available to be used")
 				a.logger.Debug(err)
-				return nil, err
+				return nil, nil, nil, err
 			}
 		}
 	}
@@ -488,27 +483,90 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 		request.Tools = &availableTools
 	}
 
-	start := time.Now()
-	policyRunner := resiliency.NewRunner[*conversation.Response](ctx,
-		a.resiliency.ComponentOutboundPolicy(req.GetName(), resiliency.Conversation),
-	)
+	return component, request, scrubber, nil
+}
 
-	// transform v2 proto -> v1 component request
-	resp, err := policyRunner(func(ctx context.Context) (*conversation.Response, error) {
-		rResp, rErr := component.Converse(ctx, request)
-		return rResp, rErr
-	})
-	elapsed := diag.ElapsedSince(start)
-	diag.DefaultComponentMonitoring.ConversationInvoked(ctx, req.GetName(), err == nil, elapsed)
+func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.ConversationRequestAlpha2) (*runtimev1pb.ConversationResponseAlpha2, error) {
+	history, req, err := a.loadConversationHistory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
 
+	component, request, scrubber, err := a.prepareConverseAlpha2(req)
 	if err != nil {
-		err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
-		a.logger.Debug(err)
-		return &runtimev1pb.ConversationResponseAlpha2{}, err
+		return nil, err
+	}
+
+	targets, maxToolSteps, err := a.conversationTools(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cached responses are served without calling the model, so they don't
+	// spend the token budget.
+	cache := a.conversationCache(req, targets)
+	if response := a.cachedConversationResponse(ctx, cache, req); response != nil {
+		if err = a.saveConversationResponse(ctx, history, component, nil, response); err != nil {
+			return &runtimev1pb.ConversationResponseAlpha2{}, err
+		}
+		return response, nil
+	}
+
+	// The model is called again with the results of the tool calls as long as
+	// it only calls tools bound to a target.
+	// Once the step limit is reached, the last reply is returned with the
+	// tool calls it still requested, along with the executed ones.
+	var (
+		resp          *conversation.Response
+		usage         rtconversation.Usage
+		replies       []*runtimev1pb.ConversationMessage
+		executions    []*runtimev1pb.ConversationToolExecution
+		stepsExceeded bool
+	)
+	for step := int32(1); ; step++ {
+		// The budget is checked before each call, so a loop of tool calls
+		// stops once it is exhausted.
+		if err = a.checkConversationSidecarBudget(); err != nil {
+			return &runtimev1pb.ConversationResponseAlpha2{}, err
+		}
+
+		var stepUsage rtconversation.Usage
+		resp, stepUsage, err = a.converseComponent(ctx, req.GetName(), component, request)
+		usage = usage.Add(stepUsage)
+		if err != nil {
+			err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
+			a.logger.Debug(err)
+			return &runtimev1pb.ConversationResponseAlpha2{}, err
+		}
+
+		reply := conversationReply(resp)
+		if !hasBoundToolCalls(reply, targets) {
+			break
+		}
+		if step > maxToolSteps {
+			a.logger.Debugf("Conversation component %s still requested tool calls after %d steps", req.GetName(), maxToolSteps)
+			stepsExceeded = true
+			break
+		}
+
+		msgs, toolHistory, stepExecutions := a.executeConversationTools(ctx, component, step, reply, targets)
+		*request.Message = append(*request.Message, msgs...)
+		replies = append(replies, toolHistory...)
+		executions = append(executions, stepExecutions...)
+
+		// A required or specific tool choice only applies to the first call,
+		// otherwise the model can never give its final answer.
+		if toolChoice := request.ToolChoice; toolChoice != nil && *toolChoice != "none" {
+			auto := "auto"
+			request.ToolChoice = &auto
+		}
 	}
 
 	// handle response
-	response := &runtimev1pb.ConversationResponseAlpha2{}
+	response := &runtimev1pb.ConversationResponseAlpha2{
+		ToolExecutions: executions,
+		Usage:          conversationUsage(usage),
+	}
 	a.logger.Debug(response)
 	if resp != nil {
 		if resp.ConversationContext != "" {
@@ -540,18 +598,7 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 				// handle tool call results for user to then execute locally
 				if choice.Message.ToolCallRequest != nil {
 					for _, toolCall := range *choice.Message.ToolCallRequest {
-						resultingToolCall := &runtimev1pb.ConversationToolCalls{
-							ToolTypes: &runtimev1pb.ConversationToolCalls_Function{
-								Function: &runtimev1pb.ConversationToolCallsOfFunction{
-									Name:      toolCall.FunctionCall.Name,
-									Arguments: toolCall.FunctionCall.Arguments,
-								},
-							},
-						}
-						if toolCall.ID != "" {
-							resultingToolCall.Id = &toolCall.ID
-						}
-						resultMessage.ToolCalls = append(resultMessage.ToolCalls, resultingToolCall)
+						resultMessage.ToolCalls = append(resultMessage.ToolCalls, conversationToolCall(toolCall.ID, toolCall.FunctionCall))
 					}
 				}
 
@@ -560,6 +607,9 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 					Index:        choice.Index,
 					Message:      resultMessage,
 				}
+				if stepsExceeded {
+					resultingChoice.FinishReason = conversationFinishReasonMaxToolSteps
+				}
 
 				resultingChoices = append(resultingChoices, resultingChoice)
 			}
@@ -570,5 +620,59 @@ func (a *Universal) ConverseAlpha2(ctx context.Context, req *runtimev1pb.Convers
 		}
 	}
 
+	if err = a.saveConversationResponse(ctx, history, component, replies, response); err != nil {
+		return &runtimev1pb.ConversationResponseAlpha2{}, err
+	}
+	if !stepsExceeded {
+		a.cacheConversationResponse(ctx, cache, response)
+	}
+
 	return response, nil
 }
+
+// converseComponent calls the conversation component with the resiliency
+// policy of the component, and records the tokens spent.
+func (a *Universal) converseComponent(ctx context.Context, name string, component conversation.Conversation, request *conversation.Request) (*conversation.Response, rtconversation.Usage, error) {
+	start := time.Now()
+	policyRunner := resiliency.NewRunner[*conversationComponentResp](ctx,
+		a.resiliency.ComponentOutboundPolicy(name, resiliency.Conversation),
+	)
+
+	res, err := policyRunner(func(ctx context.Context) (*conversationComponentResp, error) {
+		rResp, rUsage, rErr := converseWithUsage(ctx, component, request)
+		if rErr != nil {
+			return nil, rErr
+		}
+		return &conversationComponentResp{resp: rResp, usage: rUsage}, nil
+	})
+	elapsed := diag.ElapsedSince(start)
+	diag.DefaultComponentMonitoring.ConversationInvoked(ctx, name, err == nil, elapsed)
+
+	if err != nil {
+		return nil, rtconversation.Usage{}, err
+	}
+	a.recordConversationUsage(ctx, name, res.usage)
+	return res.resp, res.usage, nil
+}
+
+type conversationComponentResp struct {
+	resp  *conversation.Response
+	usage rtconversation.Usage
+}
+
+// conversationToolCall converts a tool call generated by the model to its API
+// representation.
+func conversationToolCall(id string, fn *llms.FunctionCall) *runtimev1pb.ConversationToolCalls {
+	function := &runtimev1pb.ConversationToolCallsOfFunction{}
+	if fn != nil {
+		function.Name = fn.Name
+		function.Arguments = fn.Arguments
+	}
+	toolCall := &runtimev1pb.ConversationToolCalls{
+		ToolTypes: &runtimev1pb.ConversationToolCalls_Function{Function: function},
+	}
+	if id != "" {
+		toolCall.Id = &id
+	}
+	return toolCall
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/components-contrib/state"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/encryption"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
+)
+
+// conversationCache is the entry of a request in the response cache of a
+// conversation component, which is kept in the cache state store.
+type conversationCache struct {
+	opts      *rtconversation.Cache
+	store     state.Store
+	storeName string
+	component string
+	key       string
+}
+
+// conversationCache returns the cache entry of the request, or nil if the
+// conversation component has no response cache. Responses to requests with
+// tools bound to a target aren't cached, as the tools have to be executed on
+// every request. The cache is skipped if its state store can't be used, as
+// the model can still respond without it.
+func (a *Universal) conversationCache(req *runtimev1pb.ConversationRequestAlpha2, targets map[string]*runtimev1pb.ConversationToolTarget) *conversationCache {
+	opts, ok := a.compStore.GetConversationCache(req.GetName())
+	if !ok || len(targets) > 0 {
+		return nil
+	}
+
+	store, ok := a.compStore.GetStateStore(opts.StateStore)
+	if !ok {
+		a.logger.Warnf("Response cache state store %s of conversation component %s is not found, skipping the cache", opts.StateStore, req.GetName())
+		return nil
+	}
+	if !state.FeatureTTL.IsPresent(store.Features()) {
+		a.logger.Warnf("Response cache state store %s of conversation component %s doesn't support TTLs, skipping the cache", opts.StateStore, req.GetName())
+		return nil
+	}
+
+	key, err := rtconversation.CacheKey(req)
+	if err != nil {
+		a.logger.Warnf("Failed to compute the response cache key of conversation component %s, skipping the cache: %v", req.GetName(), err)
+		return nil
+	}
+
+	return &conversationCache{
+		opts:      opts,
+		store:     store,
+		storeName: opts.StateStore,
+		component: req.GetName(),
+		key:       a.appID + "||conversation-cache||" + req.GetName() + "||" + key,
+	}
+}
+
+// cachedConversationResponse returns the cached response to the request, or
+// nil if there is none or the request bypasses the cache.
+func (a *Universal) cachedConversationResponse(ctx context.Context, c *conversationCache, req *runtimev1pb.ConversationRequestAlpha2) *runtimev1pb.ConversationResponseAlpha2 {
+	if c == nil || req.GetBypassCache() {
+		return nil
+	}
+
+	resp, err := c.load(ctx)
+	if err != nil {
+		a.logger.Warnf("Failed to load the cached response of conversation component %s: %v", c.component, err)
+	}
+	diag.DefaultComponentMonitoring.ConversationCacheLookup(ctx, c.component, resp != nil)
+	if resp == nil {
+		return nil
+	}
+
+	resp.Cached = true
+	resp.ContextId = req.ContextId
+	resp.Usage = &runtimev1pb.ConversationUsage{}
+	return resp
+}
+
+// cacheConversationResponse stores the response in the cache, if the
+// conversation component has one. Failing to do so doesn't fail the request.
+func (a *Universal) cacheConversationResponse(ctx context.Context, c *conversationCache, resp *runtimev1pb.ConversationResponseAlpha2) {
+	if c == nil {
+		return
+	}
+
+	// The context ID and usage are specific to the request which called the
+	// model.
+	resp = proto.CloneOf(resp)
+	resp.ContextId = nil
+	resp.Usage = nil
+
+	if err := c.save(ctx, resp); err != nil {
+		a.logger.Warnf("Failed to cache the response of conversation component %s: %v", c.component, err)
+	}
+}
+
+// load loads the cached response from the state store, returning nil if
+// there is none or it expired.
+func (c *conversationCache) load(ctx context.Context) (*runtimev1pb.ConversationResponseAlpha2, error) {
+	res, err := c.store.Get(ctx, &state.GetRequest{Key: c.key})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil || len(res.Data) == 0 {
+		return nil, nil
+	}
+
+	data := res.Data
+	if encryption.EncryptedStateStore(c.storeName) {
+		data, err = encryption.TryDecryptValue(c.storeName, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := new(runtimev1pb.ConversationResponseAlpha2)
+	if err = protojson.Unmarshal(data, resp); err != nil {
+		return nil, fmt.Errorf("failed to decode cached response: %w", err)
+	}
+	return resp, nil
+}
+
+// save stores the response in the state store, expiring after the TTL of
+// the cache.
+func (c *conversationCache) save(ctx context.Context, resp *runtimev1pb.ConversationResponseAlpha2) error {
+	data, err := protojson.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	if encryption.EncryptedStateStore(c.storeName) {
+		data, err = encryption.TryEncryptValue(c.storeName, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.store.Set(ctx, &state.SetRequest{
+		Key:   c.key,
+		Value: data,
+		Metadata: map[string]string{
+			"ttlInSeconds": strconv.FormatInt(int64(c.opts.TTL.Seconds()), 10),
+		},
+	})
+}
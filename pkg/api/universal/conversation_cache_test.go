@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/conversation"
+	"github.com/dapr/components-contrib/conversation/echo"
+	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
+	"github.com/dapr/kit/ptr"
+)
+
+// countingEcho echoes messages, counting the calls made to it.
+type countingEcho struct {
+	conversation.Conversation
+	calls int
+}
+
+func (c *countingEcho) Converse(ctx context.Context, r *conversation.Request) (*conversation.Response, error) {
+	c.calls++
+	return c.Conversation.Converse(ctx, r)
+}
+
+func TestConversationCache(t *testing.T) {
+	newAPI := func(t *testing.T, cache *rtconversation.Cache, history *rtconversation.History) (*Universal, *countingEcho) {
+		t.Helper()
+		store := inmemory.NewInMemoryStateStore(testLogger)
+		require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+		t.Cleanup(func() { store.(interface{ Close() error }).Close() })
+
+		comp := &countingEcho{Conversation: echo.NewEcho(testLogger)}
+		compStore := compstore.New()
+		compStore.AddStateStore("store", store)
+		compStore.AddConversation("llm", comp)
+		compStore.AddConversationCache("llm", cache)
+		if history != nil {
+			compStore.AddConversationHistory("llm", history)
+		}
+		return &Universal{
+			appID:      "myapp",
+			logger:     testLogger,
+			compStore:  compStore,
+			resiliency: resiliency.New(nil),
+		}, comp
+	}
+
+	request := func(text string) *runtimev1pb.ConversationRequestAlpha2 {
+		return &runtimev1pb.ConversationRequestAlpha2{
+			Name: "llm",
+			Inputs: []*runtimev1pb.ConversationInputAlpha2{{
+				Messages: []*runtimev1pb.ConversationMessage{{
+					MessageTypes: &runtimev1pb.ConversationMessage_OfUser{
+						OfUser: &runtimev1pb.ConversationMessageOfUser{
+							Content: []*runtimev1pb.ConversationMessageContent{{Text: text}},
+						},
+					},
+				}},
+			}},
+		}
+	}
+
+	cache := &rtconversation.Cache{StateStore: "store", TTL: time.Hour}
+
+	t.Run("repeated requests are served from the cache", func(t *testing.T) {
+		api, comp := newAPI(t, cache, nil)
+
+		resp, err := api.ConverseAlpha2(t.Context(), request("hello world"))
+		require.NoError(t, err)
+		assert.False(t, resp.GetCached())
+		assert.Equal(t, int64(6), resp.GetUsage().GetTotalTokens())
+
+		req := request("  hello\n world ")
+		req.ContextId = ptr.Of("ctx")
+		resp, err = api.ConverseAlpha2(t.Context(), req)
+		require.NoError(t, err)
+		assert.True(t, resp.GetCached())
+		assert.Equal(t, "ctx", resp.GetContextId())
+		assert.Equal(t, int64(0), resp.GetUsage().GetTotalTokens())
+		assert.Equal(t, "hello world", resp.GetOutputs()[0].GetChoices()[0].GetMessage().GetContent())
+		assert.Equal(t, 1, comp.calls)
+
+		req = request("hello world")
+		req.Temperature = ptr.Of(0.5)
+		resp, err = api.ConverseAlpha2(t.Context(), req)
+		require.NoError(t, err)
+		assert.False(t, resp.GetCached())
+		assert.Equal(t, 2, comp.calls)
+	})
+
+	t.Run("bypass skips the cache but refreshes it", func(t *testing.T) {
+		api, comp := newAPI(t, cache, nil)
+
+		req := request("hello")
+		req.BypassCache = ptr.Of(true)
+		for range 2 {
+			resp, err := api.ConverseAlpha2(t.Context(), req)
+			require.NoError(t, err)
+			assert.False(t, resp.GetCached())
+		}
+		assert.Equal(t, 2, comp.calls)
+
+		resp, err := api.ConverseAlpha2(t.Context(), request("hello"))
+		require.NoError(t, err)
+		assert.True(t, resp.GetCached())
+		assert.Equal(t, 2, comp.calls)
+	})
+
+	t.Run("cached responses don't spend the token budget", func(t *testing.T) {
+		api, comp := newAPI(t, cache, nil)
+		var err error
+		api.conversationSidecarBudget, err = rtconversation.NewSidecarBudget(5, time.Hour, clocktesting.NewFakeClock(time.Now()))
+		require.NoError(t, err)
+
+		_, err = api.ConverseAlpha2(t.Context(), request("hello world"))
+		require.NoError(t, err)
+		resp, err := api.ConverseAlpha2(t.Context(), request("hello world"))
+		require.NoError(t, err)
+		assert.True(t, resp.GetCached())
+		assert.Equal(t, 1, comp.calls)
+	})
+
+	t.Run("cached responses are saved to the history", func(t *testing.T) {
+		api, comp := newAPI(t, cache, &rtconversation.History{StateStore: "store"})
+
+		_, err := api.ConverseAlpha2(t.Context(), request("hello"))
+		require.NoError(t, err)
+		resp, err := api.ConverseAlpha2(t.Context(), request("hello"))
+		require.NoError(t, err)
+		assert.True(t, resp.GetCached())
+		assert.Equal(t, 1, comp.calls)
+
+		got, err := api.GetConversationContextAlpha2(t.Context(), &runtimev1pb.GetConversationContextRequestAlpha2{Name: "llm", ContextId: resp.GetContextId()})
+		require.NoError(t, err)
+		require.Len(t, got.GetMessages(), 2)
+		assert.Equal(t, "hello", got.GetMessages()[1].GetOfAssistant().GetContent()[0].GetText())
+	})
+
+	t.Run("cache is skipped if the state store is not found", func(t *testing.T) {
+		api, comp := newAPI(t, &rtconversation.Cache{StateStore: "missing", TTL: time.Hour}, nil)
+
+		for range 2 {
+			resp, err := api.ConverseAlpha2(t.Context(), request("hello"))
+			require.NoError(t, err)
+			assert.False(t, resp.GetCached())
+		}
+		assert.Equal(t, 2, comp.calls)
+	})
+
+	t.Run("requests with tools bound to a target are not cached", func(t *testing.T) {
+		api, _ := newAPI(t, cache, nil)
+		targets := map[string]*runtimev1pb.ConversationToolTarget{"search": {}}
+		assert.Nil(t, api.conversationCache(request("hello"), targets))
+		assert.NotNil(t, api.conversationCache(request("hello"), nil))
+	})
+}
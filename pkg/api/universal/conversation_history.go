@@ -0,0 +1,454 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tmc/langchaingo/llms"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	piiscrubber "github.com/aavaz-ai/pii-scrubber"
+
+	"github.com/dapr/components-contrib/conversation"
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/encryption"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
+)
+
+const (
+	// conversationHistorySaveAttempts is the number of times saving the
+	// history of a context is attempted when it is updated concurrently.
+	conversationHistorySaveAttempts = 3
+
+	conversationSummaryPrompt = "Summarize the following conversation concisely, keeping all facts, decisions and open questions needed to continue it. Reply with the summary only."
+)
+
+// GetConversationContextAlpha2 returns the message history of a conversation
+// context.
+func (a *Universal) GetConversationContextAlpha2(ctx context.Context, req *runtimev1pb.GetConversationContextRequestAlpha2) (*runtimev1pb.GetConversationContextResponseAlpha2, error) {
+	h, err := a.conversationContextHistory(req.GetName(), req.GetContextId())
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := h.load(ctx)
+	if err != nil {
+		err = messages.ErrConversationHistory.WithFormat(req.GetContextId(), req.GetName(), err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+	if !found {
+		err = messages.ErrConversationContextNotFound.WithFormat(req.GetContextId(), req.GetName())
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	return h.context, nil
+}
+
+// DeleteConversationContextAlpha2 deletes the message history of a
+// conversation context.
+func (a *Universal) DeleteConversationContextAlpha2(ctx context.Context, req *runtimev1pb.DeleteConversationContextRequestAlpha2) (*runtimev1pb.DeleteConversationContextResponseAlpha2, error) {
+	h, err := a.conversationContextHistory(req.GetName(), req.GetContextId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.store.Delete(ctx, &state.DeleteRequest{Key: h.key})
+	if err != nil {
+		err = messages.ErrConversationHistory.WithFormat(req.GetContextId(), req.GetName(), err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	return &runtimev1pb.DeleteConversationContextResponseAlpha2{}, nil
+}
+
+// conversationContextHistory returns the history of the given context of a
+// conversation component, which must have a history state store.
+func (a *Universal) conversationContextHistory(name, contextID string) (*conversationHistory, error) {
+	if _, ok := a.compStore.GetConversation(name); !ok {
+		err := messages.ErrConversationNotFound.WithFormat(name)
+		a.logger.Debug(err)
+		return nil, err
+	}
+	if contextID == "" {
+		err := messages.ErrConversationContextIDMissing.WithFormat(name)
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	h, err := a.conversationHistory(name, contextID)
+	if err != nil {
+		return nil, err
+	}
+	if h == nil {
+		err = messages.ErrConversationHistoryNotConfigured.WithFormat(name)
+		a.logger.Debug(err)
+		return nil, err
+	}
+	return h, nil
+}
+
+// conversationHistory is the message history of a conversation context,
+// which is kept in the history state store of the conversation component.
+type conversationHistory struct {
+	opts      *rtconversation.History
+	store     state.Store
+	storeName string
+	component string
+	contextID string
+	key       string
+
+	etag    *string
+	context *runtimev1pb.GetConversationContextResponseAlpha2
+
+	// pending are the messages of the current request and its response,
+	// which are appended to the history once the model responded.
+	pending []*runtimev1pb.ConversationMessage
+}
+
+// conversationHistory returns the history of the given context, or nil if
+// the conversation component has no history state store.
+func (a *Universal) conversationHistory(name, contextID string) (*conversationHistory, error) {
+	opts, ok := a.compStore.GetConversationHistory(name)
+	if !ok {
+		return nil, nil
+	}
+
+	store, ok := a.compStore.GetStateStore(opts.StateStore)
+	if !ok {
+		err := messages.ErrConversationHistory.WithFormat(contextID, name, "state store "+opts.StateStore+" is not found")
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	return &conversationHistory{
+		opts:      opts,
+		store:     store,
+		storeName: opts.StateStore,
+		component: name,
+		contextID: contextID,
+		key:       a.appID + "||conversation||" + name + "||" + contextID,
+	}, nil
+}
+
+// loadConversationHistory loads the history of the context of the request if
+// the conversation component has a history state store, and returns the
+// request with the history prepended to its inputs. A context ID is generated
+// if the request doesn't have one.
+func (a *Universal) loadConversationHistory(ctx context.Context, req *runtimev1pb.ConversationRequestAlpha2) (*conversationHistory, *runtimev1pb.ConversationRequestAlpha2, error) {
+	if len(req.GetInputs()) == 0 {
+		return nil, req, nil
+	}
+
+	contextID := req.GetContextId()
+	if contextID == "" {
+		contextID = uuid.NewString()
+	}
+
+	h, err := a.conversationHistory(req.GetName(), contextID)
+	if h == nil || err != nil {
+		return nil, req, err
+	}
+
+	if _, err = h.load(ctx); err != nil {
+		err = messages.ErrConversationHistory.WithFormat(contextID, req.GetName(), err)
+		a.logger.Debug(err)
+		return nil, nil, err
+	}
+
+	// The inputs are kept as they are sent to the model, so PII is scrubbed
+	// before they are stored.
+	var scrubber piiscrubber.Scrubber
+	for _, input := range req.GetInputs() {
+		for _, msg := range input.GetMessages() {
+			if input.GetScrubPii() {
+				if scrubber == nil {
+					if scrubber, err = piiscrubber.NewDefaultScrubber(); err != nil {
+						return nil, nil, messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
+					}
+				}
+				if msg, err = scrubConversationMessage(scrubber, msg); err != nil {
+					return nil, nil, messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
+				}
+			}
+			h.pending = append(h.pending, msg)
+		}
+	}
+
+	var prior []*runtimev1pb.ConversationMessage
+	if summary := h.context.GetSummary(); summary != "" {
+		prior = append(prior, &runtimev1pb.ConversationMessage{
+			MessageTypes: &runtimev1pb.ConversationMessage_OfSystem{
+				OfSystem: &runtimev1pb.ConversationMessageOfSystem{
+					Content: []*runtimev1pb.ConversationMessageContent{{Text: "Summary of the earlier conversation: " + summary}},
+				},
+			},
+		})
+	}
+	prior = append(prior, h.context.GetMessages()...)
+
+	req = proto.CloneOf(req)
+	req.ContextId = &contextID
+	if len(prior) > 0 {
+		req.Inputs = slices.Insert(req.GetInputs(), 0, &runtimev1pb.ConversationInputAlpha2{Messages: prior})
+	}
+
+	return h, req, nil
+}
+
+// saveConversationHistory appends the replies of the model to the history,
+// along with the messages of the request, and saves it. Messages which no
+// longer fit in the history window are truncated or summarized.
+func (a *Universal) saveConversationHistory(ctx context.Context, h *conversationHistory, component conversation.Conversation, replies ...*runtimev1pb.ConversationMessage) error {
+	for _, reply := range replies {
+		if reply != nil {
+			h.pending = append(h.pending, reply)
+		}
+	}
+
+	// The summary is reused across attempts while the messages it summarizes
+	// are unchanged, so a concurrent update doesn't summarize them again.
+	var (
+		err         error
+		summarized  bool
+		summaryFrom string
+		summaryOf   []*runtimev1pb.ConversationMessage
+		summaryTo   string
+	)
+	for attempt := 1; ; attempt++ {
+		msgs := slices.Concat(h.context.GetMessages(), h.pending)
+		summary := h.context.GetSummary()
+		if start := h.opts.Window(msgs); start > 0 {
+			if h.opts.Strategy == rtconversation.HistoryStrategySummarize {
+				evicted := msgs[:start]
+				if !summarized || summary != summaryFrom || !slices.EqualFunc(evicted, summaryOf, func(x, y *runtimev1pb.ConversationMessage) bool {
+					return proto.Equal(x, y)
+				}) {
+					summaryFrom, summaryOf = summary, evicted
+					summaryTo = a.summarizeConversation(ctx, component, h, summary, evicted)
+					summarized = true
+				}
+				summary = summaryTo
+			}
+			msgs = msgs[start:]
+		}
+
+		err = h.save(ctx, msgs, summary)
+		var etagErr *state.ETagError
+		if err == nil || !errors.As(err, &etagErr) || attempt == conversationHistorySaveAttempts {
+			break
+		}
+
+		// The history was updated by a concurrent request, so the messages
+		// are appended to the latest history instead.
+		if _, err = h.load(ctx); err != nil {
+			break
+		}
+	}
+
+	if err != nil {
+		err = messages.ErrConversationHistory.WithFormat(h.contextID, h.component, err)
+		a.logger.Debug(err)
+		return err
+	}
+	return nil
+}
+
+// saveConversationResponse saves the final reply of the model in the
+// response to the history, along with its earlier replies, if there is a
+// history. The context ID of the response is set to that of the history.
+func (a *Universal) saveConversationResponse(ctx context.Context, h *conversationHistory, component conversation.Conversation, replies []*runtimev1pb.ConversationMessage, response *runtimev1pb.ConversationResponseAlpha2) error {
+	if h == nil {
+		return nil
+	}
+
+	var reply *runtimev1pb.ConversationMessage
+	if outputs := response.GetOutputs(); len(outputs) > 0 && len(outputs[len(outputs)-1].GetChoices()) > 0 {
+		msg := outputs[len(outputs)-1].GetChoices()[0].GetMessage()
+		reply = conversationReplyMessage(msg.GetContent(), msg.GetToolCalls())
+	}
+	if err := a.saveConversationHistory(ctx, h, component, append(replies, reply)...); err != nil {
+		return err
+	}
+	response.ContextId = &h.contextID
+	return nil
+}
+
+// summarizeConversation has the conversation component summarize the given
+// messages, along with the previous summary. The previous summary is kept if
+// the messages can't be summarized, in which case they are truncated.
+func (a *Universal) summarizeConversation(ctx context.Context, component conversation.Conversation, h *conversationHistory, summary string, msgs []*runtimev1pb.ConversationMessage) string {
+	var transcript strings.Builder
+	if summary != "" {
+		transcript.WriteString("summary of the earlier conversation: " + summary + "\n")
+	}
+	for _, msg := range msgs {
+		transcript.WriteString(rtconversation.MessageRole(msg) + ":")
+		for _, c := range rtconversation.MessageContent(msg) {
+			transcript.WriteString(" " + c.GetText())
+		}
+		for _, tc := range msg.GetOfAssistant().GetToolCalls() {
+			fmt.Fprintf(&transcript, " [called %s(%s)]", tc.GetFunction().GetName(), tc.GetFunction().GetArguments())
+		}
+		transcript.WriteString("\n")
+	}
+
+	request := &conversation.Request{
+		Message: &[]llms.MessageContent{
+			llms.TextParts(llms.ChatMessageTypeSystem, conversationSummaryPrompt),
+			llms.TextParts(llms.ChatMessageTypeHuman, transcript.String()),
+		},
+	}
+
+	resp, _, err := a.converseComponent(ctx, h.component, component, request)
+	if err == nil {
+		if reply := conversationReply(resp); reply != nil && reply.Content != "" {
+			return reply.Content
+		}
+		err = errors.New("the model returned an empty summary")
+	}
+
+	a.logger.Warnf("Failed to summarize the history of context %s of conversation component %s, truncating it instead: %v", h.contextID, h.component, err)
+	return summary
+}
+
+// load loads the history from the state store, returning false if the
+// context has no history yet.
+func (h *conversationHistory) load(ctx context.Context) (bool, error) {
+	h.etag = nil
+	h.context = &runtimev1pb.GetConversationContextResponseAlpha2{ContextId: h.contextID}
+
+	resp, err := h.store.Get(ctx, &state.GetRequest{Key: h.key})
+	if err != nil {
+		return false, err
+	}
+	if resp == nil || len(resp.Data) == 0 {
+		return false, nil
+	}
+
+	data := resp.Data
+	if encryption.EncryptedStateStore(h.storeName) {
+		data, err = encryption.TryDecryptValue(h.storeName, data)
+		if err != nil {
+			return false, err
+		}
+	}
+	if err = protojson.Unmarshal(data, h.context); err != nil {
+		return false, fmt.Errorf("failed to decode history: %w", err)
+	}
+	h.context.ContextId = h.contextID
+	h.etag = resp.ETag
+
+	return true, nil
+}
+
+// save saves the given messages and summary as the history, failing with an
+// ETag error if it was updated since it was loaded.
+func (h *conversationHistory) save(ctx context.Context, msgs []*runtimev1pb.ConversationMessage, summary string) error {
+	hc := &runtimev1pb.GetConversationContextResponseAlpha2{
+		ContextId: h.contextID,
+		Messages:  msgs,
+	}
+	if summary != "" {
+		hc.Summary = &summary
+	}
+
+	data, err := protojson.Marshal(hc)
+	if err != nil {
+		return err
+	}
+	if encryption.EncryptedStateStore(h.storeName) {
+		data, err = encryption.TryEncryptValue(h.storeName, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	req := &state.SetRequest{
+		Key:   h.key,
+		Value: data,
+		ETag:  h.etag,
+	}
+	if state.FeatureETag.IsPresent(h.store.Features()) {
+		req.Options.Concurrency = state.FirstWrite
+	}
+	if h.opts.TTL > 0 {
+		req.Metadata = map[string]string{
+			"ttlInSeconds": strconv.FormatInt(int64(h.opts.TTL.Seconds()), 10),
+		}
+	}
+
+	if err = h.store.Set(ctx, req); err != nil {
+		return err
+	}
+
+	h.context = hc
+	h.pending = nil
+	return nil
+}
+
+// conversationReply returns the message of the first choice of the last
+// output of a response, which is the reply of the model to the conversation.
+func conversationReply(resp *conversation.Response) *conversation.Message {
+	if resp == nil || len(resp.Outputs) == 0 {
+		return nil
+	}
+	choices := resp.Outputs[len(resp.Outputs)-1].Choices
+	if len(choices) == 0 {
+		return nil
+	}
+	return &choices[0].Message
+}
+
+// conversationReplyMessage returns the reply of the model as a message of
+// the conversation history, or nil if the model replied with nothing.
+func conversationReplyMessage(content string, toolCalls []*runtimev1pb.ConversationToolCalls) *runtimev1pb.ConversationMessage {
+	if content == "" && len(toolCalls) == 0 {
+		return nil
+	}
+
+	assistant := &runtimev1pb.ConversationMessageOfAssistant{ToolCalls: toolCalls}
+	if content != "" {
+		assistant.Content = []*runtimev1pb.ConversationMessageContent{{Text: content}}
+	}
+	return &runtimev1pb.ConversationMessage{
+		MessageTypes: &runtimev1pb.ConversationMessage_OfAssistant{OfAssistant: assistant},
+	}
+}
+
+// scrubConversationMessage returns a copy of the message with PII scrubbed
+// from its content.
+func scrubConversationMessage(scrubber piiscrubber.Scrubber, msg *runtimev1pb.ConversationMessage) (*runtimev1pb.ConversationMessage, error) {
+	msg = proto.CloneOf(msg)
+	for _, c := range rtconversation.MessageContent(msg) {
+		scrubbed, err := scrubber.ScrubTexts([]string{c.GetText()})
+		if err != nil {
+			return nil, err
+		}
+		c.Text = scrubbed[0]
+	}
+	return msg, nil
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/llms"
+
+	"github.com/dapr/components-contrib/conversation"
+	"github.com/dapr/components-contrib/conversation/echo"
+	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
+	"github.com/dapr/kit/ptr"
+)
+
+// summarizingEcho echoes messages, and replies with a fixed summary when
+// asked to summarize a conversation.
+type summarizingEcho struct {
+	conversation.Conversation
+	transcripts []string
+}
+
+func (s *summarizingEcho) Converse(ctx context.Context, r *conversation.Request) (*conversation.Response, error) {
+	msgs := *r.Message
+	if len(msgs) == 2 && msgs[0].Parts[0].(llms.TextContent).Text == conversationSummaryPrompt {
+		s.transcripts = append(s.transcripts, msgs[1].Parts[0].(llms.TextContent).Text)
+		return &conversation.Response{Outputs: []conversation.Result{{
+			Choices: []conversation.Choice{{Message: conversation.Message{Content: "the user said hello"}}},
+		}}}, nil
+	}
+	return s.Conversation.Converse(ctx, r)
+}
+
+// conflictingStore fails the first save with an ETag error, as if the
+// history was updated by a concurrent request.
+type conflictingStore struct {
+	state.Store
+	conflicted bool
+}
+
+func (s *conflictingStore) Set(ctx context.Context, req *state.SetRequest) error {
+	if !s.conflicted {
+		s.conflicted = true
+		return state.NewETagError(state.ETagMismatch, nil)
+	}
+	return s.Store.Set(ctx, req)
+}
+
+func TestConversationHistory(t *testing.T) {
+	newAPI := func(t *testing.T, comp conversation.Conversation, history *rtconversation.History) *Universal {
+		t.Helper()
+		store := inmemory.NewInMemoryStateStore(testLogger)
+		require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+		t.Cleanup(func() { store.(interface{ Close() error }).Close() })
+
+		compStore := compstore.New()
+		compStore.AddStateStore("store", store)
+		compStore.AddConversation("llm", comp)
+		if history != nil {
+			compStore.AddConversationHistory("llm", history)
+		}
+		return &Universal{
+			appID:      "myapp",
+			logger:     testLogger,
+			compStore:  compStore,
+			resiliency: resiliency.New(nil),
+		}
+	}
+
+	userMessage := func(text string) *runtimev1pb.ConversationMessage {
+		return &runtimev1pb.ConversationMessage{
+			MessageTypes: &runtimev1pb.ConversationMessage_OfUser{
+				OfUser: &runtimev1pb.ConversationMessageOfUser{
+					Content: []*runtimev1pb.ConversationMessageContent{{Text: text}},
+				},
+			},
+		}
+	}
+
+	request := func(contextID *string, text string) *runtimev1pb.ConversationRequestAlpha2 {
+		return &runtimev1pb.ConversationRequestAlpha2{
+			Name:      "llm",
+			ContextId: contextID,
+			Inputs: []*runtimev1pb.ConversationInputAlpha2{{
+				Messages: []*runtimev1pb.ConversationMessage{userMessage(text)},
+			}},
+		}
+	}
+
+	texts := func(msgs []*runtimev1pb.ConversationMessage) []string {
+		res := make([]string, 0, len(msgs))
+		for _, msg := range msgs {
+			var parts []string
+			for _, c := range rtconversation.MessageContent(msg) {
+				parts = append(parts, c.GetText())
+			}
+			res = append(res, rtconversation.MessageRole(msg)+": "+strings.Join(parts, " "))
+		}
+		return res
+	}
+
+	t.Run("context ID is passed through without history", func(t *testing.T) {
+		api := newAPI(t, echo.NewEcho(testLogger), nil)
+		resp, err := api.ConverseAlpha2(t.Context(), request(nil, "hello"))
+		require.NoError(t, err)
+		assert.Nil(t, resp.ContextId)
+
+		_, err = api.GetConversationContextAlpha2(t.Context(), &runtimev1pb.GetConversationContextRequestAlpha2{Name: "llm", ContextId: "ctx"})
+		require.ErrorIs(t, err, messages.ErrConversationHistoryNotConfigured)
+	})
+
+	t.Run("history is prepended and saved", func(t *testing.T) {
+		api := newAPI(t, echo.NewEcho(testLogger), &rtconversation.History{StateStore: "store"})
+
+		resp, err := api.ConverseAlpha2(t.Context(), request(nil, "hello"))
+		require.NoError(t, err)
+		contextID := resp.GetContextId()
+		require.NotEmpty(t, contextID, "a context ID must be generated")
+		require.Len(t, resp.GetOutputs(), 1)
+
+		resp, err = api.ConverseAlpha2(t.Context(), request(&contextID, "how are you"))
+		require.NoError(t, err)
+		assert.Equal(t, contextID, resp.GetContextId())
+		// The echo component replies to each message, including those of
+		// the history.
+		require.Len(t, resp.GetOutputs(), 3)
+		assert.Equal(t, "hello", resp.GetOutputs()[0].GetChoices()[0].GetMessage().GetContent())
+
+		got, err := api.GetConversationContextAlpha2(t.Context(), &runtimev1pb.GetConversationContextRequestAlpha2{Name: "llm", ContextId: contextID})
+		require.NoError(t, err)
+		assert.Equal(t, contextID, got.GetContextId())
+		assert.Equal(t, []string{
+			"user: hello",
+			"assistant: hello",
+			"user: how are you",
+			"assistant: how are you",
+		}, texts(got.GetMessages()))
+
+		_, err = api.DeleteConversationContextAlpha2(t.Context(), &runtimev1pb.DeleteConversationContextRequestAlpha2{Name: "llm", ContextId: contextID})
+		require.NoError(t, err)
+		_, err = api.GetConversationContextAlpha2(t.Context(), &runtimev1pb.GetConversationContextRequestAlpha2{Name: "llm", ContextId: contextID})
+		require.ErrorIs(t, err, messages.ErrConversationContextNotFound)
+	})
+
+	t.Run("history is truncated", func(t *testing.T) {
+		api := newAPI(t, echo.NewEcho(testLogger), &rtconversation.History{StateStore: "store", MaxMessages: 3})
+
+		for _, text := range []string{"one", "two", "three"} {
+			_, err := api.ConverseAlpha2(t.Context(), request(ptr.Of("ctx"), text))
+			require.NoError(t, err)
+		}
+
+		got, err := api.GetConversationContextAlpha2(t.Context(), &runtimev1pb.GetConversationContextRequestAlpha2{Name: "llm", ContextId: "ctx"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"assistant: two", "user: three", "assistant: three"}, texts(got.GetMessages()))
+		assert.Nil(t, got.Summary)
+	})
+
+	t.Run("history is summarized", func(t *testing.T) {
+		comp := &summarizingEcho{Conversation: echo.NewEcho(testLogger)}
+		api := newAPI(t, comp, &rtconversation.History{
+			StateStore:  "store",
+			MaxMessages: 2,
+			Strategy:    rtconversation.HistoryStrategySummarize,
+		})
+
+		for _, text := range []string{"hello", "bye"} {
+			_, err := api.ConverseAlpha2(t.Context(), request(ptr.Of("ctx"), text))
+			require.NoError(t, err)
+		}
+
+		require.Len(t, comp.transcripts, 1)
+		assert.Equal(t, "user: hello\nassistant: hello\n", comp.transcripts[0])
+
+		got, err := api.GetConversationContextAlpha2(t.Context(), &runtimev1pb.GetConversationContextRequestAlpha2{Name: "llm", ContextId: "ctx"})
+		require.NoError(t, err)
+		assert.Equal(t, "the user said hello", got.GetSummary())
+		assert.Equal(t, []string{"user: bye", "assistant: bye"}, texts(got.GetMessages()))
+
+		// The summary is prepended to the next request as a system message.
+		resp, err := api.ConverseAlpha2(t.Context(), request(ptr.Of("ctx"), "again"))
+		require.NoError(t, err)
+		assert.Equal(t, "Summary of the earlier conversation: the user said hello", resp.GetOutputs()[0].GetChoices()[0].GetMessage().GetContent())
+	})
+
+	t.Run("summary is reused when the save is retried", func(t *testing.T) {
+		comp := &summarizingEcho{Conversation: echo.NewEcho(testLogger)}
+		api := newAPI(t, comp, &rtconversation.History{
+			StateStore:  "store",
+			MaxMessages: 2,
+			Strategy:    rtconversation.HistoryStrategySummarize,
+		})
+
+		_, err := api.ConverseAlpha2(t.Context(), request(ptr.Of("ctx"), "hello"))
+		require.NoError(t, err)
+
+		store, ok := api.compStore.GetStateStore("store")
+		require.True(t, ok)
+		api.compStore.AddStateStore("store", &conflictingStore{Store: store})
+
+		_, err = api.ConverseAlpha2(t.Context(), request(ptr.Of("ctx"), "bye"))
+		require.NoError(t, err)
+
+		require.Len(t, comp.transcripts, 1)
+		got, err := api.GetConversationContextAlpha2(t.Context(), &runtimev1pb.GetConversationContextRequestAlpha2{Name: "llm", ContextId: "ctx"})
+		require.NoError(t, err)
+		assert.Equal(t, "the user said hello", got.GetSummary())
+		assert.Equal(t, []string{"user: bye", "assistant: bye"}, texts(got.GetMessages()))
+	})
+
+	t.Run("PII is scrubbed from the history", func(t *testing.T) {
+		api := newAPI(t, echo.NewEcho(testLogger), &rtconversation.History{StateStore: "store"})
+
+		req := request(ptr.Of("ctx"), "my email is test@example.com")
+		req.Inputs[0].ScrubPii = ptr.Of(true)
+		req.ScrubPii = ptr.Of(true)
+		_, err := api.ConverseAlpha2(t.Context(), req)
+		require.NoError(t, err)
+
+		got, err := api.GetConversationContextAlpha2(t.Context(), &runtimev1pb.GetConversationContextRequestAlpha2{Name: "llm", ContextId: "ctx"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"user: my email is <EMAIL_ADDRESS>",
+			"assistant: my email is <EMAIL_ADDRESS>",
+		}, texts(got.GetMessages()))
+	})
+
+	t.Run("streamed responses are saved", func(t *testing.T) {
+		api := newAPI(t, echo.NewEcho(testLogger), &rtconversation.History{StateStore: "store"})
+
+		var complete *runtimev1pb.ConversationStreamCompleteAlpha2
+		err := api.ConverseStreamAlpha2(t.Context(), request(nil, "hello"), func(res *runtimev1pb.ConversationStreamResponseAlpha2) error {
+			if c := res.GetComplete(); c != nil {
+				complete = c
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, complete.GetContextId())
+
+		got, err := api.GetConversationContextAlpha2(t.Context(), &runtimev1pb.GetConversationContextRequestAlpha2{Name: "llm", ContextId: complete.GetContextId()})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user: hello", "assistant: hello"}, texts(got.GetMessages()))
+	})
+
+	t.Run("invalid context requests", func(t *testing.T) {
+		api := newAPI(t, echo.NewEcho(testLogger), &rtconversation.History{StateStore: "store"})
+
+		_, err := api.GetConversationContextAlpha2(t.Context(), &runtimev1pb.GetConversationContextRequestAlpha2{Name: "llm"})
+		require.ErrorIs(t, err, messages.ErrConversationContextIDMissing)
+		_, err = api.DeleteConversationContextAlpha2(t.Context(), &runtimev1pb.DeleteConversationContextRequestAlpha2{Name: "other", ContextId: "ctx"})
+		require.ErrorIs(t, err, messages.ErrConversationNotFound)
+
+		api.compStore.DeleteStateStore("store")
+		_, err = api.ConverseAlpha2(t.Context(), request(ptr.Of("ctx"), "hello"))
+		require.ErrorIs(t, err, messages.ErrConversationHistory)
+	})
+}
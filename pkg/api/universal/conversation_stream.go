@@ -0,0 +1,350 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/tmc/langchaingo/llms"
+
+	piiscrubber "github.com/aavaz-ai/pii-scrubber"
+
+	"github.com/dapr/components-contrib/conversation"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
+)
+
+// piiStreamHoldback is the number of trailing bytes of streamed text which
+// are held back from being scrubbed and sent, so that PII spanning multiple
+// words is scrubbed as a whole.
+const piiStreamHoldback = 32
+
+// errConversationStreamSend wraps errors returned when sending to the caller,
+// which are returned as is rather than as an invocation error.
+var errConversationStreamSend = errors.New("failed to send conversation stream response")
+
+// ConverseStreamAlpha2 is the streaming variant of ConverseAlpha2. The chunks
+// of the response are passed to send as they are generated by the model,
+// followed by a single complete message.
+// Components which wrap a langchaingo model stream their response as it is
+// generated, whereas the response of all other components is sent as a
+// single chunk per choice once complete.
+func (a *Universal) ConverseStreamAlpha2(ctx context.Context, req *runtimev1pb.ConversationRequestAlpha2, send func(*runtimev1pb.ConversationStreamResponseAlpha2) error) error {
+	history, req, err := a.loadConversationHistory(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	component, request, scrubber, err := a.prepareConverseAlpha2(req)
+	if err != nil {
+		return err
+	}
+
+	// Tool calls are streamed to the app as they are generated, so they can't
+	// be executed by daprd.
+	for _, tool := range req.GetTools() {
+		if tool.GetFunction().GetTarget() != nil {
+			err = messages.ErrConversationToolTarget.WithFormat(tool.GetFunction().GetName(), req.GetName(), "tools bound to a target are not supported when streaming")
+			a.logger.Debug(err)
+			return err
+		}
+	}
+
+	if err = a.checkConversationSidecarBudget(); err != nil {
+		return err
+	}
+
+	s := &conversationStream{
+		send:      send,
+		hasTools:  request.Tools != nil && len(*request.Tools) > 0,
+		scrubbers: make(map[int64]*piiStreamScrubber),
+		streamed:  make(map[int64]bool),
+	}
+	if req.GetScrubPii() {
+		s.scrubber = scrubber
+	}
+
+	start := time.Now()
+	policyRunner := resiliency.NewRunner[*conversation.Response](ctx,
+		a.resiliency.ComponentOutboundPolicy(req.GetName(), resiliency.Conversation),
+	)
+	resp, err := policyRunner(func(ctx context.Context) (*conversation.Response, error) {
+		rResp, rErr := s.converse(ctx, component, request)
+		// Once the model started streaming, the request can no longer be
+		// retried as parts of the response may have been sent already.
+		if rErr != nil && s.started {
+			return nil, backoff.Permanent(rErr)
+		}
+		return rResp, rErr
+	})
+	elapsed := diag.ElapsedSince(start)
+	diag.DefaultComponentMonitoring.ConversationInvoked(ctx, req.GetName(), err == nil, elapsed)
+
+	if err == nil {
+		a.recordConversationUsage(ctx, req.GetName(), s.usage)
+		err = s.finish(resp)
+	}
+	if errors.Is(err, errConversationStreamSend) {
+		a.logger.Debug(err)
+		return err
+	}
+	if err != nil {
+		err = messages.ErrConversationInvoke.WithFormat(req.GetName(), err.Error())
+		a.logger.Debug(err)
+		return err
+	}
+
+	complete := &runtimev1pb.ConversationStreamCompleteAlpha2{
+		Usage: conversationUsage(s.usage),
+	}
+	if resp != nil && resp.ConversationContext != "" {
+		complete.ContextId = &resp.ConversationContext
+	}
+
+	if history != nil {
+		var reply *runtimev1pb.ConversationMessage
+		if msg := conversationReply(resp); msg != nil {
+			content := msg.Content
+			if req.GetScrubPii() && content != "" {
+				scrubbed, sErr := scrubber.ScrubTexts([]string{content})
+				if sErr != nil {
+					err = messages.ErrConversationInvoke.WithFormat(req.GetName(), sErr.Error())
+					a.logger.Debug(err)
+					return err
+				}
+				content = scrubbed[0]
+			}
+			var toolCalls []*runtimev1pb.ConversationToolCalls
+			if msg.ToolCallRequest != nil {
+				for _, toolCall := range *msg.ToolCallRequest {
+					toolCalls = append(toolCalls, conversationToolCall(toolCall.ID, toolCall.FunctionCall))
+				}
+			}
+			reply = conversationReplyMessage(content, toolCalls)
+		}
+		if err = a.saveConversationHistory(ctx, history, component, reply); err != nil {
+			return err
+		}
+		complete.ContextId = &history.contextID
+	}
+	return s.sendResponse(&runtimev1pb.ConversationStreamResponseAlpha2{
+		ResponseType: &runtimev1pb.ConversationStreamResponseAlpha2_Complete{Complete: complete},
+	})
+}
+
+// conversationStream relays the chunks of a conversation response to the
+// caller as they are generated by the model.
+type conversationStream struct {
+	send     func(*runtimev1pb.ConversationStreamResponseAlpha2) error
+	hasTools bool
+	scrubber piiscrubber.Scrubber
+
+	started           bool
+	scrubbers         map[int64]*piiStreamScrubber
+	streamed          map[int64]bool
+	streamedToolCalls bool
+	usage             rtconversation.Usage
+}
+
+// converse calls the component, streaming the response of components which
+// wrap a langchaingo model.
+func (s *conversationStream) converse(ctx context.Context, component conversation.Conversation, r *conversation.Request) (*conversation.Response, error) {
+	resp, usage, err := converseWithUsage(ctx, component, r, llms.WithStreamingFunc(s.onChunk))
+	if err != nil {
+		return nil, err
+	}
+	s.usage = usage
+	return resp, nil
+}
+
+// onChunk is called by the model for each chunk of the first choice it
+// generates. Chunks are either text, or a JSON array of partial tool calls.
+func (s *conversationStream) onChunk(_ context.Context, chunk []byte) error {
+	if len(chunk) == 0 {
+		return nil
+	}
+	s.started = true
+
+	if s.hasTools {
+		if toolCalls, ok := parseToolCallDeltas(chunk); ok {
+			s.streamedToolCalls = true
+			return s.sendChunk(&runtimev1pb.ConversationStreamChunkAlpha2{ToolCalls: toolCalls})
+		}
+	}
+
+	s.streamed[0] = true
+	content, err := s.scrub(0, string(chunk), false)
+	if err != nil || len(content) == 0 {
+		return err
+	}
+	return s.sendChunk(&runtimev1pb.ConversationStreamChunkAlpha2{Content: content})
+}
+
+// finish sends the last chunk of each choice of the response, holding any
+// content and tool calls which were not streamed.
+func (s *conversationStream) finish(resp *conversation.Response) error {
+	if resp == nil {
+		return nil
+	}
+
+	for _, o := range resp.Outputs {
+		for _, choice := range o.Choices {
+			var content string
+			if !s.streamed[choice.Index] {
+				content = choice.Message.Content
+			}
+			content, err := s.scrub(choice.Index, content, true)
+			if err != nil {
+				return err
+			}
+
+			chunk := &runtimev1pb.ConversationStreamChunkAlpha2{
+				Index:   choice.Index,
+				Content: content,
+			}
+			if choice.FinishReason != "" {
+				chunk.FinishReason = &choice.FinishReason
+			}
+			if choice.Message.ToolCallRequest != nil && (choice.Index != 0 || !s.streamedToolCalls) {
+				for _, toolCall := range *choice.Message.ToolCallRequest {
+					chunk.ToolCalls = append(chunk.ToolCalls, conversationToolCall(toolCall.ID, toolCall.FunctionCall))
+				}
+			}
+
+			if err := s.sendChunk(chunk); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// scrub scrubs PII from the given content of a choice if requested. Unless
+// flushing, the trailing part of the content may be held back until more
+// content is streamed.
+func (s *conversationStream) scrub(index int64, content string, flush bool) (string, error) {
+	if s.scrubber == nil {
+		return content, nil
+	}
+
+	ps, ok := s.scrubbers[index]
+	if !ok {
+		ps = &piiStreamScrubber{scrubber: s.scrubber}
+		s.scrubbers[index] = ps
+	}
+
+	if flush {
+		ps.pending.WriteString(content)
+		return ps.flush()
+	}
+	return ps.write(content)
+}
+
+func (s *conversationStream) sendChunk(chunk *runtimev1pb.ConversationStreamChunkAlpha2) error {
+	return s.sendResponse(&runtimev1pb.ConversationStreamResponseAlpha2{
+		ResponseType: &runtimev1pb.ConversationStreamResponseAlpha2_Chunk{Chunk: chunk},
+	})
+}
+
+func (s *conversationStream) sendResponse(resp *runtimev1pb.ConversationStreamResponseAlpha2) error {
+	if err := s.send(resp); err != nil {
+		return errors.Join(errConversationStreamSend, err)
+	}
+	return nil
+}
+
+// toolCallDelta is a partial tool call streamed by a model. llms.ToolCall
+// can't be used to decode it, as it uses its own JSON encoding.
+type toolCallDelta struct {
+	ID       string             `json:"id"`
+	Type     string             `json:"type"`
+	Function *llms.FunctionCall `json:"function"`
+}
+
+// parseToolCallDeltas parses a streamed chunk holding partial tool calls. A
+// tool call without a name continues the arguments of the previous one.
+func parseToolCallDeltas(chunk []byte) ([]*runtimev1pb.ConversationToolCalls, bool) {
+	if chunk[0] != '[' {
+		return nil, false
+	}
+
+	var deltas []toolCallDelta
+	if err := json.Unmarshal(chunk, &deltas); err != nil || len(deltas) == 0 {
+		return nil, false
+	}
+
+	toolCalls := make([]*runtimev1pb.ConversationToolCalls, 0, len(deltas))
+	for _, delta := range deltas {
+		if delta.Function == nil {
+			return nil, false
+		}
+		toolCalls = append(toolCalls, conversationToolCall(delta.ID, delta.Function))
+	}
+	return toolCalls, true
+}
+
+// piiStreamScrubber scrubs PII from text which is streamed in chunks. Text is
+// only scrubbed up to a whitespace before the last piiStreamHoldback bytes,
+// so that PII is not split between the texts which are scrubbed.
+type piiStreamScrubber struct {
+	scrubber piiscrubber.Scrubber
+	pending  strings.Builder
+}
+
+func (p *piiStreamScrubber) write(text string) (string, error) {
+	p.pending.WriteString(text)
+	buf := p.pending.String()
+	if len(buf) <= piiStreamHoldback {
+		return "", nil
+	}
+
+	i := strings.LastIndexFunc(buf[:len(buf)-piiStreamHoldback], unicode.IsSpace)
+	if i < 0 {
+		return "", nil
+	}
+	_, size := utf8.DecodeRuneInString(buf[i:])
+	i += size
+
+	p.pending.Reset()
+	p.pending.WriteString(buf[i:])
+	return p.scrubText(buf[:i])
+}
+
+func (p *piiStreamScrubber) flush() (string, error) {
+	buf := p.pending.String()
+	p.pending.Reset()
+	return p.scrubText(buf)
+}
+
+func (p *piiStreamScrubber) scrubText(text string) (string, error) {
+	if len(text) == 0 {
+		return "", nil
+	}
+	scrubbed, err := p.scrubber.ScrubTexts([]string{text})
+	if err != nil {
+		return "", err
+	}
+	return scrubbed[0], nil
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/llms"
+
+	piiscrubber "github.com/aavaz-ai/pii-scrubber"
+
+	"github.com/dapr/components-contrib/conversation"
+	"github.com/dapr/components-contrib/conversation/echo"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/ptr"
+)
+
+// streamingModel is a conversation component wrapping a langchaingo model
+// which streams the given chunks.
+type streamingModel struct {
+	conversation.Conversation
+
+	chunks    []string
+	resp      *llms.ContentResponse
+	err       error
+	gotStream bool
+}
+
+func (s *streamingModel) GenerateContent(ctx context.Context, _ []llms.MessageContent, options ...llms.CallOption) (*llms.ContentResponse, error) {
+	var opts llms.CallOptions
+	for _, o := range options {
+		o(&opts)
+	}
+	s.gotStream = opts.StreamingFunc != nil
+	for _, c := range s.chunks {
+		if err := opts.StreamingFunc(ctx, []byte(c)); err != nil {
+			return nil, err
+		}
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.resp, nil
+}
+
+func (s *streamingModel) Call(context.Context, string, ...llms.CallOption) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func TestConverseStreamAlpha2(t *testing.T) {
+	newAPI := func(comp conversation.Conversation) *Universal {
+		compStore := compstore.New()
+		compStore.AddConversation("llm", comp)
+		return &Universal{
+			logger:     testLogger,
+			compStore:  compStore,
+			resiliency: resiliency.New(nil),
+		}
+	}
+
+	request := func(text string) *runtimev1pb.ConversationRequestAlpha2 {
+		return &runtimev1pb.ConversationRequestAlpha2{
+			Name:      "llm",
+			ContextId: ptr.Of("ctx-1"),
+			Inputs: []*runtimev1pb.ConversationInputAlpha2{{
+				Messages: []*runtimev1pb.ConversationMessage{{
+					MessageTypes: &runtimev1pb.ConversationMessage_OfUser{
+						OfUser: &runtimev1pb.ConversationMessageOfUser{
+							Content: []*runtimev1pb.ConversationMessageContent{{Text: text}},
+						},
+					},
+				}},
+			}},
+		}
+	}
+
+	converse := func(t *testing.T, api *Universal, req *runtimev1pb.ConversationRequestAlpha2) ([]*runtimev1pb.ConversationStreamChunkAlpha2, *runtimev1pb.ConversationStreamCompleteAlpha2, error) {
+		t.Helper()
+		var chunks []*runtimev1pb.ConversationStreamChunkAlpha2
+		var complete *runtimev1pb.ConversationStreamCompleteAlpha2
+		err := api.ConverseStreamAlpha2(t.Context(), req, func(res *runtimev1pb.ConversationStreamResponseAlpha2) error {
+			assert.Nil(t, complete, "no responses may follow the complete message")
+			if c := res.GetChunk(); c != nil {
+				chunks = append(chunks, c)
+			} else {
+				complete = res.GetComplete()
+			}
+			return nil
+		})
+		return chunks, complete, err
+	}
+
+	t.Run("component not found", func(t *testing.T) {
+		api := &Universal{logger: testLogger, compStore: compstore.New()}
+		_, _, err := converse(t, api, request("hello"))
+		require.ErrorIs(t, err, messages.ErrConversationNotFound)
+	})
+
+	t.Run("non-streaming component is sent as a single chunk", func(t *testing.T) {
+		chunks, complete, err := converse(t, newAPI(echo.NewEcho(testLogger)), request("hello"))
+		require.NoError(t, err)
+		require.Len(t, chunks, 1)
+		assert.Equal(t, "hello", chunks[0].GetContent())
+		assert.Equal(t, "stop", chunks[0].GetFinishReason())
+		require.NotNil(t, complete)
+		assert.Equal(t, "ctx-1", complete.GetContextId())
+	})
+
+	t.Run("model response is streamed", func(t *testing.T) {
+		model := &streamingModel{
+			Conversation: echo.NewEcho(testLogger),
+			chunks:       []string{"Hel", "lo ", "world"},
+			resp: &llms.ContentResponse{Choices: []*llms.ContentChoice{
+				{Content: "Hello world", StopReason: "stop"},
+			}},
+		}
+		chunks, complete, err := converse(t, newAPI(model), request("hi"))
+		require.NoError(t, err)
+		assert.True(t, model.gotStream)
+		require.Len(t, chunks, 4)
+		var content strings.Builder
+		for _, c := range chunks {
+			content.WriteString(c.GetContent())
+		}
+		assert.Equal(t, "Hello world", content.String())
+		assert.Nil(t, chunks[2].FinishReason)
+		assert.Equal(t, "stop", chunks[3].GetFinishReason())
+		require.NotNil(t, complete)
+	})
+
+	t.Run("tool calls are streamed", func(t *testing.T) {
+		req := request("weather?")
+		req.Tools = []*runtimev1pb.ConversationTools{{
+			ToolTypes: &runtimev1pb.ConversationTools_Function{
+				Function: &runtimev1pb.ConversationToolsFunction{Name: "get_weather"},
+			},
+		}}
+		toolCalls := []llms.ToolCall{{
+			ID: "call-1", Type: "function",
+			FunctionCall: &llms.FunctionCall{Name: "get_weather", Arguments: `{"city":"Paris"}`},
+		}}
+		model := &streamingModel{
+			Conversation: echo.NewEcho(testLogger),
+			chunks: []string{
+				`[{"id":"call-1","type":"function","function":{"name":"get_weather","arguments":"{\"city\":"}}]`,
+				`[{"function":{"arguments":"\"Paris\"}"}}]`,
+			},
+			resp: &llms.ContentResponse{Choices: []*llms.ContentChoice{
+				{StopReason: "tool_calls", ToolCalls: toolCalls},
+			}},
+		}
+		chunks, _, err := converse(t, newAPI(model), req)
+		require.NoError(t, err)
+		require.Len(t, chunks, 3)
+		assert.Equal(t, "call-1", chunks[0].GetToolCalls()[0].GetId())
+		assert.Equal(t, "get_weather", chunks[0].GetToolCalls()[0].GetFunction().GetName())
+		assert.Equal(t, `"Paris"}`, chunks[1].GetToolCalls()[0].GetFunction().GetArguments())
+		assert.Empty(t, chunks[2].GetToolCalls(), "streamed tool calls must not be repeated")
+		assert.Equal(t, "tool_calls", chunks[2].GetFinishReason())
+	})
+
+	t.Run("error after streaming started", func(t *testing.T) {
+		model := &streamingModel{
+			Conversation: echo.NewEcho(testLogger),
+			chunks:       []string{"Hel"},
+			err:          errors.New("boom"),
+		}
+		chunks, complete, err := converse(t, newAPI(model), request("hi"))
+		require.ErrorIs(t, err, messages.ErrConversationInvoke)
+		require.ErrorContains(t, err, "boom")
+		assert.Len(t, chunks, 1)
+		assert.Nil(t, complete)
+	})
+
+	t.Run("send error is returned", func(t *testing.T) {
+		err := newAPI(echo.NewEcho(testLogger)).ConverseStreamAlpha2(t.Context(), request("hello"), func(*runtimev1pb.ConversationStreamResponseAlpha2) error {
+			return errors.New("client gone")
+		})
+		require.ErrorContains(t, err, "client gone")
+		require.NotErrorIs(t, err, messages.ErrConversationInvoke)
+	})
+}
+
+func TestPIIStreamScrubber(t *testing.T) {
+	scrubber, err := piiscrubber.NewDefaultScrubber()
+	require.NoError(t, err)
+
+	text := "Please send the invoice to the customer by email at test@example.com and also call them on (555) 123-4567 once it has been paid."
+	want, err := scrubber.ScrubTexts([]string{text})
+	require.NoError(t, err)
+	require.NotEqual(t, text, want[0])
+
+	for _, size := range []int{1, 3, 7, 16, 64} {
+		ps := &piiStreamScrubber{scrubber: scrubber}
+		var got strings.Builder
+		for i := 0; i < len(text); i += size {
+			out, err := ps.write(text[i:min(i+size, len(text))])
+			require.NoError(t, err)
+			got.WriteString(out)
+		}
+		out, err := ps.flush()
+		require.NoError(t, err)
+		got.WriteString(out)
+
+		assert.Equal(t, want[0], got.String(), "chunk size %d", size)
+	}
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tmc/langchaingo/llms"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/dapr/components-contrib/conversation"
+	"github.com/dapr/components-contrib/conversation/mistral"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/messages"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// defaultConversationMaxToolSteps is the number of rounds of tool calls
+// executed by daprd when the request doesn't set a limit.
+const defaultConversationMaxToolSteps = 10
+
+// conversationFinishReasonMaxToolSteps is the finish reason of the choices of
+// the last reply of the model when it still called tools bound to a target
+// after the maximum number of rounds of tool calls.
+const conversationFinishReasonMaxToolSteps = "max_tool_steps"
+
+// conversationTools returns the targets of the tools of the request which
+// are bound to one, by tool name, along with the maximum number of rounds of
+// tool calls to execute.
+func (a *Universal) conversationTools(req *runtimev1pb.ConversationRequestAlpha2) (map[string]*runtimev1pb.ConversationToolTarget, int32, error) {
+	var targets map[string]*runtimev1pb.ConversationToolTarget
+	for _, tool := range req.GetTools() {
+		fn := tool.GetFunction()
+		if fn.GetTarget() == nil {
+			continue
+		}
+		if err := a.validateConversationToolTarget(fn.GetTarget()); err != nil {
+			err = messages.ErrConversationToolTarget.WithFormat(fn.GetName(), req.GetName(), err)
+			a.logger.Debug(err)
+			return nil, 0, err
+		}
+		if targets == nil {
+			targets = make(map[string]*runtimev1pb.ConversationToolTarget)
+		}
+		targets[fn.GetName()] = fn.GetTarget()
+	}
+
+	maxSteps := int32(defaultConversationMaxToolSteps)
+	if req.MaxToolSteps != nil {
+		maxSteps = req.GetMaxToolSteps()
+		if maxSteps < 1 {
+			a.logger.Debugf("Invalid max tool steps %d for conversation component %s: must be at least 1", maxSteps, req.GetName())
+			return nil, 0, messages.ErrConversationInvalidParams.WithFormat(req.GetName())
+		}
+	}
+
+	return targets, maxSteps, nil
+}
+
+func (a *Universal) validateConversationToolTarget(target *runtimev1pb.ConversationToolTarget) error {
+	switch t := target.GetTargetTypes().(type) {
+	case *runtimev1pb.ConversationToolTarget_Service:
+		if t.Service.GetAppId() == "" || t.Service.GetMethod() == "" {
+			return errors.New("app ID and method are required")
+		}
+	case *runtimev1pb.ConversationToolTarget_HttpEndpoint:
+		if t.HttpEndpoint.GetName() == "" {
+			return errors.New("name is required")
+		}
+		if _, ok := a.compStore.GetHTTPEndpoint(t.HttpEndpoint.GetName()); !ok {
+			return fmt.Errorf("HTTPEndpoint %s not found", t.HttpEndpoint.GetName())
+		}
+	case *runtimev1pb.ConversationToolTarget_Binding:
+		if t.Binding.GetName() == "" || t.Binding.GetOperation() == "" {
+			return errors.New("name and operation are required")
+		}
+		if _, ok := a.compStore.GetOutputBinding(t.Binding.GetName()); !ok {
+			return fmt.Errorf("output binding %s not found", t.Binding.GetName())
+		}
+	default:
+		return errors.New("target type is missing")
+	}
+	return nil
+}
+
+// hasBoundToolCalls returns true if the model replied with tool calls which
+// are all bound to a target. Tool calls of other tools are returned to the
+// app, along with those of bound tools made in the same reply.
+func hasBoundToolCalls(reply *conversation.Message, targets map[string]*runtimev1pb.ConversationToolTarget) bool {
+	if reply == nil || reply.ToolCallRequest == nil || len(*reply.ToolCallRequest) == 0 {
+		return false
+	}
+	for _, toolCall := range *reply.ToolCallRequest {
+		if toolCall.FunctionCall == nil {
+			return false
+		}
+		if _, ok := targets[toolCall.FunctionCall.Name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// executeConversationTools executes the tool calls of the reply of the model
+// on the targets of the tools. It returns the messages which pass the reply
+// and the results of the calls back to the model, the same messages as they
+// are kept in the conversation history, and the trace of the calls.
+// A failed call doesn't fail the request: the error is passed to the model in
+// place of the result instead.
+func (a *Universal) executeConversationTools(ctx context.Context, component conversation.Conversation, step int32, reply *conversation.Message, targets map[string]*runtimev1pb.ConversationToolTarget) ([]llms.MessageContent, []*runtimev1pb.ConversationMessage, []*runtimev1pb.ConversationToolExecution) {
+	_, isMistral := component.(*mistral.Mistral)
+
+	toolCalls := *reply.ToolCallRequest
+	assistant := llms.MessageContent{Role: llms.ChatMessageTypeAI}
+	if reply.Content != "" {
+		assistant.Parts = append(assistant.Parts, llms.TextContent{Text: reply.Content})
+	}
+	replyToolCalls := make([]*runtimev1pb.ConversationToolCalls, len(toolCalls))
+	for i := range toolCalls {
+		if isMistral {
+			assistant.Parts = append(assistant.Parts, mistral.CreateToolCallPart(&toolCalls[i]))
+		} else {
+			assistant.Parts = append(assistant.Parts, toolCalls[i])
+		}
+		replyToolCalls[i] = conversationToolCall(toolCalls[i].ID, toolCalls[i].FunctionCall)
+	}
+
+	msgs := make([]llms.MessageContent, 0, len(toolCalls)+1)
+	msgs = append(msgs, assistant)
+	history := make([]*runtimev1pb.ConversationMessage, 0, len(toolCalls)+1)
+	history = append(history, conversationReplyMessage(reply.Content, replyToolCalls))
+	executions := make([]*runtimev1pb.ConversationToolExecution, 0, len(toolCalls))
+
+	for _, toolCall := range toolCalls {
+		name := toolCall.FunctionCall.Name
+		execution := &runtimev1pb.ConversationToolExecution{
+			Step:      step,
+			Id:        toolCall.ID,
+			Name:      name,
+			Arguments: toolCall.FunctionCall.Arguments,
+		}
+
+		result, err := a.invokeConversationTool(ctx, targets[name], toolCall.FunctionCall.Arguments)
+		execution.Result = result
+		if err != nil {
+			a.logger.Debugf("Failed to execute tool call %s of tool %s: %v", toolCall.ID, name, err)
+			errMsg := err.Error()
+			execution.Error = &errMsg
+			result = "error: " + errMsg
+		}
+		executions = append(executions, execution)
+
+		toolResponse := llms.ToolCallResponse{
+			ToolCallID: toolCall.ID,
+			Name:       name,
+			Content:    result,
+		}
+		if isMistral {
+			msgs = append(msgs, mistral.CreateToolResponseMessage(toolResponse))
+		} else {
+			msgs = append(msgs, llms.MessageContent{
+				Role:  llms.ChatMessageTypeTool,
+				Parts: []llms.ContentPart{toolResponse},
+			})
+		}
+
+		tool := &runtimev1pb.ConversationMessageOfTool{
+			Name:    name,
+			Content: []*runtimev1pb.ConversationMessageContent{{Text: result}},
+		}
+		if toolCall.ID != "" {
+			tool.ToolId = &toolCall.ID
+		}
+		history = append(history, &runtimev1pb.ConversationMessage{
+			MessageTypes: &runtimev1pb.ConversationMessage_OfTool{OfTool: tool},
+		})
+	}
+
+	return msgs, history, executions
+}
+
+// invokeConversationTool invokes the target of a tool with the arguments of a
+// tool call, returning the result of the target.
+func (a *Universal) invokeConversationTool(ctx context.Context, target *runtimev1pb.ConversationToolTarget, args string) (string, error) {
+	switch t := target.GetTargetTypes().(type) {
+	case *runtimev1pb.ConversationToolTarget_Service:
+		return a.invokeConversationToolMethod(ctx, t.Service.GetAppId(), t.Service.GetMethod(), t.Service.GetHttpVerb(), args)
+	case *runtimev1pb.ConversationToolTarget_HttpEndpoint:
+		return a.invokeConversationToolMethod(ctx, t.HttpEndpoint.GetName(), t.HttpEndpoint.GetPath(), t.HttpEndpoint.GetHttpVerb(), args)
+	case *runtimev1pb.ConversationToolTarget_Binding:
+		return a.invokeConversationToolBinding(ctx, t.Binding, args)
+	default:
+		return "", errors.New("target type is missing")
+	}
+}
+
+// invokeConversationToolMethod invokes a method of an app or HTTPEndpoint
+// with service invocation, so access control policies of the target app are
+// applied.
+func (a *Universal) invokeConversationToolMethod(ctx context.Context, appID, method, verb, args string) (string, error) {
+	if a.directMessaging == nil {
+		return "", messages.ErrDirectInvokeNotReady
+	}
+
+	policyDef := a.resiliency.EndpointPolicy(appID, appID+":"+method)
+
+	req := invokev1.NewInvokeMethodRequest(method).
+		WithHTTPExtension(verb, "").
+		WithRawDataString(args).
+		WithContentType(invokev1.JSONContentType)
+	if policyDef != nil {
+		req.WithReplay(policyDef.HasRetries())
+	}
+	defer req.Close()
+
+	policyRunner := resiliency.NewRunner[[]byte](ctx, policyDef)
+	data, err := policyRunner(func(ctx context.Context) ([]byte, error) {
+		resp, rErr := a.directMessaging.Invoke(ctx, appID, req)
+		if rErr != nil {
+			return nil, rErr
+		}
+		defer resp.Close()
+
+		rData, rErr := resp.RawDataFull()
+		if rErr != nil {
+			return nil, rErr
+		}
+		if resp.IsHTTPResponse() {
+			if code := resp.Status().GetCode(); code < 200 || code > 299 {
+				return rData, fmt.Errorf("target returned status code %d: %s", code, rData)
+			}
+			return rData, nil
+		}
+		return rData, invokev1.ErrorFromInternalStatus(resp.Status())
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// invokeConversationToolBinding invokes an output binding with the arguments
+// of a tool call as the data of the request.
+func (a *Universal) invokeConversationToolBinding(ctx context.Context, target *runtimev1pb.ConversationToolTargetBinding, args string) (string, error) {
+	if a.sendToOutputBindingFn == nil {
+		return "", fmt.Errorf("output binding %s is not ready", target.GetName())
+	}
+
+	start := time.Now()
+	resp, err := a.sendToOutputBindingFn(ctx, target.GetName(), &bindings.InvokeRequest{
+		Operation: bindings.OperationKind(target.GetOperation()),
+		Data:      []byte(args),
+		Metadata:  target.GetMetadata(),
+	})
+	elapsed := diag.ElapsedSince(start)
+	diag.DefaultComponentMonitoring.OutputBindingEvent(ctx, target.GetName(), target.GetOperation(), err == nil, elapsed)
+
+	if err != nil {
+		return "", err
+	}
+	if resp == nil {
+		return "", nil
+	}
+	return string(resp.Data), nil
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/llms"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/dapr/components-contrib/conversation"
+	"github.com/dapr/components-contrib/conversation/echo"
+	"github.com/dapr/components-contrib/state"
+	inmemory "github.com/dapr/components-contrib/state/in-memory"
+	"github.com/dapr/dapr/pkg/messages"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
+	rtmock "github.com/dapr/dapr/pkg/runtime/mock"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/ptr"
+)
+
+// toolCallingModel calls a tool until it received the given number of tool
+// results, then replies with the results.
+type toolCallingModel struct {
+	conversation.Conversation
+	tool        string
+	rounds      int
+	toolChoices []string
+}
+
+func (m *toolCallingModel) Converse(_ context.Context, r *conversation.Request) (*conversation.Response, error) {
+	if r.ToolChoice != nil {
+		m.toolChoices = append(m.toolChoices, *r.ToolChoice)
+	}
+
+	var results []string
+	for _, msg := range *r.Message {
+		for _, part := range msg.Parts {
+			if res, ok := part.(llms.ToolCallResponse); ok {
+				results = append(results, res.Content)
+			}
+		}
+	}
+
+	message := conversation.Message{Content: strings.Join(results, ",")}
+	if len(results) < m.rounds {
+		message = conversation.Message{ToolCallRequest: &[]llms.ToolCall{{
+			ID:   fmt.Sprintf("call-%d", len(results)),
+			Type: "function",
+			FunctionCall: &llms.FunctionCall{
+				Name:      m.tool,
+				Arguments: `{"city":"Paris"}`,
+			},
+		}}}
+	}
+	return &conversation.Response{Outputs: []conversation.Result{{
+		Choices: []conversation.Choice{{Message: message}},
+	}}}, nil
+}
+
+func TestConverseAlpha2Tools(t *testing.T) {
+	newAPI := func(t *testing.T, comp conversation.Conversation, dm invokev1.DirectMessaging) *Universal {
+		t.Helper()
+		compStore := compstore.New()
+		compStore.AddConversation("llm", comp)
+		compStore.AddOutputBinding("mail", &rtmock.Binding{})
+		return &Universal{
+			appID:           "myapp",
+			logger:          testLogger,
+			compStore:       compStore,
+			resiliency:      resiliency.New(nil),
+			directMessaging: dm,
+		}
+	}
+
+	request := func(tool *runtimev1pb.ConversationToolsFunction) *runtimev1pb.ConversationRequestAlpha2 {
+		return &runtimev1pb.ConversationRequestAlpha2{
+			Name: "llm",
+			Inputs: []*runtimev1pb.ConversationInputAlpha2{{
+				Messages: []*runtimev1pb.ConversationMessage{{
+					MessageTypes: &runtimev1pb.ConversationMessage_OfUser{
+						OfUser: &runtimev1pb.ConversationMessageOfUser{
+							Content: []*runtimev1pb.ConversationMessageContent{{Text: "what's the weather in Paris?"}},
+						},
+					},
+				}},
+			}},
+			Tools: []*runtimev1pb.ConversationTools{{
+				ToolTypes: &runtimev1pb.ConversationTools_Function{Function: tool},
+			}},
+		}
+	}
+
+	serviceTool := &runtimev1pb.ConversationToolsFunction{
+		Name: "get_weather",
+		Target: &runtimev1pb.ConversationToolTarget{
+			TargetTypes: &runtimev1pb.ConversationToolTarget_Service{
+				Service: &runtimev1pb.ConversationToolTargetService{AppId: "weather", Method: "forecast"},
+			},
+		},
+	}
+
+	respond := func(code int32, data string) func(context.Context, string, *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+		return func(context.Context, string, *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+			return invokev1.NewInvokeMethodResponse(code, "", nil).WithRawDataString(data), nil
+		}
+	}
+
+	t.Run("service tool calls are executed", func(t *testing.T) {
+		dm := &daprt.MockDirectMessaging{}
+		dm.On("Invoke", mock.Anything, "weather", mock.MatchedBy(func(req *invokev1.InvokeMethodRequest) bool {
+			data, err := req.RawDataFull()
+			return err == nil && req.Message().GetMethod() == "forecast" && string(data) == `{"city":"Paris"}`
+		})).Return(respond(200, "sunny"), nil)
+
+		comp := &toolCallingModel{Conversation: echo.NewEcho(testLogger), tool: "get_weather", rounds: 2}
+		api := newAPI(t, comp, dm)
+
+		req := request(serviceTool)
+		req.ToolChoice = ptr.Of("required")
+		resp, err := api.ConverseAlpha2(t.Context(), req)
+		require.NoError(t, err)
+
+		assert.Equal(t, "sunny,sunny", resp.GetOutputs()[0].GetChoices()[0].GetMessage().GetContent())
+		assert.Equal(t, []string{"required", "auto", "auto"}, comp.toolChoices)
+		require.Len(t, resp.GetToolExecutions(), 2)
+		for i, execution := range resp.GetToolExecutions() {
+			assert.Equal(t, int32(i+1), execution.GetStep())
+			assert.Equal(t, fmt.Sprintf("call-%d", i), execution.GetId())
+			assert.Equal(t, "get_weather", execution.GetName())
+			assert.JSONEq(t, `{"city":"Paris"}`, execution.GetArguments())
+			assert.Equal(t, "sunny", execution.GetResult())
+			assert.Nil(t, execution.Error)
+		}
+		dm.AssertNumberOfCalls(t, "Invoke", 2)
+	})
+
+	t.Run("failed tool calls are passed to the model", func(t *testing.T) {
+		dm := &daprt.MockDirectMessaging{}
+		dm.On("Invoke", mock.Anything, "weather", mock.Anything).Return(respond(500, "boom"), nil)
+
+		api := newAPI(t, &toolCallingModel{Conversation: echo.NewEcho(testLogger), tool: "get_weather", rounds: 1}, dm)
+		resp, err := api.ConverseAlpha2(t.Context(), request(serviceTool))
+		require.NoError(t, err)
+
+		assert.Equal(t, "error: target returned status code 500: boom", resp.GetOutputs()[0].GetChoices()[0].GetMessage().GetContent())
+		require.Len(t, resp.GetToolExecutions(), 1)
+		assert.Equal(t, "target returned status code 500: boom", resp.GetToolExecutions()[0].GetError())
+	})
+
+	t.Run("binding tool calls are executed", func(t *testing.T) {
+		api := newAPI(t, &toolCallingModel{Conversation: echo.NewEcho(testLogger), tool: "send_mail", rounds: 1}, nil)
+
+		var got *bindings.InvokeRequest
+		api.sendToOutputBindingFn = func(_ context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error) {
+			assert.Equal(t, "mail", name)
+			got = req
+			return &bindings.InvokeResponse{Data: []byte("sent")}, nil
+		}
+
+		resp, err := api.ConverseAlpha2(t.Context(), request(&runtimev1pb.ConversationToolsFunction{
+			Name: "send_mail",
+			Target: &runtimev1pb.ConversationToolTarget{
+				TargetTypes: &runtimev1pb.ConversationToolTarget_Binding{
+					Binding: &runtimev1pb.ConversationToolTargetBinding{
+						Name:      "mail",
+						Operation: "create",
+						Metadata:  map[string]string{"subject": "weather"},
+					},
+				},
+			},
+		}))
+		require.NoError(t, err)
+
+		require.NotNil(t, got)
+		assert.Equal(t, bindings.CreateOperation, got.Operation)
+		assert.JSONEq(t, `{"city":"Paris"}`, string(got.Data))
+		assert.Equal(t, map[string]string{"subject": "weather"}, got.Metadata)
+		assert.Equal(t, "sent", resp.GetOutputs()[0].GetChoices()[0].GetMessage().GetContent())
+	})
+
+	t.Run("tool calls stop at the step limit", func(t *testing.T) {
+		dm := &daprt.MockDirectMessaging{}
+		dm.On("Invoke", mock.Anything, "weather", mock.Anything).Return(respond(200, "sunny"), nil)
+
+		api := newAPI(t, &toolCallingModel{Conversation: echo.NewEcho(testLogger), tool: "get_weather", rounds: 3}, dm)
+		req := request(serviceTool)
+		req.MaxToolSteps = ptr.Of(int32(2))
+		resp, err := api.ConverseAlpha2(t.Context(), req)
+		require.NoError(t, err)
+		dm.AssertNumberOfCalls(t, "Invoke", 2)
+
+		// The last reply is returned with the tool calls which weren't
+		// executed, along with the executed ones.
+		assert.Len(t, resp.GetToolExecutions(), 2)
+		choice := resp.GetOutputs()[0].GetChoices()[0]
+		assert.Equal(t, "max_tool_steps", choice.GetFinishReason())
+		require.Len(t, choice.GetMessage().GetToolCalls(), 1)
+		assert.Equal(t, "get_weather", choice.GetMessage().GetToolCalls()[0].GetFunction().GetName())
+
+		req.MaxToolSteps = ptr.Of(int32(0))
+		_, err = api.ConverseAlpha2(t.Context(), req)
+		require.ErrorIs(t, err, messages.ErrConversationInvalidParams)
+	})
+
+	t.Run("unbound tool calls are returned to the app", func(t *testing.T) {
+		api := newAPI(t, &toolCallingModel{Conversation: echo.NewEcho(testLogger), tool: "get_time", rounds: 1}, &daprt.MockDirectMessaging{})
+		resp, err := api.ConverseAlpha2(t.Context(), request(serviceTool))
+		require.NoError(t, err)
+
+		toolCalls := resp.GetOutputs()[0].GetChoices()[0].GetMessage().GetToolCalls()
+		require.Len(t, toolCalls, 1)
+		assert.Equal(t, "get_time", toolCalls[0].GetFunction().GetName())
+		assert.Empty(t, resp.GetToolExecutions())
+	})
+
+	t.Run("tool calls are saved in the history", func(t *testing.T) {
+		dm := &daprt.MockDirectMessaging{}
+		dm.On("Invoke", mock.Anything, "weather", mock.Anything).Return(respond(200, "sunny"), nil)
+
+		api := newAPI(t, &toolCallingModel{Conversation: echo.NewEcho(testLogger), tool: "get_weather", rounds: 1}, dm)
+		store := inmemory.NewInMemoryStateStore(testLogger)
+		require.NoError(t, store.Init(t.Context(), state.Metadata{}))
+		t.Cleanup(func() { store.(interface{ Close() error }).Close() })
+		api.compStore.AddStateStore("store", store)
+		api.compStore.AddConversationHistory("llm", &rtconversation.History{StateStore: "store"})
+
+		req := request(serviceTool)
+		req.ContextId = ptr.Of("ctx")
+		_, err := api.ConverseAlpha2(t.Context(), req)
+		require.NoError(t, err)
+
+		got, err := api.GetConversationContextAlpha2(t.Context(), &runtimev1pb.GetConversationContextRequestAlpha2{Name: "llm", ContextId: "ctx"})
+		require.NoError(t, err)
+		msgs := got.GetMessages()
+		require.Len(t, msgs, 4)
+		assert.Equal(t, "get_weather", msgs[1].GetOfAssistant().GetToolCalls()[0].GetFunction().GetName())
+		assert.Equal(t, "call-0", msgs[2].GetOfTool().GetToolId())
+		assert.Equal(t, "sunny", msgs[2].GetOfTool().GetContent()[0].GetText())
+		assert.Equal(t, "sunny", msgs[3].GetOfAssistant().GetContent()[0].GetText())
+	})
+
+	t.Run("invalid targets", func(t *testing.T) {
+		api := newAPI(t, echo.NewEcho(testLogger), &daprt.MockDirectMessaging{})
+
+		for _, target := range []*runtimev1pb.ConversationToolTarget{
+			{},
+			{TargetTypes: &runtimev1pb.ConversationToolTarget_Service{Service: &runtimev1pb.ConversationToolTargetService{AppId: "weather"}}},
+			{TargetTypes: &runtimev1pb.ConversationToolTarget_HttpEndpoint{HttpEndpoint: &runtimev1pb.ConversationToolTargetHTTPEndpoint{Name: "missing"}}},
+			{TargetTypes: &runtimev1pb.ConversationToolTarget_Binding{Binding: &runtimev1pb.ConversationToolTargetBinding{Name: "missing", Operation: "create"}}},
+		} {
+			_, err := api.ConverseAlpha2(t.Context(), request(&runtimev1pb.ConversationToolsFunction{Name: "tool", Target: target}))
+			require.ErrorIs(t, err, messages.ErrConversationToolTarget)
+		}
+
+		err := api.ConverseStreamAlpha2(t.Context(), request(serviceTool), func(*runtimev1pb.ConversationStreamResponseAlpha2) error {
+			return errors.New("nothing must be sent")
+		})
+		require.ErrorIs(t, err, messages.ErrConversationToolTarget)
+	})
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"time"
+
+	"github.com/tmc/langchaingo/llms"
+
+	"github.com/dapr/components-contrib/conversation"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
+)
+
+// converseWithUsage calls the component, returning the number of tokens
+// spent. Components which wrap a langchaingo model are called through the
+// model, which reports the usage, and components implementing
+// UsageReporter report it themselves. The usage is estimated for other
+// components, and whenever it isn't reported. The options are only passed to
+// langchaingo models.
+func converseWithUsage(ctx context.Context, component conversation.Conversation, r *conversation.Request, opts ...llms.CallOption) (*conversation.Response, rtconversation.Usage, error) {
+	if model, ok := component.(llms.Model); ok {
+		return rtconversation.GenerateContent(ctx, model, r, opts...)
+	}
+
+	if reporter, ok := component.(rtconversation.UsageReporter); ok {
+		return reporter.ConverseWithUsage(ctx, r)
+	}
+
+	resp, err := component.Converse(ctx, r)
+	if err != nil {
+		return nil, rtconversation.Usage{}, err
+	}
+	return resp, rtconversation.EstimateUsage(r, resp), nil
+}
+
+// checkConversationSidecarBudget returns an error if the token budget of this
+// sidecar is exhausted.
+func (a *Universal) checkConversationSidecarBudget() error {
+	if a.conversationSidecarBudget == nil {
+		return nil
+	}
+	if exhausted, reset := a.conversationSidecarBudget.Exhausted(); exhausted {
+		err := messages.ErrConversationSidecarTokenBudgetExhausted.WithFormat(a.conversationSidecarBudget.MaxTokens(), a.conversationSidecarBudget.Window(), reset.UTC().Format(time.RFC3339))
+		a.logger.Debug(err)
+		return err
+	}
+	return nil
+}
+
+// recordConversationUsage records the tokens spent on a conversation
+// component in the metrics and the token budget of this sidecar.
+func (a *Universal) recordConversationUsage(ctx context.Context, name string, usage rtconversation.Usage) {
+	diag.DefaultComponentMonitoring.ConversationTokensUsed(ctx, name, usage.PromptTokens, usage.CompletionTokens)
+	if a.conversationSidecarBudget != nil {
+		a.conversationSidecarBudget.Spend(usage.Total())
+	}
+}
+
+// conversationUsage converts the usage to its API representation.
+func conversationUsage(usage rtconversation.Usage) *runtimev1pb.ConversationUsage {
+	return &runtimev1pb.ConversationUsage{
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		TotalTokens:      usage.Total(),
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/llms"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/components-contrib/conversation"
+	"github.com/dapr/components-contrib/conversation/echo"
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
+)
+
+// usageReporter is a conversation component which reports the given usage.
+type usageReporter struct {
+	conversation.Conversation
+
+	usage rtconversation.Usage
+}
+
+func (u *usageReporter) ConverseWithUsage(ctx context.Context, req *conversation.Request) (*conversation.Response, rtconversation.Usage, error) {
+	resp, err := u.Converse(ctx, req)
+	return resp, u.usage, err
+}
+
+func TestConversationUsage(t *testing.T) {
+	newAPI := func(comp conversation.Conversation, budget *rtconversation.SidecarBudget) *Universal {
+		compStore := compstore.New()
+		compStore.AddConversation("llm", comp)
+		return &Universal{
+			logger:                    testLogger,
+			compStore:                 compStore,
+			resiliency:                resiliency.New(nil),
+			conversationSidecarBudget: budget,
+		}
+	}
+
+	request := func(text string) *runtimev1pb.ConversationRequestAlpha2 {
+		return &runtimev1pb.ConversationRequestAlpha2{
+			Name: "llm",
+			Inputs: []*runtimev1pb.ConversationInputAlpha2{{
+				Messages: []*runtimev1pb.ConversationMessage{{
+					MessageTypes: &runtimev1pb.ConversationMessage_OfUser{
+						OfUser: &runtimev1pb.ConversationMessageOfUser{
+							Content: []*runtimev1pb.ConversationMessageContent{{Text: text}},
+						},
+					},
+				}},
+			}},
+		}
+	}
+
+	t.Run("usage reported by the component", func(t *testing.T) {
+		api := newAPI(&usageReporter{
+			Conversation: echo.NewEcho(testLogger),
+			usage:        rtconversation.Usage{PromptTokens: 12, CompletionTokens: 3},
+		}, nil)
+
+		resp, err := api.ConverseAlpha2(t.Context(), request("hello"))
+		require.NoError(t, err)
+		assert.Equal(t, "hello", resp.GetOutputs()[0].GetChoices()[0].GetMessage().GetContent())
+		assert.Equal(t, int64(12), resp.GetUsage().GetPromptTokens())
+		assert.Equal(t, int64(3), resp.GetUsage().GetCompletionTokens())
+		assert.Equal(t, int64(15), resp.GetUsage().GetTotalTokens())
+	})
+
+	t.Run("usage reported by the model", func(t *testing.T) {
+		api := newAPI(&streamingModel{resp: &llms.ContentResponse{Choices: []*llms.ContentChoice{{
+			Content:        "hi",
+			GenerationInfo: map[string]any{"PromptTokens": 12, "CompletionTokens": 3},
+		}}}}, nil)
+
+		resp, err := api.ConverseAlpha2(t.Context(), request("hello"))
+		require.NoError(t, err)
+		assert.Equal(t, "hi", resp.GetOutputs()[0].GetChoices()[0].GetMessage().GetContent())
+		assert.Equal(t, int64(12), resp.GetUsage().GetPromptTokens())
+		assert.Equal(t, int64(3), resp.GetUsage().GetCompletionTokens())
+
+		var complete *runtimev1pb.ConversationStreamCompleteAlpha2
+		err = api.ConverseStreamAlpha2(t.Context(), request("hello"), func(res *runtimev1pb.ConversationStreamResponseAlpha2) error {
+			if c := res.GetComplete(); c != nil {
+				complete = c
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(12), complete.GetUsage().GetPromptTokens())
+		assert.Equal(t, int64(3), complete.GetUsage().GetCompletionTokens())
+	})
+
+	t.Run("usage is estimated", func(t *testing.T) {
+		api := newAPI(echo.NewEcho(testLogger), nil)
+
+		resp, err := api.ConverseAlpha2(t.Context(), request("hello world"))
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), resp.GetUsage().GetPromptTokens())
+		assert.Equal(t, int64(3), resp.GetUsage().GetCompletionTokens())
+
+		var complete *runtimev1pb.ConversationStreamCompleteAlpha2
+		err = api.ConverseStreamAlpha2(t.Context(), request("hello world"), func(res *runtimev1pb.ConversationStreamResponseAlpha2) error {
+			if c := res.GetComplete(); c != nil {
+				complete = c
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), complete.GetUsage().GetTotalTokens())
+	})
+
+	t.Run("requests are rejected once the budget is exhausted", func(t *testing.T) {
+		clock := clocktesting.NewFakeClock(time.Now())
+		budget, err := rtconversation.NewSidecarBudget(10, time.Hour, clock)
+		require.NoError(t, err)
+		api := newAPI(echo.NewEcho(testLogger), budget)
+
+		// 6 tokens are spent on each request.
+		for range 2 {
+			_, err = api.ConverseAlpha2(t.Context(), request("hello world"))
+			require.NoError(t, err)
+		}
+
+		_, err = api.ConverseAlpha2(t.Context(), request("hello world"))
+		require.ErrorIs(t, err, messages.ErrConversationSidecarTokenBudgetExhausted)
+		err = api.ConverseStreamAlpha2(t.Context(), request("hello world"), func(*runtimev1pb.ConversationStreamResponseAlpha2) error {
+			return nil
+		})
+		require.ErrorIs(t, err, messages.ErrConversationSidecarTokenBudgetExhausted)
+		//nolint:staticcheck
+		_, err = api.ConverseAlpha1(t.Context(), &runtimev1pb.ConversationRequest{
+			Name:   "llm",
+			Inputs: []*runtimev1pb.ConversationInput{{Content: "hello world"}},
+		})
+		require.ErrorIs(t, err, messages.ErrConversationSidecarTokenBudgetExhausted)
+
+		clock.Step(time.Hour)
+		_, err = api.ConverseAlpha2(t.Context(), request("hello world"))
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dapr/dapr/pkg/messages"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/security"
+)
+
+func (a *Universal) GetWorkloadJWTAlpha1(ctx context.Context, req *runtimev1pb.GetWorkloadJWTRequest) (*runtimev1pb.GetWorkloadJWTResponse, error) {
+	if len(req.GetAudience()) == 0 {
+		err := messages.ErrIdentityAudienceMissing
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if a.sec == nil {
+		err := messages.ErrIdentityJWTUnavailable
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	token, expiry, err := a.sec.JWTSVID(ctx, req.GetAudience())
+	if errors.Is(err, security.ErrJWTSVIDUnavailable) {
+		err = messages.ErrIdentityJWTUnavailable
+		a.logger.Debug(err)
+		return nil, err
+	}
+	if err != nil {
+		err = messages.ErrIdentityJWTGet.WithFormat(req.GetAudience(), err)
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	return &runtimev1pb.GetWorkloadJWTResponse{
+		Token:  token,
+		Expiry: timestamppb.New(expiry),
+	}, nil
+}
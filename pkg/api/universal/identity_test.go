@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	securityfake "github.com/dapr/dapr/pkg/security/fake"
+)
+
+func TestGetWorkloadJWTAlpha1(t *testing.T) {
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	sec := securityfake.New().WithJWTSVIDFn(func(_ context.Context, audience string) (string, time.Time, error) {
+		if audience == "error" {
+			return "", time.Time{}, errors.New("sentry error")
+		}
+		return "token-" + audience, expiry, nil
+	})
+
+	t.Run("missing audience should error", func(t *testing.T) {
+		fakeAPI := &Universal{logger: testLogger, sec: sec}
+		_, err := fakeAPI.GetWorkloadJWTAlpha1(t.Context(), &runtimev1pb.GetWorkloadJWTRequest{})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unavailable JWT-SVIDs should error", func(t *testing.T) {
+		fakeAPI := &Universal{logger: testLogger, sec: securityfake.New()}
+		_, err := fakeAPI.GetWorkloadJWTAlpha1(t.Context(), &runtimev1pb.GetWorkloadJWTRequest{Audience: "aud"})
+		require.Error(t, err)
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("error getting token should error", func(t *testing.T) {
+		fakeAPI := &Universal{logger: testLogger, sec: sec}
+		_, err := fakeAPI.GetWorkloadJWTAlpha1(t.Context(), &runtimev1pb.GetWorkloadJWTRequest{Audience: "error"})
+		require.Error(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("token should be returned", func(t *testing.T) {
+		fakeAPI := &Universal{logger: testLogger, sec: sec}
+		resp, err := fakeAPI.GetWorkloadJWTAlpha1(t.Context(), &runtimev1pb.GetWorkloadJWTRequest{Audience: "aud"})
+		require.NoError(t, err)
+		assert.Equal(t, "token-aud", resp.GetToken())
+		assert.Equal(t, expiry, resp.GetExpiry().AsTime().Local())
+	})
+}
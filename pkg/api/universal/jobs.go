@@ -15,7 +15,9 @@ package universal
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -31,6 +33,10 @@ import (
 
 const (
 	rpcTimeout = time.Second * 30
+
+	// defaultListJobsPageSize is the number of jobs returned by ListJobsAlpha1
+	// when the request does not specify a page size.
+	defaultListJobsPageSize = 100
 )
 
 func (a *Universal) ScheduleJobAlpha1(ctx context.Context, inReq *runtimev1pb.ScheduleJobRequest) (*runtimev1pb.ScheduleJobResponse, error) {
@@ -192,3 +198,79 @@ func (a *Universal) GetJobAlpha1(ctx context.Context, inReq *runtimev1pb.GetJobR
 		},
 	}, nil
 }
+
+func (a *Universal) ListJobsAlpha1(ctx context.Context, inReq *runtimev1pb.ListJobsRequest) (*runtimev1pb.ListJobsResponse, error) {
+	errMetadata := map[string]string{
+		"appID":     a.AppID(),
+		"namespace": a.Namespace(),
+	}
+
+	// The continuation token is the encoded name of the last job of the
+	// previous page.
+	var after string
+	if token := inReq.GetContinuationToken(); token != "" {
+		b, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil || len(b) == 0 {
+			return new(runtimev1pb.ListJobsResponse), apierrors.SchedulerListJobsContinuationToken(errMetadata)
+		}
+		after = string(b)
+	}
+
+	pageSize := int(inReq.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultListJobsPageSize
+	}
+
+	// Jobs are always scoped to the calling app and namespace.
+	internalListJobsReq := &schedulerv1pb.ListJobsRequest{
+		Metadata: &schedulerv1pb.JobMetadata{
+			AppId:     a.appID,
+			Namespace: a.Namespace(),
+			Target: &schedulerv1pb.JobTargetMetadata{
+				Type: &schedulerv1pb.JobTargetMetadata_Job{
+					Job: new(schedulerv1pb.TargetJob),
+				},
+			},
+		},
+	}
+
+	schedCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	resp, err := a.scheduler.ListJobs(schedCtx, internalListJobsReq, grpc.WaitForReady(true))
+	if err != nil {
+		a.logger.Errorf("Error listing jobs due to: %s", err)
+		return new(runtimev1pb.ListJobsResponse), apierrors.SchedulerListJobs(errMetadata, err)
+	}
+
+	jobs := make([]*runtimev1pb.Job, 0, len(resp.GetJobs()))
+	for _, job := range resp.GetJobs() {
+		if !strings.HasPrefix(job.GetName(), inReq.GetNamePrefix()) || job.GetName() <= after {
+			continue
+		}
+
+		jobs = append(jobs, &runtimev1pb.Job{
+			Name:          job.GetName(),
+			Schedule:      job.GetJob().Schedule, //nolint:protogetter
+			Data:          job.GetJob().GetData(),
+			Repeats:       job.GetJob().Repeats, //nolint:protogetter
+			DueTime:       job.GetJob().DueTime, //nolint:protogetter
+			Ttl:           job.GetJob().Ttl,     //nolint:protogetter
+			FailurePolicy: job.GetJob().GetFailurePolicy(),
+		})
+	}
+
+	slices.SortFunc(jobs, func(x, y *runtimev1pb.Job) int {
+		return strings.Compare(x.GetName(), y.GetName())
+	})
+
+	var out runtimev1pb.ListJobsResponse
+	if len(jobs) > pageSize {
+		jobs = jobs[:pageSize]
+		token := base64.RawURLEncoding.EncodeToString([]byte(jobs[pageSize-1].GetName()))
+		out.ContinuationToken = &token
+	}
+	out.Jobs = jobs
+
+	return &out, nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	schedulerv1pb "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/kit/ptr"
+)
+
+type fakeScheduler struct {
+	schedulerv1pb.SchedulerClient
+	listJobsFn func(context.Context, *schedulerv1pb.ListJobsRequest) (*schedulerv1pb.ListJobsResponse, error)
+}
+
+func (f *fakeScheduler) Addresses() []string {
+	return nil
+}
+
+func (f *fakeScheduler) ListJobs(ctx context.Context, req *schedulerv1pb.ListJobsRequest, _ ...grpc.CallOption) (*schedulerv1pb.ListJobsResponse, error) {
+	return f.listJobsFn(ctx, req)
+}
+
+func TestListJobsAlpha1(t *testing.T) {
+	var gotReq *schedulerv1pb.ListJobsRequest
+	fakeAPI := &Universal{
+		appID:     "myapp",
+		namespace: "mynamespace",
+		logger:    testLogger,
+		scheduler: &fakeScheduler{
+			listJobsFn: func(_ context.Context, req *schedulerv1pb.ListJobsRequest) (*schedulerv1pb.ListJobsResponse, error) {
+				gotReq = req
+				return &schedulerv1pb.ListJobsResponse{
+					Jobs: []*schedulerv1pb.NamedJob{
+						{Name: "cleanup-2", Job: &schedulerv1pb.Job{Schedule: ptr.Of("@every 1m")}},
+						{Name: "report", Job: &schedulerv1pb.Job{DueTime: ptr.Of("10s")}},
+						{Name: "cleanup-1", Job: &schedulerv1pb.Job{Schedule: ptr.Of("@daily")}},
+						{Name: "cleanup-3", Job: &schedulerv1pb.Job{Repeats: ptr.Of(uint32(3))}},
+					},
+				}, nil
+			},
+		},
+	}
+
+	t.Run("scoped to app and namespace", func(t *testing.T) {
+		resp, err := fakeAPI.ListJobsAlpha1(t.Context(), new(runtimev1pb.ListJobsRequest))
+		require.NoError(t, err)
+		assert.Equal(t, "myapp", gotReq.GetMetadata().GetAppId())
+		assert.Equal(t, "mynamespace", gotReq.GetMetadata().GetNamespace())
+		assert.NotNil(t, gotReq.GetMetadata().GetTarget().GetJob())
+
+		names := make([]string, 0, len(resp.GetJobs()))
+		for _, job := range resp.GetJobs() {
+			names = append(names, job.GetName())
+		}
+		assert.Equal(t, []string{"cleanup-1", "cleanup-2", "cleanup-3", "report"}, names)
+		assert.Equal(t, "@daily", resp.GetJobs()[0].GetSchedule())
+		assert.Nil(t, resp.ContinuationToken)
+	})
+
+	t.Run("prefix and paging", func(t *testing.T) {
+		resp, err := fakeAPI.ListJobsAlpha1(t.Context(), &runtimev1pb.ListJobsRequest{
+			NamePrefix: ptr.Of("cleanup-"),
+			PageSize:   ptr.Of(uint32(2)),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetJobs(), 2)
+		assert.Equal(t, "cleanup-1", resp.GetJobs()[0].GetName())
+		assert.Equal(t, "cleanup-2", resp.GetJobs()[1].GetName())
+		require.NotEmpty(t, resp.GetContinuationToken())
+
+		resp, err = fakeAPI.ListJobsAlpha1(t.Context(), &runtimev1pb.ListJobsRequest{
+			NamePrefix:        ptr.Of("cleanup-"),
+			PageSize:          ptr.Of(uint32(2)),
+			ContinuationToken: resp.ContinuationToken,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetJobs(), 1)
+		assert.Equal(t, "cleanup-3", resp.GetJobs()[0].GetName())
+		assert.Nil(t, resp.ContinuationToken)
+	})
+
+	t.Run("invalid continuation token", func(t *testing.T) {
+		_, err := fakeAPI.ListJobsAlpha1(t.Context(), &runtimev1pb.ListJobsRequest{
+			ContinuationToken: ptr.Of("!!"),
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("scheduler error", func(t *testing.T) {
+		errAPI := &Universal{
+			logger: testLogger,
+			scheduler: &fakeScheduler{
+				listJobsFn: func(context.Context, *schedulerv1pb.ListJobsRequest) (*schedulerv1pb.ListJobsResponse, error) {
+					return nil, errors.New("boom")
+				},
+			},
+		}
+		_, err := errAPI.ListJobsAlpha1(t.Context(), new(runtimev1pb.ListJobsRequest))
+		require.Error(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
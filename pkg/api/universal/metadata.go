@@ -18,6 +18,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	"github.com/dapr/dapr/pkg/buildinfo"
 	"github.com/dapr/dapr/pkg/config/protocol"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
@@ -95,8 +96,27 @@ func (a *Universal) GetMetadata(ctx context.Context, in *runtimev1pb.GetMetadata
 	endpoints := a.compStore.ListHTTPEndpoints()
 	registeredHTTPEndpoints := make([]*runtimev1pb.MetadataHTTPEndpoint, len(endpoints))
 	for i, e := range endpoints {
+		status := a.httpEndpoints.Status(e)
+		baseURLs := make([]*runtimev1pb.MetadataHTTPEndpointBaseURL, len(status))
+		for j, s := range status {
+			baseURLs[j] = &runtimev1pb.MetadataHTTPEndpointBaseURL{
+				Url:     s.URL,
+				Healthy: s.Healthy,
+			}
+		}
+
+		var strategy string
+		if len(baseURLs) > 1 {
+			strategy = string(httpendpointsapi.LoadBalancingRoundRobin)
+			if lb := e.Spec.LoadBalancing; lb != nil && len(lb.Strategy) > 0 {
+				strategy = string(lb.Strategy)
+			}
+		}
+
 		registeredHTTPEndpoints[i] = &runtimev1pb.MetadataHTTPEndpoint{
-			Name: e.Name,
+			Name:                  e.Name,
+			BaseUrls:              baseURLs,
+			LoadBalancingStrategy: strategy,
 		}
 	}
 
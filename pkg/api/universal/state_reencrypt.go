@@ -0,0 +1,275 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/components-contrib/state/query"
+	apierrors "github.com/dapr/dapr/pkg/api/errors"
+	stateLoader "github.com/dapr/dapr/pkg/components/state"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/encryption"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+const defaultReEncryptStatePageSize = 100
+
+// reEncryptQueryableStoreTypes are the types of the state stores whose query
+// API returns values which aren't JSON documents, such as encrypted values.
+// Other state stores, such as Redis, only index JSON values, so their keys
+// can't be enumerated using the query API.
+var reEncryptQueryableStoreTypes = []string{
+	"state.azure.cosmosdb",
+	"state.mongodb",
+}
+
+type reEncryptResult int
+
+const (
+	reEncryptSkipped reEncryptResult = iota
+	reEncryptDone
+	reEncryptConflict
+)
+
+// ReEncryptStateAlpha1 re-encrypts values of an encrypted state store which
+// were encrypted with its secondary key, using the primary key. If the request
+// lists keys, only those keys are processed. Otherwise a page of keys is
+// enumerated using the state store's query API, and only the keys of this app
+// are processed. State stores whose query API can't return encrypted values,
+// and stores which don't prefix keys with the app ID, can only be re-encrypted
+// by listing the keys.
+// Every value is written back using first-write concurrency with the ETag it
+// was read with, so values updated concurrently are never overwritten.
+func (a *Universal) ReEncryptStateAlpha1(ctx context.Context, in *runtimev1pb.ReEncryptStateRequest) (*runtimev1pb.ReEncryptStateResponse, error) {
+	storeName := in.GetStoreName()
+	store, err := a.GetStateStore(storeName)
+	if err != nil {
+		// Error has already been logged
+		return nil, err
+	}
+
+	if !encryption.EncryptedStateStore(storeName) {
+		err = apierrors.StateStore(storeName).NotEncrypted()
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if len(in.GetKeys()) > 0 {
+		return a.reEncryptStateKeys(ctx, store, in)
+	}
+
+	querier, ok := store.(state.Querier)
+	if !ok {
+		err = apierrors.StateStore(storeName).ReEncryptKeysRequired("querying is not supported")
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	if comp, ok := a.compStore.GetComponent(storeName); !ok || !slices.Contains(reEncryptQueryableStoreTypes, comp.Spec.Type) {
+		err = apierrors.StateStore(storeName).ReEncryptKeysRequired("querying can't return encrypted values")
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	prefix, err := stateLoader.GetModifiedStateKey("", storeName, a.appID)
+	if err != nil {
+		a.logger.Debug(err)
+		return nil, err
+	}
+	if prefix == "" {
+		err = apierrors.StateStore(storeName).ReEncryptKeysRequired("keys are not prefixed with the app ID")
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	pageSize := defaultReEncryptStatePageSize
+	if in.PageSize != nil && in.GetPageSize() > 0 {
+		pageSize = int(in.GetPageSize())
+	}
+
+	req := &state.QueryRequest{
+		Query: query.Query{
+			QueryFields: query.QueryFields{
+				Page: query.Pagination{
+					Limit: pageSize,
+					Token: in.GetContinuationToken(),
+				},
+			},
+		},
+		Metadata: in.GetMetadata(),
+	}
+
+	start := time.Now()
+	policyRunner := resiliency.NewRunner[*state.QueryResponse](ctx,
+		a.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore),
+	)
+	qresp, err := policyRunner(func(ctx context.Context) (*state.QueryResponse, error) {
+		return querier.Query(ctx, req)
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.StateInvoked(ctx, storeName, diag.StateQuery, err == nil, elapsed)
+
+	if err != nil {
+		err = apierrors.StateStore(storeName).ReEncryptFailed(err.Error())
+		a.logger.Debug(err)
+		return nil, err
+	}
+
+	resp := &runtimev1pb.ReEncryptStateResponse{}
+	if qresp == nil {
+		return resp, nil
+	}
+
+	for _, item := range qresp.Results {
+		// Keys of other apps are never touched, nor are actor state keys, which
+		// are prefixed with the app ID too but contain further separators.
+		key, ok := strings.CutPrefix(item.Key, prefix)
+		if !ok || strings.Contains(key, "||") {
+			continue
+		}
+		a.reEncryptStateKey(ctx, store, storeName, item.Key, in.GetMetadata(), resp)
+	}
+
+	if qresp.Token != "" && len(qresp.Results) > 0 {
+		resp.ContinuationToken = &qresp.Token
+	}
+
+	a.logReEncryptState(storeName, resp)
+
+	return resp, nil
+}
+
+// reEncryptStateKeys re-encrypts the values of the keys listed in the request.
+// Keys are prefixed in the same way as by the other state APIs.
+func (a *Universal) reEncryptStateKeys(ctx context.Context, store state.Store, in *runtimev1pb.ReEncryptStateRequest) (*runtimev1pb.ReEncryptStateResponse, error) {
+	storeName := in.GetStoreName()
+
+	keys := make([]string, len(in.GetKeys()))
+	for i, k := range in.GetKeys() {
+		key, err := stateLoader.GetModifiedStateKey(k, storeName, a.appID)
+		if err != nil {
+			a.logger.Debug(err)
+			return nil, err
+		}
+		keys[i] = key
+	}
+
+	resp := &runtimev1pb.ReEncryptStateResponse{}
+	for _, key := range keys {
+		a.reEncryptStateKey(ctx, store, storeName, key, in.GetMetadata(), resp)
+	}
+
+	a.logReEncryptState(storeName, resp)
+
+	return resp, nil
+}
+
+// reEncryptStateKey re-encrypts the value of the key and records the result
+// in the response.
+func (a *Universal) reEncryptStateKey(ctx context.Context, store state.Store, storeName, key string, metadata map[string]string, resp *runtimev1pb.ReEncryptStateResponse) {
+	resp.Scanned++
+
+	res, err := a.reEncryptStateItem(ctx, store, storeName, key, metadata)
+	if err != nil {
+		a.logger.Warnf("Failed to re-encrypt key %s in state store %s: %s", key, storeName, err)
+		resp.FailedKeys = append(resp.FailedKeys, key)
+		return
+	}
+
+	switch res {
+	case reEncryptDone:
+		resp.Reencrypted++
+	case reEncryptConflict:
+		resp.Conflicts++
+	default:
+		resp.Skipped++
+	}
+}
+
+func (a *Universal) logReEncryptState(storeName string, resp *runtimev1pb.ReEncryptStateResponse) {
+	a.logger.Infof("Re-encrypted %d of %d values in state store %s (skipped: %d, conflicts: %d, failed: %d)",
+		resp.GetReencrypted(), resp.GetScanned(), storeName, resp.GetSkipped(), resp.GetConflicts(), len(resp.GetFailedKeys()))
+}
+
+// reEncryptStateItem reads the value of the key from the store and, if it was
+// encrypted with the secondary key, writes it back encrypted with the primary
+// key. The key is used as-is, so it must already contain the key prefix.
+func (a *Universal) reEncryptStateItem(ctx context.Context, store state.Store, storeName string, key string, metadata map[string]string) (reEncryptResult, error) {
+	policyDef := a.resiliency.ComponentOutboundPolicy(storeName, resiliency.Statestore)
+
+	start := time.Now()
+	getRunner := resiliency.NewRunner[*state.GetResponse](ctx, policyDef)
+	getResp, err := getRunner(func(ctx context.Context) (*state.GetResponse, error) {
+		return store.Get(ctx, &state.GetRequest{
+			Key:      key,
+			Metadata: metadata,
+			Options: state.GetStateOption{
+				Consistency: state.Strong,
+			},
+		})
+	})
+	diag.DefaultComponentMonitoring.StateInvoked(ctx, storeName, diag.Get, err == nil, diag.ElapsedSince(start))
+	if err != nil {
+		return reEncryptSkipped, err
+	}
+
+	if getResp == nil || len(getResp.Data) == 0 {
+		// Deleted since the page was queried.
+		return reEncryptSkipped, nil
+	}
+
+	val, ok, err := encryption.TryReEncryptValue(storeName, getResp.Data)
+	if err != nil || !ok {
+		return reEncryptSkipped, err
+	}
+
+	if getResp.ETag == nil {
+		return reEncryptSkipped, errors.New("state store did not return an ETag")
+	}
+
+	start = time.Now()
+	setRunner := resiliency.NewRunner[struct{}](ctx, policyDef)
+	_, err = setRunner(func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, store.Set(ctx, &state.SetRequest{
+			Key:         key,
+			Value:       val,
+			ETag:        getResp.ETag,
+			Metadata:    metadata,
+			ContentType: getResp.ContentType,
+			Options: state.SetStateOption{
+				Concurrency: state.FirstWrite,
+				Consistency: state.Strong,
+			},
+		})
+	})
+	diag.DefaultComponentMonitoring.StateInvoked(ctx, storeName, diag.Set, err == nil, diag.ElapsedSince(start))
+
+	var etagErr *state.ETagError
+	if errors.As(err, &etagErr) && etagErr.Kind() == state.ETagMismatch {
+		return reEncryptConflict, nil
+	}
+	if err != nil {
+		return reEncryptSkipped, err
+	}
+
+	return reEncryptDone, nil
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package universal
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dapr/components-contrib/state"
+	commonapi "github.com/dapr/dapr/pkg/apis/common"
+	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/encryption"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/dapr/kit/ptr"
+)
+
+// queryableStateStore is an in-memory state store which supports ETags and
+// lists all keys in order when queried.
+type queryableStateStore struct {
+	state.Store
+
+	lock  sync.Mutex
+	items map[string][]byte
+	etags map[string]int
+	// onGet is called after a value is read.
+	onGet func(key string)
+}
+
+func (s *queryableStateStore) Get(_ context.Context, req *state.GetRequest) (*state.GetResponse, error) {
+	s.lock.Lock()
+	data, ok := s.items[req.Key]
+	etag := strconv.Itoa(s.etags[req.Key])
+	s.lock.Unlock()
+
+	if s.onGet != nil {
+		s.onGet(req.Key)
+	}
+
+	if !ok {
+		return &state.GetResponse{}, nil
+	}
+	return &state.GetResponse{Data: data, ETag: &etag}, nil
+}
+
+func (s *queryableStateStore) Set(_ context.Context, req *state.SetRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if req.ETag != nil && *req.ETag != strconv.Itoa(s.etags[req.Key]) {
+		return state.NewETagError(state.ETagMismatch, nil)
+	}
+
+	s.items[req.Key] = req.Value.([]byte)
+	s.etags[req.Key]++
+	return nil
+}
+
+func (s *queryableStateStore) Query(_ context.Context, req *state.QueryRequest) (*state.QueryResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	keys := slices.Sorted(maps.Keys(s.items))
+
+	var start int
+	if req.Query.Page.Token != "" {
+		start, _ = strconv.Atoi(req.Query.Page.Token)
+	}
+	end := min(start+req.Query.Page.Limit, len(keys))
+
+	resp := &state.QueryResponse{}
+	for _, k := range keys[start:end] {
+		resp.Results = append(resp.Results, state.QueryItem{Key: k})
+	}
+	if end < len(keys) {
+		resp.Token = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+func testEncryptionKeys(t *testing.T, keys map[string]string) encryption.ComponentEncryptionKeys {
+	t.Helper()
+
+	var comp compapi.Component
+	for name, keyName := range keys {
+		b := make([]byte, 32)
+		rand.Read(b)
+		comp.Spec.Metadata = append(comp.Spec.Metadata, commonapi.NameValuePair{
+			Name: name,
+			Value: commonapi.DynamicValue{
+				JSON: apiextv1.JSON{Raw: []byte(hex.EncodeToString(b))},
+			},
+			SecretKeyRef: commonapi.SecretKeyRef{Name: keyName},
+		})
+	}
+
+	cek, err := encryption.ComponentEncryptionKey(t.Context(), comp, daprt.FakeSecretStore{}, nil)
+	require.NoError(t, err)
+	return cek
+}
+
+func TestReEncryptStateAlpha1(t *testing.T) {
+	oldKeys := testEncryptionKeys(t, map[string]string{"primaryEncryptionKey": "old"})
+	newKeys := testEncryptionKeys(t, map[string]string{"primaryEncryptionKey": "new"})
+	encryption.AddEncryptedStateStore("reencrypt-writer", oldKeys)
+	encryption.AddEncryptedStateStore("reencrypt", encryption.ComponentEncryptionKeys{
+		Primary:   newKeys.Primary,
+		Secondary: oldKeys.Primary,
+	})
+
+	store := &queryableStateStore{
+		items: map[string][]byte{},
+		etags: map[string]int{},
+	}
+	for i := range 5 {
+		val, err := encryption.TryEncryptValue("reencrypt-writer", []byte("value"+strconv.Itoa(i)))
+		require.NoError(t, err)
+		store.items["myapp||key"+strconv.Itoa(i)] = val
+	}
+	val, err := encryption.TryEncryptValue("reencrypt", []byte("current"))
+	require.NoError(t, err)
+	store.items["myapp||key5"] = val
+	store.items["myapp||plain"] = []byte("plain")
+	// Keys of other apps and actor state keys are never touched.
+	foreign := map[string][]byte{}
+	for _, key := range []string{"otherapp||key0", "myapp||actortype||actorid||key0", "key0"} {
+		val, err := encryption.TryEncryptValue("reencrypt-writer", []byte("value"))
+		require.NoError(t, err)
+		store.items[key] = val
+		foreign[key] = val
+	}
+
+	// Simulate a concurrent update of key3 after it was read.
+	store.onGet = func(key string) {
+		if key == "myapp||key3" {
+			store.lock.Lock()
+			store.etags[key]++
+			store.lock.Unlock()
+		}
+	}
+
+	// The same store, without query support.
+	encryption.AddEncryptedStateStore("reencrypt-noquery", encryption.ComponentEncryptionKeys{
+		Primary:   newKeys.Primary,
+		Secondary: oldKeys.Primary,
+	})
+	noQueryStore := &queryableStateStore{
+		items: map[string][]byte{},
+		etags: map[string]int{},
+	}
+	for _, key := range []string{"key0", "key1"} {
+		val, err := encryption.TryEncryptValue("reencrypt-writer", []byte("value"))
+		require.NoError(t, err)
+		noQueryStore.items["myapp||"+key] = val
+	}
+
+	// The same store, whose query API can't return encrypted values.
+	encryption.AddEncryptedStateStore("reencrypt-redis", encryption.ComponentEncryptionKeys{
+		Primary:   newKeys.Primary,
+		Secondary: oldKeys.Primary,
+	})
+
+	compStore := compstore.New()
+	compStore.AddStateStore("reencrypt", store)
+	compStore.AddStateStore("reencrypt-noquery", struct{ state.Store }{noQueryStore})
+	compStore.AddStateStore("reencrypt-redis", noQueryStore)
+	compStore.AddStateStore("plain", &queryableStateStore{})
+	for name, typ := range map[string]string{
+		"reencrypt":         "state.mongodb",
+		"reencrypt-noquery": "state.mongodb",
+		"reencrypt-redis":   "state.redis",
+	} {
+		comp := compapi.Component{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       compapi.ComponentSpec{Type: typ, Version: "v1"},
+		}
+		require.NoError(t, compStore.AddPendingComponentForCommit(comp))
+		require.NoError(t, compStore.CommitPendingComponent())
+	}
+
+	fakeAPI := &Universal{
+		appID:      "myapp",
+		logger:     testLogger,
+		resiliency: resiliency.New(nil),
+		compStore:  compStore,
+	}
+
+	t.Run("store without encryption keys", func(t *testing.T) {
+		_, err := fakeAPI.ReEncryptStateAlpha1(t.Context(), &runtimev1pb.ReEncryptStateRequest{StoreName: "plain"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not configured with encryption keys")
+	})
+
+	t.Run("store not found", func(t *testing.T) {
+		_, err := fakeAPI.ReEncryptStateAlpha1(t.Context(), &runtimev1pb.ReEncryptStateRequest{StoreName: "notfound"})
+		require.Error(t, err)
+	})
+
+	t.Run("values are re-encrypted page by page", func(t *testing.T) {
+		var total runtimev1pb.ReEncryptStateResponse
+		var pages int
+		req := &runtimev1pb.ReEncryptStateRequest{
+			StoreName: "reencrypt",
+			PageSize:  ptr.Of(uint32(3)),
+		}
+		for {
+			resp, err := fakeAPI.ReEncryptStateAlpha1(t.Context(), req)
+			require.NoError(t, err)
+			pages++
+			total.Scanned += resp.GetScanned()
+			total.Reencrypted += resp.GetReencrypted()
+			total.Skipped += resp.GetSkipped()
+			total.Conflicts += resp.GetConflicts()
+			total.FailedKeys = append(total.FailedKeys, resp.GetFailedKeys()...)
+			if resp.ContinuationToken == nil {
+				break
+			}
+			req.ContinuationToken = resp.ContinuationToken
+		}
+
+		assert.Equal(t, 4, pages)
+		assert.Equal(t, uint64(7), total.GetScanned())
+		assert.Equal(t, uint64(4), total.GetReencrypted())
+		assert.Equal(t, uint64(2), total.GetSkipped())
+		assert.Equal(t, uint64(1), total.GetConflicts())
+		assert.Empty(t, total.GetFailedKeys())
+
+		for _, key := range []string{"myapp||key0", "myapp||key1", "myapp||key2", "myapp||key4", "myapp||key5"} {
+			assert.True(t, strings.HasSuffix(string(store.items[key]), "||new"), key)
+			val, err := encryption.TryDecryptValue("reencrypt", store.items[key])
+			require.NoError(t, err)
+			assert.NotEmpty(t, val)
+		}
+		assert.True(t, strings.HasSuffix(string(store.items["myapp||key3"]), "||old"))
+		assert.Equal(t, []byte("plain"), store.items["myapp||plain"])
+		for key, val := range foreign {
+			assert.Equal(t, val, store.items[key], key)
+		}
+	})
+
+	t.Run("store without query support requires keys", func(t *testing.T) {
+		_, err := fakeAPI.ReEncryptStateAlpha1(t.Context(), &runtimev1pb.ReEncryptStateRequest{StoreName: "reencrypt-noquery"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "keys to re-encrypt must be given in the request")
+		assert.True(t, strings.HasSuffix(string(noQueryStore.items["myapp||key0"]), "||old"))
+	})
+
+	t.Run("store whose query can't return encrypted values requires keys", func(t *testing.T) {
+		_, err := fakeAPI.ReEncryptStateAlpha1(t.Context(), &runtimev1pb.ReEncryptStateRequest{StoreName: "reencrypt-redis"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "keys to re-encrypt must be given in the request")
+		assert.True(t, strings.HasSuffix(string(noQueryStore.items["myapp||key0"]), "||old"))
+	})
+
+	t.Run("listed keys are re-encrypted", func(t *testing.T) {
+		resp, err := fakeAPI.ReEncryptStateAlpha1(t.Context(), &runtimev1pb.ReEncryptStateRequest{
+			StoreName: "reencrypt-noquery",
+			Keys:      []string{"key0", "missing"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), resp.GetScanned())
+		assert.Equal(t, uint64(1), resp.GetReencrypted())
+		assert.Equal(t, uint64(1), resp.GetSkipped())
+		assert.Nil(t, resp.ContinuationToken)
+
+		assert.True(t, strings.HasSuffix(string(noQueryStore.items["myapp||key0"]), "||new"))
+		assert.True(t, strings.HasSuffix(string(noQueryStore.items["myapp||key1"]), "||old"))
+	})
+}
@@ -19,16 +19,23 @@ import (
 	"context"
 	"sync"
 
+	"k8s.io/utils/clock"
+
+	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/dapr/pkg/actors"
 	"github.com/dapr/dapr/pkg/actors/reminders"
 	"github.com/dapr/dapr/pkg/actors/router"
 	"github.com/dapr/dapr/pkg/actors/state"
 	"github.com/dapr/dapr/pkg/actors/timers"
 	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/messaging/httpendpoints"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
 	schedclient "github.com/dapr/dapr/pkg/runtime/scheduler/client"
 	"github.com/dapr/dapr/pkg/runtime/wfengine"
+	"github.com/dapr/dapr/pkg/security"
 	"github.com/dapr/kit/logger"
 )
 
@@ -46,6 +53,10 @@ type Options struct {
 	Scheduler                   schedclient.Interface
 	Actors                      actors.Interface
 	WorkflowEngine              wfengine.Interface
+	Security                    security.Handler
+	HTTPEndpoints               *httpendpoints.Balancer
+	DirectMessaging             invokev1.DirectMessaging
+	SendToOutputBindingFn       func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
 }
 
 // Universal contains the implementation of gRPC APIs that are also used by the HTTP server.
@@ -62,12 +73,26 @@ type Universal struct {
 	globalConfig                *config.Configuration
 	workflowEngine              wfengine.Interface
 	scheduler                   schedclient.Interface
+	sec                         security.Handler
+	httpEndpoints               *httpendpoints.Balancer
+	directMessaging             invokev1.DirectMessaging
+	sendToOutputBindingFn       func(ctx context.Context, name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
+	conversationSidecarBudget   *rtconversation.SidecarBudget
 
 	extendedMetadataLock sync.RWMutex
 	actors               actors.Interface
 }
 
 func New(opts Options) *Universal {
+	var conversationSidecarBudget *rtconversation.SidecarBudget
+	if budget := opts.GlobalConfig.GetConversationSpec().SidecarTokenBudget; budget != nil {
+		var err error
+		conversationSidecarBudget, err = rtconversation.NewSidecarBudget(budget.MaxTokens, budget.GetWindow(), clock.RealClock{})
+		if err != nil {
+			opts.Logger.Errorf("Conversation sidecar token budget is not enforced as it is invalid: %v", err)
+		}
+	}
+
 	return &Universal{
 		appID:                       opts.AppID,
 		namespace:                   opts.Namespace,
@@ -82,6 +107,11 @@ func New(opts Options) *Universal {
 		scheduler:                   opts.Scheduler,
 		actors:                      opts.Actors,
 		workflowEngine:              opts.WorkflowEngine,
+		sec:                         opts.Security,
+		httpEndpoints:               opts.HTTPEndpoints,
+		directMessaging:             opts.DirectMessaging,
+		sendToOutputBindingFn:       opts.SendToOutputBindingFn,
+		conversationSidecarBudget:   conversationSidecarBudget,
 	}
 }
 
@@ -16,21 +16,31 @@ package universal
 import (
 	"context"
 	"errors"
+	"strings"
 	"unicode"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/dapr/components-contrib/workflows"
 	"github.com/dapr/dapr/pkg/messages"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/wfengine"
+	backendactors "github.com/dapr/dapr/pkg/runtime/wfengine/backends/actors"
 	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/backend"
 	"github.com/dapr/kit/ptr"
 )
 
+// workflowComponentName is the name of the built-in workflow engine in the
+// workflow APIs.
+const workflowComponentName = "dapr"
+
 // GetWorkflow is the API handler for getting workflow details
 func (a *Universal) GetWorkflow(ctx context.Context, in *runtimev1pb.GetWorkflowRequest) (*runtimev1pb.GetWorkflowResponse, error) {
 	if _, err := a.ActorRouter(ctx); err != nil {
@@ -255,6 +265,173 @@ func (a *Universal) PurgeWorkflow(ctx context.Context, in *runtimev1pb.PurgeWork
 	return emptyResponse, nil
 }
 
+// ListWorkflows is the API handler for listing workflow instances
+func (a *Universal) ListWorkflows(ctx context.Context, in *runtimev1pb.ListWorkflowsRequest) (*runtimev1pb.ListWorkflowsResponse, error) {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return nil, err
+	}
+	if err := validateWorkflowComponent(in.GetWorkflowComponent()); err != nil {
+		a.logger.Debug(err)
+		return &runtimev1pb.ListWorkflowsResponse{}, err
+	}
+
+	query := &internalsv1pb.WorkflowIndexQuery{
+		Name:            in.WorkflowName,
+		CreatedFrom:     in.GetCreatedTimeFrom(),
+		CreatedTo:       in.GetCreatedTimeTo(),
+		LastUpdatedFrom: in.GetLastUpdatedTimeFrom(),
+		LastUpdatedTo:   in.GetLastUpdatedTimeTo(),
+	}
+	for _, s := range in.GetRuntimeStatus() {
+		status, ok := wfengine.ParseStatus(strings.ToUpper(s))
+		if !ok {
+			err := messages.ErrInvalidWorkflowRuntimeStatus.WithFormat(s)
+			a.logger.Debug(err)
+			return &runtimev1pb.ListWorkflowsResponse{}, err
+		}
+		query.RuntimeStatuses = append(query.RuntimeStatuses, status)
+	}
+
+	resp, err := a.workflowEngine.ListInstances(ctx, &backendactors.ListInstancesRequest{
+		Query:             query,
+		PageSize:          in.GetPageSize(),
+		ContinuationToken: in.GetContinuationToken(),
+	})
+	if err != nil {
+		if errors.Is(err, backendactors.ErrInvalidContinuationToken) {
+			err = messages.ErrInvalidWorkflowContinuation
+		} else {
+			err = messages.ErrListWorkflows.WithFormat(err)
+		}
+		a.logger.Debug(err)
+		return &runtimev1pb.ListWorkflowsResponse{}, err
+	}
+
+	res := &runtimev1pb.ListWorkflowsResponse{
+		Workflows: make([]*runtimev1pb.GetWorkflowResponse, len(resp.Instances)),
+	}
+	for i, e := range resp.Instances {
+		res.Workflows[i] = &runtimev1pb.GetWorkflowResponse{
+			InstanceId:    e.GetInstanceId(),
+			WorkflowName:  e.GetName(),
+			CreatedAt:     e.GetCreatedAt(),
+			LastUpdatedAt: e.GetLastUpdatedAt(),
+			RuntimeStatus: wfengine.StatusString(e.GetRuntimeStatus()),
+		}
+	}
+	if resp.ContinuationToken != "" {
+		res.ContinuationToken = &resp.ContinuationToken
+	}
+
+	return res, nil
+}
+
+// ExportWorkflows exports the workflow instances matching the request as
+// portable records, calling fn with each of them in turn.
+func (a *Universal) ExportWorkflows(ctx context.Context, in *runtimev1pb.ExportWorkflowsRequest, fn func(*runtimev1pb.ExportedWorkflow) error) error {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return err
+	}
+	if err := validateWorkflowComponent(in.GetWorkflowComponent()); err != nil {
+		a.logger.Debug(err)
+		return err
+	}
+
+	query := &internalsv1pb.WorkflowIndexQuery{
+		Name: in.WorkflowName,
+	}
+	for _, s := range in.GetRuntimeStatus() {
+		status, ok := wfengine.ParseStatus(strings.ToUpper(s))
+		if !ok {
+			err := messages.ErrInvalidWorkflowRuntimeStatus.WithFormat(s)
+			a.logger.Debug(err)
+			return err
+		}
+		query.RuntimeStatuses = append(query.RuntimeStatuses, status)
+	}
+
+	err := a.workflowEngine.ExportInstances(ctx, query, func(inst *backendactors.ExportedInstance) error {
+		state, err := proto.Marshal(inst.State)
+		if err != nil {
+			return err
+		}
+
+		wf := &runtimev1pb.ExportedWorkflow{
+			InstanceId: inst.InstanceID,
+			State:      state,
+			Reminders:  make([]*runtimev1pb.ExportedWorkflowReminder, len(inst.Reminders)),
+		}
+		for i, r := range inst.Reminders {
+			wf.Reminders[i] = &runtimev1pb.ExportedWorkflowReminder{
+				ActorKind: r.ActorKind,
+				ActorId:   r.ActorID,
+				Name:      r.Name,
+				DueTime:   r.DueTime,
+				Period:    r.Period,
+				Data:      r.Data,
+			}
+		}
+		return fn(wf)
+	})
+	if err != nil {
+		err = messages.ErrExportWorkflows.WithFormat(err)
+		a.logger.Debug(err)
+		return err
+	}
+
+	return nil
+}
+
+// ImportWorkflow imports a workflow instance which was exported with
+// ExportWorkflows. Returns false if the instance was skipped because it
+// already exists.
+func (a *Universal) ImportWorkflow(ctx context.Context, in *runtimev1pb.ExportedWorkflow) (bool, error) {
+	if _, err := a.ActorRouter(ctx); err != nil {
+		return false, err
+	}
+	if err := a.validateInstanceID(in.GetInstanceId(), false /* isCreate */); err != nil {
+		a.logger.Debug(err)
+		return false, err
+	}
+
+	inst := &backendactors.ExportedInstance{
+		InstanceID: in.GetInstanceId(),
+		State:      new(backend.WorkflowState),
+		Reminders:  make([]*backendactors.ExportedReminder, len(in.GetReminders())),
+	}
+	if err := proto.Unmarshal(in.GetState(), inst.State); err != nil {
+		err = messages.ErrInvalidWorkflowImport.WithFormat(err)
+		a.logger.Debug(err)
+		return false, err
+	}
+	for i, r := range in.GetReminders() {
+		if r.GetActorKind() != backendactors.ReminderActorKindWorkflow && r.GetActorKind() != backendactors.ReminderActorKindActivity {
+			err := messages.ErrInvalidWorkflowImport.WithFormat("unknown reminder actor kind '" + r.GetActorKind() + "'")
+			a.logger.Debug(err)
+			return false, err
+		}
+		inst.Reminders[i] = &backendactors.ExportedReminder{
+			ActorKind: r.GetActorKind(),
+			ActorID:   r.GetActorId(),
+			Name:      r.GetName(),
+			DueTime:   r.GetDueTime(),
+			Period:    r.GetPeriod(),
+			Data:      r.GetData(),
+		}
+	}
+
+	if err := a.workflowEngine.ImportInstance(ctx, inst); err != nil {
+		if errors.Is(err, backendactors.ErrInstanceExists) {
+			return false, nil
+		}
+		err = messages.ErrImportWorkflow.WithFormat(in.GetInstanceId(), err)
+		a.logger.Debug(err)
+		return false, err
+	}
+
+	return true, nil
+}
+
 // GetWorkflowBeta1 is the API handler for getting workflow details
 func (a *Universal) GetWorkflowBeta1(ctx context.Context, in *runtimev1pb.GetWorkflowRequest) (*runtimev1pb.GetWorkflowResponse, error) {
 	return a.GetWorkflow(ctx, in)
@@ -290,6 +467,11 @@ func (a *Universal) PurgeWorkflowBeta1(ctx context.Context, in *runtimev1pb.Purg
 	return a.PurgeWorkflow(ctx, in)
 }
 
+// ListWorkflowsBeta1 is the API handler for listing workflow instances
+func (a *Universal) ListWorkflowsBeta1(ctx context.Context, in *runtimev1pb.ListWorkflowsRequest) (*runtimev1pb.ListWorkflowsResponse, error) {
+	return a.ListWorkflows(ctx, in)
+}
+
 // GetWorkflowAlpha1 is the API handler for getting workflow details
 // Deprecated: Use GetWorkflow instead.
 func (a *Universal) GetWorkflowAlpha1(ctx context.Context, in *runtimev1pb.GetWorkflowRequest) (*runtimev1pb.GetWorkflowResponse, error) {
@@ -332,6 +514,18 @@ func (a *Universal) PurgeWorkflowAlpha1(ctx context.Context, in *runtimev1pb.Pur
 	return a.PurgeWorkflow(ctx, in)
 }
 
+// validateWorkflowComponent returns an error if the name isn't that of the
+// built-in workflow engine, which is the only workflow component.
+func validateWorkflowComponent(name string) error {
+	if name == "" {
+		return messages.ErrNoOrMissingWorkflowComponent
+	}
+	if name != workflowComponentName {
+		return messages.ErrWorkflowComponentDoesNotExist.WithFormat(name)
+	}
+	return nil
+}
+
 func (a *Universal) validateInstanceID(instanceID string, isCreate bool) error {
 	if instanceID == "" {
 		return messages.ErrMissingOrEmptyInstance
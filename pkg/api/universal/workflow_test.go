@@ -15,17 +15,27 @@ package universal
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/dapr/components-contrib/workflows"
 	actorsfake "github.com/dapr/dapr/pkg/actors/fake"
 	"github.com/dapr/dapr/pkg/messages"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
+	backendactors "github.com/dapr/dapr/pkg/runtime/wfengine/backends/actors"
 	"github.com/dapr/dapr/pkg/runtime/wfengine/fake"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/backend"
 	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/ptr"
 )
 
 const (
@@ -366,3 +376,219 @@ func TestResumeWorkflowApi(t *testing.T) {
 		})
 	}
 }
+
+func TestListWorkflowsAPI(t *testing.T) {
+	fakeWorkflowName := "fakeWorkflow"
+	created := timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	var gotReq *backendactors.ListInstancesRequest
+	fakeAPI := &Universal{
+		logger:     logger.NewLogger("test"),
+		resiliency: resiliency.New(nil),
+		workflowEngine: fake.New().WithListInstances(func(_ context.Context, req *backendactors.ListInstancesRequest) (*backendactors.ListInstancesResponse, error) {
+			gotReq = req
+			if req.ContinuationToken == "bad" {
+				return nil, backendactors.ErrInvalidContinuationToken
+			}
+			return &backendactors.ListInstancesResponse{
+				Instances: []*internalsv1pb.WorkflowIndexEntry{{
+					InstanceId:    fakeInstanceID,
+					Name:          fakeWorkflowName,
+					RuntimeStatus: int32(api.RUNTIME_STATUS_RUNNING),
+					CreatedAt:     created,
+					LastUpdatedAt: created,
+				}},
+				ContinuationToken: "next",
+			}, nil
+		}),
+		actors: actorsfake.New(),
+	}
+
+	t.Run("workflow component", func(t *testing.T) {
+		_, err := fakeAPI.ListWorkflows(t.Context(), &runtimev1pb.ListWorkflowsRequest{})
+		require.ErrorIs(t, err, messages.ErrNoOrMissingWorkflowComponent)
+
+		_, err = fakeAPI.ListWorkflows(t.Context(), &runtimev1pb.ListWorkflowsRequest{WorkflowComponent: fakeComponentName})
+		require.ErrorIs(t, err, messages.ErrWorkflowComponentDoesNotExist)
+	})
+
+	t.Run("invalid runtime status", func(t *testing.T) {
+		_, err := fakeAPI.ListWorkflows(t.Context(), &runtimev1pb.ListWorkflowsRequest{
+			WorkflowComponent: workflowComponentName,
+			RuntimeStatus:     []string{"foo"},
+		})
+		require.ErrorIs(t, err, messages.ErrInvalidWorkflowRuntimeStatus)
+	})
+
+	t.Run("lists instances", func(t *testing.T) {
+		resp, err := fakeAPI.ListWorkflows(t.Context(), &runtimev1pb.ListWorkflowsRequest{
+			WorkflowComponent: workflowComponentName,
+			WorkflowName:      ptr.Of(fakeWorkflowName),
+			RuntimeStatus:     []string{"running"},
+			PageSize:          ptr.Of(uint32(10)),
+			ContinuationToken: ptr.Of("token"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, fakeWorkflowName, gotReq.Query.GetName())
+		assert.Equal(t, []int32{int32(api.RUNTIME_STATUS_RUNNING)}, gotReq.Query.GetRuntimeStatuses())
+		assert.Equal(t, uint32(10), gotReq.PageSize)
+		assert.Equal(t, "token", gotReq.ContinuationToken)
+
+		require.Len(t, resp.GetWorkflows(), 1)
+		assert.Equal(t, fakeInstanceID, resp.GetWorkflows()[0].GetInstanceId())
+		assert.Equal(t, fakeWorkflowName, resp.GetWorkflows()[0].GetWorkflowName())
+		assert.Equal(t, "RUNNING", resp.GetWorkflows()[0].GetRuntimeStatus())
+		assert.Equal(t, "next", resp.GetContinuationToken())
+	})
+
+	t.Run("invalid continuation token", func(t *testing.T) {
+		_, err := fakeAPI.ListWorkflows(t.Context(), &runtimev1pb.ListWorkflowsRequest{
+			WorkflowComponent: workflowComponentName,
+			ContinuationToken: ptr.Of("bad"),
+		})
+		require.ErrorIs(t, err, messages.ErrInvalidWorkflowContinuation)
+	})
+}
+
+func TestExportWorkflowsAPI(t *testing.T) {
+	t.Run("invalid runtime status", func(t *testing.T) {
+		fakeAPI := &Universal{
+			logger:         logger.NewLogger("test"),
+			resiliency:     resiliency.New(nil),
+			workflowEngine: fake.New(),
+			actors:         actorsfake.New(),
+		}
+		err := fakeAPI.ExportWorkflows(t.Context(), &runtimev1pb.ExportWorkflowsRequest{
+			WorkflowComponent: workflowComponentName,
+			RuntimeStatus:     []string{"foo"},
+		}, func(*runtimev1pb.ExportedWorkflow) error { return nil })
+		require.ErrorIs(t, err, messages.ErrInvalidWorkflowRuntimeStatus)
+	})
+
+	t.Run("exports instances", func(t *testing.T) {
+		var gotQuery *internalsv1pb.WorkflowIndexQuery
+		fakeAPI := &Universal{
+			logger:     logger.NewLogger("test"),
+			resiliency: resiliency.New(nil),
+			workflowEngine: fake.New().WithExportInstances(func(_ context.Context, query *internalsv1pb.WorkflowIndexQuery, fn func(*backendactors.ExportedInstance) error) error {
+				gotQuery = query
+				return fn(&backendactors.ExportedInstance{
+					InstanceID: fakeInstanceID,
+					State:      &backend.WorkflowState{Generation: 2},
+					Reminders: []*backendactors.ExportedReminder{{
+						ActorKind: backendactors.ReminderActorKindActivity,
+						ActorID:   fakeInstanceID + "::0::2",
+						Name:      "run-activity",
+						DueTime:   "1s",
+					}},
+				})
+			}),
+			actors: actorsfake.New(),
+		}
+
+		var exported []*runtimev1pb.ExportedWorkflow
+		err := fakeAPI.ExportWorkflows(t.Context(), &runtimev1pb.ExportWorkflowsRequest{
+			WorkflowComponent: workflowComponentName,
+			WorkflowName:      ptr.Of("wf"),
+			RuntimeStatus:     []string{"running"},
+		}, func(wf *runtimev1pb.ExportedWorkflow) error {
+			exported = append(exported, wf)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "wf", gotQuery.GetName())
+		assert.Len(t, gotQuery.GetRuntimeStatuses(), 1)
+
+		require.Len(t, exported, 1)
+		assert.Equal(t, fakeInstanceID, exported[0].GetInstanceId())
+		var state backend.WorkflowState
+		require.NoError(t, proto.Unmarshal(exported[0].GetState(), &state))
+		assert.Equal(t, uint64(2), state.GetGeneration())
+		require.Len(t, exported[0].GetReminders(), 1)
+		assert.Equal(t, backendactors.ReminderActorKindActivity, exported[0].GetReminders()[0].GetActorKind())
+		assert.Equal(t, "run-activity", exported[0].GetReminders()[0].GetName())
+	})
+
+	t.Run("engine error", func(t *testing.T) {
+		fakeAPI := &Universal{
+			logger:     logger.NewLogger("test"),
+			resiliency: resiliency.New(nil),
+			workflowEngine: fake.New().WithExportInstances(func(context.Context, *internalsv1pb.WorkflowIndexQuery, func(*backendactors.ExportedInstance) error) error {
+				return errors.New("boom")
+			}),
+			actors: actorsfake.New(),
+		}
+		err := fakeAPI.ExportWorkflows(t.Context(), &runtimev1pb.ExportWorkflowsRequest{
+			WorkflowComponent: workflowComponentName,
+		}, func(*runtimev1pb.ExportedWorkflow) error { return nil })
+		require.ErrorIs(t, err, messages.ErrExportWorkflows)
+	})
+}
+
+func TestImportWorkflowAPI(t *testing.T) {
+	state, err := proto.Marshal(&backend.WorkflowState{Generation: 1})
+	require.NoError(t, err)
+
+	var imported []*backendactors.ExportedInstance
+	fakeAPI := &Universal{
+		logger:     logger.NewLogger("test"),
+		resiliency: resiliency.New(nil),
+		workflowEngine: fake.New().WithImportInstance(func(_ context.Context, inst *backendactors.ExportedInstance) error {
+			if inst.InstanceID == "existing" {
+				return backendactors.ErrInstanceExists
+			}
+			imported = append(imported, inst)
+			return nil
+		}),
+		actors: actorsfake.New(),
+	}
+
+	t.Run("imports instance", func(t *testing.T) {
+		ok, err := fakeAPI.ImportWorkflow(t.Context(), &runtimev1pb.ExportedWorkflow{
+			InstanceId: fakeInstanceID,
+			State:      state,
+			Reminders: []*runtimev1pb.ExportedWorkflowReminder{{
+				ActorKind: backendactors.ReminderActorKindWorkflow,
+				ActorId:   fakeInstanceID,
+				Name:      "start",
+			}},
+		})
+		require.NoError(t, err)
+		assert.True(t, ok)
+		require.Len(t, imported, 1)
+		assert.Equal(t, uint64(1), imported[0].State.GetGeneration())
+		require.Len(t, imported[0].Reminders, 1)
+		assert.Equal(t, "start", imported[0].Reminders[0].Name)
+	})
+
+	t.Run("skips existing instance", func(t *testing.T) {
+		ok, err := fakeAPI.ImportWorkflow(t.Context(), &runtimev1pb.ExportedWorkflow{
+			InstanceId: "existing",
+			State:      state,
+		})
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("missing instance ID", func(t *testing.T) {
+		_, err := fakeAPI.ImportWorkflow(t.Context(), &runtimev1pb.ExportedWorkflow{State: state})
+		require.ErrorIs(t, err, messages.ErrMissingOrEmptyInstance)
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		_, err := fakeAPI.ImportWorkflow(t.Context(), &runtimev1pb.ExportedWorkflow{
+			InstanceId: fakeInstanceID,
+			State:      []byte("not a proto"),
+		})
+		require.ErrorIs(t, err, messages.ErrInvalidWorkflowImport)
+	})
+
+	t.Run("unknown reminder actor kind", func(t *testing.T) {
+		_, err := fakeAPI.ImportWorkflow(t.Context(), &runtimev1pb.ExportedWorkflow{
+			InstanceId: fakeInstanceID,
+			State:      state,
+			Reminders:  []*runtimev1pb.ExportedWorkflowReminder{{ActorKind: "foo"}},
+		})
+		require.ErrorIs(t, err, messages.ErrInvalidWorkflowImport)
+	})
+}
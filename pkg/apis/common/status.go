@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ConditionTypeReady is the condition type which is true when the
+	// resource is loaded by at least one sidecar and no sidecar failed to
+	// load it.
+	ConditionTypeReady = "Ready"
+	// ConditionTypeInitFailed is the condition type which is true when at
+	// least one sidecar failed to load the resource.
+	ConditionTypeInitFailed = "InitFailed"
+)
+
+// +kubebuilder:object:generate=true
+
+// ResourceStatus is the status of a resource, aggregated by the operator from
+// the results reported by the sidecars which load it.
+type ResourceStatus struct {
+	// Conditions are the Ready and InitFailed conditions of the resource.
+	//+optional
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Instances are the results reported by each sidecar which loads the
+	// resource, keyed by the name of its pod. Each operator instance only
+	// writes the results of the sidecars connected to it.
+	//+optional
+	Instances map[string]InstanceStatus `json:"instances,omitempty"`
+	// LoadedInstances is the number of sidecars which loaded the resource.
+	//+optional
+	LoadedInstances int32 `json:"loadedInstances,omitempty"`
+	// LastError is the most recent error reported by a sidecar which failed
+	// to load the resource.
+	//+optional
+	LastError string `json:"lastError,omitempty"`
+	// ObservedGeneration is the most recent generation of the resource
+	// reported by a sidecar.
+	//+optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// InstanceStatus is the result of loading a resource reported by a single
+// sidecar.
+type InstanceStatus struct {
+	// Loaded is true if the sidecar loaded the resource, and false if it
+	// failed to load it.
+	Loaded bool `json:"loaded"`
+	// Error is the error reported by the sidecar if it failed to load the
+	// resource.
+	//+optional
+	Error string `json:"error,omitempty"`
+	// ObservedGeneration is the generation of the resource reported by the
+	// sidecar.
+	//+optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastTransitionTime is the time at which the sidecar reported the result.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
@@ -30,6 +30,7 @@ const (
 //+genclient
 //+genclient:noStatus
 //+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
 
 // Component describes an Dapr component type.
 //
@@ -43,6 +44,8 @@ type Component struct {
 	//+optional
 	Auth          `json:"auth,omitempty"`
 	common.Scoped `json:",inline"`
+	//+optional
+	Status common.ResourceStatus `json:"status,omitempty"`
 }
 
 // Kind returns the component kind.
@@ -96,6 +99,7 @@ func (c Component) EmptyMetaDeepCopy() metav1.Object {
 		APIVersion: components.GroupName + "/" + Version,
 	}
 	n.ObjectMeta = metav1.ObjectMeta{Name: c.Name}
+	n.Status = common.ResourceStatus{}
 	return n
 }
 
@@ -18,13 +18,21 @@ import (
 
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/dapr/dapr/pkg/apis/common"
+	"github.com/dapr/dapr/pkg/apis/configuration"
 )
 
+const kind = "Configuration"
+
 // +genclient
 // +genclient:noStatus
 // +kubebuilder:object:root=true
 
 // Configuration describes an Dapr configuration setting.
+//
+//nolint:recvcheck
 type Configuration struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
@@ -33,6 +41,63 @@ type Configuration struct {
 	Spec ConfigurationSpec `json:"spec,omitempty"`
 }
 
+// Kind returns the configuration kind.
+func (Configuration) Kind() string {
+	return kind
+}
+
+func (Configuration) APIVersion() string {
+	return configuration.GroupName + "/" + SchemeGroupVersion.Version
+}
+
+// GetName returns the configuration name.
+func (c Configuration) GetName() string {
+	return c.Name
+}
+
+// GetNamespace returns the configuration namespace.
+func (c Configuration) GetNamespace() string {
+	return c.Namespace
+}
+
+// GetSecretStore returns the name of the secret store. Configurations do not
+// reference secrets.
+func (Configuration) GetSecretStore() string {
+	return ""
+}
+
+// LogName returns the name of the configuration that can be used in logging.
+func (c Configuration) LogName() string {
+	return c.Name
+}
+
+// NameValuePairs returns nil, as configurations have no metadata.
+func (Configuration) NameValuePairs() []common.NameValuePair {
+	return nil
+}
+
+func (c Configuration) ClientObject() client.Object {
+	return &c
+}
+
+// GetScopes returns nil, as a configuration applies to all apps which
+// reference it by name.
+func (Configuration) GetScopes() []string {
+	return nil
+}
+
+// EmptyMetaDeepCopy returns a new instance of the configuration type with the
+// TypeMeta's Kind and APIVersion fields set.
+func (c Configuration) EmptyMetaDeepCopy() metav1.Object {
+	n := c.DeepCopy()
+	n.TypeMeta = metav1.TypeMeta{
+		Kind:       kind,
+		APIVersion: configuration.GroupName + "/" + SchemeGroupVersion.Version,
+	}
+	n.ObjectMeta = metav1.ObjectMeta{Name: c.Name}
+	return n
+}
+
 // ConfigurationSpec is the spec for a configuration.
 type ConfigurationSpec struct {
 	// +optional
@@ -65,6 +130,24 @@ type ConfigurationSpec struct {
 	WasmSpec *WasmSpec `json:"wasm,omitempty"`
 	// +optional
 	WorkflowSpec *WorkflowSpec `json:"workflow,omitempty"`
+	// +optional
+	ConversationSpec *ConversationSpec `json:"conversation,omitempty"`
+}
+
+// ConversationSpec defines the configuration for the conversation API.
+type ConversationSpec struct {
+	// sidecarTokenBudget limits the number of tokens each sidecar of the app can spend through the conversation API.
+	// The budget is not shared between replicas.
+	// +optional
+	SidecarTokenBudget *ConversationSidecarTokenBudget `json:"sidecarTokenBudget,omitempty"`
+}
+
+// ConversationSidecarTokenBudget is the maximum number of tokens a sidecar can spend in a time window.
+type ConversationSidecarTokenBudget struct {
+	// maxTokens is the maximum number of prompt and completion tokens spent per window.
+	MaxTokens int64 `json:"maxTokens"`
+	// window is the duration of the window, such as "1h". The budget is reset at the start of each window.
+	Window string `json:"window"`
 }
 
 // WorkflowSpec defines the configuration for Dapr workflows.
@@ -316,6 +316,11 @@ func (in *ConfigurationSpec) DeepCopyInto(out *ConfigurationSpec) {
 		*out = new(WorkflowSpec)
 		**out = **in
 	}
+	if in.ConversationSpec != nil {
+		in, out := &in.ConversationSpec, &out.ConversationSpec
+		*out = new(ConversationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationSpec.
@@ -328,6 +333,41 @@ func (in *ConfigurationSpec) DeepCopy() *ConfigurationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConversationSidecarTokenBudget) DeepCopyInto(out *ConversationSidecarTokenBudget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConversationSidecarTokenBudget.
+func (in *ConversationSidecarTokenBudget) DeepCopy() *ConversationSidecarTokenBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(ConversationSidecarTokenBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConversationSpec) DeepCopyInto(out *ConversationSpec) {
+	*out = *in
+	if in.SidecarTokenBudget != nil {
+		in, out := &in.SidecarTokenBudget, &out.SidecarTokenBudget
+		*out = new(ConversationSidecarTokenBudget)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConversationSpec.
+func (in *ConversationSpec) DeepCopy() *ConversationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConversationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DynamicValue) DeepCopyInto(out *DynamicValue) {
 	*out = *in
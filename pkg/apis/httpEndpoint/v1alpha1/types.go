@@ -14,6 +14,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"slices"
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -29,6 +32,7 @@ const (
 //+genclient
 //+genclient:noStatus
 //+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
 
 // HTTPEndpoint describes a Dapr HTTPEndpoint type for external service invocation.
 // This endpoint can be external to Dapr, or external to the environment.
@@ -43,6 +47,8 @@ type HTTPEndpoint struct {
 	//+optional
 	Auth          `json:"auth,omitempty"`
 	common.Scoped `json:",inline"`
+	//+optional
+	Status common.ResourceStatus `json:"status,omitempty"`
 }
 
 const kind = "HTTPEndpoint"
@@ -73,7 +79,22 @@ func (h HTTPEndpoint) GetSecretStore() string {
 
 // LogName returns the name of the component that can be used in logging.
 func (h HTTPEndpoint) LogName() string {
-	return h.Name + " (" + h.Spec.BaseURL + ")"
+	return h.Name + " (" + strings.Join(h.AllBaseURLs(), ", ") + ")"
+}
+
+// AllBaseURLs returns the base URLs of the endpoint, BaseURL first followed by
+// BaseURLs.
+func (h HTTPEndpoint) AllBaseURLs() []string {
+	urls := make([]string, 0, len(h.Spec.BaseURLs)+1)
+	if len(h.Spec.BaseURL) > 0 {
+		urls = append(urls, h.Spec.BaseURL)
+	}
+	for _, u := range h.Spec.BaseURLs {
+		if !slices.Contains(urls, u) {
+			urls = append(urls, u)
+		}
+	}
+	return urls
 }
 
 // NameValuePairs returns the component's headers as name/value pairs
@@ -111,6 +132,12 @@ func (h HTTPEndpoint) HasTLSPrivateKey() bool {
 	return h.Spec.ClientTLS != nil && h.Spec.ClientTLS.PrivateKey != nil && h.Spec.ClientTLS.PrivateKey.Value != nil
 }
 
+// HasOAuth2 returns a bool indicating if the HTTP endpoint authenticates with
+// OAuth2 client credentials.
+func (h HTTPEndpoint) HasOAuth2() bool {
+	return h.Spec.Auth != nil && h.Spec.Auth.OAuth2 != nil
+}
+
 func (h HTTPEndpoint) ClientObject() client.Object {
 	return &h
 }
@@ -128,16 +155,156 @@ func (h HTTPEndpoint) EmptyMetaDeepCopy() metav1.Object {
 		APIVersion: httpendpoint.GroupName + "/" + Version,
 	}
 	n.ObjectMeta = metav1.ObjectMeta{Name: h.Name}
+	n.Status = common.ResourceStatus{}
 	return n
 }
 
 // HTTPEndpointSpec describes an access specification for allowing external service invocations.
 type HTTPEndpointSpec struct {
-	BaseURL string `json:"baseUrl" validate:"required"`
+	// BaseURL is the base URL of the endpoint. When BaseURLs are also given,
+	// it is the first base URL of the endpoint.
+	//+optional
+	BaseURL string `json:"baseUrl,omitempty"`
+	// BaseURLs are the base URLs of replicas of the endpoint, requests being
+	// balanced between them.
+	//+optional
+	BaseURLs []string `json:"baseUrls,omitempty"`
+	// LoadBalancing configures how a base URL is chosen for each request.
+	//+optional
+	LoadBalancing *LoadBalancing `json:"loadBalancing,omitempty"`
+	// HealthCheck configures how unhealthy base URLs are detected.
+	//+optional
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
 	//+optional
 	Headers []common.NameValuePair `json:"headers"`
 	//+optional
 	ClientTLS *common.TLS `json:"clientTLS,omitempty"`
+	//+optional
+	Auth *EndpointAuth `json:"auth,omitempty"`
+}
+
+// LoadBalancingStrategy is the strategy used to choose a base URL.
+type LoadBalancingStrategy string
+
+const (
+	// LoadBalancingRoundRobin spreads requests evenly across the healthy base
+	// URLs.
+	LoadBalancingRoundRobin LoadBalancingStrategy = "RoundRobin"
+	// LoadBalancingPriority sends requests to the first healthy base URL, in
+	// the order they are listed.
+	LoadBalancingPriority LoadBalancingStrategy = "Priority"
+)
+
+// LoadBalancing describes how a base URL is chosen for each request.
+type LoadBalancing struct {
+	//+optional
+	//+kubebuilder:validation:Enum={"RoundRobin", "Priority"}
+	//+kubebuilder:default=RoundRobin
+	Strategy LoadBalancingStrategy `json:"strategy,omitempty"`
+}
+
+// HealthCheck describes how unhealthy base URLs are detected. Unhealthy base
+// URLs are skipped, unless all of them are unhealthy.
+type HealthCheck struct {
+	// Active periodically probes every base URL.
+	//+optional
+	Active *ActiveHealthCheck `json:"active,omitempty"`
+	// Passive marks base URLs unhealthy after consecutive failed requests.
+	//+optional
+	Passive *PassiveHealthCheck `json:"passive,omitempty"`
+}
+
+// GetActive returns the active health check, or nil if there is none.
+func (h *HealthCheck) GetActive() *ActiveHealthCheck {
+	if h == nil {
+		return nil
+	}
+	return h.Active
+}
+
+// GetPassive returns the passive health check, or nil if there is none.
+func (h *HealthCheck) GetPassive() *PassiveHealthCheck {
+	if h == nil {
+		return nil
+	}
+	return h.Passive
+}
+
+// ActiveHealthCheck describes the periodic probing of base URLs. A base URL
+// is healthy when the probe returns a 2xx or 3xx status code.
+type ActiveHealthCheck struct {
+	// Path is the path probed on every base URL.
+	Path string `json:"path" validate:"required"`
+	// Interval between probes. Defaults to 10s.
+	//+optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Timeout of a probe. Defaults to 2s.
+	//+optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// FailureThreshold is the number of consecutive failed probes after which
+	// a base URL is unhealthy. Defaults to 2.
+	//+optional
+	FailureThreshold int `json:"failureThreshold,omitempty"`
+}
+
+// PassiveHealthCheck describes how base URLs are marked unhealthy from the
+// results of requests. A request fails when it can't be sent or its response
+// has a 5xx status code.
+type PassiveHealthCheck struct {
+	// FailureThreshold is the number of consecutive failed requests after which
+	// a base URL is unhealthy. Defaults to 5.
+	//+optional
+	FailureThreshold int `json:"failureThreshold,omitempty"`
+	// EjectionDuration is how long a base URL stays unhealthy before requests
+	// are sent to it again. Defaults to 30s.
+	//+optional
+	EjectionDuration *metav1.Duration `json:"ejectionDuration,omitempty"`
+}
+
+// EndpointAuth describes how Dapr authenticates requests to the HTTP endpoint.
+type EndpointAuth struct {
+	// OAuth2 configures bearer tokens obtained with the OAuth2 client
+	// credentials flow.
+	//+optional
+	OAuth2 *OAuth2ClientCredentials `json:"oauth2,omitempty"`
+}
+
+// OAuth2ClientCredentials describes the OAuth2 client credentials flow used to
+// obtain bearer tokens for the HTTP endpoint.
+type OAuth2ClientCredentials struct {
+	// TokenURL is the URL of the token endpoint of the authorization server.
+	TokenURL string `json:"tokenUrl" validate:"required"`
+	// ClientID is the client identifier.
+	ClientID SecretValue `json:"clientId"`
+	// ClientSecret is the client secret.
+	ClientSecret SecretValue `json:"clientSecret"`
+	// Scopes are the scopes requested with the token.
+	//+optional
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// SecretValue is a value either in plaintext or read from a secret store.
+type SecretValue struct {
+	// Value of the property, in plaintext.
+	//+optional
+	Value *common.DynamicValue `json:"value,omitempty"`
+	// SecretKeyRef is the reference of a value in a secret store component.
+	//+optional
+	SecretKeyRef *common.SecretKeyRef `json:"secretKeyRef,omitempty"`
+}
+
+// String returns the plaintext value, or an empty string if it has not been
+// resolved.
+func (s SecretValue) String() string {
+	if s.Value == nil {
+		return ""
+	}
+	return s.Value.String()
+}
+
+// HasSecret returns true if the value references a secret.
+func (s SecretValue) HasSecret() bool {
+	return s.SecretKeyRef != nil && s.SecretKeyRef.Name != ""
 }
 
 // Auth represents authentication details for the component.
@@ -17,12 +17,19 @@ import (
 	"encoding/json"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/dapr/dapr/pkg/apis/common"
+	"github.com/dapr/dapr/pkg/apis/resiliency"
 )
 
+const kind = "Resiliency"
+
 // +genclient
 // +genclient:noStatus
 // +kubebuilder:object:root=true
 
+//nolint:recvcheck
 type Resiliency struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
@@ -39,6 +46,61 @@ func (r *Resiliency) String() string {
 	return string(b)
 }
 
+// Kind returns the resiliency kind.
+func (Resiliency) Kind() string {
+	return kind
+}
+
+func (Resiliency) APIVersion() string {
+	return resiliency.GroupName + "/" + SchemeGroupVersion.Version
+}
+
+// GetName returns the resiliency name.
+func (r Resiliency) GetName() string {
+	return r.Name
+}
+
+// GetNamespace returns the resiliency namespace.
+func (r Resiliency) GetNamespace() string {
+	return r.Namespace
+}
+
+// GetSecretStore returns the name of the secret store. Resiliency policies do
+// not reference secrets.
+func (Resiliency) GetSecretStore() string {
+	return ""
+}
+
+// LogName returns the name of the resiliency that can be used in logging.
+func (r Resiliency) LogName() string {
+	return r.Name
+}
+
+// NameValuePairs returns nil, as resiliency policies have no metadata.
+func (Resiliency) NameValuePairs() []common.NameValuePair {
+	return nil
+}
+
+func (r Resiliency) ClientObject() client.Object {
+	return &r
+}
+
+func (r Resiliency) GetScopes() []string {
+	return r.Scopes
+}
+
+// EmptyMetaDeepCopy returns a new instance of the resiliency type with the
+// TypeMeta's Kind and APIVersion fields set.
+func (r Resiliency) EmptyMetaDeepCopy() metav1.Object {
+	n := r.DeepCopy()
+	n.TypeMeta = metav1.TypeMeta{
+		Kind:       kind,
+		APIVersion: resiliency.GroupName + "/" + SchemeGroupVersion.Version,
+	}
+	n.ObjectMeta = metav1.ObjectMeta{Name: r.Name}
+	return n
+}
+
 type ResiliencySpec struct {
 	Policies Policies `json:"policies"`
 	Targets  Targets  `json:"targets" yaml:"targets"`
@@ -48,6 +110,9 @@ type Policies struct {
 	Timeouts        map[string]string         `json:"timeouts,omitempty" yaml:"timeouts,omitempty"`
 	Retries         map[string]Retry          `json:"retries,omitempty" yaml:"retries,omitempty"`
 	CircuitBreakers map[string]CircuitBreaker `json:"circuitBreakers,omitempty" yaml:"circuitBreakers,omitempty"`
+	RateLimits      map[string]RateLimit      `json:"rateLimits,omitempty" yaml:"rateLimits,omitempty"`
+	Bulkheads       map[string]Bulkhead       `json:"bulkheads,omitempty" yaml:"bulkheads,omitempty"`
+	Hedges          map[string]Hedge          `json:"hedges,omitempty" yaml:"hedges,omitempty"`
 }
 
 type Retry struct {
@@ -73,6 +138,43 @@ type CircuitBreaker struct {
 	Trip        string `json:"trip,omitempty" yaml:"trip,omitempty"`
 }
 
+// RateLimit is a token bucket rate limiting policy.
+type RateLimit struct {
+	// Rate is the number of requests allowed per period.
+	Rate int `json:"rate" yaml:"rate"`
+	// Period is the interval in which Rate requests are allowed. Defaults to 1s.
+	Period string `json:"period,omitempty" yaml:"period,omitempty"`
+	// Burst is the maximum number of requests allowed at once. Defaults to Rate.
+	Burst int `json:"burst,omitempty" yaml:"burst,omitempty"`
+	// MaxWait is how long a request waits for a token before being rejected.
+	// Requests are rejected immediately when not set.
+	MaxWait string `json:"maxWait,omitempty" yaml:"maxWait,omitempty"`
+}
+
+// Bulkhead is a policy which limits the number of concurrent requests.
+type Bulkhead struct {
+	// MaxConcurrency is the maximum number of requests in flight.
+	MaxConcurrency int `json:"maxConcurrency" yaml:"maxConcurrency"`
+	// MaxWait is how long a request waits for a slot before being rejected.
+	// Requests are rejected immediately when not set.
+	MaxWait string `json:"maxWait,omitempty" yaml:"maxWait,omitempty"`
+}
+
+// Hedge is a policy which sends additional attempts of a service invocation
+// request to other instances of the target app if no response is received
+// within a delay.
+type Hedge struct {
+	// Delay is how long to wait for a response before sending another attempt.
+	Delay string `json:"delay" yaml:"delay"`
+	// MaxAttempts is the maximum number of attempts in flight, including the
+	// first one. Defaults to 2.
+	MaxAttempts int `json:"maxAttempts,omitempty" yaml:"maxAttempts,omitempty"`
+	// AllowNonIdempotent enables hedging of all requests. By default only
+	// HTTP GET, HEAD and OPTIONS requests are hedged, as other requests,
+	// including all gRPC requests, may not be safe to send more than once.
+	AllowNonIdempotent bool `json:"allowNonIdempotent,omitempty" yaml:"allowNonIdempotent,omitempty"`
+}
+
 type Targets struct {
 	Apps       map[string]EndpointPolicyNames  `json:"apps,omitempty" yaml:"apps,omitempty"`
 	Actors     map[string]ActorPolicyNames     `json:"actors,omitempty" yaml:"actors,omitempty"`
@@ -88,19 +190,29 @@ type PolicyNames struct {
 	Timeout        string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 	Retry          string `json:"retry,omitempty" yaml:"retry,omitempty"`
 	CircuitBreaker string `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty"`
+	RateLimit      string `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
+	Bulkhead       string `json:"bulkhead,omitempty" yaml:"bulkhead,omitempty"`
 }
 
 type EndpointPolicyNames struct {
 	Timeout                 string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 	Retry                   string `json:"retry,omitempty" yaml:"retry,omitempty"`
 	CircuitBreaker          string `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty"`
+	RateLimit               string `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
+	Bulkhead                string `json:"bulkhead,omitempty" yaml:"bulkhead,omitempty"`
 	CircuitBreakerCacheSize int    `json:"circuitBreakerCacheSize,omitempty" yaml:"circuitBreakerCacheSize,omitempty"`
+	Hedge                   string `json:"hedge,omitempty" yaml:"hedge,omitempty"`
+	// FallbackAppID is the ID of the app invoked instead when the circuit
+	// breaker is open.
+	FallbackAppID string `json:"fallbackAppId,omitempty" yaml:"fallbackAppId,omitempty"`
 }
 
 type ActorPolicyNames struct {
 	Timeout                 string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 	Retry                   string `json:"retry,omitempty" yaml:"retry,omitempty"`
 	CircuitBreaker          string `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty"`
+	RateLimit               string `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
+	Bulkhead                string `json:"bulkhead,omitempty" yaml:"bulkhead,omitempty"`
 	CircuitBreakerScope     string `json:"circuitBreakerScope,omitempty" yaml:"circuitBreakerScope,omitempty"`
 	CircuitBreakerCacheSize int    `json:"circuitBreakerCacheSize,omitempty" yaml:"circuitBreakerCacheSize,omitempty"`
 }
@@ -39,6 +39,21 @@ func (in *ActorPolicyNames) DeepCopy() *ActorPolicyNames {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Bulkhead) DeepCopyInto(out *Bulkhead) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bulkhead.
+func (in *Bulkhead) DeepCopy() *Bulkhead {
+	if in == nil {
+		return nil
+	}
+	out := new(Bulkhead)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
 	*out = *in
@@ -86,6 +101,21 @@ func (in *EndpointPolicyNames) DeepCopy() *EndpointPolicyNames {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hedge) DeepCopyInto(out *Hedge) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hedge.
+func (in *Hedge) DeepCopy() *Hedge {
+	if in == nil {
+		return nil
+	}
+	out := new(Hedge)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Policies) DeepCopyInto(out *Policies) {
 	*out = *in
@@ -110,6 +140,27 @@ func (in *Policies) DeepCopyInto(out *Policies) {
 			(*out)[key] = val
 		}
 	}
+	if in.RateLimits != nil {
+		in, out := &in.RateLimits, &out.RateLimits
+		*out = make(map[string]RateLimit, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Bulkheads != nil {
+		in, out := &in.Bulkheads, &out.Bulkheads
+		*out = make(map[string]Bulkhead, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Hedges != nil {
+		in, out := &in.Hedges, &out.Hedges
+		*out = make(map[string]Hedge, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policies.
@@ -137,6 +188,21 @@ func (in *PolicyNames) DeepCopy() *PolicyNames {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimit) DeepCopyInto(out *RateLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
+func (in *RateLimit) DeepCopy() *RateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resiliency) DeepCopyInto(out *Resiliency) {
 	*out = *in
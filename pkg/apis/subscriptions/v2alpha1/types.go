@@ -30,6 +30,7 @@ const (
 // +genclient:noStatus
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
+// +kubebuilder:subresource:status
 
 // Subscription describes an pub/sub event subscription.
 //
@@ -41,6 +42,8 @@ type Subscription struct {
 	Spec              SubscriptionSpec `json:"spec,omitempty"`
 	// +optional
 	Scopes []string `json:"scopes,omitempty"`
+	// +optional
+	Status common.ResourceStatus `json:"status,omitempty"`
 }
 
 // SubscriptionSpec is the spec for an event subscription.
@@ -58,6 +61,24 @@ type SubscriptionSpec struct {
 	DeadLetterTopic string `json:"deadLetterTopic,omitempty"`
 	// The option to enable bulk subscription for this topic.
 	BulkSubscribe BulkSubscribe `json:"bulkSubscribe,omitempty"`
+	// The optional actor to deliver the events of this topic to, instead of
+	// the app. The path of the matched route is used as the actor method.
+	// +optional
+	Actor *ActorTarget `json:"actor,omitempty"`
+}
+
+// ActorTarget configures the actor which the events of a topic are
+// delivered to. Exactly one of IDAttribute or IDExpression must be set.
+type ActorTarget struct {
+	// The actor type to deliver events to.
+	Type string `json:"type"`
+	// The name of the CloudEvent attribute holding the actor ID.
+	// +optional
+	IDAttribute string `json:"idAttribute,omitempty"`
+	// The CEL expression, evaluated against the event, which returns the
+	// actor ID.
+	// +optional
+	IDExpression string `json:"idExpression,omitempty"`
 }
 
 // BulkSubscribe encapsulates the bulk subscription configuration for a topic.
@@ -119,6 +140,7 @@ func (s Subscription) EmptyMetaDeepCopy() metav1.Object {
 		APIVersion: subscriptions.GroupName + "/" + Version,
 	}
 	n.ObjectMeta = metav1.ObjectMeta{Name: s.Name}
+	n.Status = common.ResourceStatus{}
 	return n
 }
 
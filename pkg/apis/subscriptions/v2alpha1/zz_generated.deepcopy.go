@@ -24,6 +24,21 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActorTarget) DeepCopyInto(out *ActorTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActorTarget.
+func (in *ActorTarget) DeepCopy() *ActorTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ActorTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BulkSubscribe) DeepCopyInto(out *BulkSubscribe) {
 	*out = *in
@@ -85,6 +100,7 @@ func (in *Subscription) DeepCopyInto(out *Subscription) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subscription.
@@ -149,6 +165,11 @@ func (in *SubscriptionSpec) DeepCopyInto(out *SubscriptionSpec) {
 	}
 	in.Routes.DeepCopyInto(&out.Routes)
 	out.BulkSubscribe = in.BulkSubscribe
+	if in.Actor != nil {
+		in, out := &in.Actor, &out.Actor
+		*out = new(ActorTarget)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionSpec.
@@ -42,3 +42,18 @@ type AppChannel interface {
 type HTTPEndpointAppChannel interface {
 	InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRequest, appID string) (*invokev1.InvokeMethodResponse, error)
 }
+
+type httpEndpointBaseURLKey struct{}
+
+// WithHTTPEndpointBaseURL returns a context instructing HTTP endpoint channels
+// to send the request to the given base URL of the endpoint.
+func WithHTTPEndpointBaseURL(ctx context.Context, baseURL string) context.Context {
+	return context.WithValue(ctx, httpEndpointBaseURLKey{}, baseURL)
+}
+
+// HTTPEndpointBaseURL returns the base URL of the HTTP endpoint set on the
+// context, if any.
+func HTTPEndpointBaseURL(ctx context.Context) (string, bool) {
+	baseURL, ok := ctx.Value(httpEndpointBaseURLKey{}).(string)
+	return baseURL, ok && len(baseURL) > 0
+}
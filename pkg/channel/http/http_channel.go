@@ -113,6 +113,11 @@ func CreateHTTPChannel(config ChannelConfiguration) (channel.AppChannel, error)
 	return c, nil
 }
 
+// HTTPClient returns the HTTP client the channel sends requests with.
+func (h *Channel) HTTPClient() *http.Client {
+	return h.client
+}
+
 // GetAppConfig gets application config from user application
 // GET http://localhost:<app_port>/dapr/config
 func (h *Channel) GetAppConfig(ctx context.Context, appID string) (*config.ApplicationConfig, error) {
@@ -482,7 +487,11 @@ func (h *Channel) constructRequest(ctx context.Context, req *invokev1.InvokeMeth
 		if strings.HasPrefix(appID, "https://") || strings.HasPrefix(appID, "http://") {
 			uri.WriteString(appID)
 		} else if endpoint, ok := h.compStore.GetHTTPEndpoint(appID); ok {
-			uri.WriteString(endpoint.Spec.BaseURL)
+			if baseURL, ok := channel.HTTPEndpointBaseURL(ctx); ok {
+				uri.WriteString(baseURL)
+			} else if urls := endpoint.AllBaseURLs(); len(urls) > 0 {
+				uri.WriteString(urls[0])
+			}
 			headers = endpoint.Spec.Headers
 		} else {
 			uri.WriteString(h.baseAddress)
@@ -14,6 +14,7 @@ limitations under the License.
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -28,7 +29,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	"github.com/dapr/dapr/pkg/apphealth"
+	"github.com/dapr/dapr/pkg/channel"
 	"github.com/dapr/dapr/pkg/config"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	httpMiddleware "github.com/dapr/dapr/pkg/middleware/http"
@@ -672,6 +675,51 @@ func TestInvokeWithHeaders(t *testing.T) {
 	testServer.Close()
 }
 
+func TestInvokeHTTPEndpointBaseURL(t *testing.T) {
+	newServer := func(name string) *httptest.Server {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, name+r.URL.Path)
+		}))
+		t.Cleanup(srv.Close)
+		return srv
+	}
+	srvA, srvB := newServer("a"), newServer("b")
+
+	store := compstore.New()
+	store.AddHTTPEndpoint(httpendpointsapi.HTTPEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-endpoint"},
+		Spec: httpendpointsapi.HTTPEndpointSpec{
+			BaseURLs: []string{srvA.URL, srvB.URL},
+		},
+	})
+	c := Channel{
+		client:     http.DefaultClient,
+		compStore:  store,
+		middleware: httpMiddleware.New().BuildPipelineFromSpec("test", nil),
+	}
+
+	invoke := func(t *testing.T, ctx context.Context) string {
+		t.Helper()
+		req := invokev1.NewInvokeMethodRequest("method").
+			WithHTTPExtension(http.MethodGet, "")
+		defer req.Close()
+		resp, err := c.InvokeMethod(ctx, req, "my-endpoint")
+		require.NoError(t, err)
+		defer resp.Close()
+		body, err := resp.RawDataFull()
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	t.Run("first base URL by default", func(t *testing.T) {
+		assert.Equal(t, "a/method", invoke(t, t.Context()))
+	})
+
+	t.Run("base URL from the context", func(t *testing.T) {
+		assert.Equal(t, "b/method", invoke(t, channel.WithHTTPEndpointBaseURL(t.Context(), srvB.URL)))
+	})
+}
+
 func TestContentType(t *testing.T) {
 	ctx := t.Context()
 
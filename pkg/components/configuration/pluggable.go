@@ -0,0 +1,191 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/dapr/pkg/components/pluggable"
+	proto "github.com/dapr/dapr/pkg/proto/components/v1"
+	"github.com/dapr/kit/logger"
+)
+
+// grpcConfigurationStore is a implementation of a configuration store over a gRPC Protocol.
+type grpcConfigurationStore struct {
+	*pluggable.GRPCConnector[proto.ConfigurationStoreClient]
+	logger logger.Logger
+
+	lock sync.Mutex
+	// subscriptions holds the cancel function of the stream of each active subscription.
+	subscriptions map[string]context.CancelFunc
+}
+
+// Init initializes the grpc configuration store passing out the metadata to the grpc component.
+func (g *grpcConfigurationStore) Init(ctx context.Context, metadata configuration.Metadata) error {
+	if err := g.Dial(metadata.Name); err != nil {
+		return err
+	}
+
+	protoMetadata := &proto.MetadataRequest{
+		Properties: metadata.Properties,
+	}
+
+	_, err := g.Client.Init(g.Context, &proto.ConfigurationStoreInitRequest{
+		Metadata: protoMetadata,
+	})
+	return err
+}
+
+// Get retrieves configuration items from the store.
+func (g *grpcConfigurationStore) Get(ctx context.Context, req *configuration.GetRequest) (*configuration.GetResponse, error) {
+	resp, err := g.Client.Get(ctx, &proto.GetConfigurationRequest{
+		Keys:     req.Keys,
+		Metadata: req.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &configuration.GetResponse{
+		Items: fromProtoItems(resp.GetItems()),
+	}, nil
+}
+
+// Subscribe opens a subscription stream with the component and calls the handler for every update event.
+// The first message of the stream carries the subscription ID.
+func (g *grpcConfigurationStore) Subscribe(ctx context.Context, req *configuration.SubscribeRequest, handler configuration.UpdateHandler) (string, error) {
+	streamCtx, cancel := context.WithCancel(g.Context)
+
+	stream, err := g.Client.Subscribe(streamCtx, &proto.SubscribeConfigurationRequest{
+		Keys:     req.Keys,
+		Metadata: req.Metadata,
+	})
+	if err != nil {
+		cancel()
+		return "", fmt.Errorf("unable to subscribe: %w", err)
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return "", fmt.Errorf("unable to subscribe: %w", err)
+	}
+
+	id := first.GetId()
+	if id == "" {
+		cancel()
+		return "", errors.New("unable to subscribe: component did not return a subscription ID")
+	}
+
+	g.lock.Lock()
+	g.subscriptions[id] = cancel
+	g.lock.Unlock()
+
+	// Close the stream when the caller context is done.
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-streamCtx.Done():
+		}
+	}()
+
+	go func() {
+		defer g.removeSubscription(id)
+		for {
+			msg, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				if streamCtx.Err() == nil {
+					g.logger.Errorf("Failed to receive configuration update for subscription %s: %v", id, err)
+				}
+				return
+			}
+
+			if err = handler(ctx, &configuration.UpdateEvent{
+				ID:    id,
+				Items: fromProtoItems(msg.GetItems()),
+			}); err != nil {
+				g.logger.Errorf("Error handling configuration update for subscription %s: %v", id, err)
+			}
+		}
+	}()
+
+	return id, nil
+}
+
+// Unsubscribe removes the subscription with the given ID.
+func (g *grpcConfigurationStore) Unsubscribe(ctx context.Context, req *configuration.UnsubscribeRequest) error {
+	_, err := g.Client.Unsubscribe(ctx, &proto.UnsubscribeConfigurationRequest{
+		Id: req.ID,
+	})
+	g.removeSubscription(req.ID)
+	return err
+}
+
+func (g *grpcConfigurationStore) removeSubscription(id string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if cancel, ok := g.subscriptions[id]; ok {
+		cancel()
+		delete(g.subscriptions, id)
+	}
+}
+
+func fromProtoItems(items map[string]*proto.ConfigurationItem) map[string]*configuration.Item {
+	res := make(map[string]*configuration.Item, len(items))
+	for k, v := range items {
+		res[k] = &configuration.Item{
+			Value:    v.GetValue(),
+			Version:  v.GetVersion(),
+			Metadata: v.GetMetadata(),
+		}
+	}
+	return res
+}
+
+// fromConnector creates a new GRPC configuration store using the given underlying connector.
+func fromConnector(l logger.Logger, connector *pluggable.GRPCConnector[proto.ConfigurationStoreClient]) *grpcConfigurationStore {
+	return &grpcConfigurationStore{
+		GRPCConnector: connector,
+		logger:        l,
+		subscriptions: make(map[string]context.CancelFunc),
+	}
+}
+
+// NewGRPCConfigurationStore creates a new grpc configuration store using the given socket factory.
+func NewGRPCConfigurationStore(l logger.Logger, socket string) *grpcConfigurationStore {
+	return fromConnector(l, pluggable.NewGRPCConnector(socket, proto.NewConfigurationStoreClient))
+}
+
+// newGRPCConfigurationStore creates a new grpc configuration store for the given pluggable component.
+func newGRPCConfigurationStore(dialer pluggable.GRPCConnectionDialer) func(l logger.Logger) configuration.Store {
+	return func(l logger.Logger) configuration.Store {
+		return fromConnector(l, pluggable.NewGRPCConnectorWithDialer(dialer, proto.NewConfigurationStoreClient))
+	}
+}
+
+func init() {
+	//nolint:nosnakecase
+	pluggable.AddServiceDiscoveryCallback(proto.ConfigurationStore_ServiceDesc.ServiceName, func(name string, dialer pluggable.GRPCConnectionDialer) {
+		DefaultRegistry.RegisterComponent(newGRPCConfigurationStore(dialer), name)
+	})
+}
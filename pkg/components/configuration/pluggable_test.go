@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/dapr/pkg/components/pluggable"
+	proto "github.com/dapr/dapr/pkg/proto/components/v1"
+	testingGrpc "github.com/dapr/dapr/pkg/testing/grpc"
+	"github.com/dapr/kit/logger"
+)
+
+var testLogger = logger.NewLogger("configuration-pluggable-logger")
+
+type server struct {
+	proto.UnimplementedConfigurationStoreServer
+	getCalled         atomic.Int64
+	onGet             func(*proto.GetConfigurationRequest)
+	getResp           *proto.GetConfigurationResponse
+	getErr            error
+	updates           chan map[string]*proto.ConfigurationItem
+	unsubscribeCalled atomic.Int64
+	unsubscribed      chan struct{}
+}
+
+func (s *server) Get(ctx context.Context, req *proto.GetConfigurationRequest) (*proto.GetConfigurationResponse, error) {
+	s.getCalled.Add(1)
+	if s.onGet != nil {
+		s.onGet(req)
+	}
+	return s.getResp, s.getErr
+}
+
+func (s *server) Subscribe(req *proto.SubscribeConfigurationRequest, stream proto.ConfigurationStore_SubscribeServer) error {
+	if err := stream.Send(&proto.SubscribeConfigurationResponse{Id: "sub1"}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case items := <-s.updates:
+			if err := stream.Send(&proto.SubscribeConfigurationResponse{Id: "sub1", Items: items}); err != nil {
+				return err
+			}
+		case <-s.unsubscribed:
+			return nil
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (s *server) Unsubscribe(ctx context.Context, req *proto.UnsubscribeConfigurationRequest) (*proto.UnsubscribeConfigurationResponse, error) {
+	s.unsubscribeCalled.Add(1)
+	close(s.unsubscribed)
+	return &proto.UnsubscribeConfigurationResponse{}, nil
+}
+
+func TestComponentCalls(t *testing.T) {
+	getConfigurationStore := testingGrpc.TestServerFor(testLogger, func(s *grpc.Server, svc *server) {
+		proto.RegisterConfigurationStoreServer(s, svc)
+	}, func(cci grpc.ClientConnInterface) *grpcConfigurationStore {
+		client := proto.NewConfigurationStoreClient(cci)
+		store := fromConnector(testLogger, pluggable.NewGRPCConnector("/tmp/socket.sock", proto.NewConfigurationStoreClient))
+		store.Client = client
+		return store
+	})
+
+	t.Run("get should call grpc get and convert the items", func(t *testing.T) {
+		svc := &server{
+			onGet: func(req *proto.GetConfigurationRequest) {
+				assert.Equal(t, []string{"key1"}, req.GetKeys())
+			},
+			getResp: &proto.GetConfigurationResponse{
+				Items: map[string]*proto.ConfigurationItem{
+					"key1": {Value: "value1", Version: "1"},
+				},
+			},
+		}
+		store, cleanup, err := getConfigurationStore(svc)
+		require.NoError(t, err)
+		defer cleanup()
+
+		resp, err := store.Get(t.Context(), &configuration.GetRequest{Keys: []string{"key1"}})
+		require.NoError(t, err)
+		require.Contains(t, resp.Items, "key1")
+		assert.Equal(t, "value1", resp.Items["key1"].Value)
+		assert.Equal(t, "1", resp.Items["key1"].Version)
+		assert.Equal(t, int64(1), svc.getCalled.Load())
+	})
+
+	t.Run("get should return an err when grpc returns an error", func(t *testing.T) {
+		svc := &server{
+			getErr: errors.New("fake-get-err"),
+		}
+		store, cleanup, err := getConfigurationStore(svc)
+		require.NoError(t, err)
+		defer cleanup()
+
+		_, err = store.Get(t.Context(), &configuration.GetRequest{})
+		require.Error(t, err)
+	})
+
+	t.Run("subscribe should stream updates until unsubscribed", func(t *testing.T) {
+		svc := &server{
+			updates:      make(chan map[string]*proto.ConfigurationItem),
+			unsubscribed: make(chan struct{}),
+		}
+		store, cleanup, err := getConfigurationStore(svc)
+		require.NoError(t, err)
+		defer cleanup()
+
+		events := make(chan *configuration.UpdateEvent, 1)
+		id, err := store.Subscribe(t.Context(), &configuration.SubscribeRequest{Keys: []string{"key1"}},
+			func(_ context.Context, e *configuration.UpdateEvent) error {
+				events <- e
+				return nil
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "sub1", id)
+
+		svc.updates <- map[string]*proto.ConfigurationItem{"key1": {Value: "value2"}}
+		select {
+		case e := <-events:
+			assert.Equal(t, "sub1", e.ID)
+			assert.Equal(t, "value2", e.Items["key1"].Value)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "timeout waiting for update event")
+		}
+
+		require.NoError(t, store.Unsubscribe(t.Context(), &configuration.UnsubscribeRequest{ID: id}))
+		assert.Equal(t, int64(1), svc.unsubscribeCalled.Load())
+		store.lock.Lock()
+		assert.Empty(t, store.subscriptions)
+		store.lock.Unlock()
+	})
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tmc/langchaingo/llms"
+
+	"github.com/dapr/components-contrib/conversation"
+	"github.com/dapr/dapr/pkg/components/pluggable"
+	proto "github.com/dapr/dapr/pkg/proto/components/v1"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
+	"github.com/dapr/kit/logger"
+)
+
+// grpcConversation is a implementation of a conversation component over a gRPC Protocol.
+type grpcConversation struct {
+	*pluggable.GRPCConnector[proto.ConversationClient]
+}
+
+// Init initializes the grpc conversation component passing out the metadata to the grpc component.
+func (g *grpcConversation) Init(ctx context.Context, metadata conversation.Metadata) error {
+	if err := g.Dial(metadata.Name); err != nil {
+		return err
+	}
+
+	protoMetadata := &proto.MetadataRequest{
+		Properties: metadata.Properties,
+	}
+
+	_, err := g.Client.Init(g.Context, &proto.ConversationInitRequest{
+		Metadata: protoMetadata,
+	})
+	return err
+}
+
+// Converse sends the request messages to the component and returns its response.
+func (g *grpcConversation) Converse(ctx context.Context, req *conversation.Request) (*conversation.Response, error) {
+	resp, _, err := g.converse(ctx, req)
+	return resp, err
+}
+
+// ConverseWithUsage implements rtconversation.UsageReporter. The usage is
+// estimated if the component doesn't report it.
+func (g *grpcConversation) ConverseWithUsage(ctx context.Context, req *conversation.Request) (*conversation.Response, rtconversation.Usage, error) {
+	resp, usage, err := g.converse(ctx, req)
+	if err != nil {
+		return nil, rtconversation.Usage{}, err
+	}
+	if usage == nil {
+		return resp, rtconversation.EstimateUsage(req, resp), nil
+	}
+	return resp, rtconversation.Usage{
+		PromptTokens:     usage.GetPromptTokens(),
+		CompletionTokens: usage.GetCompletionTokens(),
+	}, nil
+}
+
+// converse sends the request to the component, returning its response and the
+// usage it reported, if any.
+func (g *grpcConversation) converse(ctx context.Context, req *conversation.Request) (*conversation.Response, *proto.ConversationUsage, error) {
+	protoReq := &proto.ConverseRequest{
+		ToolChoice:          req.ToolChoice,
+		Parameters:          req.Parameters,
+		ConversationContext: req.ConversationContext,
+		Temperature:         req.Temperature,
+	}
+
+	if req.Message != nil {
+		protoReq.Messages = make([]*proto.ConversationMessage, 0, len(*req.Message))
+		for _, msg := range *req.Message {
+			m, err := toProtoMessage(msg)
+			if err != nil {
+				return nil, nil, err
+			}
+			protoReq.Messages = append(protoReq.Messages, m)
+		}
+	}
+
+	if req.Tools != nil {
+		protoReq.Tools = make([]*proto.ConversationTool, 0, len(*req.Tools))
+		for _, tool := range *req.Tools {
+			t, err := toProtoTool(tool)
+			if err != nil {
+				return nil, nil, err
+			}
+			protoReq.Tools = append(protoReq.Tools, t)
+		}
+	}
+
+	resp, err := g.Client.Converse(ctx, protoReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outputs := make([]conversation.Result, 0, len(resp.GetOutputs()))
+	for _, output := range resp.GetOutputs() {
+		choices := make([]conversation.Choice, 0, len(output.GetChoices()))
+		for _, choice := range output.GetChoices() {
+			c := conversation.Choice{
+				FinishReason: choice.GetFinishReason(),
+				Index:        choice.GetIndex(),
+				Message: conversation.Message{
+					Content: choice.GetContent(),
+				},
+			}
+			if len(choice.GetToolCalls()) > 0 {
+				calls := make([]llms.ToolCall, 0, len(choice.GetToolCalls()))
+				for _, call := range choice.GetToolCalls() {
+					calls = append(calls, fromProtoToolCall(call))
+				}
+				c.Message.ToolCallRequest = &calls
+			}
+			choices = append(choices, c)
+		}
+		outputs = append(outputs, conversation.Result{
+			StopReason: output.GetStopReason(),
+			Choices:    choices,
+		})
+	}
+
+	return &conversation.Response{
+		ConversationContext: resp.GetConversationContext(),
+		Outputs:             outputs,
+	}, resp.GetUsage(), nil
+}
+
+func toProtoMessage(msg llms.MessageContent) (*proto.ConversationMessage, error) {
+	m := &proto.ConversationMessage{
+		Role:  string(msg.Role),
+		Parts: make([]*proto.ConversationMessagePart, 0, len(msg.Parts)),
+	}
+
+	for _, part := range msg.Parts {
+		switch p := part.(type) {
+		case llms.TextContent:
+			m.Parts = append(m.Parts, &proto.ConversationMessagePart{
+				Part: &proto.ConversationMessagePart_Text{Text: p.Text},
+			})
+		case llms.ToolCall:
+			m.Parts = append(m.Parts, &proto.ConversationMessagePart{
+				Part: &proto.ConversationMessagePart_ToolCall{ToolCall: toProtoToolCall(p)},
+			})
+		case llms.ToolCallResponse:
+			m.Parts = append(m.Parts, &proto.ConversationMessagePart{
+				Part: &proto.ConversationMessagePart_ToolCallResponse{ToolCallResponse: &proto.ConversationToolCallResponse{
+					ToolCallId: p.ToolCallID,
+					Name:       p.Name,
+					Content:    p.Content,
+				}},
+			})
+		default:
+			return nil, fmt.Errorf("message part of type %T is not supported by pluggable conversation components", part)
+		}
+	}
+
+	return m, nil
+}
+
+func toProtoToolCall(call llms.ToolCall) *proto.ConversationToolCall {
+	c := &proto.ConversationToolCall{
+		Id:   call.ID,
+		Type: call.Type,
+	}
+	if call.FunctionCall != nil {
+		c.Name = call.FunctionCall.Name
+		c.Arguments = call.FunctionCall.Arguments
+	}
+	return c
+}
+
+func fromProtoToolCall(call *proto.ConversationToolCall) llms.ToolCall {
+	return llms.ToolCall{
+		ID:   call.GetId(),
+		Type: call.GetType(),
+		FunctionCall: &llms.FunctionCall{
+			Name:      call.GetName(),
+			Arguments: call.GetArguments(),
+		},
+	}
+}
+
+func toProtoTool(tool llms.Tool) (*proto.ConversationTool, error) {
+	t := &proto.ConversationTool{
+		Type: tool.Type,
+	}
+	if tool.Function != nil {
+		t.Name = tool.Function.Name
+		t.Description = tool.Function.Description
+		if tool.Function.Parameters != nil {
+			params, err := json.Marshal(tool.Function.Parameters)
+			if err != nil {
+				return nil, fmt.Errorf("failed to serialize parameters of tool %s: %w", tool.Function.Name, err)
+			}
+			t.Parameters = string(params)
+		}
+	}
+	return t, nil
+}
+
+// fromConnector creates a new GRPC conversation component using the given underlying connector.
+func fromConnector(_ logger.Logger, connector *pluggable.GRPCConnector[proto.ConversationClient]) *grpcConversation {
+	return &grpcConversation{
+		GRPCConnector: connector,
+	}
+}
+
+// NewGRPCConversation creates a new grpc conversation component using the given socket factory.
+func NewGRPCConversation(l logger.Logger, socket string) *grpcConversation {
+	return fromConnector(l, pluggable.NewGRPCConnector(socket, proto.NewConversationClient))
+}
+
+// newGRPCConversation creates a new grpc conversation component for the given pluggable component.
+func newGRPCConversation(dialer pluggable.GRPCConnectionDialer) func(l logger.Logger) conversation.Conversation {
+	return func(l logger.Logger) conversation.Conversation {
+		return fromConnector(l, pluggable.NewGRPCConnectorWithDialer(dialer, proto.NewConversationClient))
+	}
+}
+
+func init() {
+	//nolint:nosnakecase
+	pluggable.AddServiceDiscoveryCallback(proto.Conversation_ServiceDesc.ServiceName, func(name string, dialer pluggable.GRPCConnectionDialer) {
+		DefaultRegistry.RegisterComponent(newGRPCConversation(dialer), name)
+	})
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/langchaingo/llms"
+	"google.golang.org/grpc"
+
+	"github.com/dapr/components-contrib/conversation"
+	"github.com/dapr/dapr/pkg/components/pluggable"
+	proto "github.com/dapr/dapr/pkg/proto/components/v1"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
+	testingGrpc "github.com/dapr/dapr/pkg/testing/grpc"
+	"github.com/dapr/kit/logger"
+)
+
+var testLogger = logger.NewLogger("conversation-pluggable-logger")
+
+type server struct {
+	proto.UnimplementedConversationServer
+	onConverse func(*proto.ConverseRequest)
+	resp       *proto.ConverseResponse
+}
+
+func (s *server) Converse(ctx context.Context, req *proto.ConverseRequest) (*proto.ConverseResponse, error) {
+	if s.onConverse != nil {
+		s.onConverse(req)
+	}
+	return s.resp, nil
+}
+
+func TestComponentCalls(t *testing.T) {
+	getConversation := testingGrpc.TestServerFor(testLogger, func(s *grpc.Server, svc *server) {
+		proto.RegisterConversationServer(s, svc)
+	}, func(cci grpc.ClientConnInterface) *grpcConversation {
+		client := proto.NewConversationClient(cci)
+		c := fromConnector(testLogger, pluggable.NewGRPCConnector("/tmp/socket.sock", proto.NewConversationClient))
+		c.Client = client
+		return c
+	})
+
+	t.Run("converse should convert messages, tools and outputs", func(t *testing.T) {
+		svc := &server{
+			onConverse: func(req *proto.ConverseRequest) {
+				require.Len(t, req.GetMessages(), 2)
+				assert.Equal(t, string(llms.ChatMessageTypeHuman), req.GetMessages()[0].GetRole())
+				assert.Equal(t, "what is the weather?", req.GetMessages()[0].GetParts()[0].GetText())
+				assert.Equal(t, "call1", req.GetMessages()[1].GetParts()[0].GetToolCallResponse().GetToolCallId())
+
+				require.Len(t, req.GetTools(), 1)
+				assert.Equal(t, "get_weather", req.GetTools()[0].GetName())
+				assert.JSONEq(t, `{"type":"object"}`, req.GetTools()[0].GetParameters())
+				assert.InDelta(t, 0.5, req.GetTemperature(), 0.0001)
+			},
+			resp: &proto.ConverseResponse{
+				ConversationContext: "ctx1",
+				Outputs: []*proto.ConversationResult{{
+					StopReason: "tool_calls",
+					Choices: []*proto.ConversationChoice{{
+						FinishReason: "tool_calls",
+						ToolCalls: []*proto.ConversationToolCall{{
+							Id: "call2", Type: "function", Name: "get_weather", Arguments: `{"city":"Paris"}`,
+						}},
+					}},
+				}},
+			},
+		}
+		c, cleanup, err := getConversation(svc)
+		require.NoError(t, err)
+		defer cleanup()
+
+		resp, err := c.Converse(t.Context(), &conversation.Request{
+			Message: &[]llms.MessageContent{
+				llms.TextParts(llms.ChatMessageTypeHuman, "what is the weather?"),
+				{
+					Role: llms.ChatMessageTypeTool,
+					Parts: []llms.ContentPart{llms.ToolCallResponse{
+						ToolCallID: "call1", Name: "get_weather", Content: "sunny",
+					}},
+				},
+			},
+			Tools: &[]llms.Tool{{
+				Type: "function",
+				Function: &llms.FunctionDefinition{
+					Name:       "get_weather",
+					Parameters: map[string]any{"type": "object"},
+				},
+			}},
+			Temperature: 0.5,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ctx1", resp.ConversationContext)
+		require.Len(t, resp.Outputs, 1)
+		require.Len(t, resp.Outputs[0].Choices, 1)
+		calls := resp.Outputs[0].Choices[0].Message.ToolCallRequest
+		require.NotNil(t, calls)
+		require.Len(t, *calls, 1)
+		assert.Equal(t, "call2", (*calls)[0].ID)
+		assert.Equal(t, "get_weather", (*calls)[0].FunctionCall.Name)
+		assert.JSONEq(t, `{"city":"Paris"}`, (*calls)[0].FunctionCall.Arguments)
+	})
+
+	t.Run("converse with usage should return the reported usage", func(t *testing.T) {
+		c, cleanup, err := getConversation(&server{resp: &proto.ConverseResponse{
+			Outputs: []*proto.ConversationResult{{
+				Choices: []*proto.ConversationChoice{{Content: "hi"}},
+			}},
+			Usage: &proto.ConversationUsage{PromptTokens: 12, CompletionTokens: 3},
+		}})
+		require.NoError(t, err)
+		defer cleanup()
+
+		_, usage, err := c.ConverseWithUsage(t.Context(), &conversation.Request{
+			Message: &[]llms.MessageContent{llms.TextParts(llms.ChatMessageTypeHuman, "hello")},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rtconversation.Usage{PromptTokens: 12, CompletionTokens: 3}, usage)
+	})
+
+	t.Run("converse with usage should estimate unreported usage", func(t *testing.T) {
+		c, cleanup, err := getConversation(&server{resp: &proto.ConverseResponse{
+			Outputs: []*proto.ConversationResult{{
+				Choices: []*proto.ConversationChoice{{Content: "hello world"}},
+			}},
+		}})
+		require.NoError(t, err)
+		defer cleanup()
+
+		_, usage, err := c.ConverseWithUsage(t.Context(), &conversation.Request{
+			Message: &[]llms.MessageContent{llms.TextParts(llms.ChatMessageTypeHuman, "hello world")},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rtconversation.Usage{PromptTokens: 3, CompletionTokens: 3}, usage)
+	})
+
+	t.Run("converse should reject unsupported message parts", func(t *testing.T) {
+		c, cleanup, err := getConversation(&server{})
+		require.NoError(t, err)
+		defer cleanup()
+
+		_, err = c.Converse(t.Context(), &conversation.Request{
+			Message: &[]llms.MessageContent{{
+				Role:  llms.ChatMessageTypeHuman,
+				Parts: []llms.ContentPart{llms.ImageURLContent{URL: "https://example.com/img.png"}},
+			}},
+		})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crypto
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+
+	"github.com/dapr/components-contrib/crypto"
+	"github.com/dapr/dapr/pkg/components/pluggable"
+	proto "github.com/dapr/dapr/pkg/proto/components/v1"
+	"github.com/dapr/kit/logger"
+)
+
+// grpcCrypto is a implementation of a crypto provider over a gRPC Protocol.
+// Keys are exchanged with the component as JSON Web Keys.
+type grpcCrypto struct {
+	*pluggable.GRPCConnector[proto.SubtleCryptoClient]
+	encryptionAlgorithms []string
+	signatureAlgorithms  []string
+}
+
+// Init initializes the grpc crypto provider passing out the metadata to the grpc component.
+// It also fetches the algorithms supported by the component.
+func (g *grpcCrypto) Init(ctx context.Context, metadata crypto.Metadata) error {
+	if err := g.Dial(metadata.Name); err != nil {
+		return err
+	}
+
+	protoMetadata := &proto.MetadataRequest{
+		Properties: metadata.Properties,
+	}
+
+	_, err := g.Client.Init(g.Context, &proto.SubtleCryptoInitRequest{
+		Metadata: protoMetadata,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Algorithms are fetched once, as the SubtleCryptoAlgorithms interface doesn't support errors.
+	algs, err := g.Client.SupportedAlgorithms(g.Context, &proto.SupportedAlgorithmsRequest{})
+	if err != nil {
+		return err
+	}
+	g.encryptionAlgorithms = algs.GetEncryptionAlgorithms()
+	g.signatureAlgorithms = algs.GetSignatureAlgorithms()
+
+	return nil
+}
+
+// SupportedEncryptionAlgorithms returns the encryption algorithms supported by the component.
+func (g *grpcCrypto) SupportedEncryptionAlgorithms() []string {
+	return g.encryptionAlgorithms
+}
+
+// SupportedSignatureAlgorithms returns the signature algorithms supported by the component.
+func (g *grpcCrypto) SupportedSignatureAlgorithms() []string {
+	return g.signatureAlgorithms
+}
+
+// GetKey returns the public part of a key.
+func (g *grpcCrypto) GetKey(ctx context.Context, keyName string) (jwk.Key, error) {
+	resp, err := g.Client.GetKey(ctx, &proto.SubtleGetKeyRequest{
+		KeyName: keyName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.GetPublicKeyJwk()) == 0 {
+		return nil, nil
+	}
+	key, err := jwk.ParseKey(resp.GetPublicKeyJwk())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key returned by the component: %w", err)
+	}
+	return key, nil
+}
+
+// Encrypt a small message and returns the ciphertext.
+func (g *grpcCrypto) Encrypt(ctx context.Context, plaintext []byte, algorithm string, keyName string, nonce []byte, associatedData []byte) ([]byte, []byte, error) {
+	resp, err := g.Client.Encrypt(ctx, &proto.SubtleEncryptRequest{
+		Plaintext:      plaintext,
+		Algorithm:      algorithm,
+		KeyName:        keyName,
+		Nonce:          nonce,
+		AssociatedData: associatedData,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.GetCiphertext(), resp.GetTag(), nil
+}
+
+// Decrypt a small message and returns the plaintext.
+func (g *grpcCrypto) Decrypt(ctx context.Context, ciphertext []byte, algorithm string, keyName string, nonce []byte, tag []byte, associatedData []byte) ([]byte, error) {
+	resp, err := g.Client.Decrypt(ctx, &proto.SubtleDecryptRequest{
+		Ciphertext:     ciphertext,
+		Algorithm:      algorithm,
+		KeyName:        keyName,
+		Nonce:          nonce,
+		Tag:            tag,
+		AssociatedData: associatedData,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPlaintext(), nil
+}
+
+// WrapKey wraps a key.
+func (g *grpcCrypto) WrapKey(ctx context.Context, plaintextKey jwk.Key, algorithm string, keyName string, nonce []byte, associatedData []byte) ([]byte, []byte, error) {
+	keyJSON, err := json.Marshal(plaintextKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to serialize key: %w", err)
+	}
+
+	resp, err := g.Client.WrapKey(ctx, &proto.SubtleWrapKeyRequest{
+		PlaintextKeyJwk: keyJSON,
+		Algorithm:       algorithm,
+		KeyName:         keyName,
+		Nonce:           nonce,
+		AssociatedData:  associatedData,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.GetWrappedKey(), resp.GetTag(), nil
+}
+
+// UnwrapKey unwraps a key.
+func (g *grpcCrypto) UnwrapKey(ctx context.Context, wrappedKey []byte, algorithm string, keyName string, nonce []byte, tag []byte, associatedData []byte) (jwk.Key, error) {
+	resp, err := g.Client.UnwrapKey(ctx, &proto.SubtleUnwrapKeyRequest{
+		WrappedKey:     wrappedKey,
+		Algorithm:      algorithm,
+		KeyName:        keyName,
+		Nonce:          nonce,
+		Tag:            tag,
+		AssociatedData: associatedData,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := jwk.ParseKey(resp.GetPlaintextKeyJwk())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key returned by the component: %w", err)
+	}
+	return key, nil
+}
+
+// Sign a digest.
+func (g *grpcCrypto) Sign(ctx context.Context, digest []byte, algorithm string, keyName string) ([]byte, error) {
+	resp, err := g.Client.Sign(ctx, &proto.SubtleSignRequest{
+		Digest:    digest,
+		Algorithm: algorithm,
+		KeyName:   keyName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetSignature(), nil
+}
+
+// Verify a signature.
+func (g *grpcCrypto) Verify(ctx context.Context, digest []byte, signature []byte, algorithm string, keyName string) (bool, error) {
+	resp, err := g.Client.Verify(ctx, &proto.SubtleVerifyRequest{
+		Digest:    digest,
+		Signature: signature,
+		Algorithm: algorithm,
+		KeyName:   keyName,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetValid(), nil
+}
+
+// fromConnector creates a new GRPC crypto provider using the given underlying connector.
+func fromConnector(_ logger.Logger, connector *pluggable.GRPCConnector[proto.SubtleCryptoClient]) *grpcCrypto {
+	return &grpcCrypto{
+		GRPCConnector: connector,
+	}
+}
+
+// NewGRPCCrypto creates a new grpc crypto provider using the given socket factory.
+func NewGRPCCrypto(l logger.Logger, socket string) *grpcCrypto {
+	return fromConnector(l, pluggable.NewGRPCConnector(socket, proto.NewSubtleCryptoClient))
+}
+
+// newGRPCCrypto creates a new grpc crypto provider for the given pluggable component.
+func newGRPCCrypto(dialer pluggable.GRPCConnectionDialer) func(l logger.Logger) crypto.SubtleCrypto {
+	return func(l logger.Logger) crypto.SubtleCrypto {
+		return fromConnector(l, pluggable.NewGRPCConnectorWithDialer(dialer, proto.NewSubtleCryptoClient))
+	}
+}
+
+func init() {
+	//nolint:nosnakecase
+	pluggable.AddServiceDiscoveryCallback(proto.SubtleCrypto_ServiceDesc.ServiceName, func(name string, dialer pluggable.GRPCConnectionDialer) {
+		DefaultRegistry.RegisterComponent(newGRPCCrypto(dialer), name)
+	})
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crypto
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/dapr/dapr/pkg/components/pluggable"
+	proto "github.com/dapr/dapr/pkg/proto/components/v1"
+	testingGrpc "github.com/dapr/dapr/pkg/testing/grpc"
+	"github.com/dapr/kit/logger"
+)
+
+var testLogger = logger.NewLogger("crypto-pluggable-logger")
+
+type server struct {
+	proto.UnimplementedSubtleCryptoServer
+	keyJWK    []byte
+	onEncrypt func(*proto.SubtleEncryptRequest)
+	onWrapKey func(*proto.SubtleWrapKeyRequest)
+	verifyErr error
+}
+
+func (s *server) GetKey(ctx context.Context, req *proto.SubtleGetKeyRequest) (*proto.SubtleGetKeyResponse, error) {
+	return &proto.SubtleGetKeyResponse{PublicKeyJwk: s.keyJWK}, nil
+}
+
+func (s *server) Encrypt(ctx context.Context, req *proto.SubtleEncryptRequest) (*proto.SubtleEncryptResponse, error) {
+	if s.onEncrypt != nil {
+		s.onEncrypt(req)
+	}
+	return &proto.SubtleEncryptResponse{Ciphertext: []byte("ciphertext"), Tag: []byte("tag")}, nil
+}
+
+func (s *server) WrapKey(ctx context.Context, req *proto.SubtleWrapKeyRequest) (*proto.SubtleWrapKeyResponse, error) {
+	if s.onWrapKey != nil {
+		s.onWrapKey(req)
+	}
+	return &proto.SubtleWrapKeyResponse{WrappedKey: []byte("wrapped")}, nil
+}
+
+func (s *server) UnwrapKey(ctx context.Context, req *proto.SubtleUnwrapKeyRequest) (*proto.SubtleUnwrapKeyResponse, error) {
+	return &proto.SubtleUnwrapKeyResponse{PlaintextKeyJwk: s.keyJWK}, nil
+}
+
+func (s *server) Verify(ctx context.Context, req *proto.SubtleVerifyRequest) (*proto.SubtleVerifyResponse, error) {
+	return &proto.SubtleVerifyResponse{Valid: true}, s.verifyErr
+}
+
+func TestComponentCalls(t *testing.T) {
+	getCrypto := testingGrpc.TestServerFor(testLogger, func(s *grpc.Server, svc *server) {
+		proto.RegisterSubtleCryptoServer(s, svc)
+	}, func(cci grpc.ClientConnInterface) *grpcCrypto {
+		client := proto.NewSubtleCryptoClient(cci)
+		c := fromConnector(testLogger, pluggable.NewGRPCConnector("/tmp/socket.sock", proto.NewSubtleCryptoClient))
+		c.Client = client
+		return c
+	})
+
+	key, err := jwk.FromRaw([]byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+	keyJWK, err := json.Marshal(key)
+	require.NoError(t, err)
+
+	t.Run("getkey should parse the returned JWK", func(t *testing.T) {
+		c, cleanup, err := getCrypto(&server{keyJWK: keyJWK})
+		require.NoError(t, err)
+		defer cleanup()
+
+		got, err := c.GetKey(t.Context(), "mykey")
+		require.NoError(t, err)
+		assert.True(t, jwk.Equal(key, got))
+	})
+
+	t.Run("encrypt should call grpc encrypt", func(t *testing.T) {
+		c, cleanup, err := getCrypto(&server{
+			onEncrypt: func(req *proto.SubtleEncryptRequest) {
+				assert.Equal(t, []byte("plaintext"), req.GetPlaintext())
+				assert.Equal(t, "A256GCM", req.GetAlgorithm())
+				assert.Equal(t, "mykey", req.GetKeyName())
+			},
+		})
+		require.NoError(t, err)
+		defer cleanup()
+
+		ciphertext, tag, err := c.Encrypt(t.Context(), []byte("plaintext"), "A256GCM", "mykey", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("ciphertext"), ciphertext)
+		assert.Equal(t, []byte("tag"), tag)
+	})
+
+	t.Run("wrap and unwrap should exchange keys as JWK", func(t *testing.T) {
+		c, cleanup, err := getCrypto(&server{
+			keyJWK: keyJWK,
+			onWrapKey: func(req *proto.SubtleWrapKeyRequest) {
+				assert.JSONEq(t, string(keyJWK), string(req.GetPlaintextKeyJwk()))
+			},
+		})
+		require.NoError(t, err)
+		defer cleanup()
+
+		wrapped, _, err := c.WrapKey(t.Context(), key, "A256KW", "mykey", nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("wrapped"), wrapped)
+
+		unwrapped, err := c.UnwrapKey(t.Context(), wrapped, "A256KW", "mykey", nil, nil, nil)
+		require.NoError(t, err)
+		assert.True(t, jwk.Equal(key, unwrapped))
+	})
+
+	t.Run("verify should return an err when grpc returns an error", func(t *testing.T) {
+		c, cleanup, err := getCrypto(&server{verifyErr: errors.New("fake-verify-err")})
+		require.NoError(t, err)
+		defer cleanup()
+
+		_, err = c.Verify(t.Context(), []byte("digest"), []byte("sig"), "ES256", "mykey")
+		require.Error(t, err)
+	})
+}
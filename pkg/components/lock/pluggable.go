@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/lock"
+	"github.com/dapr/dapr/pkg/components/pluggable"
+	proto "github.com/dapr/dapr/pkg/proto/components/v1"
+	"github.com/dapr/kit/logger"
+)
+
+// grpcLockStore is a implementation of a lock store over a gRPC Protocol.
+type grpcLockStore struct {
+	*pluggable.GRPCConnector[proto.LockStoreClient]
+}
+
+// InitLockStore initializes the grpc lock store passing out the metadata to the grpc component.
+func (g *grpcLockStore) InitLockStore(ctx context.Context, metadata lock.Metadata) error {
+	if err := g.Dial(metadata.Name); err != nil {
+		return err
+	}
+
+	protoMetadata := &proto.MetadataRequest{
+		Properties: metadata.Properties,
+	}
+
+	_, err := g.Client.Init(g.Context, &proto.LockStoreInitRequest{
+		Metadata: protoMetadata,
+	})
+	return err
+}
+
+// TryLock tries to acquire a lock.
+func (g *grpcLockStore) TryLock(ctx context.Context, req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
+	resp, err := g.Client.TryLock(ctx, &proto.TryLockRequest{
+		ResourceId:      req.ResourceID,
+		LockOwner:       req.LockOwner,
+		ExpiryInSeconds: req.ExpiryInSeconds,
+		Metadata:        req.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &lock.TryLockResponse{
+		Success:  resp.GetSuccess(),
+		Metadata: resp.GetMetadata(),
+	}, nil
+}
+
+// Unlock tries to release a lock.
+func (g *grpcLockStore) Unlock(ctx context.Context, req *lock.UnlockRequest) (*lock.UnlockResponse, error) {
+	resp, err := g.Client.Unlock(ctx, &proto.UnlockRequest{
+		ResourceId: req.ResourceID,
+		LockOwner:  req.LockOwner,
+		Metadata:   req.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &lock.UnlockResponse{
+		Status:   lock.Status(resp.GetStatus()),
+		Metadata: resp.GetMetadata(),
+	}, nil
+}
+
+// fromConnector creates a new GRPC lock store using the given underlying connector.
+func fromConnector(_ logger.Logger, connector *pluggable.GRPCConnector[proto.LockStoreClient]) *grpcLockStore {
+	return &grpcLockStore{
+		GRPCConnector: connector,
+	}
+}
+
+// NewGRPCLockStore creates a new grpc lock store using the given socket factory.
+func NewGRPCLockStore(l logger.Logger, socket string) *grpcLockStore {
+	return fromConnector(l, pluggable.NewGRPCConnector(socket, proto.NewLockStoreClient))
+}
+
+// newGRPCLockStore creates a new grpc lock store for the given pluggable component.
+func newGRPCLockStore(dialer pluggable.GRPCConnectionDialer) func(l logger.Logger) lock.Store {
+	return func(l logger.Logger) lock.Store {
+		return fromConnector(l, pluggable.NewGRPCConnectorWithDialer(dialer, proto.NewLockStoreClient))
+	}
+}
+
+func init() {
+	//nolint:nosnakecase
+	pluggable.AddServiceDiscoveryCallback(proto.LockStore_ServiceDesc.ServiceName, func(name string, dialer pluggable.GRPCConnectionDialer) {
+		DefaultRegistry.RegisterComponent(newGRPCLockStore(dialer), name)
+	})
+}
@@ -0,0 +1,201 @@
+//go:build !windows
+// +build !windows
+
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	guuid "github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/dapr/components-contrib/lock"
+	contribMetadata "github.com/dapr/components-contrib/metadata"
+	"github.com/dapr/dapr/pkg/components/pluggable"
+	proto "github.com/dapr/dapr/pkg/proto/components/v1"
+	testingGrpc "github.com/dapr/dapr/pkg/testing/grpc"
+	"github.com/dapr/kit/logger"
+)
+
+var testLogger = logger.NewLogger("lock-pluggable-logger")
+
+type server struct {
+	proto.UnimplementedLockStoreServer
+	initCalled    atomic.Int64
+	onInitCalled  func(*proto.LockStoreInitRequest)
+	initErr       error
+	tryLockCalled atomic.Int64
+	onTryLock     func(*proto.TryLockRequest)
+	tryLockResp   *proto.TryLockResponse
+	tryLockErr    error
+	unlockCalled  atomic.Int64
+	onUnlock      func(*proto.UnlockRequest)
+	unlockResp    *proto.UnlockResponse
+	unlockErr     error
+	pingCalled    atomic.Int64
+	pingErr       error
+}
+
+func (s *server) Init(ctx context.Context, req *proto.LockStoreInitRequest) (*proto.LockStoreInitResponse, error) {
+	s.initCalled.Add(1)
+	if s.onInitCalled != nil {
+		s.onInitCalled(req)
+	}
+	return &proto.LockStoreInitResponse{}, s.initErr
+}
+
+func (s *server) TryLock(ctx context.Context, req *proto.TryLockRequest) (*proto.TryLockResponse, error) {
+	s.tryLockCalled.Add(1)
+	if s.onTryLock != nil {
+		s.onTryLock(req)
+	}
+	return s.tryLockResp, s.tryLockErr
+}
+
+func (s *server) Unlock(ctx context.Context, req *proto.UnlockRequest) (*proto.UnlockResponse, error) {
+	s.unlockCalled.Add(1)
+	if s.onUnlock != nil {
+		s.onUnlock(req)
+	}
+	return s.unlockResp, s.unlockErr
+}
+
+func (s *server) Ping(ctx context.Context, req *proto.PingRequest) (*proto.PingResponse, error) {
+	s.pingCalled.Add(1)
+	return &proto.PingResponse{}, s.pingErr
+}
+
+func TestComponentCalls(t *testing.T) {
+	getLockStore := testingGrpc.TestServerFor(testLogger, func(s *grpc.Server, svc *server) {
+		proto.RegisterLockStoreServer(s, svc)
+	}, func(cci grpc.ClientConnInterface) *grpcLockStore {
+		client := proto.NewLockStoreClient(cci)
+		lockStore := fromConnector(testLogger, pluggable.NewGRPCConnector("/tmp/socket.sock", proto.NewLockStoreClient))
+		lockStore.Client = client
+		return lockStore
+	})
+
+	t.Run("init should call grpc init", func(t *testing.T) {
+		const fakeSocketFolder = "/tmp"
+
+		uniqueID := guuid.New().String()
+		socket := fmt.Sprintf("%s/%s.sock", fakeSocketFolder, uniqueID)
+		defer os.Remove(socket)
+
+		connector := pluggable.NewGRPCConnector(socket, proto.NewLockStoreClient)
+		defer connector.Close()
+
+		listener, err := net.Listen("unix", socket)
+		require.NoError(t, err)
+		defer listener.Close()
+		s := grpc.NewServer()
+		srv := &server{
+			onInitCalled: func(req *proto.LockStoreInitRequest) {
+				assert.Equal(t, "bar", req.GetMetadata().GetProperties()["foo"])
+			},
+		}
+		proto.RegisterLockStoreServer(s, srv)
+		go func() {
+			if serveErr := s.Serve(listener); serveErr != nil {
+				testLogger.Debugf("failed to serve: %v", serveErr)
+			}
+		}()
+
+		lockStore := fromConnector(testLogger, connector)
+		err = lockStore.InitLockStore(t.Context(), lock.Metadata{
+			Base: contribMetadata.Base{Properties: map[string]string{"foo": "bar"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), srv.initCalled.Load())
+	})
+
+	t.Run("trylock should call grpc trylock", func(t *testing.T) {
+		svc := &server{
+			onTryLock: func(req *proto.TryLockRequest) {
+				assert.Equal(t, "resource", req.GetResourceId())
+				assert.Equal(t, "owner", req.GetLockOwner())
+				assert.Equal(t, int32(10), req.GetExpiryInSeconds())
+			},
+			tryLockResp: &proto.TryLockResponse{Success: true},
+		}
+		lockStore, cleanup, err := getLockStore(svc)
+		require.NoError(t, err)
+		defer cleanup()
+
+		resp, err := lockStore.TryLock(t.Context(), &lock.TryLockRequest{
+			ResourceID:      "resource",
+			LockOwner:       "owner",
+			ExpiryInSeconds: 10,
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.Success)
+		assert.Equal(t, int64(1), svc.tryLockCalled.Load())
+	})
+
+	t.Run("trylock should return an err when grpc returns an error", func(t *testing.T) {
+		svc := &server{
+			tryLockErr: errors.New("fake-trylock-err"),
+		}
+		lockStore, cleanup, err := getLockStore(svc)
+		require.NoError(t, err)
+		defer cleanup()
+
+		_, err = lockStore.TryLock(t.Context(), &lock.TryLockRequest{})
+		require.Error(t, err)
+		assert.Equal(t, int64(1), svc.tryLockCalled.Load())
+	})
+
+	t.Run("unlock should call grpc unlock and convert the status", func(t *testing.T) {
+		svc := &server{
+			onUnlock: func(req *proto.UnlockRequest) {
+				assert.Equal(t, "resource", req.GetResourceId())
+				assert.Equal(t, "owner", req.GetLockOwner())
+			},
+			unlockResp: &proto.UnlockResponse{Status: proto.UnlockResponse_LOCK_BELONGS_TO_OTHERS},
+		}
+		lockStore, cleanup, err := getLockStore(svc)
+		require.NoError(t, err)
+		defer cleanup()
+
+		resp, err := lockStore.Unlock(t.Context(), &lock.UnlockRequest{
+			ResourceID: "resource",
+			LockOwner:  "owner",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, lock.LockBelongsToOthers, resp.Status)
+		assert.Equal(t, int64(1), svc.unlockCalled.Load())
+	})
+
+	t.Run("ping should return an err when grpc returns an error", func(t *testing.T) {
+		svc := &server{
+			pingErr: errors.New("fake-ping-err"),
+		}
+		lockStore, cleanup, err := getLockStore(svc)
+		require.NoError(t, err)
+		defer cleanup()
+
+		require.Error(t, lockStore.Ping())
+		assert.Equal(t, int64(1), svc.pingCalled.Load())
+	})
+}
@@ -134,6 +134,22 @@ type ConfigurationSpec struct {
 	LoggingSpec         *LoggingSpec        `json:"logging,omitempty"         yaml:"logging,omitempty"`
 	WasmSpec            *WasmSpec           `json:"wasm,omitempty"            yaml:"wasm,omitempty"`
 	WorkflowSpec        *WorkflowSpec       `json:"workflow,omitempty"        yaml:"workflow,omitempty"`
+	ConversationSpec    *ConversationSpec   `json:"conversation,omitempty"    yaml:"conversation,omitempty"`
+}
+
+// ConversationSpec defines the configuration for the conversation API.
+type ConversationSpec struct {
+	// SidecarTokenBudget limits the number of tokens each sidecar of the app can spend through the conversation API.
+	// The budget is not shared between replicas.
+	SidecarTokenBudget *ConversationSidecarTokenBudget `json:"sidecarTokenBudget,omitempty" yaml:"sidecarTokenBudget,omitempty"`
+}
+
+// ConversationSidecarTokenBudget is the maximum number of tokens a sidecar can spend in a time window.
+type ConversationSidecarTokenBudget struct {
+	// MaxTokens is the maximum number of prompt and completion tokens spent per window.
+	MaxTokens int64 `json:"maxTokens" yaml:"maxTokens"`
+	// Window is the duration of the window, such as "1h". The budget is reset at the start of each window.
+	Window string `json:"window" yaml:"window"`
 }
 
 // WorkflowSpec defines the configuration for Dapr workflows.
@@ -530,6 +546,11 @@ func LoadStandaloneConfiguration(configs ...string) (*Configuration, error) {
 		return nil, err
 	}
 
+	err = conf.validateConversationSpec()
+	if err != nil {
+		return nil, err
+	}
+
 	conf.sortMetricsSpec()
 	conf.SetDefaultFeatures()
 	return conf, nil
@@ -549,8 +570,14 @@ func LoadKubernetesConfiguration(config string, namespace string, podName string
 	if len(b) == 0 {
 		return nil, fmt.Errorf("configuration %s not found", config)
 	}
+	return ParseKubernetesConfiguration(b)
+}
+
+// ParseKubernetesConfiguration parses a Kubernetes Configuration resource,
+// encoded as JSON, on top of the default configuration.
+func ParseKubernetesConfiguration(b []byte) (*Configuration, error) {
 	conf := LoadDefaultConfiguration()
-	err = json.Unmarshal(b, conf)
+	err := json.Unmarshal(b, conf)
 	if err != nil {
 		return nil, err
 	}
@@ -560,6 +587,11 @@ func LoadKubernetesConfiguration(config string, namespace string, podName string
 		return nil, err
 	}
 
+	err = conf.validateConversationSpec()
+	if err != nil {
+		return nil, err
+	}
+
 	conf.sortMetricsSpec()
 	conf.SetDefaultFeatures()
 	return conf, nil
@@ -784,6 +816,21 @@ func (c *Configuration) GetWorkflowSpec() WorkflowSpec {
 	return *c.Spec.WorkflowSpec
 }
 
+// GetWindow returns the duration of the window of the budget.
+func (b ConversationSidecarTokenBudget) GetWindow() time.Duration {
+	window, _ := time.ParseDuration(b.Window)
+	return window
+}
+
+// GetConversationSpec returns the Conversation spec.
+// It's a short-hand that includes nil-checks for safety.
+func (c *Configuration) GetConversationSpec() ConversationSpec {
+	if c == nil || c.Spec.ConversationSpec == nil {
+		return ConversationSpec{}
+	}
+	return *c.Spec.ConversationSpec
+}
+
 // ToYAML returns the Configuration represented as YAML.
 func (c *Configuration) ToYAML() (string, error) {
 	b, err := yaml.Marshal(c)
@@ -830,6 +877,24 @@ func (c *Configuration) sortMetricsSpec() {
 }
 
 // Validate the secrets configuration and sort to the allowed and denied lists if present.
+func (c *Configuration) validateConversationSpec() error {
+	budget := c.GetConversationSpec().SidecarTokenBudget
+	if budget == nil {
+		return nil
+	}
+	if budget.MaxTokens <= 0 {
+		return errors.New("conversation sidecar token budget: maxTokens must be greater than 0")
+	}
+	window, err := time.ParseDuration(budget.Window)
+	if err != nil {
+		return fmt.Errorf("conversation sidecar token budget: invalid window %q: %w", budget.Window, err)
+	}
+	if window <= 0 {
+		return fmt.Errorf("conversation sidecar token budget: window %q must be greater than 0", budget.Window)
+	}
+	return nil
+}
+
 func (c *Configuration) sortAndValidateSecretsConfiguration() error {
 	if c.Spec.Secrets == nil {
 		return nil
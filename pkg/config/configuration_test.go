@@ -373,6 +373,52 @@ func TestSortAndValidateSecretsConfigration(t *testing.T) {
 	}
 }
 
+func TestValidateConversationSpec(t *testing.T) {
+	testCases := []struct {
+		name          string
+		budget        *ConversationSidecarTokenBudget
+		errorExpected bool
+	}{
+		{
+			name: "no budget",
+		},
+		{
+			name:   "valid budget",
+			budget: &ConversationSidecarTokenBudget{MaxTokens: 1000, Window: "1h"},
+		},
+		{
+			name:          "missing max tokens",
+			budget:        &ConversationSidecarTokenBudget{Window: "1h"},
+			errorExpected: true,
+		},
+		{
+			name:          "invalid window",
+			budget:        &ConversationSidecarTokenBudget{MaxTokens: 1000, Window: "hourly"},
+			errorExpected: true,
+		},
+		{
+			name:          "negative window",
+			budget:        &ConversationSidecarTokenBudget{MaxTokens: 1000, Window: "-1h"},
+			errorExpected: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := Configuration{
+				Spec: ConfigurationSpec{
+					ConversationSpec: &ConversationSpec{SidecarTokenBudget: tc.budget},
+				},
+			}
+			err := config.validateConversationSpec()
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestIsSecretAllowed(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -0,0 +1,46 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"sync/atomic"
+)
+
+// Reloadable holds a section of the Configuration which may be swapped while
+// daprd is running, for example by hot reloading. Readers always observe
+// either the previous or the new value, never a partial update.
+// A nil Reloadable is valid and always holds nil.
+type Reloadable[T any] struct {
+	v atomic.Pointer[T]
+}
+
+// NewReloadable returns a new Reloadable holding the given value.
+func NewReloadable[T any](v *T) *Reloadable[T] {
+	var r Reloadable[T]
+	r.v.Store(v)
+	return &r
+}
+
+// Load returns the current value.
+func (r *Reloadable[T]) Load() *T {
+	if r == nil {
+		return nil
+	}
+	return r.v.Load()
+}
+
+// Store atomically replaces the current value.
+func (r *Reloadable[T]) Store(v *T) {
+	r.v.Store(v)
+}
@@ -60,6 +60,8 @@ type componentMetrics struct {
 
 	conversationCount   *stats.Int64Measure
 	conversationLatency *stats.Float64Measure
+	conversationTokens  *stats.Int64Measure
+	conversationCache   *stats.Int64Measure
 
 	cryptoCount   *stats.Int64Measure
 	cryptoLatency *stats.Float64Measure
@@ -161,6 +163,14 @@ func newComponentMetrics() *componentMetrics {
 			"component/conversation/latencies",
 			"The latency of the response from the conversation component.",
 			stats.UnitMilliseconds),
+		conversationTokens: stats.Int64(
+			"component/conversation/tokens",
+			"The number of prompt and completion tokens spent on the conversation component.",
+			stats.UnitDimensionless),
+		conversationCache: stats.Int64(
+			"component/conversation/cache/count",
+			"The number of lookups in the response cache of the conversation component.",
+			stats.UnitDimensionless),
 		cryptoCount: stats.Int64(
 			"component/crypto/count",
 			"The number of operations performed on the crypto component.",
@@ -201,6 +211,8 @@ func (c *componentMetrics) Init(meter view.Meter, appID, namespace string, laten
 		diagUtils.NewMeasureView(c.secretCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
 		diagUtils.NewMeasureView(c.conversationLatency, []tag.Key{appIDKey, componentKey, namespaceKey, successKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.conversationCount, []tag.Key{appIDKey, componentKey, namespaceKey, successKey}, view.Count()),
+		diagUtils.NewMeasureView(c.conversationTokens, []tag.Key{appIDKey, componentKey, namespaceKey, typeKey}, view.Sum()),
+		diagUtils.NewMeasureView(c.conversationCache, []tag.Key{appIDKey, componentKey, namespaceKey, statusKey}, view.Count()),
 		diagUtils.NewMeasureView(c.cryptoLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, latencyDistribution),
 		diagUtils.NewMeasureView(c.cryptoCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
 	)
@@ -399,6 +411,39 @@ func (c *componentMetrics) ConversationInvoked(ctx context.Context, component st
 	}
 }
 
+// ConversationTokensUsed records the number of prompt and completion tokens
+// spent on a conversation component.
+func (c *componentMetrics) ConversationTokensUsed(ctx context.Context, component string, promptTokens, completionTokens int64) {
+	if c.enabled {
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.conversationTokens.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, typeKey, "prompt")...),
+			stats.WithMeasurements(c.conversationTokens.M(promptTokens)))
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.conversationTokens.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, typeKey, "completion")...),
+			stats.WithMeasurements(c.conversationTokens.M(completionTokens)))
+	}
+}
+
+// ConversationCacheLookup records a lookup in the response cache of a
+// conversation component, with a status of "hit" or "miss".
+func (c *componentMetrics) ConversationCacheLookup(ctx context.Context, component string, hit bool) {
+	if c.enabled {
+		status := "miss"
+		if hit {
+			status = "hit"
+		}
+		stats.RecordWithOptions(
+			ctx,
+			stats.WithRecorder(c.meter),
+			stats.WithTags(diagUtils.WithTags(c.conversationCache.Name(), appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, statusKey, status)...),
+			stats.WithMeasurements(c.conversationCache.M(1)))
+	}
+}
+
 // SecretInvoked records the metrics for a secret event.
 func (c *componentMetrics) SecretInvoked(ctx context.Context, component, operation string, success bool, elapsed float64) {
 	if c.enabled {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opencensus.io/stats/view"
 
 	"github.com/dapr/dapr/pkg/config"
@@ -270,6 +271,51 @@ func TestConversation(t *testing.T) {
 		allTagsPresent(t, v, viewData[0].Tags)
 		assert.InEpsilon(t, 1, viewData[0].Data.(*view.DistributionData).Min, 0)
 	})
+
+	t.Run("record conversation tokens", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() {
+			meter.Stop()
+		})
+		c.ConversationTokensUsed(t.Context(), componentName, 10, 5)
+		c.ConversationTokensUsed(t.Context(), componentName, 20, 5)
+		viewData, _ := meter.RetrieveData("component/conversation/tokens")
+		v := meter.Find("component/conversation/tokens")
+		require.Len(t, viewData, 2)
+		tokens := map[string]float64{}
+		for _, row := range viewData {
+			allTagsPresent(t, v, row.Tags)
+			for _, tag := range row.Tags {
+				if tag.Key == typeKey {
+					tokens[tag.Value] = row.Data.(*view.SumData).Value
+				}
+			}
+		}
+		assert.Equal(t, map[string]float64{"prompt": 30, "completion": 10}, tokens)
+	})
+
+	t.Run("record conversation cache lookups", func(t *testing.T) {
+		c, meter := componentsMetrics()
+		t.Cleanup(func() {
+			meter.Stop()
+		})
+		c.ConversationCacheLookup(t.Context(), componentName, true)
+		c.ConversationCacheLookup(t.Context(), componentName, false)
+		c.ConversationCacheLookup(t.Context(), componentName, true)
+		viewData, _ := meter.RetrieveData("component/conversation/cache/count")
+		v := meter.Find("component/conversation/cache/count")
+		require.Len(t, viewData, 2)
+		lookups := map[string]int64{}
+		for _, row := range viewData {
+			allTagsPresent(t, v, row.Tags)
+			for _, tag := range row.Tags {
+				if tag.Key == statusKey {
+					lookups[tag.Value] = row.Data.(*view.CountData).Value
+				}
+			}
+		}
+		assert.Equal(t, map[string]int64{"hit": 2, "miss": 1}, lookups)
+	})
 }
 
 func TestComponentMetricsInit(t *testing.T) {
@@ -15,6 +15,17 @@ var (
 	CircuitBreakerPolicy PolicyType = "circuitbreaker"
 	RetryPolicy          PolicyType = "retry"
 	TimeoutPolicy        PolicyType = "timeout"
+	RateLimitPolicy      PolicyType = "ratelimit"
+	BulkheadPolicy       PolicyType = "bulkhead"
+	HedgingPolicy        PolicyType = "hedging"
+	FallbackPolicy       PolicyType = "fallback"
+
+	// PolicyStatusDelayed is the status of a rate limit or bulkhead activation
+	// where the request had to wait before being admitted.
+	PolicyStatusDelayed = "delayed"
+	// PolicyStatusRejected is the status of a rate limit or bulkhead activation
+	// where the request was rejected.
+	PolicyStatusRejected = "rejected"
 
 	OutboundPolicyFlowDirection PolicyFlowDirection = "outbound"
 	InboundPolicyFlowDirection  PolicyFlowDirection = "inbound"
@@ -632,3 +632,55 @@ func newTestResiliencyConfig(resiliencyName, resiliencyNamespace, appName, actor
 		},
 	}
 }
+
+func TestResiliencyRateLimitAndBulkheadMonitoring(t *testing.T) {
+	meter := view.NewMeter()
+	meter.Start()
+	t.Cleanup(func() {
+		meter.Stop()
+	})
+	require.NoError(t, diag.DefaultResiliencyMonitoring.Init(meter, testAppID))
+
+	r := resiliency.FromConfigurations(logger.NewLogger("fake-logger"), &resiliencyV1alpha.Resiliency{
+		ObjectMeta: metav1.ObjectMeta{Name: testResiliencyName, Namespace: testResiliencyNamespace},
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				RateLimits: map[string]resiliencyV1alpha.RateLimit{
+					"onePerHour": {Rate: 1, Period: "1h"},
+				},
+				Bulkheads: map[string]resiliencyV1alpha.Bulkhead{
+					"single": {MaxConcurrency: 1},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"fakeApp": {RateLimit: "onePerHour", Bulkhead: "single"},
+				},
+			},
+		},
+	})
+
+	for range 3 {
+		policyRunner := resiliency.NewRunner[any](t.Context(), r.EndpointPolicy("fakeApp", "fakeEndpoint"))
+		_, _ = policyRunner(func(ctx context.Context) (interface{}, error) {
+			return nil, nil
+		})
+	}
+
+	rows, err := meter.RetrieveData(resiliencyCountViewName)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), diag.GetCountValueForObservationWithTagSet(
+		rows, map[tag.Tag]bool{diag.NewTag(diag.PolicyKey.Name(), string(diag.RateLimitPolicy)): true}))
+	require.Equal(t, int64(3), diag.GetCountValueForObservationWithTagSet(
+		rows, map[tag.Tag]bool{diag.NewTag(diag.PolicyKey.Name(), string(diag.BulkheadPolicy)): true}))
+
+	rows, err = meter.RetrieveData(resiliencyActivationViewName)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	diag.RequireTagExist(t, rows, diag.NewTag(diag.TargetKey.Name(), diag.ResiliencyAppTarget("fakeApp")))
+	require.Equal(t, int64(2), diag.GetCountValueForObservationWithTagSet(
+		rows, map[tag.Tag]bool{
+			diag.NewTag(diag.PolicyKey.Name(), string(diag.RateLimitPolicy)): true,
+			diag.NewTag(diag.StatusKey.Name(), diag.PolicyStatusRejected):    true,
+		}))
+}
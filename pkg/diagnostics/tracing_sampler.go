@@ -1,12 +1,36 @@
 package diagnostics
 
 import (
+	"sync/atomic"
+
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
 )
 
-func NewDaprTraceSampler(samplingRateString string) sdktrace.Sampler {
+// DaprTraceSampler is a parent based, trace ID ratio sampler whose sampling
+// rate can be updated while the tracer provider is in use.
+type DaprTraceSampler struct {
+	sampler atomic.Pointer[sdktrace.Sampler]
+}
+
+func NewDaprTraceSampler(samplingRateString string) *DaprTraceSampler {
+	var d DaprTraceSampler
+	d.SetSamplingRate(samplingRateString)
+	return &d
+}
+
+// SetSamplingRate atomically replaces the sampling rate used for new traces.
+func (d *DaprTraceSampler) SetSamplingRate(samplingRateString string) {
 	samplingRate := diagUtils.GetTraceSamplingRate(samplingRateString)
-	return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplingRate))
+	sampler := sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplingRate))
+	d.sampler.Store(&sampler)
+}
+
+func (d *DaprTraceSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	return (*d.sampler.Load()).ShouldSample(p)
+}
+
+func (d *DaprTraceSampler) Description() string {
+	return (*d.sampler.Load()).Description()
 }
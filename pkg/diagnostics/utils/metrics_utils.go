@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"sync/atomic"
 
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
@@ -25,7 +26,9 @@ import (
 	"github.com/dapr/dapr/pkg/config"
 )
 
-var metricsRules map[string][]regexPair
+// metricsRules is swapped atomically so rules can be updated while metrics
+// are being recorded.
+var metricsRules atomic.Pointer[map[string][]regexPair]
 
 var StaticPaths = map[string]bool{
 	"/dapr/config":    true,
@@ -81,8 +84,8 @@ func WithTags(name string, opts ...interface{}) []tag.Mutator {
 			continue
 		}
 
-		if len(metricsRules) > 0 {
-			pairs := metricsRules[strings.ReplaceAll(name, "_", "/")+key.Name()]
+		if rules := metricsRules.Load(); rules != nil && len(*rules) > 0 {
+			pairs := (*rules)[strings.ReplaceAll(name, "_", "/")+key.Name()]
 
 			for _, p := range pairs {
 				value = p.regex.ReplaceAllString(value, p.replace)
@@ -103,7 +106,8 @@ func AddNewTagKey(views []*view.View, key *tag.Key) []*view.View {
 	return views
 }
 
-// CreateRulesMap generates a fast lookup map for metrics regex.
+// CreateRulesMap generates a fast lookup map for metrics regex, replacing any
+// rules which were previously created.
 func CreateRulesMap(rules []config.MetricsRule) error {
 	newMetricsRules := make(map[string][]regexPair, len(rules))
 
@@ -132,6 +136,6 @@ func CreateRulesMap(rules []config.MetricsRule) error {
 		}
 	}
 
-	metricsRules = newMetricsRules
+	metricsRules.Store(&newMetricsRules)
 	return nil
 }
@@ -95,10 +95,11 @@ func TestCreateRulesMap(t *testing.T) {
 		})
 
 		require.NoError(t, err)
-		assert.NotNil(t, metricsRules)
-		assert.Len(t, metricsRules, 1)
-		assert.Len(t, metricsRules["testlabel"], 1)
-		assert.Equal(t, "TEST", metricsRules["testlabel"][0].replace)
-		assert.NotNil(t, metricsRules["testlabel"][0].regex)
+		rules := metricsRules.Load()
+		require.NotNil(t, rules)
+		assert.Len(t, *rules, 1)
+		assert.Len(t, (*rules)["testlabel"], 1)
+		assert.Equal(t, "TEST", (*rules)["testlabel"][0].replace)
+		assert.NotNil(t, (*rules)["testlabel"][0].regex)
 	})
 }
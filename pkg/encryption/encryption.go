@@ -24,6 +24,9 @@ import (
 	"fmt"
 	"io"
 
+	"golang.org/x/crypto/chacha20poly1305"
+
+	contribCrypto "github.com/dapr/components-contrib/crypto"
 	"github.com/dapr/components-contrib/secretstores"
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
@@ -32,12 +35,23 @@ import (
 type Algorithm string
 
 const (
-	primaryEncryptionKey   = "primaryEncryptionKey"
-	secondaryEncryptionKey = "secondaryEncryptionKey"
-	errPrefix              = "failed to extract encryption key"
-	AESGCMAlgorithm        = "AES-GCM"
+	primaryEncryptionKey       = "primaryEncryptionKey"
+	secondaryEncryptionKey     = "secondaryEncryptionKey"
+	encryptionAlgorithm        = "encryptionAlgorithm"
+	encryptionKeyProvider      = "encryptionKeyProvider"
+	encryptionKeyWrapName      = "encryptionKeyWrapName"
+	encryptionKeyWrapAlgorithm = "encryptionKeyWrapAlgorithm"
+	errPrefix                  = "failed to extract encryption key"
+
+	AESGCMAlgorithm           Algorithm = "AES-GCM"
+	ChaCha20Poly1305Algorithm Algorithm = "ChaCha20-Poly1305"
 )
 
+// CryptoProviders returns the crypto components used to unwrap data keys.
+type CryptoProviders interface {
+	GetCryptoProvider(name string) (contribCrypto.SubtleCrypto, bool)
+}
+
 // ComponentEncryptionKeys holds the encryption keys set for a component.
 type ComponentEncryptionKeys struct {
 	Primary   Key
@@ -49,10 +63,76 @@ type Key struct {
 	Key       string
 	Name      string
 	cipherObj cipher.AEAD
+	algorithm Algorithm
+	// versioned is true if values encrypted with this key are stored with a
+	// versioned header. Values encrypted with a raw AES-GCM key are stored in
+	// the original format so they remain readable by older versions of Dapr.
+	versioned bool
+}
+
+// cipherAlgorithm returns the algorithm values are encrypted with.
+func (k Key) cipherAlgorithm() Algorithm {
+	if k.algorithm == "" {
+		return AESGCMAlgorithm
+	}
+	return k.algorithm
+}
+
+// keyOptions holds the component metadata which controls how the encryption
+// keys are used.
+type keyOptions struct {
+	algorithm     Algorithm
+	provider      string
+	wrapName      string
+	wrapAlgorithm string
+}
+
+// EncryptionKeyProvider returns the name of the crypto component which wraps the data keys of the component, if any.
+func EncryptionKeyProvider(component v1alpha1.Component) string {
+	for _, m := range component.Spec.Metadata {
+		if m.Name == encryptionKeyProvider {
+			return m.Value.String()
+		}
+	}
+	return ""
+}
+
+func componentKeyOptions(component v1alpha1.Component) (keyOptions, error) {
+	opts := keyOptions{
+		algorithm: AESGCMAlgorithm,
+	}
+
+	for _, m := range component.Spec.Metadata {
+		switch m.Name {
+		case encryptionAlgorithm:
+			if v := m.Value.String(); v != "" {
+				opts.algorithm = Algorithm(v)
+			}
+		case encryptionKeyProvider:
+			opts.provider = m.Value.String()
+		case encryptionKeyWrapName:
+			opts.wrapName = m.Value.String()
+		case encryptionKeyWrapAlgorithm:
+			opts.wrapAlgorithm = m.Value.String()
+		}
+	}
+
+	switch opts.algorithm {
+	case AESGCMAlgorithm, ChaCha20Poly1305Algorithm:
+	default:
+		return keyOptions{}, fmt.Errorf("unsupported encryption algorithm %q", opts.algorithm)
+	}
+
+	if opts.provider != "" && (opts.wrapName == "" || opts.wrapAlgorithm == "") {
+		return keyOptions{}, fmt.Errorf("%s and %s are required when %s is set", encryptionKeyWrapName, encryptionKeyWrapAlgorithm, encryptionKeyProvider)
+	}
+
+	return opts, nil
 }
 
 // ComponentEncryptionKey checks if a component definition contains an encryption key and extracts it using the supplied secret store.
-func ComponentEncryptionKey(component v1alpha1.Component, secretStore secretstores.SecretStore) (ComponentEncryptionKeys, error) {
+// If the component declares an encryption key provider, the keys are data keys wrapped by that crypto component, and are unwrapped using it.
+func ComponentEncryptionKey(ctx context.Context, component v1alpha1.Component, secretStore secretstores.SecretStore, cryptoProviders CryptoProviders) (ComponentEncryptionKeys, error) {
 	if secretStore == nil {
 		return ComponentEncryptionKeys{}, nil
 	}
@@ -92,7 +172,7 @@ func ComponentEncryptionKey(component v1alpha1.Component, secretStore secretstor
 			continue
 		}
 
-		key, err := tryGetEncryptionKeyFromMetadataItem(component.Namespace, m, secretStore)
+		key, err := tryGetEncryptionKeyFromMetadataItem(ctx, component.Namespace, m, secretStore)
 		if err != nil {
 			return ComponentEncryptionKeys{}, fmt.Errorf("%s: %w", errPrefix, err)
 		}
@@ -104,33 +184,91 @@ func ComponentEncryptionKey(component v1alpha1.Component, secretStore secretstor
 		}
 	}
 
-	if cek.Primary.Key != "" {
-		cipherObj, err := createCipher(cek.Primary, AESGCMAlgorithm)
-		if err != nil {
-			return ComponentEncryptionKeys{}, err
+	if cek.Primary.Key == "" && cek.Secondary.Key == "" {
+		return cek, nil
+	}
+
+	opts, err := componentKeyOptions(component)
+	if err != nil {
+		return ComponentEncryptionKeys{}, fmt.Errorf("%s: %w", errPrefix, err)
+	}
+
+	for _, key := range []*Key{&cek.Primary, &cek.Secondary} {
+		if key.Key == "" {
+			continue
 		}
 
-		cek.Primary.cipherObj = cipherObj
+		if err := initKey(ctx, key, opts, cryptoProviders); err != nil {
+			return ComponentEncryptionKeys{}, err
+		}
 	}
-	if cek.Secondary.Key != "" {
-		cipherObj, err := createCipher(cek.Secondary, AESGCMAlgorithm)
+
+	return cek, nil
+}
+
+// initKey unwraps the key if it is wrapped by a crypto component, and creates
+// the cipher used to encrypt values with it.
+func initKey(ctx context.Context, key *Key, opts keyOptions, cryptoProviders CryptoProviders) error {
+	if opts.provider != "" {
+		dataKey, err := unwrapDataKey(ctx, key.Key, opts, cryptoProviders)
 		if err != nil {
-			return ComponentEncryptionKeys{}, err
+			return fmt.Errorf("%s: failed to unwrap data key %s: %w", errPrefix, key.Name, err)
 		}
 
-		cek.Secondary.cipherObj = cipherObj
+		key.Key = hex.EncodeToString(dataKey)
+		key.versioned = true
 	}
 
-	return cek, nil
+	cipherObj, err := createCipher(*key, opts.algorithm)
+	if err != nil {
+		return err
+	}
+
+	key.cipherObj = cipherObj
+	key.algorithm = opts.algorithm
+	if opts.algorithm != AESGCMAlgorithm {
+		key.versioned = true
+	}
+
+	return nil
+}
+
+// unwrapDataKey unwraps a base64 encoded data key using the key encryption
+// key held by the crypto component.
+func unwrapDataKey(ctx context.Context, wrappedKey string, opts keyOptions, cryptoProviders CryptoProviders) ([]byte, error) {
+	if cryptoProviders == nil {
+		return nil, fmt.Errorf("crypto provider %s not found", opts.provider)
+	}
+
+	provider, ok := cryptoProviders.GetCryptoProvider(opts.provider)
+	if !ok {
+		return nil, fmt.Errorf("crypto provider %s not found", opts.provider)
+	}
+
+	wrapped, err := b64.StdEncoding.DecodeString(wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("wrapped data key is not base64 encoded: %w", err)
+	}
+
+	unwrapped, err := provider.UnwrapKey(ctx, wrapped, opts.wrapAlgorithm, opts.wrapName, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var dataKey []byte
+	if unwrapped == nil || unwrapped.Raw(&dataKey) != nil {
+		return nil, errors.New("unwrapped data key is not a symmetric key")
+	}
+
+	return dataKey, nil
 }
 
-func tryGetEncryptionKeyFromMetadataItem(namespace string, item commonapi.NameValuePair, secretStore secretstores.SecretStore) (Key, error) {
+func tryGetEncryptionKeyFromMetadataItem(ctx context.Context, namespace string, item commonapi.NameValuePair, secretStore secretstores.SecretStore) (Key, error) {
 	if item.SecretKeyRef.Name == "" {
 		return Key{}, fmt.Errorf("%s: secretKeyRef cannot be empty", errPrefix)
 	}
 
-	// TODO: cascade context.
-	r, err := secretStore.GetSecret(context.TODO(), secretstores.GetSecretRequest{
+	r, err := secretStore.GetSecret(ctx, secretstores.GetSecretRequest{
 		Name: item.SecretKeyRef.Name,
 		Metadata: map[string]string{
 			"namespace": namespace,
@@ -169,17 +307,34 @@ func encrypt(value []byte, key Key) ([]byte, error) {
 	return key.cipherObj.Seal(nsize, nsize, value, nil), nil
 }
 
-// Decrypt takes a byte array and decrypts it using a supplied encryption key.
-func decrypt(value []byte, key Key) ([]byte, error) {
+// Decrypt takes a byte array and decrypts it using a supplied encryption key and the algorithm the value was encrypted with.
+func decrypt(value []byte, key Key, algorithm Algorithm) ([]byte, error) {
+	if key.cipherObj == nil {
+		return value, errors.New("encryption key not found")
+	}
+
 	enc, err := b64.StdEncoding.DecodeString(string(value))
 	if err != nil {
 		return value, err
 	}
 
-	nsize := key.cipherObj.NonceSize()
+	cipherObj := key.cipherObj
+	if algorithm != key.cipherAlgorithm() {
+		// The key was used with a different algorithm before the component
+		// was updated.
+		cipherObj, err = createCipher(key, algorithm)
+		if err != nil {
+			return value, err
+		}
+	}
+
+	nsize := cipherObj.NonceSize()
+	if len(enc) < nsize {
+		return value, errors.New("encrypted value is too short")
+	}
 	nonce, ciphertext := enc[:nsize], enc[nsize:]
 
-	return key.cipherObj.Open(nil, nonce, ciphertext, nil)
+	return cipherObj.Open(nil, nonce, ciphertext, nil)
 }
 
 func createCipher(key Key, algorithm Algorithm) (cipher.AEAD, error) {
@@ -189,13 +344,14 @@ func createCipher(key Key, algorithm Algorithm) (cipher.AEAD, error) {
 	}
 
 	switch algorithm {
-	// Other authenticated ciphers can be added if needed, e.g. golang.org/x/crypto/chacha20poly1305
 	case AESGCMAlgorithm:
 		block, err := aes.NewCipher(keyBytes)
 		if err != nil {
 			return nil, err
 		}
 		return cipher.NewGCM(block)
+	case ChaCha20Poly1305Algorithm:
+		return chacha20poly1305.New(keyBytes)
 	}
 
 	return nil, errors.New("unsupported algorithm")
@@ -16,9 +16,15 @@ package encryption
 import (
 	"context"
 	"crypto/rand"
+	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"testing"
 
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	contribCrypto "github.com/dapr/components-contrib/crypto"
 	"github.com/dapr/components-contrib/metadata"
 	"github.com/dapr/components-contrib/secretstores"
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
@@ -101,7 +107,7 @@ func TestComponentEncryptionKey(t *testing.T) {
 			},
 		}})
 
-		keys, err := ComponentEncryptionKey(component, secretStore)
+		keys, err := ComponentEncryptionKey(t.Context(), component, secretStore, nil)
 		require.NoError(t, err)
 		assert.Equal(t, primaryKey, keys.Primary.Key)
 		assert.Equal(t, secondaryKey, keys.Secondary.Key)
@@ -130,7 +136,7 @@ func TestComponentEncryptionKey(t *testing.T) {
 			},
 		}
 
-		keys, err := ComponentEncryptionKey(component, nil)
+		keys, err := ComponentEncryptionKey(t.Context(), component, nil, nil)
 		assert.Empty(t, keys.Primary.Key)
 		assert.Empty(t, keys.Secondary.Key)
 		require.NoError(t, err)
@@ -150,8 +156,131 @@ func TestComponentEncryptionKey(t *testing.T) {
 			},
 		}
 
-		_, err := ComponentEncryptionKey(component, nil)
+		_, err := ComponentEncryptionKey(t.Context(), component, nil, nil)
+		require.NoError(t, err)
+	})
+}
+
+type mockCryptoProviders map[string]contribCrypto.SubtleCrypto
+
+func (m mockCryptoProviders) GetCryptoProvider(name string) (contribCrypto.SubtleCrypto, bool) {
+	p, ok := m[name]
+	return p, ok
+}
+
+// mockKeyWrapper unwraps keys by XOR-ing them with a fixed key encryption key.
+type mockKeyWrapper struct {
+	contribCrypto.SubtleCrypto
+	kek []byte
+}
+
+func (m *mockKeyWrapper) wrap(dataKey []byte) string {
+	wrapped := make([]byte, len(dataKey))
+	for i := range dataKey {
+		wrapped[i] = dataKey[i] ^ m.kek[i%len(m.kek)]
+	}
+	return base64.StdEncoding.EncodeToString(wrapped)
+}
+
+func (m *mockKeyWrapper) UnwrapKey(_ context.Context, wrappedKey []byte, algorithm string, keyName string, _, _, _ []byte) (jwk.Key, error) {
+	if algorithm != "A256KW" || keyName != "kek" {
+		return nil, errors.New("unknown key")
+	}
+	dataKey := make([]byte, len(wrappedKey))
+	for i := range wrappedKey {
+		dataKey[i] = wrappedKey[i] ^ m.kek[i%len(m.kek)]
+	}
+	return jwk.FromRaw(dataKey)
+}
+
+func TestComponentEncryptionKeyOptions(t *testing.T) {
+	newComponent := func(primaryKey string, md ...commonapi.NameValuePair) v1alpha1.Component {
+		return v1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "statestore",
+			},
+			Spec: v1alpha1.ComponentSpec{
+				Metadata: append([]commonapi.NameValuePair{
+					{
+						Name:         primaryEncryptionKey,
+						Value:        commonapi.DynamicValue{JSON: apiextv1.JSON{Raw: []byte(primaryKey)}},
+						SecretKeyRef: commonapi.SecretKeyRef{Name: "primaryKey"},
+					},
+				}, md...),
+			},
+		}
+	}
+	item := func(name, value string) commonapi.NameValuePair {
+		return commonapi.NameValuePair{
+			Name:  name,
+			Value: commonapi.DynamicValue{JSON: apiextv1.JSON{Raw: []byte(value)}},
+		}
+	}
+
+	dataKey := make([]byte, 32)
+	rand.Read(dataKey)
+
+	t.Run("ChaCha20-Poly1305 algorithm", func(t *testing.T) {
+		keys, err := ComponentEncryptionKey(t.Context(), newComponent(hex.EncodeToString(dataKey),
+			item(encryptionAlgorithm, string(ChaCha20Poly1305Algorithm)),
+		), &mockSecretStore{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, ChaCha20Poly1305Algorithm, keys.Primary.algorithm)
+		assert.True(t, keys.Primary.versioned)
+		assert.NotNil(t, keys.Primary.cipherObj)
+	})
+
+	t.Run("default algorithm keeps original format", func(t *testing.T) {
+		keys, err := ComponentEncryptionKey(t.Context(), newComponent(hex.EncodeToString(dataKey)), &mockSecretStore{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, AESGCMAlgorithm, keys.Primary.algorithm)
+		assert.False(t, keys.Primary.versioned)
+	})
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		_, err := ComponentEncryptionKey(t.Context(), newComponent(hex.EncodeToString(dataKey),
+			item(encryptionAlgorithm, "3DES"),
+		), &mockSecretStore{}, nil)
+		require.ErrorContains(t, err, "unsupported encryption algorithm")
+	})
+
+	wrapper := &mockKeyWrapper{kek: []byte("key-encryption-key")}
+	providers := mockCryptoProviders{"mykms": wrapper}
+	wrappedComponent := newComponent(wrapper.wrap(dataKey),
+		item(encryptionKeyProvider, "mykms"),
+		item(encryptionKeyWrapName, "kek"),
+		item(encryptionKeyWrapAlgorithm, "A256KW"),
+	)
+
+	t.Run("data key wrapped by crypto provider", func(t *testing.T) {
+		assert.Equal(t, "mykms", EncryptionKeyProvider(wrappedComponent))
+
+		keys, err := ComponentEncryptionKey(t.Context(), wrappedComponent, &mockSecretStore{}, providers)
 		require.NoError(t, err)
+		assert.Equal(t, hex.EncodeToString(dataKey), keys.Primary.Key)
+		assert.Equal(t, "primaryKey", keys.Primary.Name)
+		assert.True(t, keys.Primary.versioned)
+	})
+
+	t.Run("crypto provider not found", func(t *testing.T) {
+		_, err := ComponentEncryptionKey(t.Context(), wrappedComponent, &mockSecretStore{}, mockCryptoProviders{})
+		require.ErrorContains(t, err, "crypto provider mykms not found")
+	})
+
+	t.Run("crypto provider fails to unwrap", func(t *testing.T) {
+		comp := newComponent(wrapper.wrap(dataKey),
+			item(encryptionKeyProvider, "mykms"),
+			item(encryptionKeyWrapName, "other"),
+			item(encryptionKeyWrapAlgorithm, "A256KW"),
+		)
+		_, err := ComponentEncryptionKey(t.Context(), comp, &mockSecretStore{}, providers)
+		require.ErrorContains(t, err, "failed to unwrap data key")
+	})
+
+	t.Run("wrap key name is required", func(t *testing.T) {
+		comp := newComponent(wrapper.wrap(dataKey), item(encryptionKeyProvider, "mykms"))
+		_, err := ComponentEncryptionKey(t.Context(), comp, &mockSecretStore{}, providers)
+		require.ErrorContains(t, err, "encryptionKeyWrapName and encryptionKeyWrapAlgorithm are required")
 	})
 }
 
@@ -165,7 +294,7 @@ func TestTryGetEncryptionKeyFromMetadataItem(t *testing.T) {
 			},
 		}})
 
-		_, err := tryGetEncryptionKeyFromMetadataItem("", commonapi.NameValuePair{}, secretStore)
+		_, err := tryGetEncryptionKeyFromMetadataItem(t.Context(), "", commonapi.NameValuePair{}, secretStore)
 		require.Error(t, err)
 	})
 }
@@ -236,6 +365,30 @@ func TestCreateCipher(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("valid ChaCha20-Poly1305 key", func(t *testing.T) {
+		bytes := make([]byte, 32)
+		rand.Read(bytes)
+
+		cipherObj, err := createCipher(Key{
+			Key: hex.EncodeToString(bytes),
+		}, ChaCha20Poly1305Algorithm)
+
+		assert.NotNil(t, cipherObj)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid ChaCha20-Poly1305 key size", func(t *testing.T) {
+		bytes := make([]byte, 16)
+		rand.Read(bytes)
+
+		cipherObj, err := createCipher(Key{
+			Key: hex.EncodeToString(bytes),
+		}, ChaCha20Poly1305Algorithm)
+
+		assert.Nil(t, cipherObj)
+		require.Error(t, err)
+	})
+
 	t.Run("invalid algorithm", func(t *testing.T) {
 		bytes := make([]byte, 32)
 		rand.Read(bytes)
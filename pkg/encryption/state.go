@@ -23,8 +23,56 @@ var encryptedStateStores = map[string]ComponentEncryptionKeys{}
 
 const (
 	separator = "||"
+
+	// versionHeader prefixes values stored in the versioned format:
+	// "$dapr.v2$<algorithm>$<key name>$<base64 ciphertext>".
+	// Values in the original format, "<base64 ciphertext>||<key name>", never
+	// start with "$" as it is not a base64 character.
+	versionHeader   = "$dapr.v2$"
+	headerSeparator = "$"
 )
 
+// encryptedValue is a value read from an encrypted state store.
+type encryptedValue struct {
+	algorithm  Algorithm
+	keyName    string
+	ciphertext []byte
+}
+
+// parseEncryptedValue extracts the algorithm, the name of the key and the
+// ciphertext from a value in either the original or the versioned format.
+func parseEncryptedValue(value []byte) (encryptedValue, bool) {
+	if rest, ok := bytes.CutPrefix(value, []byte(versionHeader)); ok {
+		algorithm, rest, ok := bytes.Cut(rest, []byte(headerSeparator))
+		if !ok {
+			return encryptedValue{}, false
+		}
+
+		// The key name may contain the separator, but the ciphertext cannot.
+		ind := bytes.LastIndex(rest, []byte(headerSeparator))
+		if ind < 0 {
+			return encryptedValue{}, false
+		}
+
+		return encryptedValue{
+			algorithm:  Algorithm(algorithm),
+			keyName:    string(rest[:ind]),
+			ciphertext: rest[ind+len(headerSeparator):],
+		}, true
+	}
+
+	ind := bytes.LastIndex(value, []byte(separator))
+	if ind < 0 {
+		return encryptedValue{}, false
+	}
+
+	return encryptedValue{
+		algorithm:  AESGCMAlgorithm,
+		keyName:    string(value[ind+len(separator):]),
+		ciphertext: value[:ind],
+	}, true
+}
+
 // AddEncryptedStateStore adds an encrypted state store and an associated encryption key to a list.
 func AddEncryptedStateStore(storeName string, keys ComponentEncryptionKeys) bool {
 	if _, ok := encryptedStateStores[storeName]; ok {
@@ -51,6 +99,11 @@ func TryEncryptValue(storeName string, value []byte) ([]byte, error) {
 		return value, err
 	}
 
+	if keys.Primary.versioned {
+		sEnc := versionHeader + string(keys.Primary.cipherAlgorithm()) + headerSeparator + keys.Primary.Name + headerSeparator + b64.StdEncoding.EncodeToString(enc)
+		return []byte(sEnc), nil
+	}
+
 	sEnc := b64.StdEncoding.EncodeToString(enc) + separator + keys.Primary.Name
 	return []byte(sEnc), nil
 }
@@ -63,21 +116,45 @@ func TryDecryptValue(storeName string, value []byte) ([]byte, error) {
 	}
 
 	keys := encryptedStateStores[storeName]
-	// extract the decryption key that should be appended to the value
-	ind := bytes.LastIndex(value, []byte(separator))
-	keyName := string(value[ind+len(separator):])
-
-	if len(keyName) == 0 {
+	// extract the decryption key that should be stored with the value
+	enc, ok := parseEncryptedValue(value)
+	if !ok || len(enc.keyName) == 0 {
 		return value, fmt.Errorf("could not decrypt data for state store %s: encryption key name not found on record", storeName)
 	}
 
 	var key Key
 
-	if keys.Primary.Name == keyName {
+	if keys.Primary.Name == enc.keyName {
 		key = keys.Primary
-	} else if keys.Secondary.Name == keyName {
+	} else if keys.Secondary.Name == enc.keyName {
 		key = keys.Secondary
 	}
 
-	return decrypt(value[:ind], key)
+	return decrypt(enc.ciphertext, key, enc.algorithm)
+}
+
+// TryReEncryptValue will re-encrypt a byte array which was encrypted with the secondary key of the state store using the primary key.
+// If the value was not encrypted with the secondary key, the function will return the bytes unmodified and false.
+func TryReEncryptValue(storeName string, value []byte) ([]byte, bool, error) {
+	keys, ok := encryptedStateStores[storeName]
+	if !ok || keys.Secondary.cipherObj == nil || keys.Secondary.Name == keys.Primary.Name {
+		return value, false, nil
+	}
+
+	enc, ok := parseEncryptedValue(value)
+	if !ok || enc.keyName != keys.Secondary.Name {
+		return value, false, nil
+	}
+
+	dec, err := decrypt(enc.ciphertext, keys.Secondary, enc.algorithm)
+	if err != nil {
+		return value, false, fmt.Errorf("could not decrypt data for state store %s: %w", storeName, err)
+	}
+
+	reEnc, err := TryEncryptValue(storeName, dec)
+	if err != nil {
+		return value, false, err
+	}
+
+	return reEnc, true, nil
 }
@@ -17,6 +17,7 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -245,3 +246,181 @@ func TestEncryptedStateStore(t *testing.T) {
 		assert.False(t, ok)
 	})
 }
+
+func TestTryReEncryptValue(t *testing.T) {
+	newKey := func(name string) Key {
+		b := make([]byte, 32)
+		rand.Read(b)
+
+		k := Key{
+			Name: name,
+			Key:  hex.EncodeToString(b),
+		}
+		cipherObj, _ := createCipher(k, AESGCMAlgorithm)
+		k.cipherObj = cipherObj
+		return k
+	}
+
+	oldKey := newKey("old")
+	newPrimary := newKey("new")
+
+	encryptedStateStores = map[string]ComponentEncryptionKeys{}
+	AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: oldKey})
+	v := []byte("hello")
+	encOld, err := TryEncryptValue("test", v)
+	require.NoError(t, err)
+
+	encryptedStateStores = map[string]ComponentEncryptionKeys{}
+	AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: newPrimary, Secondary: oldKey})
+
+	t.Run("value encrypted with secondary key is re-encrypted with primary key", func(t *testing.T) {
+		r, ok, err := TryReEncryptValue("test", encOld)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, strings.HasSuffix(string(r), separator+"new"))
+
+		dr, err := TryDecryptValue("test", r)
+		require.NoError(t, err)
+		assert.Equal(t, v, dr)
+	})
+
+	t.Run("value encrypted with primary key is unmodified", func(t *testing.T) {
+		encNew, err := TryEncryptValue("test", v)
+		require.NoError(t, err)
+
+		r, ok, err := TryReEncryptValue("test", encNew)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, encNew, r)
+	})
+
+	t.Run("value not encrypted is unmodified", func(t *testing.T) {
+		r, ok, err := TryReEncryptValue("test", v)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, v, r)
+	})
+
+	t.Run("store without secondary key", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: newPrimary})
+
+		r, ok, err := TryReEncryptValue("test", encOld)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, encOld, r)
+	})
+}
+
+func TestVersionedValues(t *testing.T) {
+	newKey := func(name string, algorithm Algorithm, versioned bool) Key {
+		b := make([]byte, 32)
+		rand.Read(b)
+
+		k := Key{
+			Name:      name,
+			Key:       hex.EncodeToString(b),
+			algorithm: algorithm,
+			versioned: versioned,
+		}
+		cipherObj, err := createCipher(k, algorithm)
+		require.NoError(t, err)
+		k.cipherObj = cipherObj
+		return k
+	}
+
+	legacyKey := newKey("legacy", AESGCMAlgorithm, false)
+	chachaKey := newKey("chacha$key", ChaCha20Poly1305Algorithm, true)
+
+	encryptedStateStores = map[string]ComponentEncryptionKeys{}
+	AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: legacyKey})
+	legacyValue, err := TryEncryptValue("test", []byte("legacy"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(string(legacyValue), separator+"legacy"))
+
+	encryptedStateStores = map[string]ComponentEncryptionKeys{}
+	AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: chachaKey, Secondary: legacyKey})
+
+	t.Run("values are written with a versioned header", func(t *testing.T) {
+		r, err := TryEncryptValue("test", []byte("hello"))
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(r), "$dapr.v2$ChaCha20-Poly1305$chacha$key$"))
+
+		dr, err := TryDecryptValue("test", r)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hello"), dr)
+	})
+
+	t.Run("values in the original format can still be read", func(t *testing.T) {
+		dr, err := TryDecryptValue("test", legacyValue)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("legacy"), dr)
+	})
+
+	t.Run("values in the original format are re-encrypted with a versioned header", func(t *testing.T) {
+		r, ok, err := TryReEncryptValue("test", legacyValue)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, strings.HasPrefix(string(r), versionHeader))
+
+		dr, err := TryDecryptValue("test", r)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("legacy"), dr)
+	})
+
+	t.Run("value encrypted with an unknown key", func(t *testing.T) {
+		_, err := TryDecryptValue("test", []byte("$dapr.v2$AES-GCM$unknown$AAAA"))
+		require.Error(t, err)
+	})
+
+	t.Run("key used with a previous algorithm", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: chachaKey})
+		r, err := TryEncryptValue("test", []byte("hello"))
+		require.NoError(t, err)
+
+		aesKey := chachaKey
+		aesKey.algorithm = AESGCMAlgorithm
+		aesKey.cipherObj, err = createCipher(aesKey, AESGCMAlgorithm)
+		require.NoError(t, err)
+
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: aesKey})
+		dr, err := TryDecryptValue("test", r)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hello"), dr)
+	})
+}
+
+func TestParseEncryptedValue(t *testing.T) {
+	tests := map[string]struct {
+		value string
+		exp   encryptedValue
+		expOK bool
+	}{
+		"original format": {
+			value: "YWJj||mykey",
+			exp:   encryptedValue{algorithm: AESGCMAlgorithm, keyName: "mykey", ciphertext: []byte("YWJj")},
+			expOK: true,
+		},
+		"versioned format": {
+			value: "$dapr.v2$ChaCha20-Poly1305$my$key$YWJj",
+			exp:   encryptedValue{algorithm: ChaCha20Poly1305Algorithm, keyName: "my$key", ciphertext: []byte("YWJj")},
+			expOK: true,
+		},
+		"versioned format without key": {
+			value: "$dapr.v2$AES-GCM",
+		},
+		"not encrypted": {
+			value: "plain",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			v, ok := parseEncryptedValue([]byte(test.value))
+			assert.Equal(t, test.expOK, ok)
+			assert.Equal(t, test.exp, v)
+		})
+	}
+}
@@ -32,6 +32,7 @@ const (
 	CategoryHealth            Category = "health"
 	CategoryCommon            Category = "common"
 	CategoryPluggable         Category = "pluggable-component"
+	CategoryIdentity          Category = "identity"
 )
 
 type ErrorCode struct {
@@ -71,6 +72,10 @@ var (
 	WorkflowTerminate                 = ErrorCode{"ERR_TERMINATE_WORKFLOW", "", CategoryWorkflow}           // Error terminating workflow
 	WorkflowPurge                     = ErrorCode{"ERR_PURGE_WORKFLOW", "", CategoryWorkflow}               // Error purging workflow
 	WorkflowRaiseEvent                = ErrorCode{"ERR_RAISE_EVENT_WORKFLOW", "", CategoryWorkflow}         // Error raising event in workflow
+	WorkflowList                      = ErrorCode{"ERR_LIST_WORKFLOWS", "", CategoryWorkflow}               // Error listing workflows
+	WorkflowExport                    = ErrorCode{"ERR_EXPORT_WORKFLOWS", "", CategoryWorkflow}             // Error exporting workflows
+	WorkflowImport                    = ErrorCode{"ERR_IMPORT_WORKFLOW", "", CategoryWorkflow}              // Error importing workflow
+	WorkflowImportInvalid             = ErrorCode{"ERR_IMPORT_WORKFLOW_INVALID", "", CategoryWorkflow}      // Malformed exported workflow record
 	WorkflowComponentMissing          = ErrorCode{"ERR_WORKFLOW_COMPONENT_MISSING", "", CategoryWorkflow}   // Missing workflow component
 	WorkflowComponentNotFound         = ErrorCode{"ERR_WORKFLOW_COMPONENT_NOT_FOUND", "", CategoryWorkflow} // Workflow component not found
 	WorkflowEventNameMissing          = ErrorCode{"ERR_WORKFLOW_EVENT_NAME_MISSING", "", CategoryWorkflow}  // Missing workflow event name
@@ -79,6 +84,8 @@ var (
 	WorkflowInstanceIDNotFound        = ErrorCode{"ERR_INSTANCE_ID_NOT_FOUND", "", CategoryWorkflow}        // Workflow instance ID not found
 	WorkflowInstanceIDProvidedMissing = ErrorCode{"ERR_INSTANCE_ID_PROVIDED_MISSING", "", CategoryWorkflow} // Missing workflow instance ID
 	WorkflowInstanceIDTooLong         = ErrorCode{"ERR_INSTANCE_ID_TOO_LONG", "", CategoryWorkflow}         // Workflow instance ID too long
+	WorkflowStatusInvalid             = ErrorCode{"ERR_WORKFLOW_STATUS_INVALID", "", CategoryWorkflow}      // Unknown workflow runtime status
+	WorkflowContinuationTokenInvalid  = ErrorCode{"ERR_CONTINUATION_TOKEN_INVALID", "", CategoryWorkflow}   // Malformed workflow list continuation token
 
 	// ### State management API
 	StateTransaction                   = ErrorCode{"ERR_STATE_TRANSACTION", "", CategoryState}                                                 // Error in state transaction
@@ -95,6 +102,8 @@ var (
 	StateStoreQueryNotSupported        = ErrorCode{"ERR_STATE_STORE_NOT_SUPPORTED", "DAPR_STATE_QUERYING_NOT_SUPPORTED", CategoryState}        // State store does not support querying
 	StateStoreTooManyTransactions      = ErrorCode{"ERR_STATE_STORE_TOO_MANY_TRANSACTIONS", "DAPR_STATE_TOO_MANY_TRANSACTIONS", CategoryState} // Too many operations per transaction
 	StateMalformedRequest              = ErrorCode{"ERR_MALFORMED_REQUEST", "DAPR_STATE_ILLEGAL_KEY", CategoryState}                           // Invalid key
+	StateStoreNotEncrypted             = ErrorCode{"ERR_STATE_STORE_NOT_ENCRYPTED", "DAPR_STATE_NOT_ENCRYPTED", CategoryState}                 // State store does not have encryption keys
+	StateReEncrypt                     = ErrorCode{"ERR_STATE_REENCRYPT", "DAPR_STATE_REENCRYPT_FAILED", CategoryState}                        // Error re-encrypting state
 
 	// ### Configuration API
 	ConfigurationGet                = ErrorCode{"ERR_CONFIGURATION_GET", "", CategoryConfiguration}                  // Error getting configuration
@@ -131,10 +140,21 @@ var (
 	PubsubPublishOutbox         = ErrorCode{"ERR_PUBLISH_OUTBOX", "", CategoryPubsub}                                              // Error publishing message to outbox
 
 	// ### Conversation API
-	ConversationInvalidParms  = ErrorCode{"ERR_CONVERSATION_INVALID_PARMS", "", CategoryConversation}  // Invalid parameters for conversation component
-	ConversationInvoke        = ErrorCode{"ERR_CONVERSATION_INVOKE", "", CategoryConversation}         // Error invoking conversation
-	ConversationMissingInputs = ErrorCode{"ERR_CONVERSATION_MISSING_INPUTS", "", CategoryConversation} // Missing inputs for conversation
-	ConversationNotFound      = ErrorCode{"ERR_CONVERSATION_NOT_FOUND", "", CategoryConversation}      // Conversation not found
+	ConversationInvalidParms                = ErrorCode{"ERR_CONVERSATION_INVALID_PARMS", "", CategoryConversation}                  // Invalid parameters for conversation component
+	ConversationInvoke                      = ErrorCode{"ERR_CONVERSATION_INVOKE", "", CategoryConversation}                         // Error invoking conversation
+	ConversationMissingInputs               = ErrorCode{"ERR_CONVERSATION_MISSING_INPUTS", "", CategoryConversation}                 // Missing inputs for conversation
+	ConversationNotFound                    = ErrorCode{"ERR_CONVERSATION_NOT_FOUND", "", CategoryConversation}                      // Conversation not found
+	ConversationHistory                     = ErrorCode{"ERR_CONVERSATION_HISTORY", "", CategoryConversation}                        // Error loading or saving the history of a conversation context
+	ConversationHistoryNotConfigured        = ErrorCode{"ERR_CONVERSATION_HISTORY_NOT_CONFIGURED", "", CategoryConversation}         // Conversation component has no history state store
+	ConversationContextNotFound             = ErrorCode{"ERR_CONVERSATION_CONTEXT_NOT_FOUND", "", CategoryConversation}              // Conversation context not found
+	ConversationContextIDMissing            = ErrorCode{"ERR_CONVERSATION_CONTEXT_ID_MISSING", "", CategoryConversation}             // Missing context ID
+	ConversationToolTarget                  = ErrorCode{"ERR_CONVERSATION_TOOL_TARGET", "", CategoryConversation}                    // Invalid target of a conversation tool
+	ConversationSidecarTokenBudgetExhausted = ErrorCode{"ERR_CONVERSATION_SIDECAR_TOKEN_BUDGET_EXHAUSTED", "", CategoryConversation} // Token budget of the sidecar is exhausted
+
+	// ### Identity API
+	IdentityAudienceMissing = ErrorCode{"ERR_IDENTITY_AUDIENCE_MISSING", "", CategoryIdentity} // Missing audience for JWT-SVID
+	IdentityJWTUnavailable  = ErrorCode{"ERR_IDENTITY_JWT_UNAVAILABLE", "", CategoryIdentity}  // JWT-SVIDs are not available
+	IdentityJWTGet          = ErrorCode{"ERR_IDENTITY_JWT_GET", "", CategoryIdentity}          // Error getting JWT-SVID
 
 	// ### Service Invocation / Direct Messaging API
 	ServiceInvocationDirectInvoke = ErrorCode{"ERR_DIRECT_INVOKE", "", CategoryServiceInvocation} // Error invoking service
@@ -169,6 +189,7 @@ var (
 	SchedulerJobNameEmpty  = ErrorCode{"DAPR_SCHEDULER_JOB_NAME_EMPTY", "DAPR_SCHEDULER_JOB_NAME_EMPTY", CategoryJob} // Job name is empty
 	SchedulerGetJob        = ErrorCode{"DAPR_SCHEDULER_GET_JOB", "DAPR_SCHEDULER_GET_JOB", CategoryJob}               // Error getting job
 	SchedulerListJobs      = ErrorCode{"DAPR_SCHEDULER_LIST_JOBS", "DAPR_SCHEDULER_LIST_JOBS", CategoryJob}           // Error listing jobs
+	SchedulerListToken     = ErrorCode{"DAPR_SCHEDULER_LIST_TOKEN", "DAPR_SCHEDULER_LIST_TOKEN", CategoryJob}         // Invalid continuation token for listing jobs
 	SchedulerDeleteJob     = ErrorCode{"DAPR_SCHEDULER_DELETE_JOB", "DAPR_SCHEDULER_DELETE_JOB", CategoryJob}         // Error deleting job
 	SchedulerEmpty         = ErrorCode{"DAPR_SCHEDULER_EMPTY", "DAPR_SCHEDULER_EMPTY", CategoryJob}                   // Required argument is empty
 	SchedulerScheduleEmpty = ErrorCode{"DAPR_SCHEDULER_SCHEDULE_EMPTY", "DAPR_SCHEDULER_SCHEDULE_EMPTY", CategoryJob} // No schedule provided for job
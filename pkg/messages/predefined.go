@@ -133,10 +133,27 @@ var (
 	ErrPauseWorkflow                 = APIError{"error pausing workflow %s: %s", errorcodes.WorkflowPause, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrResumeWorkflow                = APIError{"error resuming workflow %s: %s", errorcodes.WorkflowResume, http.StatusInternalServerError, grpcCodes.Internal}
 	ErrPurgeWorkflow                 = APIError{"error purging workflow %s: %s", errorcodes.WorkflowPurge, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrListWorkflows                 = APIError{"error listing workflows: %s", errorcodes.WorkflowList, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrInvalidWorkflowRuntimeStatus  = APIError{"workflow runtime status '%s' is invalid", errorcodes.WorkflowStatusInvalid, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrInvalidWorkflowContinuation   = APIError{"workflow list continuation token is invalid", errorcodes.WorkflowContinuationTokenInvalid, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrExportWorkflows               = APIError{"error exporting workflows: %s", errorcodes.WorkflowExport, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrImportWorkflow                = APIError{"error importing workflow '%s': %s", errorcodes.WorkflowImport, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrInvalidWorkflowImport         = APIError{"exported workflow record is invalid: %s", errorcodes.WorkflowImportInvalid, http.StatusBadRequest, grpcCodes.InvalidArgument}
 
 	// Conversation
-	ErrConversationNotFound      = APIError{"failed finding conversation component %s", errorcodes.ConversationNotFound, http.StatusBadRequest, grpcCodes.InvalidArgument}
-	ErrConversationInvalidParams = APIError{"failed conversing with component %s: invalid params", errorcodes.ConversationInvalidParms, http.StatusBadRequest, grpcCodes.InvalidArgument}
-	ErrConversationInvoke        = APIError{"failed conversing with component %s: %s", errorcodes.ConversationInvoke, http.StatusInternalServerError, grpcCodes.Internal}
-	ErrConversationMissingInputs = APIError{"failed conversing with component %s: missing inputs in request", errorcodes.ConversationMissingInputs, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrConversationNotFound                    = APIError{"failed finding conversation component %s", errorcodes.ConversationNotFound, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrConversationInvalidParams               = APIError{"failed conversing with component %s: invalid params", errorcodes.ConversationInvalidParms, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrConversationInvoke                      = APIError{"failed conversing with component %s: %s", errorcodes.ConversationInvoke, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrConversationMissingInputs               = APIError{"failed conversing with component %s: missing inputs in request", errorcodes.ConversationMissingInputs, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrConversationHistory                     = APIError{"failed to access the history of context %s of conversation component %s: %s", errorcodes.ConversationHistory, http.StatusInternalServerError, grpcCodes.Internal}
+	ErrConversationHistoryNotConfigured        = APIError{"conversation component %s has no history state store configured", errorcodes.ConversationHistoryNotConfigured, http.StatusBadRequest, grpcCodes.FailedPrecondition}
+	ErrConversationContextNotFound             = APIError{"context %s of conversation component %s not found", errorcodes.ConversationContextNotFound, http.StatusNotFound, grpcCodes.NotFound}
+	ErrConversationContextIDMissing            = APIError{"context ID is required for conversation component %s", errorcodes.ConversationContextIDMissing, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrConversationToolTarget                  = APIError{"invalid target of tool %s of conversation component %s: %s", errorcodes.ConversationToolTarget, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrConversationSidecarTokenBudgetExhausted = APIError{"token budget of %d tokens per %s of this sidecar is exhausted until %s", errorcodes.ConversationSidecarTokenBudgetExhausted, http.StatusTooManyRequests, grpcCodes.ResourceExhausted}
+
+	// Identity
+	ErrIdentityAudienceMissing = APIError{"an audience is required", errorcodes.IdentityAudienceMissing, http.StatusBadRequest, grpcCodes.InvalidArgument}
+	ErrIdentityJWTUnavailable  = APIError{"JWT-SVIDs are not available: mTLS is disabled", errorcodes.IdentityJWTUnavailable, http.StatusNotImplemented, grpcCodes.Unimplemented}
+	ErrIdentityJWTGet          = APIError{"error getting JWT-SVID for audience '%s': %s", errorcodes.IdentityJWTGet, http.StatusInternalServerError, grpcCodes.Internal}
 )
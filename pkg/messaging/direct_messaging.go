@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"strings"
 	"sync/atomic"
@@ -27,11 +28,13 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	nr "github.com/dapr/components-contrib/nameresolution"
 	"github.com/dapr/dapr/pkg/channel"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
+	"github.com/dapr/dapr/pkg/messaging/httpendpoints"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/dapr/dapr/pkg/modes"
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
@@ -73,6 +76,8 @@ type directMessaging struct {
 	resiliency          resiliency.Provider
 	compStore           *compstore.ComponentStore
 	resolverCache       *ttlcache.Cache[nr.AddressList]
+	oauth2Tokens        *oauth2Tokens
+	httpEndpoints       *httpendpoints.Balancer
 	closed              atomic.Bool
 }
 
@@ -98,6 +103,7 @@ type NewDirectMessagingOpts struct {
 	Proxy              Proxy
 	ReadBufferSize     int
 	Resiliency         resiliency.Provider
+	HTTPEndpoints      *httpendpoints.Balancer
 }
 
 // NewDirectMessaging returns a new direct messaging api.
@@ -120,6 +126,8 @@ func NewDirectMessaging(opts NewDirectMessagingOpts) invokev1.DirectMessaging {
 		hostAddress:         hAddr,
 		hostName:            hName,
 		compStore:           opts.CompStore,
+		oauth2Tokens:        newOAuth2Tokens(opts.CompStore),
+		httpEndpoints:       opts.HTTPEndpoints,
 	}
 
 	// Set resolverMulti if the resolver implements the ResolverMulti interface
@@ -151,6 +159,12 @@ func (d *directMessaging) Close() error {
 
 // Invoke takes a message requests and invokes an app, either local or remote.
 func (d *directMessaging) Invoke(ctx context.Context, targetAppID string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	// If the circuit breaker of the target is open, the resiliency policy asks to invoke the fallback app instead
+	if fallbackAppID := resiliency.GetFallback(ctx); fallbackAppID != "" {
+		log.Debugf("Invoking fallback app %s instead of %s", fallbackAppID, targetAppID)
+		targetAppID = fallbackAppID
+	}
+
 	app, err := d.getRemoteApp(targetAppID)
 	if err != nil {
 		return nil, err
@@ -165,6 +179,10 @@ func (d *directMessaging) Invoke(ctx context.Context, targetAppID string, req *i
 		return d.invokeLocal(ctx, req)
 	}
 
+	if hedging := d.resiliency.EndpointHedgingPolicy(app.id); hedging != nil && (hedging.AllowNonIdempotent || isIdempotentRequest(req)) {
+		return d.invokeHedged(ctx, targetAppID, app, hedging, req)
+	}
+
 	return d.invokeWithRetry(ctx, retry.DefaultLinearRetryCount, retry.DefaultLinearBackoffInterval, app, d.invokeRemote, req)
 }
 
@@ -193,8 +211,8 @@ func (d *directMessaging) requestAppIDAndNamespace(targetAppID string) (string,
 func (d *directMessaging) checkHTTPEndpoints(targetAppID string) string {
 	endpoint, ok := d.compStore.GetHTTPEndpoint(targetAppID)
 	if ok {
-		if endpoint.Name == targetAppID {
-			return endpoint.Spec.BaseURL
+		if urls := endpoint.AllBaseURLs(); endpoint.Name == targetAppID && len(urls) > 0 {
+			return urls[0]
 		}
 	}
 
@@ -250,6 +268,172 @@ func (d *directMessaging) invokeWithRetry(
 	return resp, err
 }
 
+type hedgedResult struct {
+	attempt int
+	resp    *invokev1.InvokeMethodResponse
+	err     error
+}
+
+// isIdempotentRequest returns true if the request is an HTTP request with a method which is safe to send more than once.
+// gRPC requests are never considered idempotent, as the method doesn't tell whether they are.
+func isIdempotentRequest(req *invokev1.InvokeMethodRequest) bool {
+	switch req.Message().GetHttpExtension().GetVerb() {
+	case commonv1pb.HTTPExtension_GET, commonv1pb.HTTPExtension_HEAD, commonv1pb.HTTPExtension_OPTIONS:
+		return true
+	default:
+		return false
+	}
+}
+
+// invokeHedged invokes a remote app using a hedging policy: every time the hedging delay elapses without a response, another attempt is sent to a different instance of the app, up to the maximum number of attempts in flight.
+// The first successful response is returned, and all other attempts are canceled.
+// As every attempt needs its own copy of the request, the request body is buffered in memory.
+// Attempts are not retried with the built-in service invocation retries, as the hedged attempts take their place; retries of the endpoint's resiliency policy still apply to the hedged invocation as a whole.
+func (d *directMessaging) invokeHedged(ctx context.Context, targetAppID string, app remoteApp, hedging *resiliency.Hedging, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	// Every attempt needs its own copy of the request, so read the data in-memory
+	// Enable replaying so the request can still be retried by the resiliency policies
+	req.WithReplay(true)
+	reqProto, err := req.ProtoWithData()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(chan hedgedResult, hedging.MaxAttempts)
+	cancels := make([]context.CancelFunc, 0, hedging.MaxAttempts)
+	used := make(map[string]struct{}, hedging.MaxAttempts)
+
+	sendAttempt := func(target remoteApp) {
+		used[target.address] = struct{}{}
+		attemptReq, _ := invokev1.FromInternalInvokeRequest(proto.Clone(reqProto).(*internalv1pb.InternalInvokeRequest))
+		// The context of the winning attempt is only canceled when its response is closed, as the response body is streamed from it
+		attemptCtx, cancel := context.WithCancel(ctx)
+		cancels = append(cancels, cancel)
+		attempt := len(cancels) - 1
+		go func() {
+			defer attemptReq.Close()
+			resp, teardown, rErr := d.invokeRemote(attemptCtx, target.id, target.namespace, target.address, attemptReq)
+			code := status.Code(rErr)
+			if code == codes.Unavailable || code == codes.Unauthenticated {
+				teardown(true)
+				if target.cacheKey != "" && d.resolverCache != nil {
+					d.resolverCache.Delete(target.cacheKey)
+				}
+			} else {
+				teardown(false)
+			}
+			results <- hedgedResult{attempt: attempt, resp: resp, err: rErr}
+		}()
+	}
+
+	// Cancels all pending attempts and closes their responses, except for the winning one
+	stopPending := func(pending int, keep int) {
+		for i, cancel := range cancels {
+			if i != keep {
+				cancel()
+			}
+		}
+		if pending == 0 {
+			return
+		}
+		go func() {
+			for range pending {
+				if res := <-results; res.resp != nil {
+					_ = res.resp.Close()
+				}
+			}
+		}()
+	}
+
+	sendAttempt(app)
+	sent, pending := 1, 1
+
+	timer := time.NewTimer(hedging.Delay)
+	defer timer.Stop()
+
+	var lastErr error
+	for {
+		select {
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				stopPending(pending, res.attempt)
+				return cancelOnClose(res.resp, cancels[res.attempt]), nil
+			}
+			if res.resp != nil {
+				_ = res.resp.Close()
+			}
+			lastErr = res.err
+			if pending > 0 {
+				continue
+			}
+			if sent == hedging.MaxAttempts {
+				stopPending(0, -1)
+				return nil, lastErr
+			}
+			// All attempts in flight failed, so send the next one without waiting for the delay
+			timer.Reset(0)
+
+		case <-timer.C:
+			if sent == hedging.MaxAttempts {
+				continue
+			}
+			log.Debugf("No response from %s after %v; sending hedged attempt %d", app.id, hedging.Delay, sent+1)
+			hedging.HedgeSent()
+			sendAttempt(d.hedgeTarget(targetAppID, app, used))
+			sent++
+			pending++
+			timer.Reset(hedging.Delay)
+
+		case <-ctx.Done():
+			stopPending(pending, -1)
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// cancelOnClose makes closing the response cancel the context its body is streamed from.
+func cancelOnClose(resp *invokev1.InvokeMethodResponse, cancel context.CancelFunc) *invokev1.InvokeMethodResponse {
+	if resp == nil || resp.HasMessageData() {
+		// Nothing is streamed
+		cancel()
+		return resp
+	}
+	return resp.WithRawData(&cancelOnCloseReader{Reader: resp.RawData(), cancel: cancel})
+}
+
+type cancelOnCloseReader struct {
+	io.Reader
+	cancel context.CancelFunc
+}
+
+func (r *cancelOnCloseReader) Close() error {
+	defer r.cancel()
+	if c, ok := r.Reader.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// hedgeTarget returns the target of an additional hedged attempt, preferring an instance of the app which was not used by previous attempts.
+func (d *directMessaging) hedgeTarget(targetAppID string, app remoteApp, used map[string]struct{}) remoteApp {
+	target, err := d.getRemoteApp(targetAppID)
+	if err != nil {
+		return app
+	}
+	if _, ok := used[target.address]; !ok || target.cacheKey == "" || d.resolverCache == nil {
+		return target
+	}
+
+	addresses, _ := d.resolverCache.Get(target.cacheKey)
+	for _, address := range addresses {
+		if _, ok := used[address]; !ok {
+			target.address = address
+			break
+		}
+	}
+	return target
+}
+
 func (d *directMessaging) invokeLocal(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
 	appChannel := d.channels.AppChannel()
 	if appChannel == nil {
@@ -274,6 +458,18 @@ func (d *directMessaging) isHTTPEndpoint(appID string) bool {
 func (d *directMessaging) invokeHTTPEndpoint(ctx context.Context, appID, appNamespace, appAddress string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, func(destroy bool), error) {
 	ctx = d.setContextSpan(ctx)
 
+	endpoint, isEndpoint := d.compStore.GetHTTPEndpoint(appID)
+	var baseURL string
+	if isEndpoint {
+		if err := d.oauth2Tokens.authorize(ctx, endpoint, d.endpointHTTPClient(appID), req); err != nil {
+			return nil, nopTeardown, err
+		}
+
+		// Choose the base URL of the endpoint this attempt is sent to.
+		baseURL = d.httpEndpoints.Pick(endpoint)
+		ctx = channel.WithHTTPEndpointBaseURL(ctx, baseURL)
+	}
+
 	// Set up timers
 	start := time.Now()
 	diag.DefaultMonitoring.ServiceInvocationRequestSent(appID)
@@ -282,11 +478,31 @@ func (d *directMessaging) invokeHTTPEndpoint(ctx context.Context, appID, appName
 	// Diagnostics
 	if imr != nil {
 		diag.DefaultMonitoring.ServiceInvocationResponseReceived(appID, imr.Status().GetCode(), start)
+
+		// The OAuth2 token may have been revoked before its expiry: drop it so
+		// that the next request obtains a new one.
+		if imr.Status().GetCode() == http.StatusUnauthorized {
+			d.oauth2Tokens.invalidate(appID)
+		}
+	}
+
+	if isEndpoint {
+		// Streamed responses have no response object.
+		d.httpEndpoints.Report(endpoint, baseURL, err == nil && (imr == nil || imr.Status().GetCode() < http.StatusInternalServerError))
 	}
 
 	return imr, nopTeardown, err
 }
 
+// endpointHTTPClient returns the HTTP client of the given HTTP endpoint, or nil
+// if there are no channels.
+func (d *directMessaging) endpointHTTPClient(name string) *http.Client {
+	if d.channels == nil {
+		return nil
+	}
+	return d.channels.EndpointHTTPClient(name)
+}
+
 func (d *directMessaging) invokeRemote(ctx context.Context, appID, appNamespace, appAddress string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, func(destroy bool), error) {
 	conn, teardown, err := d.connectionCreatorFn(ctx, appAddress, appID, appNamespace)
 	if err != nil {
@@ -20,21 +20,30 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	nr "github.com/dapr/components-contrib/nameresolution"
 	"github.com/dapr/dapr/pkg/channel"
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	daprt "github.com/dapr/dapr/pkg/testing"
 	"github.com/dapr/kit/logger"
 )
 
@@ -329,6 +338,10 @@ func createTestClient(socket string) *grpc.ClientConn {
 }
 
 func startInternalServer(socket string, enableStreaming bool, chunks []string) *grpc.Server {
+	return startDelayedInternalServer(socket, enableStreaming, chunks, 0)
+}
+
+func startDelayedInternalServer(socket string, enableStreaming bool, chunks []string, delay time.Duration) *grpc.Server {
 	lis, _ := net.Listen("unix", socket)
 
 	server := grpc.NewServer()
@@ -336,6 +349,7 @@ func startInternalServer(socket string, enableStreaming bool, chunks []string) *
 	if enableStreaming {
 		stream := &mockGRPCServerStream{
 			chunks: chunks,
+			delay:  delay,
 		}
 		server.RegisterService(&grpc.ServiceDesc{
 			ServiceName: "dapr.proto.internals.v1.ServiceInvocation",
@@ -407,9 +421,18 @@ type mockGRPCServerStreamI interface {
 type mockGRPCServerStream struct {
 	mockGRPCServerUnary
 	chunks []string
+	delay  time.Duration
 }
 
 func (m *mockGRPCServerStream) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStreamServer) error { //nolint:nosnakecase
+	if m.delay > 0 {
+		select {
+		case <-time.After(m.delay):
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+
 	// Send the first chunk
 	resp := invokev1.NewInvokeMethodResponse(200, "OK", nil).
 		WithContentType("text/plain").
@@ -497,3 +520,180 @@ type mockChannel struct{}
 func (m *mockChannel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRequest, appID string) (*invokev1.InvokeMethodResponse, error) {
 	return nil, nil
 }
+
+func TestInvokeHedged(t *testing.T) {
+	log.SetOutputLevel(logger.FatalLevel)
+	defer log.SetOutputLevel(logger.InfoLevel)
+
+	socketDir := t.TempDir()
+
+	startServer := func(t *testing.T, name string, delay time.Duration, chunks []string) *grpc.ClientConn {
+		socket := filepath.Join(socketDir, name)
+		server := startDelayedInternalServer(socket, true, chunks, delay)
+		clientConn := createTestClient(socket)
+		t.Cleanup(func() {
+			clientConn.Close()
+			server.Stop()
+		})
+		return clientConn
+	}
+
+	prepareMessaging := func(t *testing.T, conns map[string]*grpc.ClientConn, dials *atomic.Int32) *directMessaging {
+		messaging := NewDirectMessaging(NewDirectMessagingOpts{
+			MaxRequestBodySize: 10 << 20,
+			ClientConnFn: func(ctx context.Context, address string, id string, namespace string, customOpts ...grpc.DialOption) (*grpc.ClientConn, func(destroy bool), error) {
+				// The first attempt goes to the primary instance, the others to the secondary one
+				if dials.Add(1) == 1 {
+					return conns["primary"], func(_ bool) {}, nil
+				}
+				return conns["secondary"], func(_ bool) {}, nil
+			},
+			Resiliency: resiliency.New(log),
+		}).(*directMessaging)
+		t.Cleanup(func() {
+			messaging.Close()
+		})
+		return messaging
+	}
+
+	hedging := &resiliency.Hedging{
+		Delay:       50 * time.Millisecond,
+		MaxAttempts: 2,
+	}
+	app := remoteApp{id: "app1", namespace: "namespace1", address: "addr1"}
+
+	t.Run("hedged attempt wins when the primary is slow", func(t *testing.T) {
+		var dials atomic.Int32
+		messaging := prepareMessaging(t, map[string]*grpc.ClientConn{
+			"primary":   startServer(t, "slow-primary", time.Minute, []string{"slow"}),
+			"secondary": startServer(t, "fast-secondary", 0, []string{"fast"}),
+		}, &dials)
+
+		request := invokev1.
+			NewInvokeMethodRequest("method").
+			WithRawDataString("hello").
+			WithMetadata(map[string][]string{invokev1.DestinationIDHeader: {"app1"}})
+		defer request.Close()
+
+		start := time.Now()
+		res, err := messaging.invokeHedged(t.Context(), "app1", app, hedging, request)
+		require.NoError(t, err)
+		defer res.Close()
+		assert.Less(t, time.Since(start), 10*time.Second)
+
+		pd, err := res.ProtoWithData()
+		require.NoError(t, err)
+		assert.Equal(t, "fast", string(pd.GetMessage().GetData().GetValue()))
+		assert.Equal(t, int32(2), dials.Load())
+
+		// The request can still be replayed by retries
+		data, err := request.RawDataFull()
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+	})
+
+	t.Run("no hedged attempt when the primary responds in time", func(t *testing.T) {
+		var dials atomic.Int32
+		messaging := prepareMessaging(t, map[string]*grpc.ClientConn{
+			"primary":   startServer(t, "fast-primary", 0, []string{"primary"}),
+			"secondary": startServer(t, "unused-secondary", 0, []string{"secondary"}),
+		}, &dials)
+
+		request := invokev1.
+			NewInvokeMethodRequest("method").
+			WithMetadata(map[string][]string{invokev1.DestinationIDHeader: {"app1"}})
+		defer request.Close()
+
+		res, err := messaging.invokeHedged(t.Context(), "app1", app, hedging, request)
+		require.NoError(t, err)
+		defer res.Close()
+
+		pd, err := res.ProtoWithData()
+		require.NoError(t, err)
+		assert.Equal(t, "primary", string(pd.GetMessage().GetData().GetValue()))
+		assert.Equal(t, int32(1), dials.Load())
+	})
+}
+
+func TestIsIdempotentRequest(t *testing.T) {
+	tests := map[string]struct {
+		req  *invokev1.InvokeMethodRequest
+		want bool
+	}{
+		"GET":     {req: invokev1.NewInvokeMethodRequest("method").WithHTTPExtension(http.MethodGet, ""), want: true},
+		"HEAD":    {req: invokev1.NewInvokeMethodRequest("method").WithHTTPExtension(http.MethodHead, ""), want: true},
+		"OPTIONS": {req: invokev1.NewInvokeMethodRequest("method").WithHTTPExtension(http.MethodOptions, ""), want: true},
+		"POST":    {req: invokev1.NewInvokeMethodRequest("method").WithHTTPExtension(http.MethodPost, ""), want: false},
+		"PUT":     {req: invokev1.NewInvokeMethodRequest("method").WithHTTPExtension(http.MethodPut, ""), want: false},
+		"gRPC":    {req: invokev1.NewInvokeMethodRequest("method"), want: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer tc.req.Close()
+			assert.Equal(t, tc.want, isIdempotentRequest(tc.req))
+		})
+	}
+}
+
+func TestCancelOnClose(t *testing.T) {
+	t.Run("streamed response cancels on close", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		resp := invokev1.NewInvokeMethodResponse(200, "OK", nil).
+			WithRawData(io.NopCloser(strings.NewReader("body")))
+
+		resp = cancelOnClose(resp, cancel)
+		data, err := resp.RawDataFull()
+		require.NoError(t, err)
+		assert.Equal(t, "body", string(data))
+		require.NoError(t, ctx.Err())
+
+		require.NoError(t, resp.Close())
+		require.ErrorIs(t, ctx.Err(), context.Canceled)
+	})
+
+	t.Run("buffered response cancels immediately", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		resp := invokev1.NewInvokeMethodResponse(200, "OK", nil).
+			WithMessage(&commonv1pb.InvokeResponse{Data: &anypb.Any{Value: []byte("body")}})
+		defer resp.Close()
+
+		resp = cancelOnClose(resp, cancel)
+		require.ErrorIs(t, ctx.Err(), context.Canceled)
+		pd, err := resp.ProtoWithData()
+		require.NoError(t, err)
+		assert.Equal(t, "body", string(pd.GetMessage().GetData().GetValue()))
+	})
+}
+
+func TestInvokeFallback(t *testing.T) {
+	appChannel := new(channelt.MockAppChannel)
+	appChannel.On("InvokeMethod", mock.Anything, mock.Anything).
+		Return(invokev1.NewInvokeMethodResponse(200, "OK", nil), nil)
+
+	resolver := new(daprt.MockResolver)
+	resolver.On("ResolveID", mock.MatchedBy(func(req nr.ResolveRequest) bool {
+		return req.ID == "fallback"
+	})).Return("localhost:50002", nil)
+
+	messaging := NewDirectMessaging(NewDirectMessagingOpts{
+		AppID:      "fallback",
+		Namespace:  "namespace1",
+		Resolver:   resolver,
+		Resiliency: resiliency.New(log),
+		CompStore:  compstore.New(),
+		Channels:   new(channels.Channels).WithAppChannel(appChannel),
+	}).(*directMessaging)
+	defer messaging.Close()
+
+	req := invokev1.NewInvokeMethodRequest("method")
+	defer req.Close()
+
+	// The fallback app is the local app, so the request is not sent to app1
+	res, err := messaging.Invoke(resiliency.WithFallback(t.Context(), "fallback"), "app1", req)
+	require.NoError(t, err)
+	defer res.Close()
+	assert.Equal(t, int32(200), res.Status().GetCode())
+	appChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
+	resolver.AssertNumberOfCalls(t, "ResolveID", 1)
+}
@@ -48,7 +48,7 @@ type proxy struct {
 	connectionFactory  messageClientConnection
 	remoteAppFn        func(appID string) (remoteApp, error)
 	telemetryFn        func(context.Context) context.Context
-	acl                *config.AccessControlList
+	acl                *config.Reloadable[config.AccessControlList]
 	resiliency         resiliency.Provider
 	maxRequestBodySize int
 }
@@ -58,7 +58,7 @@ type ProxyOpts struct {
 	AppClientFn        func() (grpc.ClientConnInterface, error)
 	ConnectionFactory  messageClientConnection
 	AppID              string
-	ACL                *config.AccessControlList
+	ACL                *config.Reloadable[config.AccessControlList]
 	Resiliency         resiliency.Provider
 	MaxRequestBodySize int
 }
@@ -109,6 +109,11 @@ func (p *proxy) intercept(ctx context.Context, fullName string) (context.Context
 
 	appID := v[0]
 
+	// If the circuit breaker of the target is open, the resiliency policy asks to invoke the fallback app instead
+	if fallbackAppID := resiliency.GetFallback(ctx); fallbackAppID != "" {
+		appID = fallbackAppID
+	}
+
 	if p.remoteAppFn == nil {
 		return ctx, nil, nil, nopTeardown, errors.New("failed to proxy request: proxy not initialized. daprd startup may be incomplete")
 	}
@@ -120,8 +125,8 @@ func (p *proxy) intercept(ctx context.Context, fullName string) (context.Context
 
 	if isLocal {
 		// proxy locally to the app
-		if p.acl != nil {
-			ok, authError := acl.ApplyAccessControlPolicies(ctx, fullName, common.HTTPExtension_NONE, false, p.acl) //nolint:nosnakecase
+		if accessControlList := p.acl.Load(); accessControlList != nil {
+			ok, authError := acl.ApplyAccessControlPolicies(ctx, fullName, common.HTTPExtension_NONE, false, accessControlList) //nolint:nosnakecase
 			if !ok {
 				return ctx, nil, nil, nopTeardown, status.Error(codes.PermissionDenied, authError)
 			}
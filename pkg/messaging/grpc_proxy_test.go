@@ -249,7 +249,7 @@ func TestIntercept(t *testing.T) {
 			ConnectionFactory: connectionFn,
 			AppClientFn:       appClientFn,
 			AppID:             "a",
-			ACL:               acl,
+			ACL:               config.NewReloadable(acl),
 			Resiliency:        resiliency.New(nil),
 		})
 		p.SetRemoteAppFn(func(s string) (remoteApp, error) {
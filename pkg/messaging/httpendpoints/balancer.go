@@ -0,0 +1,376 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpendpoints
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	"github.com/dapr/dapr/pkg/channel"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.httpendpoints")
+
+const (
+	defaultProbeInterval           = time.Second * 10
+	defaultProbeTimeout            = time.Second * 2
+	defaultActiveFailureThreshold  = 2
+	defaultPassiveFailureThreshold = 5
+	defaultEjectionDuration        = time.Second * 30
+
+	// tickInterval is the interval at which HTTP endpoints are checked for due
+	// active health probes.
+	tickInterval = time.Second
+)
+
+type Options struct {
+	CompStore *compstore.ComponentStore
+	Channels  *channels.Channels
+
+	// Clock is used for testing.
+	Clock clock.WithTicker
+}
+
+// Balancer chooses the base URL of HTTP endpoints for each request, balancing
+// requests between the healthy base URLs of an endpoint.
+type Balancer struct {
+	compStore *compstore.ComponentStore
+	channels  *channels.Channels
+	clock     clock.WithTicker
+
+	lock  sync.Mutex
+	pools map[string]*pool
+	wg    sync.WaitGroup
+}
+
+// URLStatus is the health of a base URL of an HTTP endpoint.
+type URLStatus struct {
+	URL     string
+	Healthy bool
+}
+
+// pool holds the state of the base URLs of a single HTTP endpoint.
+type pool struct {
+	urls      []string
+	strategy  httpendpointsapi.LoadBalancingStrategy
+	next      int
+	states    map[string]*urlState
+	lastProbe time.Time
+	probing   bool
+}
+
+type urlState struct {
+	probeFailures   int
+	probeUnhealthy  bool
+	requestFailures int
+	ejectedUntil    time.Time
+}
+
+func New(opts Options) *Balancer {
+	cl := opts.Clock
+	if cl == nil {
+		cl = clock.RealClock{}
+	}
+
+	return &Balancer{
+		compStore: opts.CompStore,
+		channels:  opts.Channels,
+		clock:     cl,
+		pools:     make(map[string]*pool),
+	}
+}
+
+// Run actively probes the base URLs of HTTP endpoints which have an active
+// health check. Blocks until the context is cancelled.
+func (b *Balancer) Run(ctx context.Context) error {
+	ticker := b.clock.NewTicker(tickInterval)
+	defer ticker.Stop()
+	defer b.wg.Wait()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+			b.probeDue(ctx)
+		}
+	}
+}
+
+// Pick returns the base URL the next request to the given HTTP endpoint is
+// sent to. When all base URLs are unhealthy, they are all considered.
+func (b *Balancer) Pick(endpoint httpendpointsapi.HTTPEndpoint) string {
+	urls := endpoint.AllBaseURLs()
+	switch {
+	case len(urls) == 0:
+		return ""
+	case len(urls) == 1, b == nil:
+		return urls[0]
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	p := b.poolLocked(endpoint)
+	now := b.clock.Now()
+
+	candidates := make([]string, 0, len(p.urls))
+	for _, u := range p.urls {
+		if p.states[u].healthy(now) {
+			candidates = append(candidates, u)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = p.urls
+	}
+
+	if p.strategy == httpendpointsapi.LoadBalancingPriority {
+		return candidates[0]
+	}
+
+	u := candidates[p.next%len(candidates)]
+	p.next++
+	return u
+}
+
+// Report records the result of a request sent to the given base URL of the
+// HTTP endpoint, ejecting the base URL after consecutive failures if the
+// endpoint has a passive health check.
+func (b *Balancer) Report(endpoint httpendpointsapi.HTTPEndpoint, baseURL string, success bool) {
+	passive := endpoint.Spec.HealthCheck.GetPassive()
+	if b == nil || passive == nil {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	state, ok := b.poolLocked(endpoint).states[baseURL]
+	if !ok {
+		return
+	}
+
+	if success {
+		state.requestFailures = 0
+		return
+	}
+
+	state.requestFailures++
+	threshold := passive.FailureThreshold
+	if threshold <= 0 {
+		threshold = defaultPassiveFailureThreshold
+	}
+	if state.requestFailures < threshold {
+		return
+	}
+
+	ejection := defaultEjectionDuration
+	if passive.EjectionDuration != nil {
+		ejection = passive.EjectionDuration.Duration
+	}
+	log.Warnf("Base URL %s of HTTP endpoint %s failed %d consecutive requests, ejecting it for %s", baseURL, endpoint.Name, state.requestFailures, ejection)
+	state.requestFailures = 0
+	state.ejectedUntil = b.clock.Now().Add(ejection)
+}
+
+// Status returns the health of the base URLs of the given HTTP endpoint.
+func (b *Balancer) Status(endpoint httpendpointsapi.HTTPEndpoint) []URLStatus {
+	urls := endpoint.AllBaseURLs()
+	status := make([]URLStatus, len(urls))
+
+	var p *pool
+	if b != nil {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		p = b.pools[endpoint.Name]
+		if p != nil && !slices.Equal(p.urls, urls) {
+			p = nil
+		}
+	}
+
+	now := b.now()
+	for i, u := range urls {
+		status[i] = URLStatus{URL: u, Healthy: true}
+		if p != nil {
+			status[i].Healthy = p.states[u].healthy(now)
+		}
+	}
+
+	return status
+}
+
+func (b *Balancer) now() time.Time {
+	if b == nil {
+		return time.Now()
+	}
+	return b.clock.Now()
+}
+
+// poolLocked returns the pool of the given HTTP endpoint, replacing it if its
+// base URLs or strategy changed. Must be called with the lock held.
+func (b *Balancer) poolLocked(endpoint httpendpointsapi.HTTPEndpoint) *pool {
+	urls := endpoint.AllBaseURLs()
+	strategy := httpendpointsapi.LoadBalancingRoundRobin
+	if lb := endpoint.Spec.LoadBalancing; lb != nil && len(lb.Strategy) > 0 {
+		strategy = lb.Strategy
+	}
+
+	p, ok := b.pools[endpoint.Name]
+	if ok && p.strategy == strategy && slices.Equal(p.urls, urls) {
+		return p
+	}
+
+	p = &pool{
+		urls:     urls,
+		strategy: strategy,
+		states:   make(map[string]*urlState, len(urls)),
+	}
+	for _, u := range urls {
+		p.states[u] = new(urlState)
+	}
+	b.pools[endpoint.Name] = p
+
+	return p
+}
+
+// probeDue probes the base URLs of the HTTP endpoints whose active health
+// check is due, and forgets the endpoints which have been removed.
+func (b *Balancer) probeDue(ctx context.Context) {
+	endpoints := b.compStore.ListHTTPEndpoints()
+	now := b.clock.Now()
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	names := make(map[string]struct{}, len(endpoints))
+	for _, endpoint := range endpoints {
+		names[endpoint.Name] = struct{}{}
+
+		active := endpoint.Spec.HealthCheck.GetActive()
+		if active == nil {
+			continue
+		}
+
+		p := b.poolLocked(endpoint)
+		interval := defaultProbeInterval
+		if active.Interval != nil {
+			interval = active.Interval.Duration
+		}
+		if p.probing || now.Sub(p.lastProbe) < interval {
+			continue
+		}
+
+		p.probing = true
+		p.lastProbe = now
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+			b.probe(ctx, endpoint, active, p)
+		}()
+	}
+
+	for name := range b.pools {
+		if _, ok := names[name]; !ok {
+			delete(b.pools, name)
+		}
+	}
+}
+
+// probe probes every base URL of the given pool, updating their health.
+func (b *Balancer) probe(ctx context.Context, endpoint httpendpointsapi.HTTPEndpoint, active *httpendpointsapi.ActiveHealthCheck, p *pool) {
+	results := make([]bool, len(p.urls))
+	var wg sync.WaitGroup
+	wg.Add(len(p.urls))
+	for i, u := range p.urls {
+		go func() {
+			defer wg.Done()
+			results[i] = b.probeURL(ctx, endpoint, active, u)
+		}()
+	}
+	wg.Wait()
+
+	threshold := active.FailureThreshold
+	if threshold <= 0 {
+		threshold = defaultActiveFailureThreshold
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	p.probing = false
+	for i, u := range p.urls {
+		state := p.states[u]
+		if results[i] {
+			if state.probeUnhealthy {
+				log.Infof("Base URL %s of HTTP endpoint %s is healthy", u, endpoint.Name)
+			}
+			state.probeFailures = 0
+			state.probeUnhealthy = false
+			continue
+		}
+
+		state.probeFailures++
+		if !state.probeUnhealthy && state.probeFailures >= threshold {
+			log.Warnf("Base URL %s of HTTP endpoint %s failed %d consecutive health probes, marking it unhealthy", u, endpoint.Name, state.probeFailures)
+			state.probeUnhealthy = true
+		}
+	}
+}
+
+// probeURL sends a health probe to the given base URL of the HTTP endpoint,
+// through the channel of the endpoint.
+func (b *Balancer) probeURL(ctx context.Context, endpoint httpendpointsapi.HTTPEndpoint, active *httpendpointsapi.ActiveHealthCheck, baseURL string) bool {
+	ch, ok := b.channels.EndpointChannels()[endpoint.Name]
+	if !ok {
+		ch = b.channels.HTTPEndpointsAppChannel()
+	}
+	if ch == nil {
+		return false
+	}
+
+	timeout := defaultProbeTimeout
+	if active.Timeout != nil {
+		timeout = active.Timeout.Duration
+	}
+	ctx, cancel := context.WithTimeout(channel.WithHTTPEndpointBaseURL(ctx, baseURL), timeout)
+	defer cancel()
+
+	req := invokev1.NewInvokeMethodRequest(active.Path).
+		WithHTTPExtension(http.MethodGet, "")
+	defer req.Close()
+
+	resp, err := ch.InvokeMethod(ctx, req, endpoint.Name)
+	if err != nil {
+		log.Debugf("Health probe of base URL %s of HTTP endpoint %s failed: %s", baseURL, endpoint.Name, err)
+		return false
+	}
+	defer resp.Close()
+
+	code := resp.Status().GetCode()
+	return code >= http.StatusOK && code < http.StatusBadRequest
+}
+
+func (s *urlState) healthy(now time.Time) bool {
+	return s == nil || (!s.probeUnhealthy && !now.Before(s.ejectedUntil))
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpendpoints
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	"github.com/dapr/dapr/pkg/channel"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/runtime/channels"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+)
+
+func testEndpoint(spec httpendpointsapi.HTTPEndpointSpec) httpendpointsapi.HTTPEndpoint {
+	if len(spec.BaseURL) == 0 && len(spec.BaseURLs) == 0 {
+		spec.BaseURL = "http://a"
+		spec.BaseURLs = []string{"http://b", "http://c"}
+	}
+	return httpendpointsapi.HTTPEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-endpoint"},
+		Spec:       spec,
+	}
+}
+
+func TestPick(t *testing.T) {
+	t.Run("single base URL", func(t *testing.T) {
+		b := New(Options{})
+		e := testEndpoint(httpendpointsapi.HTTPEndpointSpec{BaseURL: "http://a"})
+		assert.Equal(t, "http://a", b.Pick(e))
+		assert.Equal(t, "http://a", b.Pick(e))
+	})
+
+	t.Run("nil balancer picks the first base URL", func(t *testing.T) {
+		var b *Balancer
+		assert.Equal(t, "http://a", b.Pick(testEndpoint(httpendpointsapi.HTTPEndpointSpec{})))
+	})
+
+	t.Run("round robin", func(t *testing.T) {
+		b := New(Options{})
+		e := testEndpoint(httpendpointsapi.HTTPEndpointSpec{})
+		var got []string
+		for range 4 {
+			got = append(got, b.Pick(e))
+		}
+		assert.Equal(t, []string{"http://a", "http://b", "http://c", "http://a"}, got)
+	})
+
+	t.Run("priority", func(t *testing.T) {
+		b := New(Options{})
+		e := testEndpoint(httpendpointsapi.HTTPEndpointSpec{
+			LoadBalancing: &httpendpointsapi.LoadBalancing{Strategy: httpendpointsapi.LoadBalancingPriority},
+		})
+		assert.Equal(t, "http://a", b.Pick(e))
+		assert.Equal(t, "http://a", b.Pick(e))
+	})
+}
+
+func TestPassiveHealthCheck(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Now())
+	b := New(Options{Clock: clock})
+	e := testEndpoint(httpendpointsapi.HTTPEndpointSpec{
+		LoadBalancing: &httpendpointsapi.LoadBalancing{Strategy: httpendpointsapi.LoadBalancingPriority},
+		HealthCheck: &httpendpointsapi.HealthCheck{
+			Passive: &httpendpointsapi.PassiveHealthCheck{
+				FailureThreshold: 2,
+				EjectionDuration: &metav1.Duration{Duration: time.Minute},
+			},
+		},
+	})
+
+	b.Report(e, "http://a", false)
+	b.Report(e, "http://a", true)
+	b.Report(e, "http://a", false)
+	assert.Equal(t, "http://a", b.Pick(e), "failures must be consecutive")
+
+	b.Report(e, "http://a", false)
+	assert.Equal(t, "http://b", b.Pick(e))
+	assert.Equal(t, []URLStatus{
+		{URL: "http://a", Healthy: false},
+		{URL: "http://b", Healthy: true},
+		{URL: "http://c", Healthy: true},
+	}, b.Status(e))
+
+	for _, u := range []string{"http://b", "http://b", "http://c", "http://c"} {
+		b.Report(e, u, false)
+	}
+	assert.Equal(t, "http://a", b.Pick(e), "all base URLs are used when all are unhealthy")
+
+	clock.Step(time.Minute)
+	assert.Equal(t, "http://a", b.Pick(e))
+	for _, s := range b.Status(e) {
+		assert.True(t, s.Healthy, s.URL)
+	}
+}
+
+func TestStatus(t *testing.T) {
+	var b *Balancer
+	e := testEndpoint(httpendpointsapi.HTTPEndpointSpec{})
+	assert.Equal(t, []URLStatus{
+		{URL: "http://a", Healthy: true},
+		{URL: "http://b", Healthy: true},
+		{URL: "http://c", Healthy: true},
+	}, b.Status(e))
+}
+
+type fakeChannel struct {
+	lock      sync.Mutex
+	unhealthy map[string]bool
+	probes    map[string]int
+}
+
+func (f *fakeChannel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRequest, appID string) (*invokev1.InvokeMethodResponse, error) {
+	baseURL, _ := channel.HTTPEndpointBaseURL(ctx)
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.probes[baseURL+"/"+req.Message().GetMethod()]++
+	if f.unhealthy[baseURL] {
+		return invokev1.NewInvokeMethodResponse(http.StatusServiceUnavailable, "", nil), nil
+	}
+	return invokev1.NewInvokeMethodResponse(http.StatusOK, "", nil), nil
+}
+
+func (f *fakeChannel) setUnhealthy(baseURL string, unhealthy bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.unhealthy[baseURL] = unhealthy
+}
+
+func (f *fakeChannel) probeCount(baseURL string) int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.probes[baseURL+"/healthz"]
+}
+
+func TestActiveHealthCheck(t *testing.T) {
+	e := testEndpoint(httpendpointsapi.HTTPEndpointSpec{
+		LoadBalancing: &httpendpointsapi.LoadBalancing{Strategy: httpendpointsapi.LoadBalancingPriority},
+		HealthCheck: &httpendpointsapi.HealthCheck{
+			Active: &httpendpointsapi.ActiveHealthCheck{
+				Path:             "healthz",
+				Interval:         &metav1.Duration{Duration: time.Second * 5},
+				FailureThreshold: 2,
+			},
+		},
+	})
+
+	store := compstore.New()
+	store.AddHTTPEndpoint(e)
+
+	ch := &fakeChannel{
+		unhealthy: map[string]bool{"http://a": true},
+		probes:    make(map[string]int),
+	}
+	clock := clocktesting.NewFakeClock(time.Now())
+	b := New(Options{
+		CompStore: store,
+		Channels: new(channels.Channels).WithEndpointChannels(map[string]channel.HTTPEndpointAppChannel{
+			"my-endpoint": ch,
+		}),
+		Clock: clock,
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	errCh := make(chan error)
+	go func() { errCh <- b.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+
+	// probeRound advances the clock to the next probe and waits for it to be
+	// sent to every base URL.
+	probeRound := func(round int) {
+		t.Helper()
+		assert.Eventually(t, clock.HasWaiters, time.Second*5, time.Millisecond*10)
+		clock.Step(time.Second * 5)
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			for _, u := range []string{"http://a", "http://b", "http://c"} {
+				assert.Equal(c, round, ch.probeCount(u))
+			}
+			b.lock.Lock()
+			defer b.lock.Unlock()
+			assert.False(c, b.pools["my-endpoint"].probing)
+		}, time.Second*5, time.Millisecond*10)
+	}
+
+	probeRound(1)
+	assert.Equal(t, "http://a", b.Pick(e), "unhealthy after a single failed probe")
+
+	probeRound(2)
+	assert.Equal(t, "http://b", b.Pick(e))
+	assert.False(t, b.Status(e)[0].Healthy)
+
+	ch.setUnhealthy("http://a", false)
+	probeRound(3)
+	assert.Equal(t, "http://a", b.Pick(e))
+	assert.True(t, b.Status(e)[0].Healthy)
+
+	t.Run("removed endpoints are forgotten", func(t *testing.T) {
+		store.DeleteHTTPEndpoint("my-endpoint")
+		assert.Eventually(t, clock.HasWaiters, time.Second*5, time.Millisecond*10)
+		clock.Step(time.Second)
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			b.lock.Lock()
+			defer b.lock.Unlock()
+			assert.Empty(c, b.pools)
+		}, time.Second*5, time.Millisecond*10)
+	})
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+)
+
+const (
+	// oauth2RefreshBefore is how long before its expiry a cached OAuth2 token
+	// is refreshed.
+	oauth2RefreshBefore = time.Minute
+
+	authorizationHeader = "authorization"
+)
+
+// oauth2Tokens caches the OAuth2 client credentials tokens of HTTP endpoints.
+type oauth2Tokens struct {
+	compStore *compstore.ComponentStore
+	lock      sync.Mutex
+	sources   map[string]*oauth2TokenSource
+}
+
+// oauth2TokenSource holds the token of a single HTTP endpoint.
+type oauth2TokenSource struct {
+	lock   sync.Mutex
+	config *clientcredentials.Config
+	token  *oauth2.Token
+}
+
+func newOAuth2Tokens(compStore *compstore.ComponentStore) *oauth2Tokens {
+	return &oauth2Tokens{
+		compStore: compStore,
+		sources:   make(map[string]*oauth2TokenSource),
+	}
+}
+
+// authorize sets the bearer token of the given HTTP endpoint on the request,
+// obtaining a new token if the cached one is missing or about to expire.
+// Tokens are obtained with the given HTTP client of the endpoint, so that the
+// token URL is called with the client TLS configuration of the endpoint.
+func (o *oauth2Tokens) authorize(ctx context.Context, endpoint httpendpointsapi.HTTPEndpoint, client *http.Client, req *invokev1.InvokeMethodRequest) error {
+	if !endpoint.HasOAuth2() {
+		return nil
+	}
+
+	if client != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, client)
+	}
+	token, err := o.source(endpoint).get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to obtain OAuth2 token for http endpoint %s: %w", endpoint.Name, err)
+	}
+
+	value := token.Type() + " " + token.AccessToken
+	md := req.Metadata()
+	if md == nil {
+		req.WithMetadata(map[string][]string{authorizationHeader: {value}})
+		return nil
+	}
+	for k := range md {
+		if strings.EqualFold(k, authorizationHeader) {
+			delete(md, k)
+		}
+	}
+	md[authorizationHeader] = &internalv1pb.ListStringValue{
+		Values: []string{value},
+	}
+
+	return nil
+}
+
+// invalidate drops the cached token of the given HTTP endpoint, so that a new
+// one is obtained on the next request.
+func (o *oauth2Tokens) invalidate(name string) {
+	o.lock.Lock()
+	source, ok := o.sources[name]
+	o.lock.Unlock()
+	if !ok {
+		return
+	}
+
+	source.lock.Lock()
+	source.token = nil
+	source.lock.Unlock()
+}
+
+// source returns the token source of the given HTTP endpoint, replacing it if
+// the endpoint's OAuth2 configuration changed. Whenever a token source is
+// created, the token sources of HTTP endpoints which have been deleted are
+// dropped, so that their credentials and tokens aren't kept.
+func (o *oauth2Tokens) source(endpoint httpendpointsapi.HTTPEndpoint) *oauth2TokenSource {
+	auth := endpoint.Spec.Auth.OAuth2
+	config := &clientcredentials.Config{
+		ClientID:     auth.ClientID.String(),
+		ClientSecret: auth.ClientSecret.String(),
+		TokenURL:     auth.TokenURL,
+		Scopes:       auth.Scopes,
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	source, ok := o.sources[endpoint.Name]
+	if !ok || !sameOAuth2Config(source.config, config) {
+		o.pruneLocked()
+		source = &oauth2TokenSource{config: config}
+		o.sources[endpoint.Name] = source
+	}
+
+	return source
+}
+
+// pruneLocked drops the token sources of HTTP endpoints which are no longer
+// in the component store.
+func (o *oauth2Tokens) pruneLocked() {
+	if o.compStore == nil {
+		return
+	}
+	for name := range o.sources {
+		if _, ok := o.compStore.GetHTTPEndpoint(name); !ok {
+			delete(o.sources, name)
+		}
+	}
+}
+
+func (s *oauth2TokenSource) get(ctx context.Context) (*oauth2.Token, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.token != nil && (s.token.Expiry.IsZero() || time.Until(s.token.Expiry) > oauth2RefreshBefore) {
+		return s.token, nil
+	}
+
+	token, err := s.config.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.token = token
+
+	return token, nil
+}
+
+func sameOAuth2Config(a, b *clientcredentials.Config) bool {
+	return a.ClientID == b.ClientID &&
+		a.ClientSecret == b.ClientSecret &&
+		a.TokenURL == b.TokenURL &&
+		slices.Equal(a.Scopes, b.Scopes)
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextapi "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonapi "github.com/dapr/dapr/pkg/apis/common"
+	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/runtime/compstore"
+)
+
+func TestOAuth2Tokens(t *testing.T) {
+	var calls atomic.Int32
+	var expiresIn atomic.Int32
+	expiresIn.Store(3600)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "my-client" || secret != "my-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		n := calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":%d}`, n, expiresIn.Load())
+	})
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	endpoint := func(secret string) httpendpointsapi.HTTPEndpoint {
+		return httpendpointsapi.HTTPEndpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-endpoint"},
+			Spec: httpendpointsapi.HTTPEndpointSpec{
+				BaseURL: "http://api.example.com",
+				Auth: &httpendpointsapi.EndpointAuth{
+					OAuth2: &httpendpointsapi.OAuth2ClientCredentials{
+						TokenURL: srv.URL,
+						ClientID: httpendpointsapi.SecretValue{
+							Value: &commonapi.DynamicValue{JSON: apiextapi.JSON{Raw: []byte(`"my-client"`)}},
+						},
+						ClientSecret: httpendpointsapi.SecretValue{
+							Value: &commonapi.DynamicValue{JSON: apiextapi.JSON{Raw: []byte(`"` + secret + `"`)}},
+						},
+						Scopes: []string{"read"},
+					},
+				},
+			},
+		}
+	}
+
+	authorize := func(t *testing.T, o *oauth2Tokens, e httpendpointsapi.HTTPEndpoint) string {
+		t.Helper()
+		req := invokev1.NewInvokeMethodRequest("method").WithMetadata(map[string][]string{
+			"Authorization": {"Bearer from-caller"},
+		})
+		t.Cleanup(func() { _ = req.Close() })
+		require.NoError(t, o.authorize(t.Context(), e, nil, req))
+		md := req.Metadata()
+		assert.NotContains(t, md, "Authorization")
+		return md[authorizationHeader].GetValues()[0]
+	}
+
+	t.Run("endpoint without oauth2 is left untouched", func(t *testing.T) {
+		o := newOAuth2Tokens(nil)
+		req := invokev1.NewInvokeMethodRequest("method")
+		t.Cleanup(func() { _ = req.Close() })
+		require.NoError(t, o.authorize(t.Context(), httpendpointsapi.HTTPEndpoint{}, nil, req))
+		assert.NotContains(t, req.Metadata(), authorizationHeader)
+		assert.Equal(t, int32(0), calls.Load())
+	})
+
+	t.Run("token is cached until it is about to expire", func(t *testing.T) {
+		calls.Store(0)
+		o := newOAuth2Tokens(nil)
+		assert.Equal(t, "Bearer token-1", authorize(t, o, endpoint("my-secret")))
+		assert.Equal(t, "Bearer token-1", authorize(t, o, endpoint("my-secret")))
+		assert.Equal(t, int32(1), calls.Load())
+
+		expiresIn.Store(30)
+		t.Cleanup(func() { expiresIn.Store(3600) })
+		o.invalidate("my-endpoint")
+		assert.Equal(t, "Bearer token-2", authorize(t, o, endpoint("my-secret")))
+		assert.Equal(t, "Bearer token-3", authorize(t, o, endpoint("my-secret")))
+	})
+
+	t.Run("changed configuration obtains a new token", func(t *testing.T) {
+		calls.Store(0)
+		o := newOAuth2Tokens(nil)
+		assert.Equal(t, "Bearer token-1", authorize(t, o, endpoint("my-secret")))
+
+		e := endpoint("other-secret")
+		req := invokev1.NewInvokeMethodRequest("method")
+		t.Cleanup(func() { _ = req.Close() })
+		require.Error(t, o.authorize(t.Context(), e, nil, req))
+		assert.NotContains(t, req.Metadata(), authorizationHeader)
+	})
+
+	t.Run("invalidated token is obtained again", func(t *testing.T) {
+		calls.Store(0)
+		o := newOAuth2Tokens(nil)
+		assert.Equal(t, "Bearer token-1", authorize(t, o, endpoint("my-secret")))
+		o.invalidate("my-endpoint")
+		o.invalidate("unknown-endpoint")
+		assert.Equal(t, "Bearer token-2", authorize(t, o, endpoint("my-secret")))
+	})
+
+	t.Run("token is obtained with the HTTP client of the endpoint", func(t *testing.T) {
+		calls.Store(0)
+		tlsSrv := httptest.NewTLSServer(handler)
+		t.Cleanup(tlsSrv.Close)
+
+		e := endpoint("my-secret")
+		e.Spec.Auth.OAuth2.TokenURL = tlsSrv.URL
+
+		// The token server isn't trusted without the root CA of the endpoint.
+		o := newOAuth2Tokens(nil)
+		req := invokev1.NewInvokeMethodRequest("method")
+		t.Cleanup(func() { _ = req.Close() })
+		require.Error(t, o.authorize(t.Context(), e, nil, req))
+
+		require.NoError(t, o.authorize(t.Context(), e, tlsSrv.Client(), req))
+		assert.Equal(t, "Bearer token-1", req.Metadata()[authorizationHeader].GetValues()[0])
+	})
+
+	t.Run("token sources of deleted endpoints are dropped", func(t *testing.T) {
+		calls.Store(0)
+		store := compstore.New()
+		e := endpoint("my-secret")
+		other := endpoint("my-secret")
+		other.Name = "other-endpoint"
+		store.AddHTTPEndpoint(e)
+		store.AddHTTPEndpoint(other)
+
+		o := newOAuth2Tokens(store)
+		assert.Equal(t, "Bearer token-1", authorize(t, o, e))
+		assert.Contains(t, o.sources, "my-endpoint")
+
+		store.DeleteHTTPEndpoint("my-endpoint")
+		assert.Equal(t, "Bearer token-2", authorize(t, o, other))
+		assert.NotContains(t, o.sources, "my-endpoint")
+		assert.Contains(t, o.sources, "other-endpoint")
+	})
+}
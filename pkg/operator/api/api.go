@@ -27,7 +27,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	configurationapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
 	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	"github.com/dapr/dapr/pkg/operator/api/informer"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
@@ -45,7 +47,10 @@ const (
 var log = logger.NewLogger("dapr.operator.api")
 
 type Options struct {
-	Client        client.Client
+	Client client.Client
+	// APIReader reads objects which aren't cached, such as the pods of
+	// sidecars. Defaults to Client.
+	APIReader     client.Reader
 	Cache         cache.Cache
 	Security      security.Provider
 	Port          int
@@ -64,11 +69,14 @@ type Server interface {
 type apiServer struct {
 	operatorv1pb.UnimplementedOperatorServer
 	Client        client.Client
+	apiReader     client.Reader
 	sec           security.Provider
 	port          string
 	listenAddress string
 
-	compInformer informer.Interface[componentsapi.Component]
+	compInformer   informer.Interface[componentsapi.Component]
+	resInformer    informer.Interface[resiliencyapi.Resiliency]
+	configInformer informer.Interface[configurationapi.Configuration]
 
 	endpointLock              sync.Mutex
 	allEndpointsUpdateChan    map[string]chan *httpendpointsapi.HTTPEndpoint
@@ -81,11 +89,22 @@ type apiServer struct {
 
 // NewAPIServer returns a new API server.
 func NewAPIServer(opts Options) Server {
+	apiReader := opts.APIReader
+	if apiReader == nil {
+		apiReader = opts.Client
+	}
 	return &apiServer{
-		Client: opts.Client,
+		Client:    opts.Client,
+		apiReader: apiReader,
 		compInformer: informer.New[componentsapi.Component](informer.Options{
 			Cache: opts.Cache,
 		}),
+		resInformer: informer.New[resiliencyapi.Resiliency](informer.Options{
+			Cache: opts.Cache,
+		}),
+		configInformer: informer.New[configurationapi.Configuration](informer.Options{
+			Cache: opts.Cache,
+		}),
 		sec:                       opts.Security,
 		port:                      strconv.Itoa(opts.Port),
 		listenAddress:             opts.ListenAddress,
@@ -119,6 +138,9 @@ func (a *apiServer) Run(ctx context.Context) error {
 
 	return concurrency.NewRunnerManager(
 		a.compInformer.Run,
+		a.resInformer.Run,
+		a.configInformer.Run,
+		a.runStatusGC,
 		func(ctx context.Context) error {
 			if err := s.Serve(lis); err != nil {
 				return fmt.Errorf("gRPC server error: %w", err)
@@ -17,6 +17,9 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -27,14 +30,20 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	corev1 "k8s.io/api/core/v1"
+	apiextapi "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/yaml"
 
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	configurationapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
 	httpendpointapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	subscriptionsapiV2alpha1 "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
@@ -43,6 +52,7 @@ import (
 	informerfake "github.com/dapr/dapr/pkg/operator/api/informer/fake"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 	"github.com/dapr/kit/crypto/test"
+	"github.com/dapr/kit/ptr"
 )
 
 type mockComponentUpdateServer struct {
@@ -193,6 +203,36 @@ func TestProcessComponentSecrets(t *testing.T) {
 	})
 }
 
+type mockResiliencyUpdateServer struct {
+	grpc.ServerStream
+	events []*operatorv1pb.ResiliencyUpdateEvent
+	ctx    context.Context
+}
+
+func (m *mockResiliencyUpdateServer) Send(event *operatorv1pb.ResiliencyUpdateEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *mockResiliencyUpdateServer) Context() context.Context {
+	return m.ctx
+}
+
+type mockConfigurationUpdateServer struct {
+	grpc.ServerStream
+	events []*operatorv1pb.ConfigurationUpdateEvent
+	ctx    context.Context
+}
+
+func (m *mockConfigurationUpdateServer) Send(event *operatorv1pb.ConfigurationUpdateEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *mockConfigurationUpdateServer) Context() context.Context {
+	return m.ctx
+}
+
 func TestComponentUpdate(t *testing.T) {
 	appID := spiffeid.RequireFromString("spiffe://example.org/ns/ns1/app1")
 	serverID := spiffeid.RequireFromString("spiffe://example.org/ns/dapr-system/dapr-operator")
@@ -314,6 +354,94 @@ func TestComponentUpdate(t *testing.T) {
 	})
 }
 
+func TestResiliencyUpdate(t *testing.T) {
+	appID := spiffeid.RequireFromString("spiffe://example.org/ns/ns1/app1")
+	serverID := spiffeid.RequireFromString("spiffe://example.org/ns/dapr-system/dapr-operator")
+	pki := test.GenPKI(t, test.PKIOptions{
+		LeafID:   serverID,
+		ClientID: appID,
+	})
+
+	t.Run("watch error is returned", func(t *testing.T) {
+		api := NewAPIServer(Options{}).(*apiServer)
+		api.resInformer = informerfake.New[resiliencyapi.Resiliency]().
+			WithWatchUpdates(func(context.Context, string) (<-chan *informer.Event[resiliencyapi.Resiliency], error) {
+				return nil, status.Error(codes.PermissionDenied, "denied")
+			})
+
+		mockSidecar := &mockResiliencyUpdateServer{ctx: pki.ClientGRPCCtx(t)}
+		err := api.ResiliencyUpdate(&operatorv1pb.ResiliencyUpdateRequest{Namespace: "ns2"}, mockSidecar)
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Empty(t, mockSidecar.events)
+	})
+
+	t.Run("sidecar is sent resiliency events", func(t *testing.T) {
+		api := NewAPIServer(Options{}).(*apiServer)
+		api.resInformer = informerfake.New[resiliencyapi.Resiliency]().
+			WithWatchUpdates(func(context.Context, string) (<-chan *informer.Event[resiliencyapi.Resiliency], error) {
+				ch := make(chan *informer.Event[resiliencyapi.Resiliency], 2)
+				ch <- &informer.Event[resiliencyapi.Resiliency]{
+					Manifest: resiliencyapi.Resiliency{ObjectMeta: metav1.ObjectMeta{Name: "res1", Namespace: "ns1"}},
+					Type:     operatorv1pb.ResourceEventType_CREATED,
+				}
+				ch <- &informer.Event[resiliencyapi.Resiliency]{
+					Manifest: resiliencyapi.Resiliency{ObjectMeta: metav1.ObjectMeta{Name: "res1", Namespace: "ns1"}},
+					Type:     operatorv1pb.ResourceEventType_DELETED,
+				}
+				close(ch)
+				return ch, nil
+			})
+
+		mockSidecar := &mockResiliencyUpdateServer{ctx: pki.ClientGRPCCtx(t)}
+		require.NoError(t, api.ResiliencyUpdate(&operatorv1pb.ResiliencyUpdateRequest{Namespace: "ns1"}, mockSidecar))
+
+		require.Len(t, mockSidecar.events, 2)
+		assert.Equal(t, operatorv1pb.ResourceEventType_CREATED, mockSidecar.events[0].GetType())
+		assert.Equal(t, operatorv1pb.ResourceEventType_DELETED, mockSidecar.events[1].GetType())
+		var res resiliencyapi.Resiliency
+		require.NoError(t, json.Unmarshal(mockSidecar.events[0].GetResiliency(), &res))
+		assert.Equal(t, "res1", res.Name)
+	})
+}
+
+func TestConfigurationUpdate(t *testing.T) {
+	appID := spiffeid.RequireFromString("spiffe://example.org/ns/ns1/app1")
+	serverID := spiffeid.RequireFromString("spiffe://example.org/ns/dapr-system/dapr-operator")
+	pki := test.GenPKI(t, test.PKIOptions{
+		LeafID:   serverID,
+		ClientID: appID,
+	})
+
+	api := NewAPIServer(Options{}).(*apiServer)
+	api.configInformer = informerfake.New[configurationapi.Configuration]().
+		WithWatchUpdates(func(context.Context, string) (<-chan *informer.Event[configurationapi.Configuration], error) {
+			ch := make(chan *informer.Event[configurationapi.Configuration], 2)
+			ch <- &informer.Event[configurationapi.Configuration]{
+				Manifest: configurationapi.Configuration{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ns1"}},
+				Type:     operatorv1pb.ResourceEventType_UPDATED,
+			}
+			ch <- &informer.Event[configurationapi.Configuration]{
+				Manifest: configurationapi.Configuration{ObjectMeta: metav1.ObjectMeta{Name: "appconfig", Namespace: "ns1"}},
+				Type:     operatorv1pb.ResourceEventType_UPDATED,
+			}
+			close(ch)
+			return ch, nil
+		})
+
+	mockSidecar := &mockConfigurationUpdateServer{ctx: pki.ClientGRPCCtx(t)}
+	require.NoError(t, api.ConfigurationUpdate(&operatorv1pb.ConfigurationUpdateRequest{
+		Name:      "appconfig",
+		Namespace: "ns1",
+	}, mockSidecar))
+
+	// Only updates to the configuration of the sidecar are sent.
+	require.Len(t, mockSidecar.events, 1)
+	var config configurationapi.Configuration
+	require.NoError(t, json.Unmarshal(mockSidecar.events[0].GetConfiguration(), &config))
+	assert.Equal(t, "appconfig", config.Name)
+}
+
 func TestHTTPEndpointUpdate(t *testing.T) {
 	appID := spiffeid.RequireFromString("spiffe://example.org/ns/ns1/app1")
 	serverID := spiffeid.RequireFromString("spiffe://example.org/ns/dapr-system/dapr-operator")
@@ -780,6 +908,53 @@ func TestProcessHTTPEndpointSecrets(t *testing.T) {
 		require.NoError(t, err)
 		assert.JSONEq(t, string(jsonEnc), string(e.Spec.Headers[0].Value.Raw))
 	})
+
+	t.Run("oauth2 client secret ref, kubernetes secret store, secret extracted", func(t *testing.T) {
+		e := httpendpointapi.HTTPEndpoint{
+			Spec: httpendpointapi.HTTPEndpointSpec{
+				BaseURL: "http://test.com/",
+				Auth: &httpendpointapi.EndpointAuth{
+					OAuth2: &httpendpointapi.OAuth2ClientCredentials{
+						TokenURL: "http://test.com/token",
+						ClientID: httpendpointapi.SecretValue{
+							Value: &commonapi.DynamicValue{JSON: apiextapi.JSON{Raw: []byte(`"my-client"`)}},
+						},
+						ClientSecret: httpendpointapi.SecretValue{
+							SecretKeyRef: &commonapi.SecretKeyRef{
+								Name: "secret1",
+								Key:  "key1",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		s := runtime.NewScheme()
+		require.NoError(t, scheme.AddToScheme(s))
+		require.NoError(t, corev1.AddToScheme(s))
+
+		client := fake.NewClientBuilder().
+			WithScheme(s).
+			WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret1",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"key1": []byte("value1"),
+				},
+			}).
+			Build()
+
+		require.NoError(t, processHTTPEndpointSecrets(t.Context(), &e, "default", client))
+
+		enc := base64.StdEncoding.EncodeToString([]byte("value1"))
+		jsonEnc, err := json.Marshal(enc)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(jsonEnc), string(e.Spec.Auth.OAuth2.ClientSecret.Value.Raw))
+		assert.Equal(t, "my-client", e.Spec.Auth.OAuth2.ClientID.String())
+	})
 }
 
 func Test_Ready(t *testing.T) {
@@ -818,3 +993,335 @@ func Test_Ready(t *testing.T) {
 		})
 	}
 }
+
+type mockReportResourceStatusServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	reqs   chan *operatorv1pb.ReportResourceStatusRequest
+	closed atomic.Bool
+}
+
+func (m *mockReportResourceStatusServer) Recv() (*operatorv1pb.ReportResourceStatusRequest, error) {
+	req, ok := <-m.reqs
+	if !ok {
+		return nil, io.EOF
+	}
+	return req, nil
+}
+
+func (m *mockReportResourceStatusServer) SendAndClose(*emptypb.Empty) error {
+	m.closed.Store(true)
+	return nil
+}
+
+func (m *mockReportResourceStatusServer) Context() context.Context {
+	return m.ctx
+}
+
+func testAppPod(name, appID string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "namespace-a",
+			Annotations: map[string]string{"dapr.io/app-id": appID},
+		},
+	}
+}
+
+func TestReportResourceStatus(t *testing.T) {
+	appID := spiffeid.RequireFromString("spiffe://example.org/ns/namespace-a/app1")
+	serverID := spiffeid.RequireFromString("spiffe://example.org/ns/dapr-system/dapr-operator")
+	pki := test.GenPKI(t, test.PKIOptions{
+		LeafID:   serverID,
+		ClientID: appID,
+	})
+
+	s := runtime.NewScheme()
+	require.NoError(t, scheme.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, componentsapi.AddToScheme(s))
+
+	comp := &componentsapi.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "comp1",
+			Namespace:  "namespace-a",
+			Generation: 2,
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(comp, testAppPod("pod-a", "app1"), testAppPod("pod-b", "app1"), testAppPod("pod-other", "app2")).
+		WithStatusSubresource(comp).
+		Build()
+
+	api := NewAPIServer(Options{Client: cl}).(*apiServer)
+
+	connect := func(podName string) (*mockReportResourceStatusServer, chan error) {
+		stream := &mockReportResourceStatusServer{
+			ctx:  pki.ClientGRPCCtx(t),
+			reqs: make(chan *operatorv1pb.ReportResourceStatusRequest),
+		}
+		errCh := make(chan error, 1)
+		go func() { errCh <- api.ReportResourceStatus(stream) }()
+		return stream, errCh
+	}
+
+	report := func(stream *mockReportResourceStatusServer, podName string, condition operatorv1pb.ResourceConditionStatus, message string) {
+		result := &operatorv1pb.ResourceResult{
+			ResourceType:       operatorv1pb.ResourceType_RESOURCE_COMPONENT,
+			EventType:          operatorv1pb.EventType_EVENT_INIT,
+			Name:               "comp1",
+			Condition:          condition,
+			ObservedGeneration: 2,
+		}
+		if len(message) > 0 {
+			result.Message = &message
+		}
+		stream.reqs <- &operatorv1pb.ReportResourceStatusRequest{
+			Namespace: "namespace-a",
+			PodName:   podName,
+			Result:    result,
+		}
+	}
+
+	getStatus := func(t *testing.T) commonapi.ResourceStatus {
+		t.Helper()
+		var got componentsapi.Component
+		require.NoError(t, cl.Get(t.Context(), client.ObjectKeyFromObject(comp), &got))
+		return got.Status
+	}
+
+	t.Run("pods of other apps are rejected", func(t *testing.T) {
+		for _, podName := range []string{"pod-other", "pod-unknown"} {
+			stream, errCh := connect(podName)
+			report(stream, podName, operatorv1pb.ResourceConditionStatus_STATUS_SUCCESS, "")
+			err := <-errCh
+			require.Error(t, err)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			assert.Empty(t, getStatus(t).Instances)
+		}
+	})
+
+	streamA, errChA := connect("pod-a")
+	streamB, errChB := connect("pod-b")
+
+	report(streamA, "pod-a", operatorv1pb.ResourceConditionStatus_STATUS_SUCCESS, "")
+	report(streamB, "pod-b", operatorv1pb.ResourceConditionStatus_STATUS_FAILURE, "init error")
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		st := getStatus(t)
+		assert.Equal(c, int32(1), st.LoadedInstances)
+		assert.Equal(c, "init error", st.LastError)
+		assert.Equal(c, int64(2), st.ObservedGeneration)
+		assert.True(c, apimeta.IsStatusConditionFalse(st.Conditions, commonapi.ConditionTypeReady))
+		assert.True(c, apimeta.IsStatusConditionTrue(st.Conditions, commonapi.ConditionTypeInitFailed))
+	}, time.Second*5, time.Millisecond*10)
+
+	// Results of a sidecar are dropped when its stream ends.
+	close(streamB.reqs)
+	require.NoError(t, <-errChB)
+	assert.True(t, streamB.closed.Load())
+
+	st := getStatus(t)
+	assert.Equal(t, int32(1), st.LoadedInstances)
+	assert.Empty(t, st.LastError)
+	assert.True(t, apimeta.IsStatusConditionTrue(st.Conditions, commonapi.ConditionTypeReady))
+	assert.True(t, apimeta.IsStatusConditionFalse(st.Conditions, commonapi.ConditionTypeInitFailed))
+
+	close(streamA.reqs)
+	require.NoError(t, <-errChA)
+
+	st = getStatus(t)
+	assert.Equal(t, int32(0), st.LoadedInstances)
+	cond := apimeta.FindStatusCondition(st.Conditions, commonapi.ConditionTypeReady)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, "NotLoaded", cond.Reason)
+}
+
+func TestReportResourceStatusMultipleOperators(t *testing.T) {
+	appID := spiffeid.RequireFromString("spiffe://example.org/ns/namespace-a/app1")
+	serverID := spiffeid.RequireFromString("spiffe://example.org/ns/dapr-system/dapr-operator")
+	pki := test.GenPKI(t, test.PKIOptions{
+		LeafID:   serverID,
+		ClientID: appID,
+	})
+
+	s := runtime.NewScheme()
+	require.NoError(t, scheme.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, componentsapi.AddToScheme(s))
+
+	comp := &componentsapi.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "comp1",
+			Namespace:  "namespace-a",
+			Generation: 1,
+		},
+	}
+	const podsPerOperator = 5
+	objs := []client.Object{comp}
+	for i := range 2 * podsPerOperator {
+		objs = append(objs, testAppPod(fmt.Sprintf("pod-%d", i), "app1"))
+	}
+	// The fake client doesn't apply patches atomically, so they are
+	// serialized as they are by the API server. Operator instances can still
+	// patch the status based on a stale read, which conflicts.
+	var patchLock sync.Mutex
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(comp).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourcePatch: func(ctx context.Context, cl client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+				patchLock.Lock()
+				defer patchLock.Unlock()
+				return cl.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+			},
+		}).
+		Build()
+
+	// Two operator instances sharing the same API server, each with its own
+	// connected sidecars.
+	apis := []*apiServer{
+		NewAPIServer(Options{Client: cl}).(*apiServer),
+		NewAPIServer(Options{Client: cl}).(*apiServer),
+	}
+
+	streams := make([]*mockReportResourceStatusServer, 0, 2*podsPerOperator)
+	errChs := make([]chan error, 0, 2*podsPerOperator)
+	for i := range 2 * podsPerOperator {
+		stream := &mockReportResourceStatusServer{
+			ctx:  pki.ClientGRPCCtx(t),
+			reqs: make(chan *operatorv1pb.ReportResourceStatusRequest),
+		}
+		errCh := make(chan error, 1)
+		go func(api *apiServer) { errCh <- api.ReportResourceStatus(stream) }(apis[i%2])
+		streams = append(streams, stream)
+		errChs = append(errChs, errCh)
+	}
+
+	// Sidecars connected to the second operator fail to load the component.
+	var wg sync.WaitGroup
+	for i, stream := range streams {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := &operatorv1pb.ResourceResult{
+				ResourceType:       operatorv1pb.ResourceType_RESOURCE_COMPONENT,
+				EventType:          operatorv1pb.EventType_EVENT_INIT,
+				Name:               "comp1",
+				Condition:          operatorv1pb.ResourceConditionStatus_STATUS_SUCCESS,
+				ObservedGeneration: 1,
+			}
+			if i%2 == 1 {
+				result.Condition = operatorv1pb.ResourceConditionStatus_STATUS_FAILURE
+				result.Message = ptr.Of("init error")
+			}
+			stream.reqs <- &operatorv1pb.ReportResourceStatusRequest{
+				Namespace: "namespace-a",
+				PodName:   fmt.Sprintf("pod-%d", i),
+				Result:    result,
+			}
+		}()
+	}
+	wg.Wait()
+
+	getStatus := func(t require.TestingT) commonapi.ResourceStatus {
+		var got componentsapi.Component
+		require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(comp), &got))
+		return got.Status
+	}
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		st := getStatus(c)
+		assert.Len(c, st.Instances, 2*podsPerOperator)
+		assert.Equal(c, int32(podsPerOperator), st.LoadedInstances)
+		assert.Equal(c, "init error", st.LastError)
+		assert.True(c, apimeta.IsStatusConditionTrue(st.Conditions, commonapi.ConditionTypeInitFailed))
+	}, time.Second*5, time.Millisecond*10)
+
+	// Disconnecting the sidecars of the second operator only removes their
+	// entries.
+	for i := 1; i < len(streams); i += 2 {
+		close(streams[i].reqs)
+		require.NoError(t, <-errChs[i])
+	}
+
+	st := getStatus(t)
+	assert.Len(t, st.Instances, podsPerOperator)
+	for i := 0; i < len(streams); i += 2 {
+		assert.Contains(t, st.Instances, fmt.Sprintf("pod-%d", i))
+	}
+	assert.Equal(t, int32(podsPerOperator), st.LoadedInstances)
+	assert.Empty(t, st.LastError)
+	assert.True(t, apimeta.IsStatusConditionTrue(st.Conditions, commonapi.ConditionTypeReady))
+	assert.True(t, apimeta.IsStatusConditionFalse(st.Conditions, commonapi.ConditionTypeInitFailed))
+
+	for i := 0; i < len(streams); i += 2 {
+		close(streams[i].reqs)
+		require.NoError(t, <-errChs[i])
+	}
+
+	st = getStatus(t)
+	assert.Empty(t, st.Instances)
+	assert.Equal(t, int32(0), st.LoadedInstances)
+	assert.True(t, apimeta.IsStatusConditionFalse(st.Conditions, commonapi.ConditionTypeReady))
+}
+
+func TestCollectStatusGarbage(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, scheme.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, componentsapi.AddToScheme(s))
+	require.NoError(t, subscriptionsapiV2alpha1.AddToScheme(s))
+	require.NoError(t, httpendpointapi.AddToScheme(s))
+
+	instances := func() map[string]commonapi.InstanceStatus {
+		return map[string]commonapi.InstanceStatus{
+			"pod-a":       {Loaded: true, ObservedGeneration: 1},
+			"pod-deleted": {Error: "init error", ObservedGeneration: 1},
+		}
+	}
+	comp := &componentsapi.Component{
+		ObjectMeta: metav1.ObjectMeta{Name: "comp1", Namespace: "namespace-a", Generation: 1},
+		Status:     commonapi.ResourceStatus{Instances: instances()},
+	}
+	sub := &subscriptionsapiV2alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "sub1", Namespace: "namespace-a", Generation: 1},
+		Status:     commonapi.ResourceStatus{Instances: instances()},
+	}
+	endpoint := &httpendpointapi.HTTPEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "endpoint1", Namespace: "namespace-a", Generation: 1},
+		Status:     commonapi.ResourceStatus{Instances: instances()},
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(comp, sub, endpoint, testAppPod("pod-a", "app1")).
+		WithStatusSubresource(comp, sub, endpoint).
+		Build()
+
+	api := NewAPIServer(Options{Client: cl}).(*apiServer)
+	require.NoError(t, api.collectStatusGarbage(t.Context()))
+
+	var (
+		gotComp     componentsapi.Component
+		gotSub      subscriptionsapiV2alpha1.Subscription
+		gotEndpoint httpendpointapi.HTTPEndpoint
+	)
+	require.NoError(t, cl.Get(t.Context(), client.ObjectKeyFromObject(comp), &gotComp))
+	require.NoError(t, cl.Get(t.Context(), client.ObjectKeyFromObject(sub), &gotSub))
+	require.NoError(t, cl.Get(t.Context(), client.ObjectKeyFromObject(endpoint), &gotEndpoint))
+
+	for name, st := range map[string]commonapi.ResourceStatus{
+		"component":     gotComp.Status,
+		"subscription":  gotSub.Status,
+		"http endpoint": gotEndpoint.Status,
+	} {
+		assert.Len(t, st.Instances, 1, name)
+		assert.Contains(t, st.Instances, "pod-a", name)
+		assert.Equal(t, int32(1), st.LoadedInstances, name)
+		assert.Empty(t, st.LastError, name)
+		assert.True(t, apimeta.IsStatusConditionTrue(st.Conditions, commonapi.ConditionTypeReady), name)
+	}
+}
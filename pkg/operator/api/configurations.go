@@ -44,3 +44,47 @@ func (a *apiServer) GetConfiguration(ctx context.Context, in *operatorv1pb.GetCo
 		Configuration: b,
 	}, nil
 }
+
+// ConfigurationUpdate updates Dapr sidecars whenever the configuration they
+// were started with is modified.
+func (a *apiServer) ConfigurationUpdate(in *operatorv1pb.ConfigurationUpdateRequest, srv operatorv1pb.Operator_ConfigurationUpdateServer) error { //nolint:nosnakecase
+	log.Info("sidecar connected for configuration updates")
+
+	ch, err := a.configInformer.WatchUpdates(srv.Context(), in.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case event, ok := <-ch:
+			if !ok {
+				return nil
+			}
+
+			c := event.Manifest
+			if c.GetName() != in.GetName() {
+				continue
+			}
+
+			b, err := json.Marshal(&c)
+			if err != nil {
+				log.Warnf("error serializing configuration %s from pod %s/%s: %s", c.GetName(), in.GetNamespace(), in.GetPodName(), err)
+				continue
+			}
+
+			err = srv.Send(&operatorv1pb.ConfigurationUpdateEvent{
+				Configuration: b,
+				Type:          event.Type,
+			})
+			if err != nil {
+				log.Warnf("error updating sidecar with configuration %s from pod %s/%s: %s", c.GetName(), in.GetNamespace(), in.GetPodName(), err)
+				continue
+			}
+
+			log.Debugf("updated sidecar with configuration %s %s from pod %s/%s", event.Type.String(), c.GetName(), in.GetNamespace(), in.GetPodName())
+		}
+	}
+}
@@ -77,6 +77,22 @@ func processHTTPEndpointSecrets(ctx context.Context, endpoint *httpendpointsapi.
 		endpoint.Spec.ClientTLS.RootCA.Value = &v
 	}
 
+	if endpoint.HasOAuth2() {
+		for _, sv := range []*httpendpointsapi.SecretValue{
+			&endpoint.Spec.Auth.OAuth2.ClientID,
+			&endpoint.Spec.Auth.OAuth2.ClientSecret,
+		} {
+			if sv.HasSecret() && pairNeedsSecretExtraction(*sv.SecretKeyRef, endpoint.Auth) {
+				v, err := getSecret(ctx, sv.SecretKeyRef.Name, namespace, *sv.SecretKeyRef, kubeClient)
+				if err != nil {
+					return err
+				}
+
+				sv.Value = &v
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -149,6 +149,12 @@ func (i *informer[T]) handleEvent(ctx context.Context, oldObj, newObj any, event
 			return
 		}
 
+		// Status updates do not change the generation of the resource, and are
+		// not propagated to sidecars.
+		if gen := newT.ClientObject().GetGeneration(); gen != 0 && gen == oldT.ClientObject().GetGeneration() {
+			return
+		}
+
 		event.oldObj = &oldT
 	}
 
@@ -139,6 +139,49 @@ func Test_WatchUpdates(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("updates which do not change the generation should be ignored", func(t *testing.T) {
+		appID := spiffeid.RequireFromString("spiffe://example.org/ns/ns1/app1")
+		serverID := spiffeid.RequireFromString("spiffe://example.org/ns/dapr-system/dapr-operator")
+		pki := test.GenPKI(t, test.PKIOptions{LeafID: serverID, ClientID: appID})
+
+		i := New[compapi.Component](Options{}).(*informer[compapi.Component])
+		t.Cleanup(func() { close(i.closeCh) })
+
+		appCh, err := i.WatchUpdates(pki.ClientGRPCCtx(t), "ns1")
+		require.NoError(t, err)
+
+		i.handleEvent(t.Context(),
+			&compapi.Component{
+				ObjectMeta: metav1.ObjectMeta{Name: "comp1", Namespace: "ns1", Generation: 1},
+			},
+			&compapi.Component{
+				ObjectMeta: metav1.ObjectMeta{Name: "comp1", Namespace: "ns1", Generation: 1},
+				Status:     common.ResourceStatus{LoadedInstances: 1},
+			},
+			operator.ResourceEventType_UPDATED,
+		)
+		assert.Equal(t, 0, int(i.batchID.Load()))
+
+		i.handleEvent(t.Context(),
+			&compapi.Component{
+				ObjectMeta: metav1.ObjectMeta{Name: "comp1", Namespace: "ns1", Generation: 1},
+			},
+			&compapi.Component{
+				ObjectMeta: metav1.ObjectMeta{Name: "comp1", Namespace: "ns1", Generation: 2},
+				Spec:       compapi.ComponentSpec{Type: "bindings.redis"},
+			},
+			operator.ResourceEventType_UPDATED,
+		)
+
+		select {
+		case event := <-appCh:
+			assert.Equal(t, int64(2), event.Manifest.GetGeneration())
+			assert.Equal(t, operator.ResourceEventType_UPDATED, event.Type)
+		case <-time.After(time.Second * 5):
+			assert.Fail(t, "timeout waiting for app event")
+		}
+	})
 }
 
 func Test_anyToT(t *testing.T) {
@@ -74,3 +74,43 @@ func (a *apiServer) ListResiliency(ctx context.Context, in *operatorv1pb.ListRes
 
 	return resp, nil
 }
+
+// ResiliencyUpdate updates Dapr sidecars whenever a resiliency configuration
+// in the cluster is modified.
+func (a *apiServer) ResiliencyUpdate(in *operatorv1pb.ResiliencyUpdateRequest, srv operatorv1pb.Operator_ResiliencyUpdateServer) error { //nolint:nosnakecase
+	log.Info("sidecar connected for resiliency updates")
+
+	ch, err := a.resInformer.WatchUpdates(srv.Context(), in.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case event, ok := <-ch:
+			if !ok {
+				return nil
+			}
+
+			r := event.Manifest
+			b, err := json.Marshal(&r)
+			if err != nil {
+				log.Warnf("error serializing resiliency %s from pod %s/%s: %s", r.GetName(), in.GetNamespace(), in.GetPodName(), err)
+				continue
+			}
+
+			err = srv.Send(&operatorv1pb.ResiliencyUpdateEvent{
+				Resiliency: b,
+				Type:       event.Type,
+			})
+			if err != nil {
+				log.Warnf("error updating sidecar with resiliency %s from pod %s/%s: %s", r.GetName(), in.GetNamespace(), in.GetPodName(), err)
+				continue
+			}
+
+			log.Debugf("updated sidecar with resiliency %s %s from pod %s/%s", event.Type.String(), r.GetName(), in.GetNamespace(), in.GetPodName())
+		}
+	}
+}
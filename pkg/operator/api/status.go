@@ -0,0 +1,376 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonapi "github.com/dapr/dapr/pkg/apis/common"
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	"github.com/dapr/dapr/pkg/injector/annotations"
+	"github.com/dapr/dapr/pkg/operator/api/authz"
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/dapr/pkg/security/spiffe"
+)
+
+// statusCleanupTimeout is the time allowed to rewrite the status of the
+// resources reported by a sidecar once its stream has ended.
+const statusCleanupTimeout = 10 * time.Second
+
+// statusGCInterval is the interval at which the entries of pods which no
+// longer exist are removed from the status of the resources. Entries are
+// normally removed when the stream of the sidecar ends, but they are left
+// behind if the operator instance it was connected to crashed.
+const statusGCInterval = 5 * time.Minute
+
+// statusUpdateBackoff is the backoff between retries of status updates which
+// conflict with a concurrent update, such as from another operator instance.
+// Every sidecar which loads a resource writes to its status, so conflicts are
+// expected when many sidecars start at once.
+var statusUpdateBackoff = wait.Backoff{
+	Steps:    10,
+	Duration: 10 * time.Millisecond,
+	Factor:   2,
+	Jitter:   0.5,
+	Cap:      time.Second,
+}
+
+type statusKey struct {
+	resourceType operatorv1pb.ResourceType
+	namespace    string
+	name         string
+}
+
+// setInstanceStatus sets the result reported by the sidecar of the given pod
+// in the status, removing it if the sidecar closed the resource or doesn't
+// know its condition. Returns false if the status is unchanged.
+func setInstanceStatus(st *commonapi.ResourceStatus, podName string, result *operatorv1pb.ResourceResult) bool {
+	old, ok := st.Instances[podName]
+
+	if result == nil ||
+		result.GetEventType() == operatorv1pb.EventType_EVENT_CLOSE ||
+		result.GetCondition() == operatorv1pb.ResourceConditionStatus_STATUS_UNKNOWN {
+		if !ok {
+			return false
+		}
+		delete(st.Instances, podName)
+		return true
+	}
+
+	instance := commonapi.InstanceStatus{
+		Loaded:             result.GetCondition() == operatorv1pb.ResourceConditionStatus_STATUS_SUCCESS,
+		ObservedGeneration: result.GetObservedGeneration(),
+		LastTransitionTime: metav1.Now(),
+	}
+	if !instance.Loaded {
+		instance.Error = result.GetMessage()
+	}
+	if result.GetLastTransactionTime() != nil {
+		instance.LastTransitionTime = metav1.NewTime(result.GetLastTransactionTime().AsTime())
+	}
+	if ok && old.Loaded == instance.Loaded && old.Error == instance.Error &&
+		old.ObservedGeneration == instance.ObservedGeneration {
+		return false
+	}
+
+	if st.Instances == nil {
+		st.Instances = make(map[string]commonapi.InstanceStatus)
+	}
+	st.Instances[podName] = instance
+	return true
+}
+
+// computeResourceStatus aggregates the results of all sidecars, reported to
+// any operator instance, into the status.
+func computeResourceStatus(st *commonapi.ResourceStatus) {
+	var (
+		loaded, failed int32
+		lastFailure    *commonapi.InstanceStatus
+		generation     int64
+	)
+	for _, instance := range st.Instances {
+		generation = max(generation, instance.ObservedGeneration)
+		if instance.Loaded {
+			loaded++
+			continue
+		}
+		failed++
+		if lastFailure == nil || lastFailure.LastTransitionTime.Before(&instance.LastTransitionTime) {
+			lastFailure = &instance
+		}
+	}
+
+	st.LoadedInstances = loaded
+	st.ObservedGeneration = generation
+	st.LastError = ""
+	if lastFailure != nil {
+		st.LastError = lastFailure.Error
+	}
+
+	ready := metav1.Condition{
+		Type:               commonapi.ConditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Loaded",
+		Message:            fmt.Sprintf("Loaded by %d instance(s)", loaded),
+		ObservedGeneration: generation,
+	}
+	initFailed := metav1.Condition{
+		Type:               commonapi.ConditionTypeInitFailed,
+		Status:             metav1.ConditionFalse,
+		Reason:             "NoFailures",
+		ObservedGeneration: generation,
+	}
+	switch {
+	case failed > 0:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = "InitFailed"
+		initFailed.Status = metav1.ConditionTrue
+		initFailed.Reason = "InitFailed"
+		initFailed.Message = fmt.Sprintf("Failed to load in %d instance(s): %s", failed, st.LastError)
+	case loaded == 0:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = "NotLoaded"
+		ready.Message = "Not loaded by any instance"
+	}
+	apimeta.SetStatusCondition(&st.Conditions, ready)
+	apimeta.SetStatusCondition(&st.Conditions, initFailed)
+}
+
+// ReportResourceStatus receives the results of loading resources from a Dapr
+// sidecar, and writes them to the status of the resources. Sidecars may be
+// connected to different operator instances, so each result is written as
+// the entry of the sidecar's pod in the status, and the aggregated status is
+// recomputed from the entries of all pods.
+func (a *apiServer) ReportResourceStatus(stream operatorv1pb.Operator_ReportResourceStatusServer) error { //nolint:nosnakecase
+	var namespace, podName string
+	reported := make(map[statusKey]struct{})
+	defer func() {
+		if len(podName) == 0 {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), statusCleanupTimeout)
+		defer cancel()
+		for key := range reported {
+			if err := a.updateResourceStatus(ctx, key, podName, nil); err != nil {
+				log.Warnf("error updating status of %s %s/%s: %s", key.resourceType, key.namespace, key.name, err)
+			}
+		}
+	}()
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&emptypb.Empty{})
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(podName) == 0 {
+			var id *spiffe.Parsed
+			if id, err = authz.Request(stream.Context(), req.GetNamespace()); err != nil {
+				return err
+			}
+			if len(req.GetPodName()) == 0 {
+				return status.Error(codes.InvalidArgument, "pod name is required")
+			}
+			if err = a.authorizePod(stream.Context(), id, req.GetPodName()); err != nil {
+				return err
+			}
+			namespace, podName = req.GetNamespace(), req.GetPodName()
+			log.Debugf("sidecar connected for resource status reporting from pod %s/%s", namespace, podName)
+		} else if req.GetNamespace() != namespace || req.GetPodName() != podName {
+			return status.Error(codes.InvalidArgument, "namespace and pod name must not change on the stream")
+		}
+
+		result := req.GetResult()
+		key := statusKey{
+			resourceType: result.GetResourceType(),
+			namespace:    namespace,
+			name:         result.GetName(),
+		}
+		if result.GetEventType() == operatorv1pb.EventType_EVENT_CLOSE {
+			delete(reported, key)
+		} else {
+			reported[key] = struct{}{}
+		}
+		if err = a.updateResourceStatus(stream.Context(), key, podName, result); err != nil {
+			log.Warnf("error updating status of %s %s/%s: %s", key.resourceType, key.namespace, key.name, err)
+		}
+	}
+}
+
+// authorizePod ensures that the pod reported by a sidecar runs the app of the
+// sidecar's identity, so a sidecar can't write the status entries of the pods
+// of other apps. The app ID of a pod is its app ID annotation, or its name if
+// it isn't annotated, as validated by Sentry when signing the identity.
+func (a *apiServer) authorizePod(ctx context.Context, id *spiffe.Parsed, podName string) error {
+	var pod corev1.Pod
+	err := a.apiReader.Get(ctx, types.NamespacedName{Namespace: id.Namespace(), Name: podName}, &pod)
+	if apierrors.IsNotFound(err) {
+		return status.Errorf(codes.PermissionDenied, "pod %s/%s not found", id.Namespace(), podName)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get pod %s/%s: %s", id.Namespace(), podName, err)
+	}
+
+	appID, ok := pod.GetAnnotations()[annotations.KeyAppID]
+	if !ok {
+		appID = pod.GetName()
+	}
+	if appID != id.AppID() {
+		return status.Errorf(codes.PermissionDenied, "pod %s/%s does not run app %s", id.Namespace(), podName, id.AppID())
+	}
+	return nil
+}
+
+// runStatusGC periodically removes the entries of pods which no longer exist
+// from the status of the resources.
+func (a *apiServer) runStatusGC(ctx context.Context) error {
+	ticker := time.NewTicker(statusGCInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := a.collectStatusGarbage(ctx); err != nil {
+				log.Warnf("error removing stale resource status entries: %s", err)
+			}
+		}
+	}
+}
+
+// collectStatusGarbage removes the entries of pods which no longer exist from
+// the status of all resources.
+func (a *apiServer) collectStatusGarbage(ctx context.Context) error {
+	statuses := make(map[statusKey]*commonapi.ResourceStatus)
+
+	var comps componentsapi.ComponentList
+	if err := a.Client.List(ctx, &comps); err != nil {
+		return fmt.Errorf("error listing components: %w", err)
+	}
+	for i := range comps.Items {
+		key := statusKey{operatorv1pb.ResourceType_RESOURCE_COMPONENT, comps.Items[i].Namespace, comps.Items[i].Name}
+		statuses[key] = &comps.Items[i].Status
+	}
+
+	var subs subapi.SubscriptionList
+	if err := a.Client.List(ctx, &subs); err != nil {
+		return fmt.Errorf("error listing subscriptions: %w", err)
+	}
+	for i := range subs.Items {
+		key := statusKey{operatorv1pb.ResourceType_RESOURCE_SUBSCRIPTION, subs.Items[i].Namespace, subs.Items[i].Name}
+		statuses[key] = &subs.Items[i].Status
+	}
+
+	var endpoints httpendpointsapi.HTTPEndpointList
+	if err := a.Client.List(ctx, &endpoints); err != nil {
+		return fmt.Errorf("error listing HTTP endpoints: %w", err)
+	}
+	for i := range endpoints.Items {
+		key := statusKey{operatorv1pb.ResourceType_RESOURCE_HTTPENDPOINT, endpoints.Items[i].Namespace, endpoints.Items[i].Name}
+		statuses[key] = &endpoints.Items[i].Status
+	}
+
+	// Pods are looked up once per run, as most pods load many resources.
+	exists := make(map[types.NamespacedName]bool)
+	for key, st := range statuses {
+		for podName := range st.Instances {
+			name := types.NamespacedName{Namespace: key.namespace, Name: podName}
+			found, ok := exists[name]
+			if !ok {
+				err := a.apiReader.Get(ctx, name, &corev1.Pod{})
+				if err != nil && !apierrors.IsNotFound(err) {
+					return fmt.Errorf("error getting pod %s: %w", name, err)
+				}
+				found = err == nil
+				exists[name] = found
+			}
+			if found {
+				continue
+			}
+			log.Debugf("removing status entry of deleted pod %s from %s %s/%s", name, key.resourceType, key.namespace, key.name)
+			if err := a.updateResourceStatus(ctx, key, podName, nil); err != nil {
+				log.Warnf("error updating status of %s %s/%s: %s", key.resourceType, key.namespace, key.name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateResourceStatus writes the result reported by the sidecar of the given
+// pod to the status of the given resource, removing its entry if the result
+// is nil, and recomputes the aggregated status. The status is patched with an
+// optimistic lock, retrying on conflicts, so concurrent writes from other
+// operator instances aren't overwritten.
+func (a *apiServer) updateResourceStatus(ctx context.Context, key statusKey, podName string, result *operatorv1pb.ResourceResult) error {
+	return retry.RetryOnConflict(statusUpdateBackoff, func() error {
+		var (
+			obj client.Object
+			st  *commonapi.ResourceStatus
+		)
+		switch key.resourceType {
+		case operatorv1pb.ResourceType_RESOURCE_COMPONENT:
+			var comp componentsapi.Component
+			obj, st = &comp, &comp.Status
+		case operatorv1pb.ResourceType_RESOURCE_SUBSCRIPTION:
+			var sub subapi.Subscription
+			obj, st = &sub, &sub.Status
+		case operatorv1pb.ResourceType_RESOURCE_HTTPENDPOINT:
+			var endpoint httpendpointsapi.HTTPEndpoint
+			obj, st = &endpoint, &endpoint.Status
+		default:
+			return fmt.Errorf("unsupported resource type %s", key.resourceType)
+		}
+
+		err := a.Client.Get(ctx, types.NamespacedName{Namespace: key.namespace, Name: key.name}, obj)
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		base, ok := obj.DeepCopyObject().(client.Object)
+		if !ok {
+			return fmt.Errorf("unexpected type %T", obj)
+		}
+		if !setInstanceStatus(st, podName, result) {
+			return nil
+		}
+		computeResourceStatus(st)
+
+		return a.Client.Status().Patch(ctx, obj, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{}))
+	})
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.operator.client")
+
+type StatusReporterOptions struct {
+	Client    operatorv1pb.OperatorClient
+	Namespace string
+	PodName   string
+}
+
+type statusKey struct {
+	resourceType operatorv1pb.ResourceType
+	name         string
+}
+
+// StatusReporter reports the results of loading resources to the operator,
+// which aggregates them into the status of the resources. Reporting never
+// blocks; the latest result of every resource is re-sent whenever the stream
+// to the operator is re-established.
+type StatusReporter struct {
+	client    operatorv1pb.OperatorClient
+	namespace string
+	podName   string
+
+	lock    sync.Mutex
+	latest  map[statusKey]*operatorv1pb.ResourceResult
+	pending map[statusKey]*operatorv1pb.ResourceResult
+	notify  chan struct{}
+}
+
+func NewStatusReporter(opts StatusReporterOptions) *StatusReporter {
+	return &StatusReporter{
+		client:    opts.Client,
+		namespace: opts.Namespace,
+		podName:   opts.PodName,
+		latest:    make(map[statusKey]*operatorv1pb.ResourceResult),
+		pending:   make(map[statusKey]*operatorv1pb.ResourceResult),
+		notify:    make(chan struct{}, 1),
+	}
+}
+
+// Report queues the given result to be sent to the operator.
+func (s *StatusReporter) Report(result *operatorv1pb.ResourceResult) {
+	key := statusKey{resourceType: result.GetResourceType(), name: result.GetName()}
+
+	s.lock.Lock()
+	if result.GetEventType() == operatorv1pb.EventType_EVENT_CLOSE {
+		delete(s.latest, key)
+	} else {
+		s.latest[key] = result
+	}
+	s.pending[key] = result
+	s.lock.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Run sends the reported results to the operator until the context is
+// cancelled. Run returns nil if the operator does not support status
+// reporting.
+func (s *StatusReporter) Run(ctx context.Context) error {
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxElapsedTime = 0
+
+	for {
+		err := s.stream(ctx, bo)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if status.Code(err) == codes.Unimplemented {
+			log.Warnf("Operator does not support resource status reporting: %s", err)
+			return nil
+		}
+
+		log.Errorf("Error reporting resource status to operator: %s", err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(bo.NextBackOff()):
+		}
+	}
+}
+
+func (s *StatusReporter) stream(ctx context.Context, bo backoff.BackOff) error {
+	stream, err := s.client.ReportResourceStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The operator drops all results of a sidecar when its stream ends, so
+	// send the latest result of every resource on (re)connect.
+	s.lock.Lock()
+	for key, result := range s.latest {
+		s.pending[key] = result
+	}
+	s.lock.Unlock()
+
+	for {
+		s.lock.Lock()
+		pending := s.pending
+		s.pending = make(map[statusKey]*operatorv1pb.ResourceResult)
+		s.lock.Unlock()
+
+		for _, result := range pending {
+			if err = stream.Send(&operatorv1pb.ReportResourceStatusRequest{
+				Namespace: s.namespace,
+				PodName:   s.podName,
+				Result:    result,
+			}); err != nil {
+				// The status of the stream is returned by Recv.
+				if _, rerr := stream.CloseAndRecv(); rerr != nil {
+					return rerr
+				}
+				return err
+			}
+			bo.Reset()
+		}
+
+		select {
+		case <-ctx.Done():
+			_, err = stream.CloseAndRecv()
+			return err
+		case <-s.notify:
+		}
+	}
+}
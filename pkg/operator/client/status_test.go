@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+)
+
+type fakeOperatorClient struct {
+	operatorv1pb.OperatorClient
+	fn func(context.Context) (operatorv1pb.Operator_ReportResourceStatusClient, error)
+}
+
+func (f *fakeOperatorClient) ReportResourceStatus(ctx context.Context, _ ...grpc.CallOption) (operatorv1pb.Operator_ReportResourceStatusClient, error) {
+	return f.fn(ctx)
+}
+
+type fakeReportStream struct {
+	grpc.ClientStream
+	sendErr error
+	sent    chan *operatorv1pb.ReportResourceStatusRequest
+}
+
+func (f *fakeReportStream) Send(req *operatorv1pb.ReportResourceStatusRequest) error {
+	if f.sendErr != nil {
+		return f.sendErr
+	}
+	f.sent <- req
+	return nil
+}
+
+func (f *fakeReportStream) CloseAndRecv() (*emptypb.Empty, error) {
+	return nil, f.sendErr
+}
+
+func TestStatusReporter(t *testing.T) {
+	t.Run("returns if the operator does not support reporting", func(t *testing.T) {
+		s := NewStatusReporter(StatusReporterOptions{
+			Client: &fakeOperatorClient{fn: func(context.Context) (operatorv1pb.Operator_ReportResourceStatusClient, error) {
+				return nil, status.Error(codes.Unimplemented, "unimplemented")
+			}},
+		})
+		require.NoError(t, s.Run(t.Context()))
+	})
+
+	t.Run("latest results are re-sent on reconnect", func(t *testing.T) {
+		sent := make(chan *operatorv1pb.ReportResourceStatusRequest, 10)
+		var connects atomic.Int32
+		s := NewStatusReporter(StatusReporterOptions{
+			Namespace: "ns1",
+			PodName:   "pod1",
+			Client: &fakeOperatorClient{fn: func(context.Context) (operatorv1pb.Operator_ReportResourceStatusClient, error) {
+				if connects.Add(1) == 1 {
+					return &fakeReportStream{sendErr: errors.New("stream error")}, nil
+				}
+				return &fakeReportStream{sent: sent}, nil
+			}},
+		})
+
+		s.Report(&operatorv1pb.ResourceResult{
+			ResourceType: operatorv1pb.ResourceType_RESOURCE_COMPONENT,
+			EventType:    operatorv1pb.EventType_EVENT_INIT,
+			Name:         "comp1",
+			Condition:    operatorv1pb.ResourceConditionStatus_STATUS_SUCCESS,
+		})
+
+		ctx, cancel := context.WithCancel(t.Context())
+		errCh := make(chan error)
+		go func() { errCh <- s.Run(ctx) }()
+
+		select {
+		case req := <-sent:
+			assert.Equal(t, "ns1", req.GetNamespace())
+			assert.Equal(t, "pod1", req.GetPodName())
+			assert.Equal(t, "comp1", req.GetResult().GetName())
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "expected result to be sent after reconnect")
+		}
+		assert.Equal(t, int32(2), connects.Load())
+
+		s.Report(&operatorv1pb.ResourceResult{
+			ResourceType: operatorv1pb.ResourceType_RESOURCE_COMPONENT,
+			EventType:    operatorv1pb.EventType_EVENT_CLOSE,
+			Name:         "comp1",
+		})
+		select {
+		case req := <-sent:
+			assert.Equal(t, operatorv1pb.EventType_EVENT_CLOSE, req.GetResult().GetEventType())
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "expected close result to be sent")
+		}
+
+		s.lock.Lock()
+		assert.Empty(t, s.latest)
+		s.lock.Unlock()
+
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+}
@@ -27,13 +27,16 @@ import (
 	argov1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/go-logr/logr"
 	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
@@ -48,6 +51,7 @@ import (
 	"github.com/dapr/dapr/pkg/operator/api"
 	operatorcache "github.com/dapr/dapr/pkg/operator/cache"
 	"github.com/dapr/dapr/pkg/operator/handlers"
+	"github.com/dapr/dapr/pkg/operator/validation"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 	"github.com/dapr/dapr/pkg/security"
 	"github.com/dapr/kit/concurrency"
@@ -56,6 +60,10 @@ import (
 
 var log = logger.NewLogger("dapr.operator")
 
+// validatingWebhookConfigurationName is the name of the validating webhook
+// configuration for the Dapr resources, installed by the Helm chart.
+const validatingWebhookConfigurationName = "dapr-operator"
+
 // Operator is an Dapr Kubernetes Operator for managing components and sidecar lifecycle.
 type Operator interface {
 	Start(ctx context.Context) error
@@ -198,6 +206,7 @@ func NewOperator(ctx context.Context, opts Options) (Operator, error) {
 		subInformerHealthz:          opts.Healthz.AddTarget("operator-sub-informer"),
 		apiServer: api.NewAPIServer(api.Options{
 			Client:        mgr.GetClient(),
+			APIReader:     mgr.GetAPIReader(),
 			Cache:         mgr.GetCache(),
 			Security:      secProvider,
 			Port:          opts.APIPort,
@@ -232,6 +241,20 @@ func (o *operator) syncSubscription(ctx context.Context, eventType operatorv1pb.
 	}
 }
 
+// generationChanged returns false if an update did not change the generation
+// of the resource, meaning only its status or metadata was updated.
+func generationChanged(oldObj, newObj interface{}) bool {
+	oldO, ok := oldObj.(client.Object)
+	if !ok {
+		return true
+	}
+	newO, ok := newObj.(client.Object)
+	if !ok {
+		return true
+	}
+	return newO.GetGeneration() == 0 || newO.GetGeneration() != oldO.GetGeneration()
+}
+
 func (o *operator) Start(ctx context.Context) error {
 	log.Info("Dapr Operator is starting")
 
@@ -252,6 +275,9 @@ func (o *operator) Start(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("unable to create webhook Subscriptions v2alpha1: %w", err)
 		}
+		if err = validation.Register(o.mgr); err != nil {
+			return err
+		}
 	}
 
 	caBundleCh := make(chan []byte)
@@ -310,6 +336,10 @@ func (o *operator) Start(ctx context.Context) error {
 				if rErr != nil {
 					return rErr
 				}
+				rErr = o.patchValidatingWebhookConfiguration(ctx, caBundle, o.mgr.GetConfig())
+				if rErr != nil {
+					return rErr
+				}
 
 				o.webhookHealthz.Ready()
 
@@ -340,7 +370,10 @@ func (o *operator) Start(ctx context.Context) error {
 
 			_, rErr = httpEndpointInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 				AddFunc: o.syncHTTPEndpoint(ctx),
-				UpdateFunc: func(_, newObj interface{}) {
+				UpdateFunc: func(oldObj, newObj interface{}) {
+					if !generationChanged(oldObj, newObj) {
+						return
+					}
 					o.syncHTTPEndpoint(ctx)(newObj)
 				},
 			})
@@ -361,7 +394,10 @@ func (o *operator) Start(ctx context.Context) error {
 			}
 			_, rErr = subscriptionInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 				AddFunc: o.syncSubscription(ctx, operatorv1pb.ResourceEventType_CREATED),
-				UpdateFunc: func(_, newObj interface{}) {
+				UpdateFunc: func(oldObj, newObj interface{}) {
+					if !generationChanged(oldObj, newObj) {
+						return
+					}
 					o.syncSubscription(ctx, operatorv1pb.ResourceEventType_UPDATED)(newObj)
 				},
 				DeleteFunc: o.syncSubscription(ctx, operatorv1pb.ResourceEventType_DELETED),
@@ -440,6 +476,59 @@ func (o *operator) patchConversionWebhooksInCRDs(ctx context.Context, caBundle [
 	return nil
 }
 
+// patchValidatingWebhookConfiguration sets the CA bundle of all webhooks in
+// the validating webhook configuration of the operator. The configuration is
+// optional, so it is not an error if it does not exist or cannot be accessed.
+func (o *operator) patchValidatingWebhookConfiguration(ctx context.Context, caBundle []byte, conf *rest.Config) error {
+	clientSet, err := kubernetes.NewForConfig(conf)
+	if err != nil {
+		return fmt.Errorf("could not get Kubernetes client: %w", err)
+	}
+
+	webhookClient := clientSet.AdmissionregistrationV1().ValidatingWebhookConfigurations()
+	whc, err := webhookClient.Get(ctx, validatingWebhookConfigurationName, v1.GetOptions{})
+	if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+		log.Infof("Validating webhook configuration %q is not available, resources will not be validated on apply: %s", validatingWebhookConfigurationName, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not get validating webhook configuration %q: %w", validatingWebhookConfigurationName, err)
+	}
+
+	type patchValue struct {
+		Op    string      `json:"op"`
+		Path  string      `json:"path"`
+		Value interface{} `json:"value"`
+	}
+	var payload []patchValue
+	for i, wh := range whc.Webhooks {
+		if bytes.Equal(wh.ClientConfig.CABundle, caBundle) {
+			continue
+		}
+		payload = append(payload, patchValue{
+			Op:    "add",
+			Path:  fmt.Sprintf("/webhooks/%d/clientConfig/caBundle", i),
+			Value: caBundle,
+		})
+	}
+	if len(payload) == 0 {
+		log.Infof("Validating webhook configuration %q is up to date", validatingWebhookConfigurationName)
+		return nil
+	}
+
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("could not marshal webhook spec: %w", err)
+	}
+	if _, err := webhookClient.Patch(ctx, validatingWebhookConfigurationName, types.JSONPatchType, payloadJSON, v1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to patch validating webhook configuration %q: %w", validatingWebhookConfigurationName, err)
+	}
+
+	log.Infof("Successfully patched validating webhook configuration %q", validatingWebhookConfigurationName)
+
+	return nil
+}
+
 func buildScheme(opts Options) (*runtime.Scheme, error) {
 	builders := []func(*runtime.Scheme) error{
 		clientgoscheme.AddToScheme,
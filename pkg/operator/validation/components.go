@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/components"
+)
+
+//go:generate go run ./gen
+
+// categories are the component categories supported by daprd.
+var categories = []components.Category{
+	components.CategoryBindings,
+	components.CategoryPubSub,
+	components.CategorySecretStore,
+	components.CategoryStateStore,
+	components.CategoryWorkflow,
+	components.CategoryWorkflowBackend,
+	components.CategoryMiddleware,
+	components.CategoryConfiguration,
+	components.CategoryCryptoProvider,
+	components.CategoryLock,
+	components.CategoryNameResolution,
+	components.CategoryConversation,
+}
+
+var versionRegexp = regexp.MustCompile(`^v[0-9]+([a-z]+[0-9]*)?$`)
+
+// ValidateComponent returns an error if the component has an invalid type or
+// version.
+func ValidateComponent(comp *componentsapi.Component) (admission.Warnings, error) {
+	var (
+		errs     []error
+		warnings admission.Warnings
+	)
+
+	category, name, ok := strings.Cut(comp.Spec.Type, ".")
+	switch {
+	case !ok || len(category) == 0 || len(name) == 0:
+		errs = append(errs, fmt.Errorf("spec.type: %q must be in the form <category>.<name>", comp.Spec.Type))
+	case !slices.Contains(categories, components.Category(category)):
+		errs = append(errs, fmt.Errorf("spec.type: unknown component category %q", category))
+	}
+
+	version := strings.ToLower(comp.Spec.Version)
+	if len(version) > 0 && !versionRegexp.MatchString(version) {
+		errs = append(errs, fmt.Errorf("spec.version: %q must be in the form v<number>, e.g. v1", comp.Spec.Version))
+	} else if len(errs) == 0 {
+		warning, err := validateComponentVersion(strings.ToLower(comp.Spec.Type), version)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if len(warning) > 0 {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	if len(comp.Spec.InitTimeout) > 0 {
+		if _, err := time.ParseDuration(comp.Spec.InitTimeout); err != nil {
+			warnings = append(warnings, fmt.Sprintf("spec.initTimeout: %q is not a valid duration and the default will be used", comp.Spec.InitTimeout))
+		}
+	}
+
+	names := make(map[string]struct{}, len(comp.Spec.Metadata))
+	for _, item := range comp.Spec.Metadata {
+		if _, ok := names[item.Name]; ok {
+			warnings = append(warnings, fmt.Sprintf("spec.metadata: %q is set more than once", item.Name))
+		}
+		names[item.Name] = struct{}{}
+	}
+
+	return warnings, errors.Join(errs...)
+}
+
+// validateComponentVersion returns an error if the component type isn't built
+// into daprd in the given version. Types which aren't built in may be provided
+// by pluggable components, which are only known to the sidecars, so a warning
+// is returned for them instead, unless the version isn't an initial version,
+// as pluggable components only have the initial version.
+func validateComponentVersion(typ, version string) (string, error) {
+	versions, ok := builtinComponents[typ]
+	if !ok {
+		if !components.IsInitialVersion(version) {
+			return "", fmt.Errorf("spec.type: %q is not a built-in component type, and pluggable components don't have version %q", typ, version)
+		}
+		return fmt.Sprintf("spec.type: %q is not a built-in component type, so it must be provided by a pluggable component", typ), nil
+	}
+
+	switch {
+	case len(versions) == 0 && components.IsInitialVersion(version),
+		len(versions) > 0 && (len(version) == 0 || slices.Contains(versions, version)):
+		return "", nil
+	case len(versions) == 0:
+		return "", fmt.Errorf("spec.version: %q is not a version of %q, which only has version v1", version, typ)
+	default:
+		return "", fmt.Errorf("spec.version: %q is not a version of %q, which has versions %s", version, typ, strings.Join(versions, ", "))
+	}
+}
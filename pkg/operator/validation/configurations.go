@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/dapr/dapr/pkg/acl"
+	configurationapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
+	"github.com/dapr/dapr/pkg/config"
+)
+
+// ValidateConfiguration returns an error if the configuration cannot be
+// loaded by daprd, or has an invalid access control spec.
+func ValidateConfiguration(conf *configurationapi.Configuration) (admission.Warnings, error) {
+	b, err := json.Marshal(conf)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling configuration: %w", err)
+	}
+
+	parsed, err := config.ParseKubernetesConfiguration(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	if _, err = acl.ParseAccessControlSpec(parsed.Spec.AccessControlSpec, true); err != nil {
+		return nil, fmt.Errorf("spec.accessControl: %w", err)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// gen generates the manifest of the component types, and their versions,
+// built into daprd, from the registrations in cmd/daprd/components. The
+// operator can't import the components themselves, so it validates component
+// resources against the manifest.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// registryPrefixes are the type prefixes of the components registered with
+// the registries of each package.
+var registryPrefixes = map[string]string{
+	"github.com/dapr/dapr/pkg/components/bindings":        "bindings.",
+	"github.com/dapr/dapr/pkg/components/configuration":   "configuration.",
+	"github.com/dapr/dapr/pkg/components/conversation":    "conversation.",
+	"github.com/dapr/dapr/pkg/components/crypto":          "crypto.",
+	"github.com/dapr/dapr/pkg/components/lock":            "lock.",
+	"github.com/dapr/dapr/pkg/components/middleware/http": "middleware.http.",
+	"github.com/dapr/dapr/pkg/components/nameresolution":  "nameresolution.",
+	"github.com/dapr/dapr/pkg/components/pubsub":          "pubsub.",
+	"github.com/dapr/dapr/pkg/components/secretstores":    "secretstores.",
+	"github.com/dapr/dapr/pkg/components/state":           "state.",
+}
+
+func main() {
+	src := flag.String("src", "../../../cmd/daprd/components", "directory of the daprd component registrations")
+	out := flag.String("out", "zz_generated.components.go", "file to write the manifest to")
+	flag.Parse()
+
+	b, err := Generate(*src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(*out, b, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Generate returns the source of the manifest of the components registered
+// in the Go files of the given directory.
+func Generate(dir string) ([]byte, error) {
+	types, err := parseRegistrations(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var buf bytes.Buffer
+	buf.WriteString(`/*
+Copyright The Dapr Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by gen/main.go. DO NOT EDIT.
+
+package validation
+
+// builtinComponents are the component types built into daprd, with their
+// registered versions. Types without versions only have the initial version.
+var builtinComponents = map[string][]string{
+`)
+	for _, name := range names {
+		versions := types[name]
+		if len(versions) == 0 {
+			fmt.Fprintf(&buf, "\t%q: nil,\n", name)
+			continue
+		}
+		quoted := make([]string, len(versions))
+		for i, v := range versions {
+			quoted[i] = strconv.Quote(v)
+		}
+		fmt.Fprintf(&buf, "\t%q: {%s},\n", name, strings.Join(quoted, ", "))
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// parseRegistrations returns the component types registered in the Go files
+// of the given directory, with their sorted versions.
+func parseRegistrations(dir string) (map[string][]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	types := make(map[string][]string)
+	fset := token.NewFileSet()
+	for _, path := range files {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		prefixes := make(map[string]string)
+		for _, imp := range f.Imports {
+			importPath, _ := strconv.Unquote(imp.Path.Value)
+			prefix, ok := registryPrefixes[importPath]
+			if !ok {
+				continue
+			}
+			name := filepath.Base(importPath)
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			prefixes[name] = prefix
+		}
+
+		ast.Inspect(f, func(n ast.Node) bool {
+			if err != nil {
+				return false
+			}
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			prefix, method, ok := registryCall(call, prefixes)
+			if !ok {
+				return true
+			}
+			err = addRegistration(types, prefix, method, call)
+			if err != nil {
+				err = fmt.Errorf("%s: %w", fset.Position(call.Pos()), err)
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for name := range types {
+		slices.Sort(types[name])
+		types[name] = slices.Compact(types[name])
+	}
+	return types, nil
+}
+
+// registryCall returns the type prefix of the registry and the name of the
+// method, if the call is a method call on the default registry of a package.
+func registryCall(call *ast.CallExpr, prefixes map[string]string) (string, string, bool) {
+	method, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", "", false
+	}
+	registry, ok := method.X.(*ast.SelectorExpr)
+	if !ok || registry.Sel.Name != "DefaultRegistry" {
+		return "", "", false
+	}
+	pkg, ok := registry.X.(*ast.Ident)
+	if !ok {
+		return "", "", false
+	}
+	prefix, ok := prefixes[pkg.Name]
+	return prefix, method.Sel.Name, ok
+}
+
+func addRegistration(types map[string][]string, prefix, method string, call *ast.CallExpr) error {
+	switch method {
+	case "RegisterComponent", "RegisterInputBinding", "RegisterOutputBinding":
+		if len(call.Args) < 2 {
+			return fmt.Errorf("%s: no component names", method)
+		}
+		for _, arg := range call.Args[1:] {
+			name, err := stringLit(arg)
+			if err != nil {
+				return err
+			}
+			name = strings.ToLower(prefix + name)
+			if _, ok := types[name]; !ok {
+				types[name] = nil
+			}
+		}
+	case "RegisterComponentWithVersions":
+		if len(call.Args) != 2 {
+			return fmt.Errorf("%s: unexpected arguments", method)
+		}
+		name, err := stringLit(call.Args[0])
+		if err != nil {
+			return err
+		}
+		versions, err := versioningVersions(call.Args[1])
+		if err != nil {
+			return err
+		}
+		name = strings.ToLower(prefix + name)
+		types[name] = append(types[name], versions...)
+	default:
+		return fmt.Errorf("unknown registration method %s", method)
+	}
+	return nil
+}
+
+// versioningVersions returns the versions of a components.Versioning
+// literal.
+func versioningVersions(expr ast.Expr) ([]string, error) {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil, errors.New("versions must be a components.Versioning literal")
+	}
+
+	var versions []string
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, errors.New("versions must be a keyed components.Versioning literal")
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			return nil, errors.New("versions must be a keyed components.Versioning literal")
+		}
+		switch key.Name {
+		case "Preferred":
+			v, err := constructorVersion(kv.Value)
+			if err != nil {
+				return nil, err
+			}
+			versions = append(versions, v)
+		case "Deprecated", "Others":
+			list, ok := kv.Value.(*ast.CompositeLit)
+			if !ok {
+				return nil, fmt.Errorf("%s must be a slice literal", key.Name)
+			}
+			for _, item := range list.Elts {
+				v, err := constructorVersion(item)
+				if err != nil {
+					return nil, err
+				}
+				versions = append(versions, v)
+			}
+		}
+	}
+	return versions, nil
+}
+
+// constructorVersion returns the version of a components.VersionConstructor
+// literal.
+func constructorVersion(expr ast.Expr) (string, error) {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return "", errors.New("version must be a components.VersionConstructor literal")
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "Version" {
+			v, err := stringLit(kv.Value)
+			return strings.ToLower(v), err
+		}
+	}
+	return "", errors.New("version constructor without a version")
+}
+
+func stringLit(expr ast.Expr) (string, error) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", errors.New("component names and versions must be string literals")
+	}
+	return strconv.Unquote(lit.Value)
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	got, err := Generate("../../../../cmd/daprd/components")
+	require.NoError(t, err)
+
+	exp, err := os.ReadFile("../zz_generated.components.go")
+	require.NoError(t, err)
+
+	assert.Equal(t, string(exp), string(got), "the component manifest is out of date, run go generate ./pkg/operator/validation")
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+)
+
+// ValidateHTTPEndpoint returns an error if the HTTP endpoint has an invalid
+// base URL, load balancing or health check, or an incomplete client
+// certificate.
+func ValidateHTTPEndpoint(endpoint *httpendpointsapi.HTTPEndpoint) (admission.Warnings, error) {
+	var errs []error
+
+	urls := endpoint.AllBaseURLs()
+	if len(urls) == 0 {
+		errs = append(errs, errors.New("spec.baseUrl: at least one of baseUrl or baseUrls must be set"))
+	}
+	for _, baseURL := range urls {
+		u, err := url.Parse(baseURL)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("spec.baseUrl: %w", err))
+		case u.Scheme != "http" && u.Scheme != "https":
+			errs = append(errs, fmt.Errorf("spec.baseUrl: %q must use the http or https scheme", baseURL))
+		case len(u.Host) == 0:
+			errs = append(errs, fmt.Errorf("spec.baseUrl: %q must have a host", baseURL))
+		}
+	}
+
+	if hc := endpoint.Spec.HealthCheck; hc != nil {
+		if hc.Active != nil {
+			if len(hc.Active.Path) == 0 {
+				errs = append(errs, errors.New("spec.healthCheck.active.path: must be set"))
+			}
+			if hc.Active.FailureThreshold < 0 {
+				errs = append(errs, errors.New("spec.healthCheck.active.failureThreshold: must not be negative"))
+			}
+		}
+		if hc.Passive != nil && hc.Passive.FailureThreshold < 0 {
+			errs = append(errs, errors.New("spec.healthCheck.passive.failureThreshold: must not be negative"))
+		}
+	}
+
+	if lb := endpoint.Spec.LoadBalancing; lb != nil {
+		switch lb.Strategy {
+		case "", httpendpointsapi.LoadBalancingRoundRobin, httpendpointsapi.LoadBalancingPriority:
+		default:
+			errs = append(errs, fmt.Errorf("spec.loadBalancing.strategy: %q must be one of RoundRobin or Priority", lb.Strategy))
+		}
+	}
+
+	hasCert := endpoint.HasTLSClientCert() || endpoint.HasTLSClientCertSecret()
+	hasKey := endpoint.HasTLSPrivateKey() || endpoint.HasTLSPrivateKeySecret()
+	if hasCert != hasKey {
+		errs = append(errs, errors.New("spec.clientTLS: certificate and privateKey must be set together"))
+	}
+
+	return nil, errors.Join(errs...)
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+// ValidateResiliency returns an error if the resiliency policies or targets
+// cannot be decoded by daprd, for example because of an invalid duration.
+func ValidateResiliency(res *resiliencyapi.Resiliency) (admission.Warnings, error) {
+	return nil, resiliency.New(log).DecodeConfiguration(res)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	"github.com/dapr/dapr/pkg/expr"
+)
+
+// ValidateSubscription returns an error if a route rule of the subscription
+// has no path, or a match expression which does not compile, or if its actor
+// target is invalid.
+func ValidateSubscription(sub *subapi.Subscription) (admission.Warnings, error) {
+	var errs []error
+	for i, rule := range sub.Spec.Routes.Rules {
+		if len(rule.Path) == 0 {
+			errs = append(errs, fmt.Errorf("spec.routes.rules[%d].path: path is required", i))
+		}
+		if match := strings.TrimSpace(rule.Match); len(match) > 0 {
+			var e expr.Expr
+			if err := e.DecodeString(match); err != nil {
+				errs = append(errs, fmt.Errorf("spec.routes.rules[%d].match: invalid expression %q: %w", i, rule.Match, err))
+			}
+		}
+	}
+	if actor := sub.Spec.Actor; actor != nil {
+		if len(actor.Type) == 0 {
+			errs = append(errs, errors.New("spec.actor.type: actor type is required"))
+		}
+		idExpression := strings.TrimSpace(actor.IDExpression)
+		if (len(actor.IDAttribute) == 0) == (len(idExpression) == 0) {
+			errs = append(errs, errors.New("spec.actor: exactly one of idAttribute or idExpression is required"))
+		}
+		if len(idExpression) > 0 {
+			var e expr.Expr
+			if err := e.DecodeString(idExpression); err != nil {
+				errs = append(errs, fmt.Errorf("spec.actor.idExpression: invalid expression %q: %w", actor.IDExpression, err))
+			}
+		}
+		if sub.Spec.BulkSubscribe.Enabled {
+			errs = append(errs, errors.New("spec.bulkSubscribe: bulk subscribe is not supported when delivering to an actor"))
+		}
+	}
+	return nil, errors.Join(errs...)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation implements the validating admission webhooks for the
+// Dapr resources, so invalid resources are rejected when applied rather than
+// when they are loaded by daprd.
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	configurationapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
+	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.operator.validation")
+
+// Register registers the validating webhooks for the Dapr resources with the
+// manager's webhook server.
+func Register(mgr ctrl.Manager) error {
+	for _, w := range []struct {
+		obj       runtime.Object
+		validator admission.CustomValidator
+	}{
+		{&componentsapi.Component{}, validator[componentsapi.Component](ValidateComponent)},
+		{&configurationapi.Configuration{}, validator[configurationapi.Configuration](ValidateConfiguration)},
+		{&httpendpointsapi.HTTPEndpoint{}, validator[httpendpointsapi.HTTPEndpoint](ValidateHTTPEndpoint)},
+		{&resiliencyapi.Resiliency{}, validator[resiliencyapi.Resiliency](ValidateResiliency)},
+		{&subapi.Subscription{}, validator[subapi.Subscription](ValidateSubscription)},
+	} {
+		err := ctrl.NewWebhookManagedBy(mgr).
+			For(w.obj).
+			WithValidator(w.validator).
+			Complete()
+		if err != nil {
+			return fmt.Errorf("unable to create validating webhook for %T: %w", w.obj, err)
+		}
+	}
+	return nil
+}
+
+// validator adapts a validation func to an admission.CustomValidator. Only
+// creates and updates are validated.
+type validator[T any] func(*T) (admission.Warnings, error)
+
+func (v validator[T]) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return v.validate(obj)
+}
+
+func (v validator[T]) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return v.validate(newObj)
+}
+
+func (v validator[T]) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v validator[T]) validate(obj runtime.Object) (admission.Warnings, error) {
+	t, ok := any(obj).(*T)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+	return v(t)
+}
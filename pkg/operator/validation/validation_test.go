@@ -0,0 +1,299 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonapi "github.com/dapr/dapr/pkg/apis/common"
+	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	configurationapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
+	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+)
+
+func TestValidator(t *testing.T) {
+	v := validator[componentsapi.Component](ValidateComponent)
+
+	_, err := v.ValidateCreate(t.Context(), &componentsapi.Component{
+		Spec: componentsapi.ComponentSpec{Type: "state.redis", Version: "v1"},
+	})
+	require.NoError(t, err)
+
+	_, err = v.ValidateUpdate(t.Context(), nil, &componentsapi.Component{
+		Spec: componentsapi.ComponentSpec{Type: "redis", Version: "v1"},
+	})
+	require.Error(t, err)
+
+	_, err = v.ValidateCreate(t.Context(), &subapi.Subscription{})
+	require.ErrorContains(t, err, "unexpected object type")
+
+	_, err = v.ValidateDelete(t.Context(), &componentsapi.Component{})
+	require.NoError(t, err)
+}
+
+func TestValidateComponent(t *testing.T) {
+	tests := map[string]struct {
+		spec     componentsapi.ComponentSpec
+		expErr   string
+		warnings int
+	}{
+		"valid": {
+			spec: componentsapi.ComponentSpec{Type: "pubsub.redis", Version: "v1"},
+		},
+		"valid middleware": {
+			spec: componentsapi.ComponentSpec{Type: "middleware.http.uppercase", Version: "v1"},
+		},
+		"no category": {
+			spec:   componentsapi.ComponentSpec{Type: "redis", Version: "v1"},
+			expErr: "spec.type",
+		},
+		"unknown category": {
+			spec:   componentsapi.ComponentSpec{Type: "queue.redis", Version: "v1"},
+			expErr: "unknown component category",
+		},
+		"invalid version": {
+			spec:   componentsapi.ComponentSpec{Type: "state.redis", Version: "1.0"},
+			expErr: "spec.version",
+		},
+		"valid without version": {
+			spec: componentsapi.ComponentSpec{Type: "state.redis"},
+		},
+		"valid versioned component": {
+			spec: componentsapi.ComponentSpec{Type: "state.postgresql", Version: "v2"},
+		},
+		"unknown version of built-in component": {
+			spec:   componentsapi.ComponentSpec{Type: "state.redis", Version: "v2"},
+			expErr: "is not a version of",
+		},
+		"unknown version of versioned component": {
+			spec:   componentsapi.ComponentSpec{Type: "state.yugabyte", Version: "v1"},
+			expErr: "is not a version of",
+		},
+		"unknown component with version of no pluggable component": {
+			spec:   componentsapi.ComponentSpec{Type: "state.doesnotexist", Version: "v99"},
+			expErr: "is not a built-in component type",
+		},
+		"unknown component is a warning as it may be pluggable": {
+			spec:     componentsapi.ComponentSpec{Type: "state.mypluggable", Version: "v1"},
+			warnings: 1,
+		},
+		"invalid init timeout and duplicate metadata are warnings": {
+			spec: componentsapi.ComponentSpec{
+				Type:        "state.redis",
+				Version:     "v1",
+				InitTimeout: "2",
+				Metadata: []commonapi.NameValuePair{
+					{Name: "redisHost"}, {Name: "redisHost"},
+				},
+			},
+			warnings: 2,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			warnings, err := ValidateComponent(&componentsapi.Component{Spec: test.spec})
+			if len(test.expErr) > 0 {
+				require.ErrorContains(t, err, test.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Len(t, warnings, test.warnings)
+		})
+	}
+}
+
+func TestValidateResiliency(t *testing.T) {
+	_, err := ValidateResiliency(&resiliencyapi.Resiliency{
+		Spec: resiliencyapi.ResiliencySpec{
+			Policies: resiliencyapi.Policies{
+				Timeouts: map[string]string{"general": "5s"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = ValidateResiliency(&resiliencyapi.Resiliency{
+		Spec: resiliencyapi.ResiliencySpec{
+			Policies: resiliencyapi.Policies{
+				Timeouts: map[string]string{"general": "5 parsecs"},
+			},
+		},
+	})
+	require.Error(t, err)
+}
+
+func TestValidateSubscription(t *testing.T) {
+	sub := func(rules ...subapi.Rule) *subapi.Subscription {
+		return &subapi.Subscription{
+			Spec: subapi.SubscriptionSpec{
+				Pubsubname: "pubsub",
+				Topic:      "topic",
+				Routes:     subapi.Routes{Rules: rules},
+			},
+		}
+	}
+
+	_, err := ValidateSubscription(sub(
+		subapi.Rule{Match: `event.type == "widget"`, Path: "/widgets"},
+		subapi.Rule{Path: "/default"},
+	))
+	require.NoError(t, err)
+
+	_, err = ValidateSubscription(sub(subapi.Rule{Match: `event.type ==`, Path: "/widgets"}))
+	require.ErrorContains(t, err, "spec.routes.rules[0].match")
+
+	_, err = ValidateSubscription(sub(subapi.Rule{Match: `event.type == "widget"`}))
+	require.ErrorContains(t, err, "spec.routes.rules[0].path")
+
+	t.Run("actor target", func(t *testing.T) {
+		actorSub := func(actor subapi.ActorTarget) *subapi.Subscription {
+			s := sub(subapi.Rule{Path: "onevent"})
+			s.Spec.Actor = &actor
+			return s
+		}
+
+		_, err := ValidateSubscription(actorSub(subapi.ActorTarget{Type: "myactor", IDAttribute: "subject"}))
+		require.NoError(t, err)
+
+		_, err = ValidateSubscription(actorSub(subapi.ActorTarget{Type: "myactor", IDExpression: `event.data.id`}))
+		require.NoError(t, err)
+
+		_, err = ValidateSubscription(actorSub(subapi.ActorTarget{IDAttribute: "subject"}))
+		require.ErrorContains(t, err, "spec.actor.type")
+
+		_, err = ValidateSubscription(actorSub(subapi.ActorTarget{Type: "myactor"}))
+		require.ErrorContains(t, err, "exactly one of idAttribute or idExpression")
+
+		_, err = ValidateSubscription(actorSub(subapi.ActorTarget{Type: "myactor", IDAttribute: "subject", IDExpression: `event.subject`}))
+		require.ErrorContains(t, err, "exactly one of idAttribute or idExpression")
+
+		_, err = ValidateSubscription(actorSub(subapi.ActorTarget{Type: "myactor", IDExpression: `event.data.`}))
+		require.ErrorContains(t, err, "spec.actor.idExpression")
+
+		s := actorSub(subapi.ActorTarget{Type: "myactor", IDAttribute: "subject"})
+		s.Spec.BulkSubscribe.Enabled = true
+		_, err = ValidateSubscription(s)
+		require.ErrorContains(t, err, "spec.bulkSubscribe")
+	})
+}
+
+func TestValidateConfiguration(t *testing.T) {
+	_, err := ValidateConfiguration(&configurationapi.Configuration{
+		Spec: configurationapi.ConfigurationSpec{
+			Secrets: &configurationapi.SecretsSpec{
+				Scopes: []configurationapi.SecretsScope{{StoreName: "store", DefaultAccess: "allow"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = ValidateConfiguration(&configurationapi.Configuration{
+		Spec: configurationapi.ConfigurationSpec{
+			Secrets: &configurationapi.SecretsSpec{
+				Scopes: []configurationapi.SecretsScope{{StoreName: "store", DefaultAccess: "maybe"}},
+			},
+		},
+	})
+	require.Error(t, err)
+
+	_, err = ValidateConfiguration(&configurationapi.Configuration{
+		Spec: configurationapi.ConfigurationSpec{
+			AccessControlSpec: &configurationapi.AccessControlSpec{
+				DefaultAction: "deny",
+				AppPolicies:   []configurationapi.AppPolicySpec{{AppName: "app1"}},
+			},
+		},
+	})
+	require.ErrorContains(t, err, "spec.accessControl")
+}
+
+func TestValidateHTTPEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		spec   httpendpointsapi.HTTPEndpointSpec
+		expErr string
+	}{
+		"valid": {
+			spec: httpendpointsapi.HTTPEndpointSpec{BaseURL: "https://example.com/api"},
+		},
+		"invalid scheme": {
+			spec:   httpendpointsapi.HTTPEndpointSpec{BaseURL: "ftp://example.com"},
+			expErr: "http or https",
+		},
+		"no host": {
+			spec:   httpendpointsapi.HTTPEndpointSpec{BaseURL: "http://"},
+			expErr: "must have a host",
+		},
+		"multiple base URLs": {
+			spec: httpendpointsapi.HTTPEndpointSpec{
+				BaseURLs:      []string{"https://eu.example.com", "https://us.example.com"},
+				LoadBalancing: &httpendpointsapi.LoadBalancing{Strategy: httpendpointsapi.LoadBalancingPriority},
+			},
+		},
+		"no base URL": {
+			spec:   httpendpointsapi.HTTPEndpointSpec{},
+			expErr: "at least one of baseUrl or baseUrls",
+		},
+		"invalid additional base URL": {
+			spec: httpendpointsapi.HTTPEndpointSpec{
+				BaseURL:  "https://example.com",
+				BaseURLs: []string{"ftp://example.com"},
+			},
+			expErr: "http or https",
+		},
+		"unknown load balancing strategy": {
+			spec: httpendpointsapi.HTTPEndpointSpec{
+				BaseURL:       "https://example.com",
+				LoadBalancing: &httpendpointsapi.LoadBalancing{Strategy: "Random"},
+			},
+			expErr: "spec.loadBalancing.strategy",
+		},
+		"active health check without path": {
+			spec: httpendpointsapi.HTTPEndpointSpec{
+				BaseURL: "https://example.com",
+				HealthCheck: &httpendpointsapi.HealthCheck{
+					Active: &httpendpointsapi.ActiveHealthCheck{},
+				},
+			},
+			expErr: "spec.healthCheck.active.path",
+		},
+		"certificate without private key": {
+			spec: httpendpointsapi.HTTPEndpointSpec{
+				BaseURL: "https://example.com",
+				ClientTLS: &commonapi.TLS{
+					Certificate: &commonapi.TLSDocument{
+						SecretKeyRef: &commonapi.SecretKeyRef{Name: "cert"},
+					},
+				},
+			},
+			expErr: "spec.clientTLS",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ValidateHTTPEndpoint(&httpendpointsapi.HTTPEndpoint{Spec: test.spec})
+			if len(test.expErr) > 0 {
+				require.ErrorContains(t, err, test.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -37,18 +37,47 @@ import (
 // ErrNoHosts is an error for no hosts.
 var ErrNoHosts = errors.New("no hosts added")
 
+// DefaultLoadBoundFactor is the factor by which the load of a host may exceed
+// its share of the total load when using Consistent Hashing With Bounded Loads.
+const DefaultLoadBoundFactor = 1.25
+
+// MaxWeight is the maximum weight of a host. Each unit of weight adds
+// replicationFactor virtual nodes to the ring, so the weight is bounded to keep
+// the size of the ring in check.
+const MaxWeight = 100
+
 // ConsistentHashTables is a table holding a map of consistent hashes with a given version.
 type ConsistentHashTables struct {
 	Version string
 	Entries map[string]*Consistent
+	// LoadBoundFactor enables Consistent Hashing With Bounded Loads when
+	// greater than 1.
+	LoadBoundFactor float64
 }
 
-// Host represents a host of stateful entities with a given name, id, port and load.
+// Host represents a host of stateful entities with a given name, id, port, load and weight.
 type Host struct {
 	Name  string
 	Port  int64
 	Load  int64
 	AppID string
+	// Weight is the relative capacity of the host. A host is assigned
+	// replicationFactor*Weight virtual nodes and its share of the total load
+	// is proportional to its weight. A zero weight is treated as 1, and weights
+	// greater than MaxWeight as MaxWeight.
+	Weight uint32
+}
+
+// weight returns the weight of the host, defaulting to 1.
+func (h *Host) weight() int64 {
+	switch {
+	case h.Weight == 0:
+		return 1
+	case h.Weight > MaxWeight:
+		return MaxWeight
+	default:
+		return int64(h.Weight)
+	}
 }
 
 // Consistent represents a data structure for consistent hashing.
@@ -57,7 +86,9 @@ type Consistent struct {
 	sortedSet         []uint64
 	loadMap           map[string]*Host
 	totalLoad         int64
+	totalWeight       int64
 	replicationFactor int64
+	loadBoundFactor   float64
 
 	sync.RWMutex
 }
@@ -79,6 +110,7 @@ func NewConsistentHash(replicationFactory int64) *Consistent {
 		sortedSet:         []uint64{},
 		loadMap:           map[string]*Host{},
 		replicationFactor: replicationFactory,
+		loadBoundFactor:   DefaultLoadBoundFactor,
 	}
 }
 
@@ -89,10 +121,14 @@ func NewFromExisting(loadMap map[string]*Host, replicationFactor int64, virtualN
 		sortedSet:         []uint64{},
 		loadMap:           loadMap,
 		replicationFactor: replicationFactor,
+		loadBoundFactor:   DefaultLoadBoundFactor,
 	}
 
-	for hostName := range loadMap {
-		hashes := virtualNodesCache.GetHashes(replicationFactor, hostName)
+	for hostName, host := range loadMap {
+		newHash.totalLoad += host.Load
+		newHash.totalWeight += host.weight()
+
+		hashes := virtualNodesCache.GetHashes(replicationFactor*host.weight(), hostName)
 		for _, h := range hashes {
 			newHash.hosts[h] = hostName
 		}
@@ -165,16 +201,19 @@ func (hc *VirtualNodesCache) setHashes(replicationFactor int64, host string) []u
 		}
 	}
 
-	hashMap := newHashMap()
-	hashMap.hashes[host] = make([]uint64, replicationFactor)
+	hashMap, exists := hc.data[replicationFactor]
+	if !exists {
+		hashMap = newHashMap()
+		hc.data[replicationFactor] = hashMap
+	}
 
+	hashes := make([]uint64, replicationFactor)
 	for i := range int(replicationFactor) {
-		hashMap.hashes[host][i] = hash(host + strconv.Itoa(i))
+		hashes[i] = hash(host + strconv.Itoa(i))
 	}
+	hashMap.hashes[host] = hashes
 
-	hc.data[replicationFactor] = hashMap
-
-	return hashMap.hashes[host]
+	return hashes
 }
 
 // ReadInternals returns the internal data structure of the consistent hash.
@@ -185,9 +224,9 @@ func (c *Consistent) ReadInternals(reader func(map[uint64]string, []uint64, map[
 	reader(c.hosts, c.sortedSet, c.loadMap, c.totalLoad)
 }
 
-// Add adds a host with port to the table.
+// Add adds a host with port and weight to the table.
 // Used on the Placement side to add hosts to the ring. It doesn't calculate vnodes.
-func (c *Consistent) Add(host, id string, port int64) bool {
+func (c *Consistent) Add(host, id string, port int64, weight uint32) bool {
 	c.Lock()
 	defer c.Unlock()
 
@@ -195,11 +234,25 @@ func (c *Consistent) Add(host, id string, port int64) bool {
 		return true
 	}
 
-	c.loadMap[host] = &Host{Name: host, AppID: id, Load: 0, Port: port}
+	h := &Host{Name: host, AppID: id, Load: 0, Port: port, Weight: weight}
+	c.loadMap[host] = h
+	c.totalWeight += h.weight()
 
 	return false
 }
 
+// SetLoadBoundFactor sets the factor by which the load of a host may exceed
+// its weighted share of the total load in GetLeast.
+// Values lower than 1 are ignored, since no host could satisfy the bound.
+func (c *Consistent) SetLoadBoundFactor(factor float64) {
+	c.Lock()
+	defer c.Unlock()
+
+	if factor >= 1 {
+		c.loadBoundFactor = factor
+	}
+}
+
 // Get returns the host that owns `key`.
 // It assumes that the struct was created on the Daprd side with NewFromExisting,
 // which is where the hashes are calculated
@@ -231,6 +284,17 @@ func (c *Consistent) GetHost(key string) (*Host, error) {
 	return c.loadMap[h], nil
 }
 
+// GetLeastHost gets the least loaded host that can serve the key.
+// See GetLeast.
+func (c *Consistent) GetLeastHost(key string) (*Host, error) {
+	h, err := c.GetLeast(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.loadMap[h], nil
+}
+
 // GetLeast uses Consistent Hashing With Bounded loads
 //
 // https://research.googleblog.com/2017/04/consistent-hashing-with-bounded-loads.html
@@ -256,7 +320,7 @@ func (c *Consistent) GetLeast(key string) (string, error) {
 			return host, nil
 		}
 		i++
-		if i >= len(c.hosts) {
+		if i >= len(c.sortedSet) {
 			i = 0
 		}
 	}
@@ -274,7 +338,16 @@ func (c *Consistent) search(key uint64) int {
 }
 
 // UpdateLoad sets the load of `host` to the given `load`.
+// The write lock is only taken if the load changed.
 func (c *Consistent) UpdateLoad(host string, load int64) {
+	c.RLock()
+	h, ok := c.loadMap[host]
+	changed := ok && atomic.LoadInt64(&h.Load) != load
+	c.RUnlock()
+	if !changed {
+		return
+	}
+
 	c.Lock()
 	defer c.Unlock()
 
@@ -316,11 +389,18 @@ func (c *Consistent) Remove(host string) bool {
 	c.Lock()
 	defer c.Unlock()
 
-	for i := range int(c.replicationFactor) {
+	bhost, ok := c.loadMap[host]
+	if !ok {
+		return true
+	}
+
+	for i := range int(c.replicationFactor * bhost.weight()) {
 		h := hash(host + strconv.Itoa(i))
 		delete(c.hosts, h)
 		c.delSlice(h)
 	}
+	c.totalLoad -= bhost.Load
+	c.totalWeight -= bhost.weight()
 	delete(c.loadMap, host)
 	return true
 }
@@ -345,9 +425,9 @@ func (c *Consistent) GetLoads() map[string]int64 {
 	return loads
 }
 
-// MaxLoad returns the maximum load of the single host
+// MaxLoad returns the maximum load of a single host of weight 1
 // which is:
-// (total_load/number_of_hosts)*1.25
+// (total_load/total_weight)*load_bound_factor
 // total_load = is the total number of active requests served by hosts
 // for more info:
 // https://research.googleblog.com/2017/04/consistent-hashing-with-bounded-loads.html
@@ -355,13 +435,23 @@ func (c *Consistent) MaxLoad() int64 {
 	if c.totalLoad == 0 {
 		c.totalLoad = 1
 	}
-	var avgLoadPerNode float64
-	avgLoadPerNode = float64(c.totalLoad / int64(len(c.loadMap)))
-	if avgLoadPerNode == 0 {
-		avgLoadPerNode = 1
+	return int64(c.maxLoad(c.totalLoad, 1))
+}
+
+// maxLoad returns the maximum load of a host with the given weight, which is
+// the host's weighted share of the total load multiplied by the load bound
+// factor, and never lower than 1.
+func (c *Consistent) maxLoad(totalLoad int64, weight int64) float64 {
+	totalWeight := c.totalWeight
+	if totalWeight <= 0 {
+		totalWeight = int64(len(c.loadMap))
 	}
-	avgLoadPerNode = math.Ceil(avgLoadPerNode * 1.25)
-	return int64(avgLoadPerNode)
+
+	maxLoad := math.Ceil(float64(totalLoad) * float64(weight) / float64(totalWeight) * c.loadBoundFactor)
+	if maxLoad < 1 {
+		maxLoad = 1
+	}
+	return maxLoad
 }
 
 func (c *Consistent) loadOK(host string) bool {
@@ -370,23 +460,12 @@ func (c *Consistent) loadOK(host string) bool {
 		c.totalLoad = 0
 	}
 
-	var avgLoadPerNode float64
-	avgLoadPerNode = float64((c.totalLoad + 1) / int64(len(c.loadMap)))
-	if avgLoadPerNode == 0 {
-		avgLoadPerNode = 1
-	}
-	avgLoadPerNode = math.Ceil(avgLoadPerNode * 1.25)
-
 	bhost, ok := c.loadMap[host]
 	if !ok {
-		panic(fmt.Sprintf("given host(%s) not in loadsMap", bhost.Name))
+		panic(fmt.Sprintf("given host(%s) not in loadsMap", host))
 	}
 
-	if float64(bhost.Load)+1 <= avgLoadPerNode {
-		return true
-	}
-
-	return false
+	return float64(bhost.Load)+1 <= c.maxLoad(c.totalLoad+1, bhost.weight())
 }
 
 func (c *Consistent) delSlice(val uint64) {
@@ -109,3 +109,164 @@ func TestGetAndSetVirtualNodeCacheHashesConcurrently(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestWeightedHosts(t *testing.T) {
+	loadMap := map[string]*Host{
+		"small": NewHost("small", "app", 0, 1),
+		"large": NewHost("large", "app", 0, 1),
+	}
+	loadMap["large"].Weight = 3
+
+	h := NewFromExisting(loadMap, 100, NewVirtualNodesCache())
+
+	h.ReadInternals(func(hosts map[uint64]string, sortedSet []uint64, _ map[string]*Host, _ int64) {
+		assert.Len(t, sortedSet, 400)
+		assert.Len(t, hosts, 400)
+	})
+
+	counts := map[string]int{}
+	for i := range 10000 {
+		host, err := h.Get(strconv.Itoa(i))
+		require.NoError(t, err)
+		counts[host]++
+	}
+
+	ratio := float64(counts["large"]) / float64(counts["small"])
+	assert.Greater(t, ratio, 2.0)
+	assert.Less(t, ratio, 4.0)
+
+	t.Run("remove weighted host", func(t *testing.T) {
+		h.Remove("large")
+		h.ReadInternals(func(hosts map[uint64]string, sortedSet []uint64, _ map[string]*Host, _ int64) {
+			assert.Len(t, sortedSet, 100)
+			assert.Len(t, hosts, 100)
+		})
+		host, err := h.Get("1")
+		require.NoError(t, err)
+		assert.Equal(t, "small", host)
+	})
+
+	t.Run("weight is capped at MaxWeight", func(t *testing.T) {
+		loadMap := map[string]*Host{"huge": NewHost("huge", "app", 0, 1)}
+		loadMap["huge"].Weight = 4_000_000_000
+
+		h := NewFromExisting(loadMap, 10, NewVirtualNodesCache())
+		h.ReadInternals(func(hosts map[uint64]string, sortedSet []uint64, _ map[string]*Host, _ int64) {
+			assert.Len(t, sortedSet, 10*MaxWeight)
+			assert.Len(t, hosts, 10*MaxWeight)
+		})
+
+		h.Remove("huge")
+		h.ReadInternals(func(hosts map[uint64]string, sortedSet []uint64, _ map[string]*Host, _ int64) {
+			assert.Empty(t, sortedSet)
+			assert.Empty(t, hosts)
+		})
+	})
+}
+
+func TestGetLeast(t *testing.T) {
+	newHash := func(loads map[string]int64, weights map[string]uint32) *Consistent {
+		loadMap := make(map[string]*Host, len(nodes))
+		for _, node := range nodes {
+			loadMap[node] = NewHost(node, node, loads[node], 1)
+			loadMap[node].Weight = weights[node]
+		}
+		return NewFromExisting(loadMap, 100, NewVirtualNodesCache())
+	}
+
+	t.Run("no load is the same as Get", func(t *testing.T) {
+		h := newHash(nil, nil)
+		for i := range 100 {
+			expected, err := h.Get(strconv.Itoa(i))
+			require.NoError(t, err)
+			got, err := h.GetLeast(strconv.Itoa(i))
+			require.NoError(t, err)
+			assert.Equal(t, expected, got)
+		}
+	})
+
+	t.Run("overloaded host is skipped", func(t *testing.T) {
+		h := newHash(map[string]int64{"node1": 100, "node2": 10, "node3": 10, "node4": 10, "node5": 10}, nil)
+		for i := range 1000 {
+			host, err := h.GetLeast(strconv.Itoa(i))
+			require.NoError(t, err)
+			assert.NotEqual(t, "node1", host)
+		}
+	})
+
+	t.Run("weighted host can take a larger share of the load", func(t *testing.T) {
+		loads := map[string]int64{"node1": 100, "node2": 10, "node3": 10, "node4": 10, "node5": 10}
+		h := newHash(loads, map[string]uint32{"node1": 8})
+
+		var found bool
+		for i := range 1000 {
+			host, err := h.GetLeast(strconv.Itoa(i))
+			require.NoError(t, err)
+			if host == "node1" {
+				found = true
+				break
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("load bound factor", func(t *testing.T) {
+		loads := map[string]int64{"node1": 30, "node2": 20, "node3": 20, "node4": 20, "node5": 20}
+		h := newHash(loads, nil)
+		// Share of node1 is 111/5=22.2, so the default bound of 1.25 is exceeded.
+		assert.False(t, h.loadOK("node1"))
+
+		h.SetLoadBoundFactor(1.5)
+		assert.True(t, h.loadOK("node1"))
+
+		// Factors lower than 1 are ignored.
+		h.SetLoadBoundFactor(0.5)
+		assert.True(t, h.loadOK("node1"))
+	})
+
+	t.Run("no hosts", func(t *testing.T) {
+		_, err := NewConsistentHash(100).GetLeast("key")
+		require.ErrorIs(t, err, ErrNoHosts)
+	})
+}
+
+func TestUpdateLoad(t *testing.T) {
+	h := NewConsistentHash(100)
+	h.Add("node1", "node1", 0, 1)
+	h.Add("node2", "node2", 0, 1)
+
+	totalLoad := func() (total int64) {
+		h.ReadInternals(func(_ map[uint64]string, _ []uint64, _ map[string]*Host, t int64) {
+			total = t
+		})
+		return total
+	}
+
+	h.UpdateLoad("node1", 5)
+	h.UpdateLoad("node2", 3)
+	assert.Equal(t, map[string]int64{"node1": 5, "node2": 3}, h.GetLoads())
+	assert.Equal(t, int64(8), totalLoad())
+
+	// Reporting the same load again doesn't change the total.
+	h.UpdateLoad("node1", 5)
+	assert.Equal(t, int64(8), totalLoad())
+
+	h.UpdateLoad("node1", 1)
+	assert.Equal(t, map[string]int64{"node1": 1, "node2": 3}, h.GetLoads())
+	assert.Equal(t, int64(4), totalLoad())
+
+	// Unknown hosts are ignored.
+	h.UpdateLoad("node3", 10)
+	assert.Equal(t, int64(4), totalLoad())
+}
+
+func TestVirtualNodeCacheMultipleHosts(t *testing.T) {
+	cache := NewVirtualNodesCache()
+	first := cache.GetHashes(5, "host1")
+	cache.GetHashes(5, "host2")
+
+	cache.RLock()
+	defer cache.RUnlock()
+	assert.Len(t, cache.data[5].hashes, 2)
+	assert.Equal(t, first, cache.data[5].hashes["host1"])
+}
@@ -32,6 +32,7 @@ import (
 	"k8s.io/utils/clock"
 
 	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/placement/hashing"
 	"github.com/dapr/dapr/pkg/placement/monitoring"
 	"github.com/dapr/dapr/pkg/placement/raft"
 	placementv1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
@@ -374,6 +375,14 @@ func (p *Service) ReportDaprStatus(stream placementv1pb.Placement_ReportDaprStat
 		}
 	}()
 
+	// The last load reported by the host and the table generation it was set
+	// in, so the hashing tables are only updated when either changes.
+	var (
+		lastLoad           int64 = -1
+		lastLoadGeneration uint64
+		weightClamped      bool
+	)
+
 	for p.hasLeadership.Load() {
 		select {
 		case <-ctx.Done():
@@ -387,6 +396,16 @@ func (p *Service) ReportDaprStatus(stream placementv1pb.Placement_ReportDaprStat
 
 			host := in.host
 
+			// The weight is bounded as it determines the number of virtual nodes
+			// every runtime in the namespace adds to the hashing tables.
+			if host.GetWeight() > hashing.MaxWeight {
+				if !weightClamped {
+					log.Warnf("Host %s in namespace %s reported weight %d; using the maximum weight of %d", host.GetName(), host.GetNamespace(), host.GetWeight(), hashing.MaxWeight)
+					weightClamped = true
+				}
+				host.Weight = hashing.MaxWeight
+			}
+
 			if !requiresUpdateInPlacementTables(host, &isActorHost) {
 				continue
 			}
@@ -413,10 +432,21 @@ func (p *Service) ReportDaprStatus(stream placementv1pb.Placement_ReportDaprStat
 						Entities:  host.GetEntities(),
 						UpdatedAt: now.UnixNano(),
 						APILevel:  host.GetApiLevel(),
+						Weight:    host.GetWeight(),
 					},
 				}
 				log.Debugf("Member changed; upserting appid %s in namespace %s with entities %v", appID, namespace, host.GetEntities())
 			}
+
+			// Loads are only kept in memory by the leader; they are picked up by
+			// the next table dissemination for bounded-load placement. Tables are
+			// rebuilt when the membership changes, so the load is set again in
+			// every new table generation.
+			state := p.raftNode.FSM().State()
+			if gen := state.TableGeneration(); host.GetLoad() != lastLoad || gen != lastLoadGeneration {
+				state.UpdateLoad(namespace, host.GetName(), host.GetLoad())
+				lastLoad, lastLoadGeneration = host.GetLoad(), gen
+			}
 		}
 	}
 
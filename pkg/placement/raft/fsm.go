@@ -75,6 +75,7 @@ func (c *FSM) PlacementState(namespace string) *v1pb.PlacementTables {
 		Entries:           make(map[string]*v1pb.PlacementTable),
 		ApiLevel:          c.state.APILevel(),
 		ReplicationFactor: c.config.replicationFactor,
+		LoadBoundFactor:   c.config.loadBoundFactor,
 	}
 
 	totalLoadMap := 0
@@ -97,10 +98,11 @@ func (c *FSM) PlacementState(namespace string) *v1pb.PlacementTables {
 
 			for lk, lv := range loadMap {
 				h := v1pb.Host{
-					Name: lv.Name,
-					Load: lv.Load,
-					Port: lv.Port,
-					Id:   lv.AppID,
+					Name:   lv.Name,
+					Load:   lv.Load,
+					Port:   lv.Port,
+					Id:     lv.AppID,
+					Weight: lv.Weight,
 				}
 				table.LoadMap[lk] = &h
 			}
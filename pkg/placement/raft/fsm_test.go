@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/dapr/dapr/pkg/placement/hashing"
+	v1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
 )
 
 func TestFSMApply(t *testing.T) {
@@ -269,3 +270,57 @@ func TestRestoreFromOldVersion(t *testing.T) {
 
 	require.True(t, ok)
 }
+
+func TestPlacementStateWeightAndLoad(t *testing.T) {
+	fsm := newFSM(DaprHostMemberStateConfig{
+		replicationFactor: 5,
+		loadBoundFactor:   1.5,
+	})
+	m := DaprHostMember{
+		Name:      "127.0.0.1:3030",
+		Namespace: "ns1",
+		AppID:     "fakeAppID",
+		Entities:  []string{"actorTypeOne", "actorTypeTwo"},
+		Weight:    4,
+	}
+	cmdLog, err := makeRaftLogCommand(MemberUpsert, m)
+	require.NoError(t, err)
+
+	fsm.Apply(&raft.Log{
+		Index: 1,
+		Term:  1,
+		Type:  raft.LogCommand,
+		Data:  cmdLog,
+	})
+
+	fsm.State().UpdateLoad("ns1", "127.0.0.1:3030", 7)
+	// Unknown hosts and namespaces are ignored
+	fsm.State().UpdateLoad("ns1", "127.0.0.1:4040", 7)
+	fsm.State().UpdateLoad("ns2", "127.0.0.1:3030", 7)
+
+	newTable := fsm.PlacementState("ns1")
+	assert.InDelta(t, 1.5, newTable.GetLoadBoundFactor(), 0)
+	require.Len(t, newTable.GetEntries(), 2)
+	for _, table := range newTable.GetEntries() {
+		assert.Equal(t, int64(7), table.GetTotalLoad())
+		require.Contains(t, table.GetLoadMap(), "127.0.0.1:3030")
+		host := table.GetLoadMap()["127.0.0.1:3030"]
+		assert.Equal(t, uint32(4), host.GetWeight())
+		assert.Equal(t, int64(7), host.GetLoad())
+	}
+
+	t.Run("weight change requires an upsert", func(t *testing.T) {
+		assert.False(t, fsm.State().UpsertRequired("ns1", &v1pb.Host{
+			Name:     "127.0.0.1:3030",
+			Id:       "fakeAppID",
+			Entities: []string{"actorTypeOne", "actorTypeTwo"},
+			Weight:   4,
+		}))
+		assert.True(t, fsm.State().UpsertRequired("ns1", &v1pb.Host{
+			Name:     "127.0.0.1:3030",
+			Id:       "fakeAppID",
+			Entities: []string{"actorTypeOne", "actorTypeTwo"},
+			Weight:   2,
+		}))
+	})
+}
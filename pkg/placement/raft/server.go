@@ -86,6 +86,7 @@ type Options struct {
 	LogStorePath      string
 	Clock             clock.Clock
 	ReplicationFactor int64
+	LoadBoundFactor   float64
 	MinAPILevel       uint32
 	MaxAPILevel       uint32
 	Healthz           healthz.Healthz
@@ -118,6 +119,7 @@ func New(opts Options) (*Server, error) {
 		config:           opts.Config,
 		fsm: newFSM(DaprHostMemberStateConfig{
 			replicationFactor: opts.ReplicationFactor,
+			loadBoundFactor:   opts.LoadBoundFactor,
 			minAPILevel:       opts.MinAPILevel,
 			maxAPILevel:       opts.MaxAPILevel,
 		}),
@@ -46,6 +46,10 @@ type DaprHostMember struct {
 
 	// Version of the Actor APIs supported by the Dapr runtime
 	APILevel uint32
+
+	// Weight is the relative capacity reported by the Dapr runtime, used to
+	// assign it a proportional share of the actors.
+	Weight uint32
 }
 
 func (d *DaprHostMember) NamespaceAndName() string {
@@ -97,6 +101,7 @@ type DaprHostMemberState struct {
 // that needs to be consistent across leader changes
 type DaprHostMemberStateConfig struct {
 	replicationFactor int64
+	loadBoundFactor   float64
 	minAPILevel       uint32
 	maxAPILevel       uint32
 }
@@ -215,12 +220,34 @@ func (s *DaprHostMemberState) UpsertRequired(ns string, new *placementv1pb.Host)
 	}
 	if m, ok := n.Members[new.GetName()]; ok {
 		// If all attributes match, no upsert is required
-		return !(m.AppID == new.GetId() && m.Name == new.GetName() && cmp.Equal(m.Entities, new.GetEntities()))
+		return !(m.AppID == new.GetId() && m.Name == new.GetName() && m.Weight == new.GetWeight() && cmp.Equal(m.Entities, new.GetEntities()))
 	}
 
 	return true
 }
 
+// UpdateLoad sets the load reported by a Dapr runtime in the hashing tables
+// of all the actor types it hosts. Loads are only used to compute bounded-load
+// placement at dissemination time, so they are not replicated via raft.
+func (s *DaprHostMemberState) UpdateLoad(ns string, name string, load int64) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	n, ok := s.data.Namespace[ns]
+	if !ok {
+		return
+	}
+	m, ok := n.Members[name]
+	if !ok {
+		return
+	}
+	for _, e := range m.Entities {
+		if t, ok := n.hashingTableMap[e]; ok {
+			t.UpdateLoad(name, load)
+		}
+	}
+}
+
 func (s *DaprHostMemberState) TableGeneration() uint64 {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -325,6 +352,7 @@ func (s *DaprHostMemberState) clone() *DaprHostMemberState {
 				Entities:  make([]string, len(v.Entities)),
 				UpdatedAt: v.UpdatedAt,
 				APILevel:  v.APILevel,
+				Weight:    v.Weight,
 			}
 			copy(m.Entities, v.Entities)
 			newMembers.data.Namespace[nsName].Members[k] = m
@@ -351,7 +379,7 @@ func (s *DaprHostMemberState) updateHashingTables(host *DaprHostMember) {
 			s.data.Namespace[host.Namespace].hashingTableMap[e] = hashing.NewConsistentHash(s.config.replicationFactor)
 		}
 
-		s.data.Namespace[host.Namespace].hashingTableMap[e].Add(host.Name, host.AppID, 0)
+		s.data.Namespace[host.Namespace].hashingTableMap[e].Add(host.Name, host.AppID, 0, host.Weight)
 	}
 }
 
@@ -394,7 +422,7 @@ func (s *DaprHostMemberState) upsertMember(host *DaprHostMember) bool {
 
 	if m, ok := ns.Members[host.Name]; ok {
 		// No need to update consistent hashing table if the same dapr host member exists
-		if m.AppID == host.AppID && m.Name == host.Name && cmp.Equal(m.Entities, host.Entities) {
+		if m.AppID == host.AppID && m.Name == host.Name && m.Weight == host.Weight && cmp.Equal(m.Entities, host.Entities) {
 			m.UpdatedAt = host.UpdatedAt
 			return false
 		}
@@ -410,6 +438,7 @@ func (s *DaprHostMemberState) upsertMember(host *DaprHostMember) bool {
 		AppID:     host.AppID,
 		UpdatedAt: host.UpdatedAt,
 		APILevel:  host.APILevel,
+		Weight:    host.Weight,
 	}
 
 	ns.Members[host.Name].Entities = make([]string, len(host.Entities))
@@ -0,0 +1,311 @@
+//
+//Copyright 2025 The Dapr Authors
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//http://www.apache.org/licenses/LICENSE-2.0
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.4
+// source: dapr/proto/components/v1/configuration.proto
+
+package components
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ConfigurationStore_Init_FullMethodName        = "/dapr.proto.components.v1.ConfigurationStore/Init"
+	ConfigurationStore_Get_FullMethodName         = "/dapr.proto.components.v1.ConfigurationStore/Get"
+	ConfigurationStore_Subscribe_FullMethodName   = "/dapr.proto.components.v1.ConfigurationStore/Subscribe"
+	ConfigurationStore_Unsubscribe_FullMethodName = "/dapr.proto.components.v1.ConfigurationStore/Unsubscribe"
+	ConfigurationStore_Ping_FullMethodName        = "/dapr.proto.components.v1.ConfigurationStore/Ping"
+)
+
+// ConfigurationStoreClient is the client API for ConfigurationStore service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConfigurationStoreClient interface {
+	// Initializes the configuration store with the given metadata.
+	Init(ctx context.Context, in *ConfigurationStoreInitRequest, opts ...grpc.CallOption) (*ConfigurationStoreInitResponse, error)
+	// Gets configuration items from the store.
+	Get(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error)
+	// Subscribes to changes of configuration items. The first message sent by
+	// the server must carry the subscription ID and no items. Every following
+	// message is an update event for that subscription. The server closes the
+	// stream once the subscription is removed with Unsubscribe.
+	Subscribe(ctx context.Context, in *SubscribeConfigurationRequest, opts ...grpc.CallOption) (ConfigurationStore_SubscribeClient, error)
+	// Removes a subscription.
+	Unsubscribe(ctx context.Context, in *UnsubscribeConfigurationRequest, opts ...grpc.CallOption) (*UnsubscribeConfigurationResponse, error)
+	// Ping the configuration store. Used for liveness purposes.
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+}
+
+type configurationStoreClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConfigurationStoreClient(cc grpc.ClientConnInterface) ConfigurationStoreClient {
+	return &configurationStoreClient{cc}
+}
+
+func (c *configurationStoreClient) Init(ctx context.Context, in *ConfigurationStoreInitRequest, opts ...grpc.CallOption) (*ConfigurationStoreInitResponse, error) {
+	out := new(ConfigurationStoreInitResponse)
+	err := c.cc.Invoke(ctx, ConfigurationStore_Init_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationStoreClient) Get(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error) {
+	out := new(GetConfigurationResponse)
+	err := c.cc.Invoke(ctx, ConfigurationStore_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationStoreClient) Subscribe(ctx context.Context, in *SubscribeConfigurationRequest, opts ...grpc.CallOption) (ConfigurationStore_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ConfigurationStore_ServiceDesc.Streams[0], ConfigurationStore_Subscribe_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &configurationStoreSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ConfigurationStore_SubscribeClient interface {
+	Recv() (*SubscribeConfigurationResponse, error)
+	grpc.ClientStream
+}
+
+type configurationStoreSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *configurationStoreSubscribeClient) Recv() (*SubscribeConfigurationResponse, error) {
+	m := new(SubscribeConfigurationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *configurationStoreClient) Unsubscribe(ctx context.Context, in *UnsubscribeConfigurationRequest, opts ...grpc.CallOption) (*UnsubscribeConfigurationResponse, error) {
+	out := new(UnsubscribeConfigurationResponse)
+	err := c.cc.Invoke(ctx, ConfigurationStore_Unsubscribe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configurationStoreClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, ConfigurationStore_Ping_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConfigurationStoreServer is the server API for ConfigurationStore service.
+// All implementations should embed UnimplementedConfigurationStoreServer
+// for forward compatibility
+type ConfigurationStoreServer interface {
+	// Initializes the configuration store with the given metadata.
+	Init(context.Context, *ConfigurationStoreInitRequest) (*ConfigurationStoreInitResponse, error)
+	// Gets configuration items from the store.
+	Get(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error)
+	// Subscribes to changes of configuration items. The first message sent by
+	// the server must carry the subscription ID and no items. Every following
+	// message is an update event for that subscription. The server closes the
+	// stream once the subscription is removed with Unsubscribe.
+	Subscribe(*SubscribeConfigurationRequest, ConfigurationStore_SubscribeServer) error
+	// Removes a subscription.
+	Unsubscribe(context.Context, *UnsubscribeConfigurationRequest) (*UnsubscribeConfigurationResponse, error)
+	// Ping the configuration store. Used for liveness purposes.
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+}
+
+// UnimplementedConfigurationStoreServer should be embedded to have forward compatible implementations.
+type UnimplementedConfigurationStoreServer struct {
+}
+
+func (UnimplementedConfigurationStoreServer) Init(context.Context, *ConfigurationStoreInitRequest) (*ConfigurationStoreInitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (UnimplementedConfigurationStoreServer) Get(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedConfigurationStoreServer) Subscribe(*SubscribeConfigurationRequest, ConfigurationStore_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedConfigurationStoreServer) Unsubscribe(context.Context, *UnsubscribeConfigurationRequest) (*UnsubscribeConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
+}
+func (UnimplementedConfigurationStoreServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+
+// UnsafeConfigurationStoreServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConfigurationStoreServer will
+// result in compilation errors.
+type UnsafeConfigurationStoreServer interface {
+	mustEmbedUnimplementedConfigurationStoreServer()
+}
+
+func RegisterConfigurationStoreServer(s grpc.ServiceRegistrar, srv ConfigurationStoreServer) {
+	s.RegisterService(&ConfigurationStore_ServiceDesc, srv)
+}
+
+func _ConfigurationStore_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigurationStoreInitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationStoreServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationStore_Init_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationStoreServer).Init(ctx, req.(*ConfigurationStoreInitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationStore_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationStoreServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationStore_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationStoreServer).Get(ctx, req.(*GetConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationStore_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeConfigurationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConfigurationStoreServer).Subscribe(m, &configurationStoreSubscribeServer{stream})
+}
+
+type ConfigurationStore_SubscribeServer interface {
+	Send(*SubscribeConfigurationResponse) error
+	grpc.ServerStream
+}
+
+type configurationStoreSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *configurationStoreSubscribeServer) Send(m *SubscribeConfigurationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ConfigurationStore_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationStoreServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationStore_Unsubscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationStoreServer).Unsubscribe(ctx, req.(*UnsubscribeConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigurationStore_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationStoreServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigurationStore_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationStoreServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConfigurationStore_ServiceDesc is the grpc.ServiceDesc for ConfigurationStore service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConfigurationStore_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dapr.proto.components.v1.ConfigurationStore",
+	HandlerType: (*ConfigurationStoreServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _ConfigurationStore_Init_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ConfigurationStore_Get_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _ConfigurationStore_Unsubscribe_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _ConfigurationStore_Ping_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _ConfigurationStore_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dapr/proto/components/v1/configuration.proto",
+}
@@ -0,0 +1,199 @@
+//
+//Copyright 2025 The Dapr Authors
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//http://www.apache.org/licenses/LICENSE-2.0
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.4
+// source: dapr/proto/components/v1/conversation.proto
+
+package components
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Conversation_Init_FullMethodName     = "/dapr.proto.components.v1.Conversation/Init"
+	Conversation_Converse_FullMethodName = "/dapr.proto.components.v1.Conversation/Converse"
+	Conversation_Ping_FullMethodName     = "/dapr.proto.components.v1.Conversation/Ping"
+)
+
+// ConversationClient is the client API for Conversation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConversationClient interface {
+	// Initializes the conversation component with the given metadata.
+	Init(ctx context.Context, in *ConversationInitRequest, opts ...grpc.CallOption) (*ConversationInitResponse, error)
+	// Sends messages to the LLM and returns its response.
+	Converse(ctx context.Context, in *ConverseRequest, opts ...grpc.CallOption) (*ConverseResponse, error)
+	// Ping the conversation component. Used for liveness purposes.
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+}
+
+type conversationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConversationClient(cc grpc.ClientConnInterface) ConversationClient {
+	return &conversationClient{cc}
+}
+
+func (c *conversationClient) Init(ctx context.Context, in *ConversationInitRequest, opts ...grpc.CallOption) (*ConversationInitResponse, error) {
+	out := new(ConversationInitResponse)
+	err := c.cc.Invoke(ctx, Conversation_Init_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) Converse(ctx context.Context, in *ConverseRequest, opts ...grpc.CallOption) (*ConverseResponse, error) {
+	out := new(ConverseResponse)
+	err := c.cc.Invoke(ctx, Conversation_Converse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, Conversation_Ping_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConversationServer is the server API for Conversation service.
+// All implementations should embed UnimplementedConversationServer
+// for forward compatibility
+type ConversationServer interface {
+	// Initializes the conversation component with the given metadata.
+	Init(context.Context, *ConversationInitRequest) (*ConversationInitResponse, error)
+	// Sends messages to the LLM and returns its response.
+	Converse(context.Context, *ConverseRequest) (*ConverseResponse, error)
+	// Ping the conversation component. Used for liveness purposes.
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+}
+
+// UnimplementedConversationServer should be embedded to have forward compatible implementations.
+type UnimplementedConversationServer struct {
+}
+
+func (UnimplementedConversationServer) Init(context.Context, *ConversationInitRequest) (*ConversationInitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (UnimplementedConversationServer) Converse(context.Context, *ConverseRequest) (*ConverseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Converse not implemented")
+}
+func (UnimplementedConversationServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+
+// UnsafeConversationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConversationServer will
+// result in compilation errors.
+type UnsafeConversationServer interface {
+	mustEmbedUnimplementedConversationServer()
+}
+
+func RegisterConversationServer(s grpc.ServiceRegistrar, srv ConversationServer) {
+	s.RegisterService(&Conversation_ServiceDesc, srv)
+}
+
+func _Conversation_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConversationInitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_Init_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).Init(ctx, req.(*ConversationInitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_Converse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConverseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).Converse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_Converse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).Converse(ctx, req.(*ConverseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Conversation_ServiceDesc is the grpc.ServiceDesc for Conversation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Conversation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dapr.proto.components.v1.Conversation",
+	HandlerType: (*ConversationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _Conversation_Init_Handler,
+		},
+		{
+			MethodName: "Converse",
+			Handler:    _Conversation_Converse_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _Conversation_Ping_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dapr/proto/components/v1/conversation.proto",
+}
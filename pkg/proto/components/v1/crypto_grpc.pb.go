@@ -0,0 +1,472 @@
+//
+//Copyright 2025 The Dapr Authors
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//http://www.apache.org/licenses/LICENSE-2.0
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.4
+// source: dapr/proto/components/v1/crypto.proto
+
+package components
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SubtleCrypto_Init_FullMethodName                = "/dapr.proto.components.v1.SubtleCrypto/Init"
+	SubtleCrypto_SupportedAlgorithms_FullMethodName = "/dapr.proto.components.v1.SubtleCrypto/SupportedAlgorithms"
+	SubtleCrypto_GetKey_FullMethodName              = "/dapr.proto.components.v1.SubtleCrypto/GetKey"
+	SubtleCrypto_Encrypt_FullMethodName             = "/dapr.proto.components.v1.SubtleCrypto/Encrypt"
+	SubtleCrypto_Decrypt_FullMethodName             = "/dapr.proto.components.v1.SubtleCrypto/Decrypt"
+	SubtleCrypto_WrapKey_FullMethodName             = "/dapr.proto.components.v1.SubtleCrypto/WrapKey"
+	SubtleCrypto_UnwrapKey_FullMethodName           = "/dapr.proto.components.v1.SubtleCrypto/UnwrapKey"
+	SubtleCrypto_Sign_FullMethodName                = "/dapr.proto.components.v1.SubtleCrypto/Sign"
+	SubtleCrypto_Verify_FullMethodName              = "/dapr.proto.components.v1.SubtleCrypto/Verify"
+	SubtleCrypto_Ping_FullMethodName                = "/dapr.proto.components.v1.SubtleCrypto/Ping"
+)
+
+// SubtleCryptoClient is the client API for SubtleCrypto service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SubtleCryptoClient interface {
+	// Initializes the crypto provider with the given metadata.
+	Init(ctx context.Context, in *SubtleCryptoInitRequest, opts ...grpc.CallOption) (*SubtleCryptoInitResponse, error)
+	// Returns the encryption and signature algorithms supported by the provider.
+	SupportedAlgorithms(ctx context.Context, in *SupportedAlgorithmsRequest, opts ...grpc.CallOption) (*SupportedAlgorithmsResponse, error)
+	// Returns the public part of a key.
+	GetKey(ctx context.Context, in *SubtleGetKeyRequest, opts ...grpc.CallOption) (*SubtleGetKeyResponse, error)
+	// Encrypts a small message.
+	Encrypt(ctx context.Context, in *SubtleEncryptRequest, opts ...grpc.CallOption) (*SubtleEncryptResponse, error)
+	// Decrypts a small message.
+	Decrypt(ctx context.Context, in *SubtleDecryptRequest, opts ...grpc.CallOption) (*SubtleDecryptResponse, error)
+	// Wraps a key.
+	WrapKey(ctx context.Context, in *SubtleWrapKeyRequest, opts ...grpc.CallOption) (*SubtleWrapKeyResponse, error)
+	// Unwraps a key.
+	UnwrapKey(ctx context.Context, in *SubtleUnwrapKeyRequest, opts ...grpc.CallOption) (*SubtleUnwrapKeyResponse, error)
+	// Signs a digest.
+	Sign(ctx context.Context, in *SubtleSignRequest, opts ...grpc.CallOption) (*SubtleSignResponse, error)
+	// Verifies the signature of a digest.
+	Verify(ctx context.Context, in *SubtleVerifyRequest, opts ...grpc.CallOption) (*SubtleVerifyResponse, error)
+	// Ping the crypto provider. Used for liveness purposes.
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+}
+
+type subtleCryptoClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSubtleCryptoClient(cc grpc.ClientConnInterface) SubtleCryptoClient {
+	return &subtleCryptoClient{cc}
+}
+
+func (c *subtleCryptoClient) Init(ctx context.Context, in *SubtleCryptoInitRequest, opts ...grpc.CallOption) (*SubtleCryptoInitResponse, error) {
+	out := new(SubtleCryptoInitResponse)
+	err := c.cc.Invoke(ctx, SubtleCrypto_Init_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subtleCryptoClient) SupportedAlgorithms(ctx context.Context, in *SupportedAlgorithmsRequest, opts ...grpc.CallOption) (*SupportedAlgorithmsResponse, error) {
+	out := new(SupportedAlgorithmsResponse)
+	err := c.cc.Invoke(ctx, SubtleCrypto_SupportedAlgorithms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subtleCryptoClient) GetKey(ctx context.Context, in *SubtleGetKeyRequest, opts ...grpc.CallOption) (*SubtleGetKeyResponse, error) {
+	out := new(SubtleGetKeyResponse)
+	err := c.cc.Invoke(ctx, SubtleCrypto_GetKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subtleCryptoClient) Encrypt(ctx context.Context, in *SubtleEncryptRequest, opts ...grpc.CallOption) (*SubtleEncryptResponse, error) {
+	out := new(SubtleEncryptResponse)
+	err := c.cc.Invoke(ctx, SubtleCrypto_Encrypt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subtleCryptoClient) Decrypt(ctx context.Context, in *SubtleDecryptRequest, opts ...grpc.CallOption) (*SubtleDecryptResponse, error) {
+	out := new(SubtleDecryptResponse)
+	err := c.cc.Invoke(ctx, SubtleCrypto_Decrypt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subtleCryptoClient) WrapKey(ctx context.Context, in *SubtleWrapKeyRequest, opts ...grpc.CallOption) (*SubtleWrapKeyResponse, error) {
+	out := new(SubtleWrapKeyResponse)
+	err := c.cc.Invoke(ctx, SubtleCrypto_WrapKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subtleCryptoClient) UnwrapKey(ctx context.Context, in *SubtleUnwrapKeyRequest, opts ...grpc.CallOption) (*SubtleUnwrapKeyResponse, error) {
+	out := new(SubtleUnwrapKeyResponse)
+	err := c.cc.Invoke(ctx, SubtleCrypto_UnwrapKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subtleCryptoClient) Sign(ctx context.Context, in *SubtleSignRequest, opts ...grpc.CallOption) (*SubtleSignResponse, error) {
+	out := new(SubtleSignResponse)
+	err := c.cc.Invoke(ctx, SubtleCrypto_Sign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subtleCryptoClient) Verify(ctx context.Context, in *SubtleVerifyRequest, opts ...grpc.CallOption) (*SubtleVerifyResponse, error) {
+	out := new(SubtleVerifyResponse)
+	err := c.cc.Invoke(ctx, SubtleCrypto_Verify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subtleCryptoClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, SubtleCrypto_Ping_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubtleCryptoServer is the server API for SubtleCrypto service.
+// All implementations should embed UnimplementedSubtleCryptoServer
+// for forward compatibility
+type SubtleCryptoServer interface {
+	// Initializes the crypto provider with the given metadata.
+	Init(context.Context, *SubtleCryptoInitRequest) (*SubtleCryptoInitResponse, error)
+	// Returns the encryption and signature algorithms supported by the provider.
+	SupportedAlgorithms(context.Context, *SupportedAlgorithmsRequest) (*SupportedAlgorithmsResponse, error)
+	// Returns the public part of a key.
+	GetKey(context.Context, *SubtleGetKeyRequest) (*SubtleGetKeyResponse, error)
+	// Encrypts a small message.
+	Encrypt(context.Context, *SubtleEncryptRequest) (*SubtleEncryptResponse, error)
+	// Decrypts a small message.
+	Decrypt(context.Context, *SubtleDecryptRequest) (*SubtleDecryptResponse, error)
+	// Wraps a key.
+	WrapKey(context.Context, *SubtleWrapKeyRequest) (*SubtleWrapKeyResponse, error)
+	// Unwraps a key.
+	UnwrapKey(context.Context, *SubtleUnwrapKeyRequest) (*SubtleUnwrapKeyResponse, error)
+	// Signs a digest.
+	Sign(context.Context, *SubtleSignRequest) (*SubtleSignResponse, error)
+	// Verifies the signature of a digest.
+	Verify(context.Context, *SubtleVerifyRequest) (*SubtleVerifyResponse, error)
+	// Ping the crypto provider. Used for liveness purposes.
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+}
+
+// UnimplementedSubtleCryptoServer should be embedded to have forward compatible implementations.
+type UnimplementedSubtleCryptoServer struct {
+}
+
+func (UnimplementedSubtleCryptoServer) Init(context.Context, *SubtleCryptoInitRequest) (*SubtleCryptoInitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (UnimplementedSubtleCryptoServer) SupportedAlgorithms(context.Context, *SupportedAlgorithmsRequest) (*SupportedAlgorithmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupportedAlgorithms not implemented")
+}
+func (UnimplementedSubtleCryptoServer) GetKey(context.Context, *SubtleGetKeyRequest) (*SubtleGetKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKey not implemented")
+}
+func (UnimplementedSubtleCryptoServer) Encrypt(context.Context, *SubtleEncryptRequest) (*SubtleEncryptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Encrypt not implemented")
+}
+func (UnimplementedSubtleCryptoServer) Decrypt(context.Context, *SubtleDecryptRequest) (*SubtleDecryptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decrypt not implemented")
+}
+func (UnimplementedSubtleCryptoServer) WrapKey(context.Context, *SubtleWrapKeyRequest) (*SubtleWrapKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrapKey not implemented")
+}
+func (UnimplementedSubtleCryptoServer) UnwrapKey(context.Context, *SubtleUnwrapKeyRequest) (*SubtleUnwrapKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwrapKey not implemented")
+}
+func (UnimplementedSubtleCryptoServer) Sign(context.Context, *SubtleSignRequest) (*SubtleSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedSubtleCryptoServer) Verify(context.Context, *SubtleVerifyRequest) (*SubtleVerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Verify not implemented")
+}
+func (UnimplementedSubtleCryptoServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+
+// UnsafeSubtleCryptoServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SubtleCryptoServer will
+// result in compilation errors.
+type UnsafeSubtleCryptoServer interface {
+	mustEmbedUnimplementedSubtleCryptoServer()
+}
+
+func RegisterSubtleCryptoServer(s grpc.ServiceRegistrar, srv SubtleCryptoServer) {
+	s.RegisterService(&SubtleCrypto_ServiceDesc, srv)
+}
+
+func _SubtleCrypto_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtleCryptoInitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubtleCryptoServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubtleCrypto_Init_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubtleCryptoServer).Init(ctx, req.(*SubtleCryptoInitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubtleCrypto_SupportedAlgorithms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupportedAlgorithmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubtleCryptoServer).SupportedAlgorithms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubtleCrypto_SupportedAlgorithms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubtleCryptoServer).SupportedAlgorithms(ctx, req.(*SupportedAlgorithmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubtleCrypto_GetKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtleGetKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubtleCryptoServer).GetKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubtleCrypto_GetKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubtleCryptoServer).GetKey(ctx, req.(*SubtleGetKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubtleCrypto_Encrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtleEncryptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubtleCryptoServer).Encrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubtleCrypto_Encrypt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubtleCryptoServer).Encrypt(ctx, req.(*SubtleEncryptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubtleCrypto_Decrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtleDecryptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubtleCryptoServer).Decrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubtleCrypto_Decrypt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubtleCryptoServer).Decrypt(ctx, req.(*SubtleDecryptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubtleCrypto_WrapKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtleWrapKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubtleCryptoServer).WrapKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubtleCrypto_WrapKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubtleCryptoServer).WrapKey(ctx, req.(*SubtleWrapKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubtleCrypto_UnwrapKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtleUnwrapKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubtleCryptoServer).UnwrapKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubtleCrypto_UnwrapKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubtleCryptoServer).UnwrapKey(ctx, req.(*SubtleUnwrapKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubtleCrypto_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtleSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubtleCryptoServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubtleCrypto_Sign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubtleCryptoServer).Sign(ctx, req.(*SubtleSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubtleCrypto_Verify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtleVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubtleCryptoServer).Verify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubtleCrypto_Verify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubtleCryptoServer).Verify(ctx, req.(*SubtleVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubtleCrypto_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubtleCryptoServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubtleCrypto_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubtleCryptoServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SubtleCrypto_ServiceDesc is the grpc.ServiceDesc for SubtleCrypto service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SubtleCrypto_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dapr.proto.components.v1.SubtleCrypto",
+	HandlerType: (*SubtleCryptoServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _SubtleCrypto_Init_Handler,
+		},
+		{
+			MethodName: "SupportedAlgorithms",
+			Handler:    _SubtleCrypto_SupportedAlgorithms_Handler,
+		},
+		{
+			MethodName: "GetKey",
+			Handler:    _SubtleCrypto_GetKey_Handler,
+		},
+		{
+			MethodName: "Encrypt",
+			Handler:    _SubtleCrypto_Encrypt_Handler,
+		},
+		{
+			MethodName: "Decrypt",
+			Handler:    _SubtleCrypto_Decrypt_Handler,
+		},
+		{
+			MethodName: "WrapKey",
+			Handler:    _SubtleCrypto_WrapKey_Handler,
+		},
+		{
+			MethodName: "UnwrapKey",
+			Handler:    _SubtleCrypto_UnwrapKey_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _SubtleCrypto_Sign_Handler,
+		},
+		{
+			MethodName: "Verify",
+			Handler:    _SubtleCrypto_Verify_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _SubtleCrypto_Ping_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dapr/proto/components/v1/crypto.proto",
+}
@@ -0,0 +1,238 @@
+//
+//Copyright 2025 The Dapr Authors
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//http://www.apache.org/licenses/LICENSE-2.0
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.4
+// source: dapr/proto/components/v1/lock.proto
+
+package components
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	LockStore_Init_FullMethodName    = "/dapr.proto.components.v1.LockStore/Init"
+	LockStore_TryLock_FullMethodName = "/dapr.proto.components.v1.LockStore/TryLock"
+	LockStore_Unlock_FullMethodName  = "/dapr.proto.components.v1.LockStore/Unlock"
+	LockStore_Ping_FullMethodName    = "/dapr.proto.components.v1.LockStore/Ping"
+)
+
+// LockStoreClient is the client API for LockStore service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LockStoreClient interface {
+	// Initializes the lock store with the given metadata.
+	Init(ctx context.Context, in *LockStoreInitRequest, opts ...grpc.CallOption) (*LockStoreInitResponse, error)
+	// Tries to acquire a lock.
+	TryLock(ctx context.Context, in *TryLockRequest, opts ...grpc.CallOption) (*TryLockResponse, error)
+	// Tries to release a lock.
+	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+	// Ping the lock store. Used for liveness purposes.
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+}
+
+type lockStoreClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLockStoreClient(cc grpc.ClientConnInterface) LockStoreClient {
+	return &lockStoreClient{cc}
+}
+
+func (c *lockStoreClient) Init(ctx context.Context, in *LockStoreInitRequest, opts ...grpc.CallOption) (*LockStoreInitResponse, error) {
+	out := new(LockStoreInitResponse)
+	err := c.cc.Invoke(ctx, LockStore_Init_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lockStoreClient) TryLock(ctx context.Context, in *TryLockRequest, opts ...grpc.CallOption) (*TryLockResponse, error) {
+	out := new(TryLockResponse)
+	err := c.cc.Invoke(ctx, LockStore_TryLock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lockStoreClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
+	out := new(UnlockResponse)
+	err := c.cc.Invoke(ctx, LockStore_Unlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lockStoreClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, LockStore_Ping_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LockStoreServer is the server API for LockStore service.
+// All implementations should embed UnimplementedLockStoreServer
+// for forward compatibility
+type LockStoreServer interface {
+	// Initializes the lock store with the given metadata.
+	Init(context.Context, *LockStoreInitRequest) (*LockStoreInitResponse, error)
+	// Tries to acquire a lock.
+	TryLock(context.Context, *TryLockRequest) (*TryLockResponse, error)
+	// Tries to release a lock.
+	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	// Ping the lock store. Used for liveness purposes.
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+}
+
+// UnimplementedLockStoreServer should be embedded to have forward compatible implementations.
+type UnimplementedLockStoreServer struct {
+}
+
+func (UnimplementedLockStoreServer) Init(context.Context, *LockStoreInitRequest) (*LockStoreInitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (UnimplementedLockStoreServer) TryLock(context.Context, *TryLockRequest) (*TryLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TryLock not implemented")
+}
+func (UnimplementedLockStoreServer) Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (UnimplementedLockStoreServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+
+// UnsafeLockStoreServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LockStoreServer will
+// result in compilation errors.
+type UnsafeLockStoreServer interface {
+	mustEmbedUnimplementedLockStoreServer()
+}
+
+func RegisterLockStoreServer(s grpc.ServiceRegistrar, srv LockStoreServer) {
+	s.RegisterService(&LockStore_ServiceDesc, srv)
+}
+
+func _LockStore_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockStoreInitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LockStoreServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LockStore_Init_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LockStoreServer).Init(ctx, req.(*LockStoreInitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LockStore_TryLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TryLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LockStoreServer).TryLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LockStore_TryLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LockStoreServer).TryLock(ctx, req.(*TryLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LockStore_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LockStoreServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LockStore_Unlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LockStoreServer).Unlock(ctx, req.(*UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LockStore_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LockStoreServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LockStore_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LockStoreServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LockStore_ServiceDesc is the grpc.ServiceDesc for LockStore service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LockStore_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dapr.proto.components.v1.LockStore",
+	HandlerType: (*LockStoreServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _LockStore_Init_Handler,
+		},
+		{
+			MethodName: "TryLock",
+			Handler:    _LockStore_TryLock_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _LockStore_Unlock_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _LockStore_Ping_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dapr/proto/components/v1/lock.proto",
+}
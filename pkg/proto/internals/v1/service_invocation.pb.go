@@ -520,7 +520,7 @@ var file_dapr_proto_internals_v1_service_invocation_proto_rawDesc = []byte{
 	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x29, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
 	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x32, 0xc4, 0x04, 0x0a, 0x11, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x75, 0x65, 0x73, 0x32, 0xc9, 0x05, 0x0a, 0x11, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
 	0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6e, 0x0a, 0x09, 0x43, 0x61, 0x6c,
 	0x6c, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x2e, 0x76, 0x31,
@@ -556,11 +556,19 @@ var file_dapr_proto_internals_v1_service_invocation_proto_rawDesc = []byte{
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e,
 	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61,
-	0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x13, 0x43,
+	0x61, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x34, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x1a, 0x2f, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x6b,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42,
+	0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61,
+	0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -615,13 +623,15 @@ var file_dapr_proto_internals_v1_service_invocation_proto_depIdxs = []int32{
 	14, // 17: dapr.proto.internals.v1.ServiceInvocation.CallActorReminder:input_type -> dapr.proto.internals.v1.Reminder
 	3,  // 18: dapr.proto.internals.v1.ServiceInvocation.CallLocalStream:input_type -> dapr.proto.internals.v1.InternalInvokeRequestStream
 	1,  // 19: dapr.proto.internals.v1.ServiceInvocation.CallActorStream:input_type -> dapr.proto.internals.v1.InternalInvokeRequest
-	2,  // 20: dapr.proto.internals.v1.ServiceInvocation.CallActor:output_type -> dapr.proto.internals.v1.InternalInvokeResponse
-	2,  // 21: dapr.proto.internals.v1.ServiceInvocation.CallLocal:output_type -> dapr.proto.internals.v1.InternalInvokeResponse
-	15, // 22: dapr.proto.internals.v1.ServiceInvocation.CallActorReminder:output_type -> google.protobuf.Empty
-	4,  // 23: dapr.proto.internals.v1.ServiceInvocation.CallLocalStream:output_type -> dapr.proto.internals.v1.InternalInvokeResponseStream
-	2,  // 24: dapr.proto.internals.v1.ServiceInvocation.CallActorStream:output_type -> dapr.proto.internals.v1.InternalInvokeResponse
-	20, // [20:25] is the sub-list for method output_type
-	15, // [15:20] is the sub-list for method input_type
+	3,  // 20: dapr.proto.internals.v1.ServiceInvocation.CallActorStreamBody:input_type -> dapr.proto.internals.v1.InternalInvokeRequestStream
+	2,  // 21: dapr.proto.internals.v1.ServiceInvocation.CallActor:output_type -> dapr.proto.internals.v1.InternalInvokeResponse
+	2,  // 22: dapr.proto.internals.v1.ServiceInvocation.CallLocal:output_type -> dapr.proto.internals.v1.InternalInvokeResponse
+	15, // 23: dapr.proto.internals.v1.ServiceInvocation.CallActorReminder:output_type -> google.protobuf.Empty
+	4,  // 24: dapr.proto.internals.v1.ServiceInvocation.CallLocalStream:output_type -> dapr.proto.internals.v1.InternalInvokeResponseStream
+	2,  // 25: dapr.proto.internals.v1.ServiceInvocation.CallActorStream:output_type -> dapr.proto.internals.v1.InternalInvokeResponse
+	2,  // 26: dapr.proto.internals.v1.ServiceInvocation.CallActorStreamBody:output_type -> dapr.proto.internals.v1.InternalInvokeResponse
+	21, // [21:27] is the sub-list for method output_type
+	15, // [15:21] is the sub-list for method input_type
 	15, // [15:15] is the sub-list for extension type_name
 	15, // [15:15] is the sub-list for extension extendee
 	0,  // [0:15] is the sub-list for field type_name
@@ -32,11 +32,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ServiceInvocation_CallActor_FullMethodName         = "/dapr.proto.internals.v1.ServiceInvocation/CallActor"
-	ServiceInvocation_CallLocal_FullMethodName         = "/dapr.proto.internals.v1.ServiceInvocation/CallLocal"
-	ServiceInvocation_CallActorReminder_FullMethodName = "/dapr.proto.internals.v1.ServiceInvocation/CallActorReminder"
-	ServiceInvocation_CallLocalStream_FullMethodName   = "/dapr.proto.internals.v1.ServiceInvocation/CallLocalStream"
-	ServiceInvocation_CallActorStream_FullMethodName   = "/dapr.proto.internals.v1.ServiceInvocation/CallActorStream"
+	ServiceInvocation_CallActor_FullMethodName           = "/dapr.proto.internals.v1.ServiceInvocation/CallActor"
+	ServiceInvocation_CallLocal_FullMethodName           = "/dapr.proto.internals.v1.ServiceInvocation/CallLocal"
+	ServiceInvocation_CallActorReminder_FullMethodName   = "/dapr.proto.internals.v1.ServiceInvocation/CallActorReminder"
+	ServiceInvocation_CallLocalStream_FullMethodName     = "/dapr.proto.internals.v1.ServiceInvocation/CallLocalStream"
+	ServiceInvocation_CallActorStream_FullMethodName     = "/dapr.proto.internals.v1.ServiceInvocation/CallActorStream"
+	ServiceInvocation_CallActorStreamBody_FullMethodName = "/dapr.proto.internals.v1.ServiceInvocation/CallActorStreamBody"
 )
 
 // ServiceInvocationClient is the client API for ServiceInvocation service.
@@ -62,6 +63,14 @@ type ServiceInvocationClient interface {
 	// CallActorStream is used to invoke actor method with request and streaming
 	// response.
 	CallActorStream(ctx context.Context, in *InternalInvokeRequest, opts ...grpc.CallOption) (ServiceInvocation_CallActorStreamClient, error)
+	// CallActorStreamBody is a variant of CallActorStream in which the body of
+	// the request is streamed too.
+	// The request is sent following the same rules as CallLocalStream: the first
+	// message MUST contain a `request`, and subsequent messages MUST only contain
+	// a `payload`.
+	// The first message of the response contains the status and headers of the
+	// actor's response, and each message contains a chunk of the response data.
+	CallActorStreamBody(ctx context.Context, opts ...grpc.CallOption) (ServiceInvocation_CallActorStreamBodyClient, error)
 }
 
 type serviceInvocationClient struct {
@@ -162,6 +171,37 @@ func (x *serviceInvocationCallActorStreamClient) Recv() (*InternalInvokeResponse
 	return m, nil
 }
 
+func (c *serviceInvocationClient) CallActorStreamBody(ctx context.Context, opts ...grpc.CallOption) (ServiceInvocation_CallActorStreamBodyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ServiceInvocation_ServiceDesc.Streams[2], ServiceInvocation_CallActorStreamBody_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceInvocationCallActorStreamBodyClient{stream}
+	return x, nil
+}
+
+type ServiceInvocation_CallActorStreamBodyClient interface {
+	Send(*InternalInvokeRequestStream) error
+	Recv() (*InternalInvokeResponse, error)
+	grpc.ClientStream
+}
+
+type serviceInvocationCallActorStreamBodyClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceInvocationCallActorStreamBodyClient) Send(m *InternalInvokeRequestStream) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serviceInvocationCallActorStreamBodyClient) Recv() (*InternalInvokeResponse, error) {
+	m := new(InternalInvokeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ServiceInvocationServer is the server API for ServiceInvocation service.
 // All implementations should embed UnimplementedServiceInvocationServer
 // for forward compatibility
@@ -185,6 +225,14 @@ type ServiceInvocationServer interface {
 	// CallActorStream is used to invoke actor method with request and streaming
 	// response.
 	CallActorStream(*InternalInvokeRequest, ServiceInvocation_CallActorStreamServer) error
+	// CallActorStreamBody is a variant of CallActorStream in which the body of
+	// the request is streamed too.
+	// The request is sent following the same rules as CallLocalStream: the first
+	// message MUST contain a `request`, and subsequent messages MUST only contain
+	// a `payload`.
+	// The first message of the response contains the status and headers of the
+	// actor's response, and each message contains a chunk of the response data.
+	CallActorStreamBody(ServiceInvocation_CallActorStreamBodyServer) error
 }
 
 // UnimplementedServiceInvocationServer should be embedded to have forward compatible implementations.
@@ -206,6 +254,9 @@ func (UnimplementedServiceInvocationServer) CallLocalStream(ServiceInvocation_Ca
 func (UnimplementedServiceInvocationServer) CallActorStream(*InternalInvokeRequest, ServiceInvocation_CallActorStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method CallActorStream not implemented")
 }
+func (UnimplementedServiceInvocationServer) CallActorStreamBody(ServiceInvocation_CallActorStreamBodyServer) error {
+	return status.Errorf(codes.Unimplemented, "method CallActorStreamBody not implemented")
+}
 
 // UnsafeServiceInvocationServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ServiceInvocationServer will
@@ -319,6 +370,32 @@ func (x *serviceInvocationCallActorStreamServer) Send(m *InternalInvokeResponse)
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ServiceInvocation_CallActorStreamBody_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServiceInvocationServer).CallActorStreamBody(&serviceInvocationCallActorStreamBodyServer{stream})
+}
+
+type ServiceInvocation_CallActorStreamBodyServer interface {
+	Send(*InternalInvokeResponse) error
+	Recv() (*InternalInvokeRequestStream, error)
+	grpc.ServerStream
+}
+
+type serviceInvocationCallActorStreamBodyServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceInvocationCallActorStreamBodyServer) Send(m *InternalInvokeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *serviceInvocationCallActorStreamBodyServer) Recv() (*InternalInvokeRequestStream, error) {
+	m := new(InternalInvokeRequestStream)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ServiceInvocation_ServiceDesc is the grpc.ServiceDesc for ServiceInvocation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -351,6 +428,12 @@ var ServiceInvocation_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ServiceInvocation_CallActorStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "CallActorStreamBody",
+			Handler:       _ServiceInvocation_CallActorStreamBody_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "dapr/proto/internals/v1/service_invocation.proto",
 }
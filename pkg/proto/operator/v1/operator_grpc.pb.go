@@ -32,16 +32,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Operator_ComponentUpdate_FullMethodName     = "/dapr.proto.operator.v1.Operator/ComponentUpdate"
-	Operator_ListComponents_FullMethodName      = "/dapr.proto.operator.v1.Operator/ListComponents"
-	Operator_GetConfiguration_FullMethodName    = "/dapr.proto.operator.v1.Operator/GetConfiguration"
-	Operator_ListSubscriptions_FullMethodName   = "/dapr.proto.operator.v1.Operator/ListSubscriptions"
-	Operator_GetResiliency_FullMethodName       = "/dapr.proto.operator.v1.Operator/GetResiliency"
-	Operator_ListResiliency_FullMethodName      = "/dapr.proto.operator.v1.Operator/ListResiliency"
-	Operator_ListSubscriptionsV2_FullMethodName = "/dapr.proto.operator.v1.Operator/ListSubscriptionsV2"
-	Operator_SubscriptionUpdate_FullMethodName  = "/dapr.proto.operator.v1.Operator/SubscriptionUpdate"
-	Operator_ListHTTPEndpoints_FullMethodName   = "/dapr.proto.operator.v1.Operator/ListHTTPEndpoints"
-	Operator_HTTPEndpointUpdate_FullMethodName  = "/dapr.proto.operator.v1.Operator/HTTPEndpointUpdate"
+	Operator_ComponentUpdate_FullMethodName      = "/dapr.proto.operator.v1.Operator/ComponentUpdate"
+	Operator_ListComponents_FullMethodName       = "/dapr.proto.operator.v1.Operator/ListComponents"
+	Operator_GetConfiguration_FullMethodName     = "/dapr.proto.operator.v1.Operator/GetConfiguration"
+	Operator_ListSubscriptions_FullMethodName    = "/dapr.proto.operator.v1.Operator/ListSubscriptions"
+	Operator_GetResiliency_FullMethodName        = "/dapr.proto.operator.v1.Operator/GetResiliency"
+	Operator_ListResiliency_FullMethodName       = "/dapr.proto.operator.v1.Operator/ListResiliency"
+	Operator_ListSubscriptionsV2_FullMethodName  = "/dapr.proto.operator.v1.Operator/ListSubscriptionsV2"
+	Operator_SubscriptionUpdate_FullMethodName   = "/dapr.proto.operator.v1.Operator/SubscriptionUpdate"
+	Operator_ListHTTPEndpoints_FullMethodName    = "/dapr.proto.operator.v1.Operator/ListHTTPEndpoints"
+	Operator_HTTPEndpointUpdate_FullMethodName   = "/dapr.proto.operator.v1.Operator/HTTPEndpointUpdate"
+	Operator_ResiliencyUpdate_FullMethodName     = "/dapr.proto.operator.v1.Operator/ResiliencyUpdate"
+	Operator_ConfigurationUpdate_FullMethodName  = "/dapr.proto.operator.v1.Operator/ConfigurationUpdate"
+	Operator_ReportResourceStatus_FullMethodName = "/dapr.proto.operator.v1.Operator/ReportResourceStatus"
 )
 
 // OperatorClient is the client API for Operator service.
@@ -68,6 +71,14 @@ type OperatorClient interface {
 	ListHTTPEndpoints(ctx context.Context, in *ListHTTPEndpointsRequest, opts ...grpc.CallOption) (*ListHTTPEndpointsResponse, error)
 	// Sends events to Dapr sidecars upon http endpoint changes.
 	HTTPEndpointUpdate(ctx context.Context, in *HTTPEndpointUpdateRequest, opts ...grpc.CallOption) (Operator_HTTPEndpointUpdateClient, error)
+	// Sends events to Dapr sidecars upon resiliency changes.
+	ResiliencyUpdate(ctx context.Context, in *ResiliencyUpdateRequest, opts ...grpc.CallOption) (Operator_ResiliencyUpdateClient, error)
+	// Sends events to Dapr sidecars upon changes to their configuration.
+	ConfigurationUpdate(ctx context.Context, in *ConfigurationUpdateRequest, opts ...grpc.CallOption) (Operator_ConfigurationUpdateClient, error)
+	// Receives the results of Dapr sidecars loading resources, which are
+	// aggregated into the status of the resources. The results of a sidecar are
+	// discarded when its stream is closed.
+	ReportResourceStatus(ctx context.Context, opts ...grpc.CallOption) (Operator_ReportResourceStatusClient, error)
 }
 
 type operatorClient struct {
@@ -237,6 +248,104 @@ func (x *operatorHTTPEndpointUpdateClient) Recv() (*HTTPEndpointUpdateEvent, err
 	return m, nil
 }
 
+func (c *operatorClient) ResiliencyUpdate(ctx context.Context, in *ResiliencyUpdateRequest, opts ...grpc.CallOption) (Operator_ResiliencyUpdateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Operator_ServiceDesc.Streams[3], Operator_ResiliencyUpdate_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operatorResiliencyUpdateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Operator_ResiliencyUpdateClient interface {
+	Recv() (*ResiliencyUpdateEvent, error)
+	grpc.ClientStream
+}
+
+type operatorResiliencyUpdateClient struct {
+	grpc.ClientStream
+}
+
+func (x *operatorResiliencyUpdateClient) Recv() (*ResiliencyUpdateEvent, error) {
+	m := new(ResiliencyUpdateEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *operatorClient) ConfigurationUpdate(ctx context.Context, in *ConfigurationUpdateRequest, opts ...grpc.CallOption) (Operator_ConfigurationUpdateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Operator_ServiceDesc.Streams[4], Operator_ConfigurationUpdate_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operatorConfigurationUpdateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Operator_ConfigurationUpdateClient interface {
+	Recv() (*ConfigurationUpdateEvent, error)
+	grpc.ClientStream
+}
+
+type operatorConfigurationUpdateClient struct {
+	grpc.ClientStream
+}
+
+func (x *operatorConfigurationUpdateClient) Recv() (*ConfigurationUpdateEvent, error) {
+	m := new(ConfigurationUpdateEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *operatorClient) ReportResourceStatus(ctx context.Context, opts ...grpc.CallOption) (Operator_ReportResourceStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Operator_ServiceDesc.Streams[5], Operator_ReportResourceStatus_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operatorReportResourceStatusClient{stream}
+	return x, nil
+}
+
+type Operator_ReportResourceStatusClient interface {
+	Send(*ReportResourceStatusRequest) error
+	CloseAndRecv() (*emptypb.Empty, error)
+	grpc.ClientStream
+}
+
+type operatorReportResourceStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *operatorReportResourceStatusClient) Send(m *ReportResourceStatusRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *operatorReportResourceStatusClient) CloseAndRecv() (*emptypb.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(emptypb.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // OperatorServer is the server API for Operator service.
 // All implementations should embed UnimplementedOperatorServer
 // for forward compatibility
@@ -261,6 +370,14 @@ type OperatorServer interface {
 	ListHTTPEndpoints(context.Context, *ListHTTPEndpointsRequest) (*ListHTTPEndpointsResponse, error)
 	// Sends events to Dapr sidecars upon http endpoint changes.
 	HTTPEndpointUpdate(*HTTPEndpointUpdateRequest, Operator_HTTPEndpointUpdateServer) error
+	// Sends events to Dapr sidecars upon resiliency changes.
+	ResiliencyUpdate(*ResiliencyUpdateRequest, Operator_ResiliencyUpdateServer) error
+	// Sends events to Dapr sidecars upon changes to their configuration.
+	ConfigurationUpdate(*ConfigurationUpdateRequest, Operator_ConfigurationUpdateServer) error
+	// Receives the results of Dapr sidecars loading resources, which are
+	// aggregated into the status of the resources. The results of a sidecar are
+	// discarded when its stream is closed.
+	ReportResourceStatus(Operator_ReportResourceStatusServer) error
 }
 
 // UnimplementedOperatorServer should be embedded to have forward compatible implementations.
@@ -297,6 +414,15 @@ func (UnimplementedOperatorServer) ListHTTPEndpoints(context.Context, *ListHTTPE
 func (UnimplementedOperatorServer) HTTPEndpointUpdate(*HTTPEndpointUpdateRequest, Operator_HTTPEndpointUpdateServer) error {
 	return status.Errorf(codes.Unimplemented, "method HTTPEndpointUpdate not implemented")
 }
+func (UnimplementedOperatorServer) ResiliencyUpdate(*ResiliencyUpdateRequest, Operator_ResiliencyUpdateServer) error {
+	return status.Errorf(codes.Unimplemented, "method ResiliencyUpdate not implemented")
+}
+func (UnimplementedOperatorServer) ConfigurationUpdate(*ConfigurationUpdateRequest, Operator_ConfigurationUpdateServer) error {
+	return status.Errorf(codes.Unimplemented, "method ConfigurationUpdate not implemented")
+}
+func (UnimplementedOperatorServer) ReportResourceStatus(Operator_ReportResourceStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReportResourceStatus not implemented")
+}
 
 // UnsafeOperatorServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to OperatorServer will
@@ -498,6 +624,74 @@ func (x *operatorHTTPEndpointUpdateServer) Send(m *HTTPEndpointUpdateEvent) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Operator_ResiliencyUpdate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResiliencyUpdateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperatorServer).ResiliencyUpdate(m, &operatorResiliencyUpdateServer{stream})
+}
+
+type Operator_ResiliencyUpdateServer interface {
+	Send(*ResiliencyUpdateEvent) error
+	grpc.ServerStream
+}
+
+type operatorResiliencyUpdateServer struct {
+	grpc.ServerStream
+}
+
+func (x *operatorResiliencyUpdateServer) Send(m *ResiliencyUpdateEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Operator_ConfigurationUpdate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConfigurationUpdateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperatorServer).ConfigurationUpdate(m, &operatorConfigurationUpdateServer{stream})
+}
+
+type Operator_ConfigurationUpdateServer interface {
+	Send(*ConfigurationUpdateEvent) error
+	grpc.ServerStream
+}
+
+type operatorConfigurationUpdateServer struct {
+	grpc.ServerStream
+}
+
+func (x *operatorConfigurationUpdateServer) Send(m *ConfigurationUpdateEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Operator_ReportResourceStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OperatorServer).ReportResourceStatus(&operatorReportResourceStatusServer{stream})
+}
+
+type Operator_ReportResourceStatusServer interface {
+	SendAndClose(*emptypb.Empty) error
+	Recv() (*ReportResourceStatusRequest, error)
+	grpc.ServerStream
+}
+
+type operatorReportResourceStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *operatorReportResourceStatusServer) SendAndClose(m *emptypb.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *operatorReportResourceStatusServer) Recv() (*ReportResourceStatusRequest, error) {
+	m := new(ReportResourceStatusRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Operator_ServiceDesc is the grpc.ServiceDesc for Operator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -550,6 +744,21 @@ var Operator_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Operator_HTTPEndpointUpdate_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ResiliencyUpdate",
+			Handler:       _Operator_ResiliencyUpdate_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ConfigurationUpdate",
+			Handler:       _Operator_ConfigurationUpdate_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReportResourceStatus",
+			Handler:       _Operator_ReportResourceStatus_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "dapr/proto/operator/v1/operator.proto",
 }
@@ -33,66 +33,75 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Dapr_InvokeService_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/InvokeService"
-	Dapr_GetState_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/GetState"
-	Dapr_GetBulkState_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/GetBulkState"
-	Dapr_SaveState_FullMethodName                      = "/dapr.proto.runtime.v1.Dapr/SaveState"
-	Dapr_QueryStateAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/QueryStateAlpha1"
-	Dapr_DeleteState_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/DeleteState"
-	Dapr_DeleteBulkState_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/DeleteBulkState"
-	Dapr_ExecuteStateTransaction_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/ExecuteStateTransaction"
-	Dapr_PublishEvent_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/PublishEvent"
-	Dapr_BulkPublishEventAlpha1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/BulkPublishEventAlpha1"
-	Dapr_SubscribeTopicEventsAlpha1_FullMethodName     = "/dapr.proto.runtime.v1.Dapr/SubscribeTopicEventsAlpha1"
-	Dapr_InvokeBinding_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/InvokeBinding"
-	Dapr_GetSecret_FullMethodName                      = "/dapr.proto.runtime.v1.Dapr/GetSecret"
-	Dapr_GetBulkSecret_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/GetBulkSecret"
-	Dapr_RegisterActorTimer_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/RegisterActorTimer"
-	Dapr_UnregisterActorTimer_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/UnregisterActorTimer"
-	Dapr_RegisterActorReminder_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/RegisterActorReminder"
-	Dapr_UnregisterActorReminder_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/UnregisterActorReminder"
-	Dapr_GetActorState_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/GetActorState"
-	Dapr_ExecuteActorStateTransaction_FullMethodName   = "/dapr.proto.runtime.v1.Dapr/ExecuteActorStateTransaction"
-	Dapr_InvokeActor_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/InvokeActor"
-	Dapr_GetConfigurationAlpha1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/GetConfigurationAlpha1"
-	Dapr_GetConfiguration_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/GetConfiguration"
-	Dapr_SubscribeConfigurationAlpha1_FullMethodName   = "/dapr.proto.runtime.v1.Dapr/SubscribeConfigurationAlpha1"
-	Dapr_SubscribeConfiguration_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/SubscribeConfiguration"
-	Dapr_UnsubscribeConfigurationAlpha1_FullMethodName = "/dapr.proto.runtime.v1.Dapr/UnsubscribeConfigurationAlpha1"
-	Dapr_UnsubscribeConfiguration_FullMethodName       = "/dapr.proto.runtime.v1.Dapr/UnsubscribeConfiguration"
-	Dapr_TryLockAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/TryLockAlpha1"
-	Dapr_UnlockAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/UnlockAlpha1"
-	Dapr_EncryptAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/EncryptAlpha1"
-	Dapr_DecryptAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/DecryptAlpha1"
-	Dapr_GetMetadata_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/GetMetadata"
-	Dapr_SetMetadata_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/SetMetadata"
-	Dapr_SubtleGetKeyAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleGetKeyAlpha1"
-	Dapr_SubtleEncryptAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/SubtleEncryptAlpha1"
-	Dapr_SubtleDecryptAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/SubtleDecryptAlpha1"
-	Dapr_SubtleWrapKeyAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/SubtleWrapKeyAlpha1"
-	Dapr_SubtleUnwrapKeyAlpha1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/SubtleUnwrapKeyAlpha1"
-	Dapr_SubtleSignAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/SubtleSignAlpha1"
-	Dapr_SubtleVerifyAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleVerifyAlpha1"
-	Dapr_StartWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/StartWorkflowAlpha1"
-	Dapr_GetWorkflowAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/GetWorkflowAlpha1"
-	Dapr_PurgeWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/PurgeWorkflowAlpha1"
-	Dapr_TerminateWorkflowAlpha1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/TerminateWorkflowAlpha1"
-	Dapr_PauseWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/PauseWorkflowAlpha1"
-	Dapr_ResumeWorkflowAlpha1_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/ResumeWorkflowAlpha1"
-	Dapr_RaiseEventWorkflowAlpha1_FullMethodName       = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowAlpha1"
-	Dapr_StartWorkflowBeta1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/StartWorkflowBeta1"
-	Dapr_GetWorkflowBeta1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/GetWorkflowBeta1"
-	Dapr_PurgeWorkflowBeta1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/PurgeWorkflowBeta1"
-	Dapr_TerminateWorkflowBeta1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/TerminateWorkflowBeta1"
-	Dapr_PauseWorkflowBeta1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/PauseWorkflowBeta1"
-	Dapr_ResumeWorkflowBeta1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/ResumeWorkflowBeta1"
-	Dapr_RaiseEventWorkflowBeta1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowBeta1"
-	Dapr_Shutdown_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/Shutdown"
-	Dapr_ScheduleJobAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/ScheduleJobAlpha1"
-	Dapr_GetJobAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/GetJobAlpha1"
-	Dapr_DeleteJobAlpha1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/DeleteJobAlpha1"
-	Dapr_ConverseAlpha1_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha1"
-	Dapr_ConverseAlpha2_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha2"
+	Dapr_InvokeService_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/InvokeService"
+	Dapr_GetState_FullMethodName                        = "/dapr.proto.runtime.v1.Dapr/GetState"
+	Dapr_GetBulkState_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/GetBulkState"
+	Dapr_SaveState_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/SaveState"
+	Dapr_QueryStateAlpha1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/QueryStateAlpha1"
+	Dapr_ReEncryptStateAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/ReEncryptStateAlpha1"
+	Dapr_DeleteState_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/DeleteState"
+	Dapr_DeleteBulkState_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/DeleteBulkState"
+	Dapr_ExecuteStateTransaction_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/ExecuteStateTransaction"
+	Dapr_PublishEvent_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/PublishEvent"
+	Dapr_BulkPublishEventAlpha1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/BulkPublishEventAlpha1"
+	Dapr_SubscribeTopicEventsAlpha1_FullMethodName      = "/dapr.proto.runtime.v1.Dapr/SubscribeTopicEventsAlpha1"
+	Dapr_InvokeBinding_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/InvokeBinding"
+	Dapr_GetSecret_FullMethodName                       = "/dapr.proto.runtime.v1.Dapr/GetSecret"
+	Dapr_GetBulkSecret_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/GetBulkSecret"
+	Dapr_RegisterActorTimer_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/RegisterActorTimer"
+	Dapr_UnregisterActorTimer_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/UnregisterActorTimer"
+	Dapr_RegisterActorReminder_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/RegisterActorReminder"
+	Dapr_UnregisterActorReminder_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/UnregisterActorReminder"
+	Dapr_GetActorState_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/GetActorState"
+	Dapr_ExecuteActorStateTransaction_FullMethodName    = "/dapr.proto.runtime.v1.Dapr/ExecuteActorStateTransaction"
+	Dapr_InvokeActor_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/InvokeActor"
+	Dapr_GetConfigurationAlpha1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/GetConfigurationAlpha1"
+	Dapr_GetConfiguration_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/GetConfiguration"
+	Dapr_SubscribeConfigurationAlpha1_FullMethodName    = "/dapr.proto.runtime.v1.Dapr/SubscribeConfigurationAlpha1"
+	Dapr_SubscribeConfiguration_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/SubscribeConfiguration"
+	Dapr_UnsubscribeConfigurationAlpha1_FullMethodName  = "/dapr.proto.runtime.v1.Dapr/UnsubscribeConfigurationAlpha1"
+	Dapr_UnsubscribeConfiguration_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/UnsubscribeConfiguration"
+	Dapr_TryLockAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/TryLockAlpha1"
+	Dapr_UnlockAlpha1_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/UnlockAlpha1"
+	Dapr_EncryptAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/EncryptAlpha1"
+	Dapr_DecryptAlpha1_FullMethodName                   = "/dapr.proto.runtime.v1.Dapr/DecryptAlpha1"
+	Dapr_GetMetadata_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/GetMetadata"
+	Dapr_SetMetadata_FullMethodName                     = "/dapr.proto.runtime.v1.Dapr/SetMetadata"
+	Dapr_SubtleGetKeyAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/SubtleGetKeyAlpha1"
+	Dapr_SubtleEncryptAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleEncryptAlpha1"
+	Dapr_SubtleDecryptAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleDecryptAlpha1"
+	Dapr_SubtleWrapKeyAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/SubtleWrapKeyAlpha1"
+	Dapr_SubtleUnwrapKeyAlpha1_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/SubtleUnwrapKeyAlpha1"
+	Dapr_SubtleSignAlpha1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/SubtleSignAlpha1"
+	Dapr_SubtleVerifyAlpha1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/SubtleVerifyAlpha1"
+	Dapr_StartWorkflowAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/StartWorkflowAlpha1"
+	Dapr_GetWorkflowAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/GetWorkflowAlpha1"
+	Dapr_PurgeWorkflowAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/PurgeWorkflowAlpha1"
+	Dapr_TerminateWorkflowAlpha1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/TerminateWorkflowAlpha1"
+	Dapr_PauseWorkflowAlpha1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/PauseWorkflowAlpha1"
+	Dapr_ResumeWorkflowAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/ResumeWorkflowAlpha1"
+	Dapr_RaiseEventWorkflowAlpha1_FullMethodName        = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowAlpha1"
+	Dapr_StartWorkflowBeta1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/StartWorkflowBeta1"
+	Dapr_GetWorkflowBeta1_FullMethodName                = "/dapr.proto.runtime.v1.Dapr/GetWorkflowBeta1"
+	Dapr_PurgeWorkflowBeta1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/PurgeWorkflowBeta1"
+	Dapr_TerminateWorkflowBeta1_FullMethodName          = "/dapr.proto.runtime.v1.Dapr/TerminateWorkflowBeta1"
+	Dapr_PauseWorkflowBeta1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/PauseWorkflowBeta1"
+	Dapr_ResumeWorkflowBeta1_FullMethodName             = "/dapr.proto.runtime.v1.Dapr/ResumeWorkflowBeta1"
+	Dapr_RaiseEventWorkflowBeta1_FullMethodName         = "/dapr.proto.runtime.v1.Dapr/RaiseEventWorkflowBeta1"
+	Dapr_ListWorkflowsBeta1_FullMethodName              = "/dapr.proto.runtime.v1.Dapr/ListWorkflowsBeta1"
+	Dapr_ExportWorkflowsAlpha1_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/ExportWorkflowsAlpha1"
+	Dapr_ImportWorkflowsAlpha1_FullMethodName           = "/dapr.proto.runtime.v1.Dapr/ImportWorkflowsAlpha1"
+	Dapr_Shutdown_FullMethodName                        = "/dapr.proto.runtime.v1.Dapr/Shutdown"
+	Dapr_ScheduleJobAlpha1_FullMethodName               = "/dapr.proto.runtime.v1.Dapr/ScheduleJobAlpha1"
+	Dapr_GetJobAlpha1_FullMethodName                    = "/dapr.proto.runtime.v1.Dapr/GetJobAlpha1"
+	Dapr_DeleteJobAlpha1_FullMethodName                 = "/dapr.proto.runtime.v1.Dapr/DeleteJobAlpha1"
+	Dapr_ListJobsAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/ListJobsAlpha1"
+	Dapr_ConverseAlpha1_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha1"
+	Dapr_ConverseAlpha2_FullMethodName                  = "/dapr.proto.runtime.v1.Dapr/ConverseAlpha2"
+	Dapr_ConverseStreamAlpha2_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/ConverseStreamAlpha2"
+	Dapr_GetConversationContextAlpha2_FullMethodName    = "/dapr.proto.runtime.v1.Dapr/GetConversationContextAlpha2"
+	Dapr_DeleteConversationContextAlpha2_FullMethodName = "/dapr.proto.runtime.v1.Dapr/DeleteConversationContextAlpha2"
+	Dapr_GetWorkloadJWTAlpha1_FullMethodName            = "/dapr.proto.runtime.v1.Dapr/GetWorkloadJWTAlpha1"
 )
 
 // DaprClient is the client API for Dapr service.
@@ -110,6 +119,9 @@ type DaprClient interface {
 	SaveState(ctx context.Context, in *SaveStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Queries the state.
 	QueryStateAlpha1(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error)
+	// Re-encrypts a page of values of an encrypted state store which were
+	// encrypted with its secondary key, using the primary key.
+	ReEncryptStateAlpha1(ctx context.Context, in *ReEncryptStateRequest, opts ...grpc.CallOption) (*ReEncryptStateResponse, error)
 	// Deletes the state for a specific key.
 	DeleteState(ctx context.Context, in *DeleteStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Deletes a bulk of state items for a list of keys
@@ -216,6 +228,14 @@ type DaprClient interface {
 	ResumeWorkflowBeta1(ctx context.Context, in *ResumeWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Raise an event to a running workflow instance
 	RaiseEventWorkflowBeta1(ctx context.Context, in *RaiseEventWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists workflow instances, optionally filtered by name, status and time ranges
+	ListWorkflowsBeta1(ctx context.Context, in *ListWorkflowsRequest, opts ...grpc.CallOption) (*ListWorkflowsResponse, error)
+	// Exports workflow instances, including their inbox, history and pending
+	// reminders, as a stream of portable records.
+	ExportWorkflowsAlpha1(ctx context.Context, in *ExportWorkflowsRequest, opts ...grpc.CallOption) (Dapr_ExportWorkflowsAlpha1Client, error)
+	// Imports a stream of workflow instances previously exported with
+	// ExportWorkflowsAlpha1 into the workflow state store.
+	ImportWorkflowsAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_ImportWorkflowsAlpha1Client, error)
 	// Shutdown the sidecar
 	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Create and schedule a job
@@ -224,10 +244,22 @@ type DaprClient interface {
 	GetJobAlpha1(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
 	// Delete a job
 	DeleteJobAlpha1(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error)
+	// Lists the jobs of this app, optionally filtered by name prefix
+	ListJobsAlpha1(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
 	// Converse with a LLM service
 	ConverseAlpha1(ctx context.Context, in *ConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
 	// Converse with a LLM service via alpha2 api
 	ConverseAlpha2(ctx context.Context, in *ConversationRequestAlpha2, opts ...grpc.CallOption) (*ConversationResponseAlpha2, error)
+	// Converse with a LLM service via alpha2 api, streaming the response chunks
+	// as they are generated.
+	ConverseStreamAlpha2(ctx context.Context, in *ConversationRequestAlpha2, opts ...grpc.CallOption) (Dapr_ConverseStreamAlpha2Client, error)
+	// Gets the message history of a conversation context, which is kept by the
+	// sidecar for conversation components with a history state store.
+	GetConversationContextAlpha2(ctx context.Context, in *GetConversationContextRequestAlpha2, opts ...grpc.CallOption) (*GetConversationContextResponseAlpha2, error)
+	// Deletes the message history of a conversation context.
+	DeleteConversationContextAlpha2(ctx context.Context, in *DeleteConversationContextRequestAlpha2, opts ...grpc.CallOption) (*DeleteConversationContextResponseAlpha2, error)
+	// Gets a JWT-SVID of this app, signed by Sentry, for the given audience.
+	GetWorkloadJWTAlpha1(ctx context.Context, in *GetWorkloadJWTRequest, opts ...grpc.CallOption) (*GetWorkloadJWTResponse, error)
 }
 
 type daprClient struct {
@@ -283,6 +315,15 @@ func (c *daprClient) QueryStateAlpha1(ctx context.Context, in *QueryStateRequest
 	return out, nil
 }
 
+func (c *daprClient) ReEncryptStateAlpha1(ctx context.Context, in *ReEncryptStateRequest, opts ...grpc.CallOption) (*ReEncryptStateResponse, error) {
+	out := new(ReEncryptStateResponse)
+	err := c.cc.Invoke(ctx, Dapr_ReEncryptStateAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) DeleteState(ctx context.Context, in *DeleteStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Dapr_DeleteState_FullMethodName, in, out, opts...)
@@ -843,6 +884,81 @@ func (c *daprClient) RaiseEventWorkflowBeta1(ctx context.Context, in *RaiseEvent
 	return out, nil
 }
 
+func (c *daprClient) ListWorkflowsBeta1(ctx context.Context, in *ListWorkflowsRequest, opts ...grpc.CallOption) (*ListWorkflowsResponse, error) {
+	out := new(ListWorkflowsResponse)
+	err := c.cc.Invoke(ctx, Dapr_ListWorkflowsBeta1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) ExportWorkflowsAlpha1(ctx context.Context, in *ExportWorkflowsRequest, opts ...grpc.CallOption) (Dapr_ExportWorkflowsAlpha1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[5], Dapr_ExportWorkflowsAlpha1_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprExportWorkflowsAlpha1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dapr_ExportWorkflowsAlpha1Client interface {
+	Recv() (*ExportedWorkflow, error)
+	grpc.ClientStream
+}
+
+type daprExportWorkflowsAlpha1Client struct {
+	grpc.ClientStream
+}
+
+func (x *daprExportWorkflowsAlpha1Client) Recv() (*ExportedWorkflow, error) {
+	m := new(ExportedWorkflow)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daprClient) ImportWorkflowsAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_ImportWorkflowsAlpha1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[6], Dapr_ImportWorkflowsAlpha1_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprImportWorkflowsAlpha1Client{stream}
+	return x, nil
+}
+
+type Dapr_ImportWorkflowsAlpha1Client interface {
+	Send(*ImportWorkflowsRequest) error
+	CloseAndRecv() (*ImportWorkflowsResponse, error)
+	grpc.ClientStream
+}
+
+type daprImportWorkflowsAlpha1Client struct {
+	grpc.ClientStream
+}
+
+func (x *daprImportWorkflowsAlpha1Client) Send(m *ImportWorkflowsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *daprImportWorkflowsAlpha1Client) CloseAndRecv() (*ImportWorkflowsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportWorkflowsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *daprClient) Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Dapr_Shutdown_FullMethodName, in, out, opts...)
@@ -879,6 +995,15 @@ func (c *daprClient) DeleteJobAlpha1(ctx context.Context, in *DeleteJobRequest,
 	return out, nil
 }
 
+func (c *daprClient) ListJobsAlpha1(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, Dapr_ListJobsAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) ConverseAlpha1(ctx context.Context, in *ConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
 	out := new(ConversationResponse)
 	err := c.cc.Invoke(ctx, Dapr_ConverseAlpha1_FullMethodName, in, out, opts...)
@@ -897,6 +1022,65 @@ func (c *daprClient) ConverseAlpha2(ctx context.Context, in *ConversationRequest
 	return out, nil
 }
 
+func (c *daprClient) ConverseStreamAlpha2(ctx context.Context, in *ConversationRequestAlpha2, opts ...grpc.CallOption) (Dapr_ConverseStreamAlpha2Client, error) {
+	stream, err := c.cc.NewStream(ctx, &Dapr_ServiceDesc.Streams[7], Dapr_ConverseStreamAlpha2_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprConverseStreamAlpha2Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dapr_ConverseStreamAlpha2Client interface {
+	Recv() (*ConversationStreamResponseAlpha2, error)
+	grpc.ClientStream
+}
+
+type daprConverseStreamAlpha2Client struct {
+	grpc.ClientStream
+}
+
+func (x *daprConverseStreamAlpha2Client) Recv() (*ConversationStreamResponseAlpha2, error) {
+	m := new(ConversationStreamResponseAlpha2)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daprClient) GetConversationContextAlpha2(ctx context.Context, in *GetConversationContextRequestAlpha2, opts ...grpc.CallOption) (*GetConversationContextResponseAlpha2, error) {
+	out := new(GetConversationContextResponseAlpha2)
+	err := c.cc.Invoke(ctx, Dapr_GetConversationContextAlpha2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) DeleteConversationContextAlpha2(ctx context.Context, in *DeleteConversationContextRequestAlpha2, opts ...grpc.CallOption) (*DeleteConversationContextResponseAlpha2, error) {
+	out := new(DeleteConversationContextResponseAlpha2)
+	err := c.cc.Invoke(ctx, Dapr_DeleteConversationContextAlpha2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) GetWorkloadJWTAlpha1(ctx context.Context, in *GetWorkloadJWTRequest, opts ...grpc.CallOption) (*GetWorkloadJWTResponse, error) {
+	out := new(GetWorkloadJWTResponse)
+	err := c.cc.Invoke(ctx, Dapr_GetWorkloadJWTAlpha1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DaprServer is the server API for Dapr service.
 // All implementations should embed UnimplementedDaprServer
 // for forward compatibility
@@ -912,6 +1096,9 @@ type DaprServer interface {
 	SaveState(context.Context, *SaveStateRequest) (*emptypb.Empty, error)
 	// Queries the state.
 	QueryStateAlpha1(context.Context, *QueryStateRequest) (*QueryStateResponse, error)
+	// Re-encrypts a page of values of an encrypted state store which were
+	// encrypted with its secondary key, using the primary key.
+	ReEncryptStateAlpha1(context.Context, *ReEncryptStateRequest) (*ReEncryptStateResponse, error)
 	// Deletes the state for a specific key.
 	DeleteState(context.Context, *DeleteStateRequest) (*emptypb.Empty, error)
 	// Deletes a bulk of state items for a list of keys
@@ -1018,6 +1205,14 @@ type DaprServer interface {
 	ResumeWorkflowBeta1(context.Context, *ResumeWorkflowRequest) (*emptypb.Empty, error)
 	// Raise an event to a running workflow instance
 	RaiseEventWorkflowBeta1(context.Context, *RaiseEventWorkflowRequest) (*emptypb.Empty, error)
+	// Lists workflow instances, optionally filtered by name, status and time ranges
+	ListWorkflowsBeta1(context.Context, *ListWorkflowsRequest) (*ListWorkflowsResponse, error)
+	// Exports workflow instances, including their inbox, history and pending
+	// reminders, as a stream of portable records.
+	ExportWorkflowsAlpha1(*ExportWorkflowsRequest, Dapr_ExportWorkflowsAlpha1Server) error
+	// Imports a stream of workflow instances previously exported with
+	// ExportWorkflowsAlpha1 into the workflow state store.
+	ImportWorkflowsAlpha1(Dapr_ImportWorkflowsAlpha1Server) error
 	// Shutdown the sidecar
 	Shutdown(context.Context, *ShutdownRequest) (*emptypb.Empty, error)
 	// Create and schedule a job
@@ -1026,10 +1221,22 @@ type DaprServer interface {
 	GetJobAlpha1(context.Context, *GetJobRequest) (*GetJobResponse, error)
 	// Delete a job
 	DeleteJobAlpha1(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error)
+	// Lists the jobs of this app, optionally filtered by name prefix
+	ListJobsAlpha1(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
 	// Converse with a LLM service
 	ConverseAlpha1(context.Context, *ConversationRequest) (*ConversationResponse, error)
 	// Converse with a LLM service via alpha2 api
 	ConverseAlpha2(context.Context, *ConversationRequestAlpha2) (*ConversationResponseAlpha2, error)
+	// Converse with a LLM service via alpha2 api, streaming the response chunks
+	// as they are generated.
+	ConverseStreamAlpha2(*ConversationRequestAlpha2, Dapr_ConverseStreamAlpha2Server) error
+	// Gets the message history of a conversation context, which is kept by the
+	// sidecar for conversation components with a history state store.
+	GetConversationContextAlpha2(context.Context, *GetConversationContextRequestAlpha2) (*GetConversationContextResponseAlpha2, error)
+	// Deletes the message history of a conversation context.
+	DeleteConversationContextAlpha2(context.Context, *DeleteConversationContextRequestAlpha2) (*DeleteConversationContextResponseAlpha2, error)
+	// Gets a JWT-SVID of this app, signed by Sentry, for the given audience.
+	GetWorkloadJWTAlpha1(context.Context, *GetWorkloadJWTRequest) (*GetWorkloadJWTResponse, error)
 }
 
 // UnimplementedDaprServer should be embedded to have forward compatible implementations.
@@ -1051,6 +1258,9 @@ func (UnimplementedDaprServer) SaveState(context.Context, *SaveStateRequest) (*e
 func (UnimplementedDaprServer) QueryStateAlpha1(context.Context, *QueryStateRequest) (*QueryStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryStateAlpha1 not implemented")
 }
+func (UnimplementedDaprServer) ReEncryptStateAlpha1(context.Context, *ReEncryptStateRequest) (*ReEncryptStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReEncryptStateAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) DeleteState(context.Context, *DeleteStateRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteState not implemented")
 }
@@ -1198,6 +1408,15 @@ func (UnimplementedDaprServer) ResumeWorkflowBeta1(context.Context, *ResumeWorkf
 func (UnimplementedDaprServer) RaiseEventWorkflowBeta1(context.Context, *RaiseEventWorkflowRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RaiseEventWorkflowBeta1 not implemented")
 }
+func (UnimplementedDaprServer) ListWorkflowsBeta1(context.Context, *ListWorkflowsRequest) (*ListWorkflowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowsBeta1 not implemented")
+}
+func (UnimplementedDaprServer) ExportWorkflowsAlpha1(*ExportWorkflowsRequest, Dapr_ExportWorkflowsAlpha1Server) error {
+	return status.Errorf(codes.Unimplemented, "method ExportWorkflowsAlpha1 not implemented")
+}
+func (UnimplementedDaprServer) ImportWorkflowsAlpha1(Dapr_ImportWorkflowsAlpha1Server) error {
+	return status.Errorf(codes.Unimplemented, "method ImportWorkflowsAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) Shutdown(context.Context, *ShutdownRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
 }
@@ -1210,12 +1429,27 @@ func (UnimplementedDaprServer) GetJobAlpha1(context.Context, *GetJobRequest) (*G
 func (UnimplementedDaprServer) DeleteJobAlpha1(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteJobAlpha1 not implemented")
 }
+func (UnimplementedDaprServer) ListJobsAlpha1(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobsAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) ConverseAlpha1(context.Context, *ConversationRequest) (*ConversationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConverseAlpha1 not implemented")
 }
 func (UnimplementedDaprServer) ConverseAlpha2(context.Context, *ConversationRequestAlpha2) (*ConversationResponseAlpha2, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConverseAlpha2 not implemented")
 }
+func (UnimplementedDaprServer) ConverseStreamAlpha2(*ConversationRequestAlpha2, Dapr_ConverseStreamAlpha2Server) error {
+	return status.Errorf(codes.Unimplemented, "method ConverseStreamAlpha2 not implemented")
+}
+func (UnimplementedDaprServer) GetConversationContextAlpha2(context.Context, *GetConversationContextRequestAlpha2) (*GetConversationContextResponseAlpha2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationContextAlpha2 not implemented")
+}
+func (UnimplementedDaprServer) DeleteConversationContextAlpha2(context.Context, *DeleteConversationContextRequestAlpha2) (*DeleteConversationContextResponseAlpha2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConversationContextAlpha2 not implemented")
+}
+func (UnimplementedDaprServer) GetWorkloadJWTAlpha1(context.Context, *GetWorkloadJWTRequest) (*GetWorkloadJWTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkloadJWTAlpha1 not implemented")
+}
 
 // UnsafeDaprServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DaprServer will
@@ -1318,6 +1552,24 @@ func _Dapr_QueryStateAlpha1_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_ReEncryptStateAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReEncryptStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ReEncryptStateAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_ReEncryptStateAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ReEncryptStateAlpha1(ctx, req.(*ReEncryptStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_DeleteState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteStateRequest)
 	if err := dec(in); err != nil {
@@ -2230,6 +2482,71 @@ func _Dapr_RaiseEventWorkflowBeta1_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_ListWorkflowsBeta1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ListWorkflowsBeta1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_ListWorkflowsBeta1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ListWorkflowsBeta1(ctx, req.(*ListWorkflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_ExportWorkflowsAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportWorkflowsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaprServer).ExportWorkflowsAlpha1(m, &daprExportWorkflowsAlpha1Server{stream})
+}
+
+type Dapr_ExportWorkflowsAlpha1Server interface {
+	Send(*ExportedWorkflow) error
+	grpc.ServerStream
+}
+
+type daprExportWorkflowsAlpha1Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprExportWorkflowsAlpha1Server) Send(m *ExportedWorkflow) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Dapr_ImportWorkflowsAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DaprServer).ImportWorkflowsAlpha1(&daprImportWorkflowsAlpha1Server{stream})
+}
+
+type Dapr_ImportWorkflowsAlpha1Server interface {
+	SendAndClose(*ImportWorkflowsResponse) error
+	Recv() (*ImportWorkflowsRequest, error)
+	grpc.ServerStream
+}
+
+type daprImportWorkflowsAlpha1Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprImportWorkflowsAlpha1Server) SendAndClose(m *ImportWorkflowsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *daprImportWorkflowsAlpha1Server) Recv() (*ImportWorkflowsRequest, error) {
+	m := new(ImportWorkflowsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Dapr_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ShutdownRequest)
 	if err := dec(in); err != nil {
@@ -2302,6 +2619,24 @@ func _Dapr_DeleteJobAlpha1_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_ListJobsAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ListJobsAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_ListJobsAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ListJobsAlpha1(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_ConverseAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConversationRequest)
 	if err := dec(in); err != nil {
@@ -2338,6 +2673,81 @@ func _Dapr_ConverseAlpha2_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_ConverseStreamAlpha2_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConversationRequestAlpha2)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaprServer).ConverseStreamAlpha2(m, &daprConverseStreamAlpha2Server{stream})
+}
+
+type Dapr_ConverseStreamAlpha2Server interface {
+	Send(*ConversationStreamResponseAlpha2) error
+	grpc.ServerStream
+}
+
+type daprConverseStreamAlpha2Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprConverseStreamAlpha2Server) Send(m *ConversationStreamResponseAlpha2) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Dapr_GetConversationContextAlpha2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationContextRequestAlpha2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).GetConversationContextAlpha2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_GetConversationContextAlpha2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).GetConversationContextAlpha2(ctx, req.(*GetConversationContextRequestAlpha2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_DeleteConversationContextAlpha2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConversationContextRequestAlpha2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).DeleteConversationContextAlpha2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_DeleteConversationContextAlpha2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).DeleteConversationContextAlpha2(ctx, req.(*DeleteConversationContextRequestAlpha2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_GetWorkloadJWTAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkloadJWTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).GetWorkloadJWTAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dapr_GetWorkloadJWTAlpha1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).GetWorkloadJWTAlpha1(ctx, req.(*GetWorkloadJWTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dapr_ServiceDesc is the grpc.ServiceDesc for Dapr service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2365,6 +2775,10 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryStateAlpha1",
 			Handler:    _Dapr_QueryStateAlpha1_Handler,
 		},
+		{
+			MethodName: "ReEncryptStateAlpha1",
+			Handler:    _Dapr_ReEncryptStateAlpha1_Handler,
+		},
 		{
 			MethodName: "DeleteState",
 			Handler:    _Dapr_DeleteState_Handler,
@@ -2541,6 +2955,10 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RaiseEventWorkflowBeta1",
 			Handler:    _Dapr_RaiseEventWorkflowBeta1_Handler,
 		},
+		{
+			MethodName: "ListWorkflowsBeta1",
+			Handler:    _Dapr_ListWorkflowsBeta1_Handler,
+		},
 		{
 			MethodName: "Shutdown",
 			Handler:    _Dapr_Shutdown_Handler,
@@ -2557,6 +2975,10 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteJobAlpha1",
 			Handler:    _Dapr_DeleteJobAlpha1_Handler,
 		},
+		{
+			MethodName: "ListJobsAlpha1",
+			Handler:    _Dapr_ListJobsAlpha1_Handler,
+		},
 		{
 			MethodName: "ConverseAlpha1",
 			Handler:    _Dapr_ConverseAlpha1_Handler,
@@ -2565,6 +2987,18 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConverseAlpha2",
 			Handler:    _Dapr_ConverseAlpha2_Handler,
 		},
+		{
+			MethodName: "GetConversationContextAlpha2",
+			Handler:    _Dapr_GetConversationContextAlpha2_Handler,
+		},
+		{
+			MethodName: "DeleteConversationContextAlpha2",
+			Handler:    _Dapr_DeleteConversationContextAlpha2_Handler,
+		},
+		{
+			MethodName: "GetWorkloadJWTAlpha1",
+			Handler:    _Dapr_GetWorkloadJWTAlpha1_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2595,6 +3029,21 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ExportWorkflowsAlpha1",
+			Handler:       _Dapr_ExportWorkflowsAlpha1_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportWorkflowsAlpha1",
+			Handler:       _Dapr_ImportWorkflowsAlpha1_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ConverseStreamAlpha2",
+			Handler:       _Dapr_ConverseStreamAlpha2_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "dapr/proto/runtime/v1/dapr.proto",
 }
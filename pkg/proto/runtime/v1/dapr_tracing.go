@@ -95,6 +95,9 @@ func (*DecryptRequest) AppendSpanAttributes(rpcMethod string, m map[string]strin
 func (*DeleteBulkStateRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
+func (*DeleteConversationContextRequestAlpha2) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
 func (*EncryptRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -104,6 +107,9 @@ func (*ExecuteActorStateTransactionRequest) AppendSpanAttributes(rpcMethod strin
 func (*ExecuteStateTransactionRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
+func (*ExportWorkflowsRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
 func (*GetActorStateRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -116,6 +122,9 @@ func (*GetBulkStateRequest) AppendSpanAttributes(rpcMethod string, m map[string]
 func (*GetConfigurationRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
+func (*GetConversationContextRequestAlpha2) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
 func (*GetJobRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -125,9 +134,21 @@ func (*GetMetadataRequest) AppendSpanAttributes(rpcMethod string, m map[string]s
 func (*GetWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
+func (*GetWorkloadJWTRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+func (*ImportWorkflowsRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
 func (*InvokeActorRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
+func (*ListJobsRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
+func (*ListWorkflowsRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
 func (*PauseWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
@@ -140,6 +161,9 @@ func (*QueryStateRequest) AppendSpanAttributes(rpcMethod string, m map[string]st
 func (*RaiseEventWorkflowRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
+func (*ReEncryptStateRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
+	// TODO
+}
 func (*RegisterActorReminderRequest) AppendSpanAttributes(rpcMethod string, m map[string]string) {
 	// TODO
 }
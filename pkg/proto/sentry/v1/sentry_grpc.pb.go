@@ -32,6 +32,8 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	CA_SignCertificate_FullMethodName = "/dapr.proto.sentry.v1.CA/SignCertificate"
+	CA_SignJWTSVID_FullMethodName     = "/dapr.proto.sentry.v1.CA/SignJWTSVID"
+	CA_WatchDenyList_FullMethodName   = "/dapr.proto.sentry.v1.CA/WatchDenyList"
 )
 
 // CAClient is the client API for CA service.
@@ -43,6 +45,15 @@ type CAClient interface {
 	// The requesting side must provide an id for both loosely based
 	// And strong based identities.
 	SignCertificate(ctx context.Context, in *SignCertificateRequest, opts ...grpc.CallOption) (*SignCertificateResponse, error)
+	// A request for a time-bound JWT-SVID, scoped to the given audiences, to be
+	// signed.
+	//
+	// The requesting side is authenticated in the same way as for
+	// SignCertificate.
+	SignJWTSVID(ctx context.Context, in *SignJWTSVIDRequest, opts ...grpc.CallOption) (*SignJWTSVIDResponse, error)
+	// Streams the list of denied identities and certificate serial numbers.
+	// The current list is sent first, followed by every update to it.
+	WatchDenyList(ctx context.Context, in *WatchDenyListRequest, opts ...grpc.CallOption) (CA_WatchDenyListClient, error)
 }
 
 type cAClient struct {
@@ -62,6 +73,47 @@ func (c *cAClient) SignCertificate(ctx context.Context, in *SignCertificateReque
 	return out, nil
 }
 
+func (c *cAClient) SignJWTSVID(ctx context.Context, in *SignJWTSVIDRequest, opts ...grpc.CallOption) (*SignJWTSVIDResponse, error) {
+	out := new(SignJWTSVIDResponse)
+	err := c.cc.Invoke(ctx, CA_SignJWTSVID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAClient) WatchDenyList(ctx context.Context, in *WatchDenyListRequest, opts ...grpc.CallOption) (CA_WatchDenyListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CA_ServiceDesc.Streams[0], CA_WatchDenyList_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cAWatchDenyListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CA_WatchDenyListClient interface {
+	Recv() (*DenyList, error)
+	grpc.ClientStream
+}
+
+type cAWatchDenyListClient struct {
+	grpc.ClientStream
+}
+
+func (x *cAWatchDenyListClient) Recv() (*DenyList, error) {
+	m := new(DenyList)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // CAServer is the server API for CA service.
 // All implementations should embed UnimplementedCAServer
 // for forward compatibility
@@ -71,6 +123,15 @@ type CAServer interface {
 	// The requesting side must provide an id for both loosely based
 	// And strong based identities.
 	SignCertificate(context.Context, *SignCertificateRequest) (*SignCertificateResponse, error)
+	// A request for a time-bound JWT-SVID, scoped to the given audiences, to be
+	// signed.
+	//
+	// The requesting side is authenticated in the same way as for
+	// SignCertificate.
+	SignJWTSVID(context.Context, *SignJWTSVIDRequest) (*SignJWTSVIDResponse, error)
+	// Streams the list of denied identities and certificate serial numbers.
+	// The current list is sent first, followed by every update to it.
+	WatchDenyList(*WatchDenyListRequest, CA_WatchDenyListServer) error
 }
 
 // UnimplementedCAServer should be embedded to have forward compatible implementations.
@@ -80,6 +141,12 @@ type UnimplementedCAServer struct {
 func (UnimplementedCAServer) SignCertificate(context.Context, *SignCertificateRequest) (*SignCertificateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignCertificate not implemented")
 }
+func (UnimplementedCAServer) SignJWTSVID(context.Context, *SignJWTSVIDRequest) (*SignJWTSVIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignJWTSVID not implemented")
+}
+func (UnimplementedCAServer) WatchDenyList(*WatchDenyListRequest, CA_WatchDenyListServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDenyList not implemented")
+}
 
 // UnsafeCAServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to CAServer will
@@ -110,6 +177,45 @@ func _CA_SignCertificate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CA_SignJWTSVID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignJWTSVIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAServer).SignJWTSVID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CA_SignJWTSVID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAServer).SignJWTSVID(ctx, req.(*SignJWTSVIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CA_WatchDenyList_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDenyListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CAServer).WatchDenyList(m, &cAWatchDenyListServer{stream})
+}
+
+type CA_WatchDenyListServer interface {
+	Send(*DenyList) error
+	grpc.ServerStream
+}
+
+type cAWatchDenyListServer struct {
+	grpc.ServerStream
+}
+
+func (x *cAWatchDenyListServer) Send(m *DenyList) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // CA_ServiceDesc is the grpc.ServiceDesc for CA service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -121,7 +227,17 @@ var CA_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignCertificate",
 			Handler:    _CA_SignCertificate_Handler,
 		},
+		{
+			MethodName: "SignJWTSVID",
+			Handler:    _CA_SignJWTSVID_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDenyList",
+			Handler:       _CA_WatchDenyList_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "dapr/proto/sentry/v1/sentry.proto",
 }
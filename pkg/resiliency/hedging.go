@@ -0,0 +1,62 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const defaultHedgingMaxAttempts = 2
+
+// Hedging contains the configuration of a hedging policy for service invocation.
+// After Delay elapses without a response, another attempt is sent to a
+// different instance of the target app, up to MaxAttempts attempts in flight.
+// Unless AllowNonIdempotent is set, only idempotent HTTP requests are hedged.
+type Hedging struct {
+	Delay              time.Duration
+	MaxAttempts        int
+	AllowNonIdempotent bool
+
+	addActivatedMetric func()
+}
+
+// String implements fmt.Stringer and is used for debugging.
+func (h Hedging) String() string {
+	return fmt.Sprintf("delay='%v' maxAttempts='%d' allowNonIdempotent='%t'", h.Delay, h.MaxAttempts, h.AllowNonIdempotent)
+}
+
+// HedgeSent records that an additional attempt was sent.
+func (h *Hedging) HedgeSent() {
+	if h.addActivatedMetric != nil {
+		h.addActivatedMetric()
+	}
+}
+
+type fallbackCtxKey struct{}
+
+// WithFallback returns a context which signals to the operation that the
+// request must be sent to the fallback target instead.
+func WithFallback(ctx context.Context, target string) context.Context {
+	return context.WithValue(ctx, fallbackCtxKey{}, target)
+}
+
+// GetFallback returns the fallback target set in the context by a runner
+// whose circuit breaker is open. If the context doesn't have a fallback
+// target, returns an empty string.
+func GetFallback(ctx context.Context) string {
+	target, _ := ctx.Value(fallbackCtxKey{}).(string)
+	return target
+}
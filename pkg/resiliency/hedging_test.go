@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+func hedgingTestConfig() *resiliencyV1alpha.Resiliency {
+	return &resiliencyV1alpha.Resiliency{
+		ObjectMeta: v1.ObjectMeta{Name: "hedging"},
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				CircuitBreakers: map[string]resiliencyV1alpha.CircuitBreaker{
+					"cb": {MaxRequests: 1, Timeout: "1m", Trip: "consecutiveFailures > 1"},
+				},
+				Hedges: map[string]resiliencyV1alpha.Hedge{
+					"fast":  {Delay: "50ms"},
+					"three": {Delay: "1s", MaxAttempts: 3, AllowNonIdempotent: true},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"app1": {Hedge: "fast", CircuitBreaker: "cb", FallbackAppID: "app2"},
+					"app2": {Hedge: "three"},
+					"app3": {FallbackAppID: "app2"},
+				},
+			},
+		},
+	}
+}
+
+func TestHedgingPolicy(t *testing.T) {
+	r := FromConfigurations(log, hedgingTestConfig())
+
+	h := r.EndpointHedgingPolicy("app1")
+	require.NotNil(t, h)
+	assert.Equal(t, 50*time.Millisecond, h.Delay)
+	assert.Equal(t, 2, h.MaxAttempts)
+	assert.False(t, h.AllowNonIdempotent)
+
+	h = r.EndpointHedgingPolicy("app2")
+	require.NotNil(t, h)
+	assert.Equal(t, time.Second, h.Delay)
+	assert.Equal(t, 3, h.MaxAttempts)
+	assert.True(t, h.AllowNonIdempotent)
+
+	assert.Nil(t, r.EndpointHedgingPolicy("app3"))
+	assert.Nil(t, r.EndpointHedgingPolicy("notfound"))
+	assert.Nil(t, NoOp{}.EndpointHedgingPolicy("app1"))
+}
+
+func TestInvalidHedgingPolicies(t *testing.T) {
+	tests := map[string]resiliencyV1alpha.ResiliencySpec{
+		"missing delay": {
+			Policies: resiliencyV1alpha.Policies{
+				Hedges: map[string]resiliencyV1alpha.Hedge{"h": {}},
+			},
+		},
+		"single attempt": {
+			Policies: resiliencyV1alpha.Policies{
+				Hedges: map[string]resiliencyV1alpha.Hedge{"h": {Delay: "1s", MaxAttempts: 1}},
+			},
+		},
+		"app is its own fallback": {
+			Targets: resiliencyV1alpha.Targets{
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"app1": {FallbackAppID: "app1"},
+				},
+			},
+		},
+	}
+
+	for name, spec := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := New(log).DecodeConfiguration(&resiliencyV1alpha.Resiliency{Spec: spec})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestFallbackWhenCircuitBreakerIsOpen(t *testing.T) {
+	r := FromConfigurations(log, hedgingTestConfig())
+
+	// Without a circuit breaker there is no fallback
+	assert.Empty(t, r.EndpointPolicy("app3", "method").FallbackTarget())
+
+	policyDef := r.EndpointPolicy("app1", "method")
+	require.Equal(t, "app2", policyDef.FallbackTarget())
+
+	targets := []string{}
+	oper := func(ctx context.Context) (any, error) {
+		if fallback := GetFallback(ctx); fallback != "" {
+			targets = append(targets, fallback)
+			return nil, nil
+		}
+		targets = append(targets, "app1")
+		return nil, errors.New("fail")
+	}
+
+	for range 2 {
+		_, err := NewRunner[any](t.Context(), policyDef)(oper)
+		require.Error(t, err)
+	}
+
+	// The circuit breaker is now open, so the operation is invoked with the fallback target
+	_, err := NewRunner[any](t.Context(), policyDef)(oper)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app1", "app1", "app2"}, targets)
+}
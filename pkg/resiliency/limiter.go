@@ -0,0 +1,203 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+var (
+	// ErrRateLimited is returned when a request is rejected by a rate limit policy.
+	ErrRateLimited = errors.New("rate limit exceeded")
+	// ErrBulkheadFull is returned when a request is rejected by a bulkhead policy.
+	ErrBulkheadFull = errors.New("bulkhead max concurrency reached")
+)
+
+// RateLimit contains the configuration of a token bucket rate limit policy.
+type RateLimit struct {
+	// Limit is the number of requests allowed per second.
+	Limit rate.Limit
+	// Burst is the size of the token bucket.
+	Burst int
+	// MaxWait is how long a request waits for a token. When zero, requests
+	// are rejected immediately if no token is available.
+	MaxWait time.Duration
+}
+
+// String implements fmt.Stringer and is used for debugging.
+func (r RateLimit) String() string {
+	return fmt.Sprintf("limit='%v/s' burst='%d' maxWait='%v'", float64(r.Limit), r.Burst, r.MaxWait)
+}
+
+// Bulkhead contains the configuration of a bulkhead policy.
+type Bulkhead struct {
+	// MaxConcurrency is the maximum number of requests in flight.
+	MaxConcurrency int
+	// MaxWait is how long a request waits for a slot. When zero, requests are
+	// rejected immediately if all slots are taken.
+	MaxWait time.Duration
+}
+
+// String implements fmt.Stringer and is used for debugging.
+func (b Bulkhead) String() string {
+	return fmt.Sprintf("maxConcurrency='%d' maxWait='%v'", b.MaxConcurrency, b.MaxWait)
+}
+
+// RateLimiter enforces a RateLimit policy.
+type RateLimiter struct {
+	limiter *rate.Limiter
+	maxWait time.Duration
+}
+
+// NewRateLimiter returns a RateLimiter for the given policy.
+func NewRateLimiter(config RateLimit) *RateLimiter {
+	return &RateLimiter{
+		limiter: rate.NewLimiter(config.Limit, config.Burst),
+		maxWait: config.MaxWait,
+	}
+}
+
+// Take takes a token from the bucket, waiting up to the configured max wait
+// duration. It returns true if the request had to wait for a token.
+func (l *RateLimiter) Take(ctx context.Context) (waited bool, err error) {
+	res := l.limiter.Reserve()
+	if !res.OK() {
+		return false, ErrRateLimited
+	}
+
+	delay := res.Delay()
+	if delay == 0 {
+		return false, nil
+	}
+	if delay > l.maxWait {
+		res.Cancel()
+		return false, ErrRateLimited
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return true, nil
+	case <-ctx.Done():
+		res.Cancel()
+		return true, ctx.Err()
+	}
+}
+
+// BulkheadLimiter enforces a Bulkhead policy.
+type BulkheadLimiter struct {
+	slots   chan struct{}
+	maxWait time.Duration
+}
+
+// NewBulkheadLimiter returns a BulkheadLimiter for the given policy.
+func NewBulkheadLimiter(config Bulkhead) *BulkheadLimiter {
+	return &BulkheadLimiter{
+		slots:   make(chan struct{}, config.MaxConcurrency),
+		maxWait: config.MaxWait,
+	}
+}
+
+// Acquire takes a slot, waiting up to the configured max wait duration. The
+// returned function must be invoked to release the slot. It returns true if
+// the request had to wait for a slot.
+func (b *BulkheadLimiter) Acquire(ctx context.Context) (release func(), waited bool, err error) {
+	release = func() {
+		<-b.slots
+	}
+
+	select {
+	case b.slots <- struct{}{}:
+		return release, false, nil
+	default:
+	}
+
+	if b.maxWait <= 0 {
+		return nil, false, ErrBulkheadFull
+	}
+
+	t := time.NewTimer(b.maxWait)
+	defer t.Stop()
+	select {
+	case b.slots <- struct{}{}:
+		return release, true, nil
+	case <-t.C:
+		return nil, true, ErrBulkheadFull
+	case <-ctx.Done():
+		return nil, true, ctx.Err()
+	}
+}
+
+// InFlight returns the number of requests currently holding a slot.
+func (b *BulkheadLimiter) InFlight() int {
+	return len(b.slots)
+}
+
+// limiterInstances stores the rate limiters and bulkheads of targets, so that
+// their state is shared by all the policies for the same target.
+type limiterInstances struct {
+	lock         sync.Mutex
+	rateLimiters map[string]*RateLimiter
+	bulkheads    map[string]*BulkheadLimiter
+}
+
+func newLimiterInstances() *limiterInstances {
+	return &limiterInstances{
+		rateLimiters: make(map[string]*RateLimiter),
+		bulkheads:    make(map[string]*BulkheadLimiter),
+	}
+}
+
+// RateLimiter returns the rate limiter for the target, creating it from the
+// policy if needed.
+func (l *limiterInstances) RateLimiter(policyName, target string, config RateLimit) *RateLimiter {
+	key := policyName + "||" + target
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	rl, ok := l.rateLimiters[key]
+	if !ok {
+		rl = NewRateLimiter(config)
+		l.rateLimiters[key] = rl
+	}
+	return rl
+}
+
+// Bulkhead returns the bulkhead for the target, creating it from the policy
+// if needed.
+func (l *limiterInstances) Bulkhead(policyName, target string, config Bulkhead) *BulkheadLimiter {
+	key := policyName + "||" + target
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	bh, ok := l.bulkheads[key]
+	if !ok {
+		bh = NewBulkheadLimiter(config)
+		l.bulkheads[key] = bh
+	}
+	return bh
+}
+
+// IsRateLimitedError returns true if the error was returned because a rate
+// limit or bulkhead policy rejected the request.
+func IsRateLimitedError(err error) bool {
+	return errors.Is(err, ErrRateLimited) || errors.Is(err, ErrBulkheadFull)
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("rejects immediately without max wait", func(t *testing.T) {
+		l := NewRateLimiter(RateLimit{Limit: rate.Every(time.Hour), Burst: 2})
+		for range 2 {
+			waited, err := l.Take(t.Context())
+			require.NoError(t, err)
+			assert.False(t, waited)
+		}
+		_, err := l.Take(t.Context())
+		require.ErrorIs(t, err, ErrRateLimited)
+	})
+
+	t.Run("waits up to max wait", func(t *testing.T) {
+		l := NewRateLimiter(RateLimit{Limit: rate.Every(50 * time.Millisecond), Burst: 1, MaxWait: time.Second})
+		_, err := l.Take(t.Context())
+		require.NoError(t, err)
+
+		start := time.Now()
+		waited, err := l.Take(t.Context())
+		require.NoError(t, err)
+		assert.True(t, waited)
+		assert.Greater(t, time.Since(start), 25*time.Millisecond)
+	})
+
+	t.Run("rejects if the wait is longer than max wait", func(t *testing.T) {
+		l := NewRateLimiter(RateLimit{Limit: rate.Every(time.Hour), Burst: 1, MaxWait: 10 * time.Millisecond})
+		_, err := l.Take(t.Context())
+		require.NoError(t, err)
+		_, err = l.Take(t.Context())
+		require.ErrorIs(t, err, ErrRateLimited)
+	})
+
+	t.Run("context canceled while waiting", func(t *testing.T) {
+		l := NewRateLimiter(RateLimit{Limit: rate.Every(time.Second), Burst: 1, MaxWait: time.Minute})
+		_, err := l.Take(t.Context())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+		_, err = l.Take(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestBulkheadLimiter(t *testing.T) {
+	t.Run("rejects immediately without max wait", func(t *testing.T) {
+		b := NewBulkheadLimiter(Bulkhead{MaxConcurrency: 1})
+		release, waited, err := b.Acquire(t.Context())
+		require.NoError(t, err)
+		assert.False(t, waited)
+		assert.Equal(t, 1, b.InFlight())
+
+		_, _, err = b.Acquire(t.Context())
+		require.ErrorIs(t, err, ErrBulkheadFull)
+
+		release()
+		assert.Equal(t, 0, b.InFlight())
+		release, _, err = b.Acquire(t.Context())
+		require.NoError(t, err)
+		release()
+	})
+
+	t.Run("waits for a slot up to max wait", func(t *testing.T) {
+		b := NewBulkheadLimiter(Bulkhead{MaxConcurrency: 1, MaxWait: time.Second})
+		release, _, err := b.Acquire(t.Context())
+		require.NoError(t, err)
+
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			release()
+		}()
+
+		release2, waited, err := b.Acquire(t.Context())
+		require.NoError(t, err)
+		assert.True(t, waited)
+		release2()
+	})
+
+	t.Run("rejects after max wait", func(t *testing.T) {
+		b := NewBulkheadLimiter(Bulkhead{MaxConcurrency: 1, MaxWait: 10 * time.Millisecond})
+		release, _, err := b.Acquire(t.Context())
+		require.NoError(t, err)
+		defer release()
+
+		_, waited, err := b.Acquire(t.Context())
+		require.ErrorIs(t, err, ErrBulkheadFull)
+		assert.True(t, waited)
+	})
+}
+
+func limiterTestConfig() *resiliencyV1alpha.Resiliency {
+	return &resiliencyV1alpha.Resiliency{
+		ObjectMeta: v1.ObjectMeta{Name: "limits"},
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				RateLimits: map[string]resiliencyV1alpha.RateLimit{
+					"twoPerHour": {Rate: 2, Period: "1h"},
+				},
+				Bulkheads: map[string]resiliencyV1alpha.Bulkhead{
+					"single": {MaxConcurrency: 1},
+				},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"app1": {RateLimit: "twoPerHour"},
+				},
+				Actors: map[string]resiliencyV1alpha.ActorPolicyNames{
+					"actor1": {Bulkhead: "single"},
+				},
+				Components: map[string]resiliencyV1alpha.ComponentPolicyNames{
+					"store1": {
+						Outbound: resiliencyV1alpha.PolicyNames{RateLimit: "twoPerHour", Bulkhead: "single"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRateLimitAndBulkheadPolicies(t *testing.T) {
+	r := FromConfigurations(log, limiterTestConfig())
+
+	run := func(def *PolicyDefinition) error {
+		_, err := NewRunner[any](t.Context(), def)(func(context.Context) (any, error) {
+			return nil, nil
+		})
+		return err
+	}
+
+	t.Run("policies are decoded", func(t *testing.T) {
+		require.Contains(t, r.rateLimits, "twoPerHour")
+		assert.Equal(t, 2, r.rateLimits["twoPerHour"].Burst)
+		assert.InDelta(t, 2.0/3600, float64(r.rateLimits["twoPerHour"].Limit), 1e-9)
+		require.Contains(t, r.bulkheads, "single")
+		assert.Equal(t, 1, r.bulkheads["single"].MaxConcurrency)
+	})
+
+	t.Run("rate limit is shared by all endpoints of an app", func(t *testing.T) {
+		require.NoError(t, run(r.EndpointPolicy("app1", "a")))
+		require.NoError(t, run(r.EndpointPolicy("app1", "b")))
+		err := run(r.EndpointPolicy("app1", "c"))
+		require.ErrorIs(t, err, ErrRateLimited)
+		assert.True(t, IsRateLimitedError(err))
+
+		// Other apps are not affected.
+		require.NoError(t, run(r.EndpointPolicy("app2", "a")))
+	})
+
+	t.Run("bulkhead limits concurrent actor calls", func(t *testing.T) {
+		started := make(chan struct{})
+		unblock := make(chan struct{})
+		errCh := make(chan error)
+		go func() {
+			_, err := NewRunner[any](t.Context(), r.ActorPreLockPolicy("actor1", "id1"))(func(context.Context) (any, error) {
+				close(started)
+				<-unblock
+				return nil, nil
+			})
+			errCh <- err
+		}()
+		<-started
+
+		require.ErrorIs(t, run(r.ActorPreLockPolicy("actor1", "id2")), ErrBulkheadFull)
+
+		close(unblock)
+		require.NoError(t, <-errCh)
+		require.NoError(t, run(r.ActorPreLockPolicy("actor1", "id2")))
+	})
+
+	t.Run("component limits are per direction", func(t *testing.T) {
+		require.NotNil(t, r.ComponentOutboundPolicy("store1", Statestore).rl)
+		require.NotNil(t, r.ComponentOutboundPolicy("store1", Statestore).bh)
+		assert.Nil(t, r.ComponentInboundPolicy("store1", Statestore).rl)
+	})
+
+	t.Run("rejected requests are not retried", func(t *testing.T) {
+		def := r.EndpointPolicy("app1", "a")
+		def.r = r.retries[string(BuiltInServiceRetries)]
+		var calls atomic.Int32
+		_, err := NewRunner[any](t.Context(), def)(func(context.Context) (any, error) {
+			calls.Add(1)
+			return nil, nil
+		})
+		require.ErrorIs(t, err, ErrRateLimited)
+		assert.Equal(t, int32(0), calls.Load())
+	})
+}
+
+func TestRateLimitAndBulkheadDefaultPolicies(t *testing.T) {
+	r := FromConfigurations(log, &resiliencyV1alpha.Resiliency{
+		ObjectMeta: v1.ObjectMeta{Name: "defaults"},
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				RateLimits: map[string]resiliencyV1alpha.RateLimit{
+					fmt.Sprintf(string(DefaultRateLimitTemplate), "App"): {Rate: 10},
+				},
+				Bulkheads: map[string]resiliencyV1alpha.Bulkhead{
+					fmt.Sprintf(string(DefaultBulkheadTemplate), "Component"): {MaxConcurrency: 5},
+				},
+			},
+		},
+	})
+
+	assert.NotNil(t, r.EndpointPolicy("app1", "a").rl)
+	assert.Nil(t, r.EndpointPolicy("app1", "a").bh)
+	assert.NotNil(t, r.ComponentOutboundPolicy("store1", Statestore).bh)
+	assert.NotNil(t, r.ComponentInboundPolicy("pubsub1", Pubsub).bh)
+	assert.Nil(t, r.ActorPreLockPolicy("actor1", "id1").rl)
+}
+
+func TestInvalidRateLimitAndBulkheadPolicies(t *testing.T) {
+	tests := map[string]resiliencyV1alpha.Policies{
+		"rate limit without rate": {
+			RateLimits: map[string]resiliencyV1alpha.RateLimit{"rl": {}},
+		},
+		"rate limit with invalid period": {
+			RateLimits: map[string]resiliencyV1alpha.RateLimit{"rl": {Rate: 1, Period: "foo"}},
+		},
+		"rate limit with invalid max wait": {
+			RateLimits: map[string]resiliencyV1alpha.RateLimit{"rl": {Rate: 1, MaxWait: "foo"}},
+		},
+		"bulkhead without max concurrency": {
+			Bulkheads: map[string]resiliencyV1alpha.Bulkhead{"bh": {}},
+		},
+		"bulkhead with invalid max wait": {
+			Bulkheads: map[string]resiliencyV1alpha.Bulkhead{"bh": {MaxConcurrency: 1, MaxWait: "foo"}},
+		},
+	}
+
+	for name, policies := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := New(log)
+			err := r.DecodeConfiguration(&resiliencyV1alpha.Resiliency{
+				Spec: resiliencyV1alpha.ResiliencySpec{Policies: policies},
+			})
+			require.Error(t, err)
+		})
+	}
+}
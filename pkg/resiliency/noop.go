@@ -46,6 +46,11 @@ func (NoOp) ComponentOutboundPolicy(name string, componentName ComponentType) *P
 	return nil
 }
 
+// EndpointHedgingPolicy returns a NoOp hedging policy for a service endpoint.
+func (NoOp) EndpointHedgingPolicy(app string) *Hedging {
+	return nil
+}
+
 // BuiltInPolicy returns a NoOp policy definition for a built-in policy.
 func (NoOp) BuiltInPolicy(name BuiltInPolicyName) *PolicyDefinition {
 	return nil
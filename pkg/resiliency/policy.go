@@ -24,6 +24,7 @@ import (
 
 	"github.com/cenkalti/backoff/v4"
 
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/resiliency/breaker"
 	"github.com/dapr/kit/logger"
 	"github.com/dapr/kit/retry"
@@ -51,9 +52,15 @@ type PolicyDefinition struct {
 	t                         time.Duration
 	r                         *Retry
 	cb                        *breaker.CircuitBreaker
+	rl                        *RateLimiter
+	bh                        *BulkheadLimiter
+	fallback                  string
 	addTimeoutActivatedMetric func()
 	addRetryActivatedMetric   func()
 	addCBStateChangedMetric   func()
+	addRateLimitActivated     func(status string)
+	addBulkheadActivated      func(status string)
+	addFallbackActivated      func()
 }
 
 // NewPolicyDefinition returns a PolicyDefinition object with the given parameters.
@@ -70,11 +77,16 @@ func NewPolicyDefinition(log logger.Logger, name string, t time.Duration, r *Ret
 // String implements fmt.Stringer and is used for debugging.
 func (p PolicyDefinition) String() string {
 	return fmt.Sprintf(
-		"Policy: name='%s' timeout='%v' retry=(%v) circuitBreaker=(%v)",
-		p.name, p.t, p.r, p.cb,
+		"Policy: name='%s' timeout='%v' retry=(%v) circuitBreaker=(%v) rateLimit=(%v) bulkhead=(%v)",
+		p.name, p.t, p.r, p.cb, p.rl != nil, p.bh != nil,
 	)
 }
 
+// FallbackTarget returns the target which is invoked instead when the circuit breaker is open, if any.
+func (p PolicyDefinition) FallbackTarget() string {
+	return p.fallback
+}
+
 // HasRetries returns true if the policy is configured to have more than 1 retry.
 func (p PolicyDefinition) HasRetries() bool {
 	return p.r != nil && p.r.MaxRetries != 0
@@ -111,6 +123,37 @@ func NewRunnerWithOptions[T any](ctx context.Context, def *PolicyDefinition, opt
 	var zero T
 	timeoutMetricsActivated := atomic.Bool{}
 	return func(oper Operation[T]) (T, error) {
+		// Rate limits and bulkheads are applied once per invocation, outside of
+		// retries, so that a rejected request is not retried.
+		if def.rl != nil {
+			waited, err := def.rl.Take(ctx)
+			if def.addRateLimitActivated != nil {
+				if errors.Is(err, ErrRateLimited) {
+					def.addRateLimitActivated(diag.PolicyStatusRejected)
+				} else if waited {
+					def.addRateLimitActivated(diag.PolicyStatusDelayed)
+				}
+			}
+			if err != nil {
+				return zero, err
+			}
+		}
+
+		if def.bh != nil {
+			release, waited, err := def.bh.Acquire(ctx)
+			if def.addBulkheadActivated != nil {
+				if errors.Is(err, ErrBulkheadFull) {
+					def.addBulkheadActivated(diag.PolicyStatusRejected)
+				} else if waited {
+					def.addBulkheadActivated(diag.PolicyStatusDelayed)
+				}
+			}
+			if err != nil {
+				return zero, err
+			}
+			defer release()
+		}
+
 		operation := oper
 		if def.t > 0 {
 			// Handle timeout
@@ -185,6 +228,14 @@ func NewRunnerWithOptions[T any](ctx context.Context, def *PolicyDefinition, opt
 				if def.addCBStateChangedMetric != nil && prevState != def.cb.State() {
 					def.addCBStateChangedMetric()
 				}
+				if def.fallback != "" && IsCircuitBreakerError(err) {
+					// The circuit breaker is open, so signal to the operation that it must invoke the fallback target
+					def.log.Debugf("Circuit breaker for operation %s is open; invoking fallback target %s", def.name, def.fallback)
+					if def.addFallbackActivated != nil {
+						def.addFallbackActivated()
+					}
+					return operCopy(WithFallback(ctx, def.fallback))
+				}
 				if def.r != nil && breaker.IsErrorPermanent(err) {
 					// Break out of retry
 					err = backoff.Permanent(err)
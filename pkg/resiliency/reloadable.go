@@ -0,0 +1,96 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"sync/atomic"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+// Reloadable is a Provider whose underlying Resiliency can be atomically
+// replaced while daprd is running.
+// Policy definitions which have already been returned keep referencing the
+// Resiliency they were created from, so in-flight requests complete with the
+// policies they started with. Circuit breaker state is not carried over to
+// the new Resiliency.
+type Reloadable struct {
+	current atomic.Pointer[Resiliency]
+}
+
+// Ensure `*Reloadable` satisfies the `Provider` interface.
+var _ = (Provider)((*Reloadable)(nil))
+
+// NewReloadable returns a new Reloadable which initially serves policies from
+// the given Resiliency.
+func NewReloadable(r *Resiliency) *Reloadable {
+	var rl Reloadable
+	rl.current.Store(r)
+	return &rl
+}
+
+// Swap atomically replaces the Resiliency used to serve new policies.
+func (r *Reloadable) Swap(res *Resiliency) {
+	r.current.Store(res)
+}
+
+// Configurations returns the resiliency configurations the current
+// Resiliency was created from.
+func (r *Reloadable) Configurations() []*resiliencyV1alpha.Resiliency {
+	return r.current.Load().configs
+}
+
+// EndpointPolicy returns the policy for a service endpoint.
+func (r *Reloadable) EndpointPolicy(service string, endpoint string) *PolicyDefinition {
+	return r.current.Load().EndpointPolicy(service, endpoint)
+}
+
+// ActorPreLockPolicy returns the policy for an actor instance to be used
+// before the lock is acquired.
+func (r *Reloadable) ActorPreLockPolicy(actorType string, id string) *PolicyDefinition {
+	return r.current.Load().ActorPreLockPolicy(actorType, id)
+}
+
+// ActorPostLockPolicy returns the policy for an actor instance to be used
+// after the lock is acquired.
+func (r *Reloadable) ActorPostLockPolicy(actorType string, id string) *PolicyDefinition {
+	return r.current.Load().ActorPostLockPolicy(actorType, id)
+}
+
+// ComponentOutboundPolicy returns the outbound policy for a component.
+func (r *Reloadable) ComponentOutboundPolicy(name string, componentType ComponentType) *PolicyDefinition {
+	return r.current.Load().ComponentOutboundPolicy(name, componentType)
+}
+
+// ComponentInboundPolicy returns the inbound policy for a component.
+func (r *Reloadable) ComponentInboundPolicy(name string, componentType ComponentType) *PolicyDefinition {
+	return r.current.Load().ComponentInboundPolicy(name, componentType)
+}
+
+// EndpointHedgingPolicy returns the hedging policy for service invocation to
+// an app, if any.
+func (r *Reloadable) EndpointHedgingPolicy(app string) *Hedging {
+	return r.current.Load().EndpointHedgingPolicy(app)
+}
+
+// BuiltInPolicy returns a policy that represents a specific built-in retry
+// scenario.
+func (r *Reloadable) BuiltInPolicy(name BuiltInPolicyName) *PolicyDefinition {
+	return r.current.Load().BuiltInPolicy(name)
+}
+
+// PolicyDefined returns true if there's policy that applies to the target.
+func (r *Reloadable) PolicyDefined(target string, policyType PolicyType) bool {
+	return r.current.Load().PolicyDefined(target, policyType)
+}
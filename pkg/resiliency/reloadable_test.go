@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resiliencyV1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+func appTimeoutConfig(timeout string) *resiliencyV1alpha.Resiliency {
+	return &resiliencyV1alpha.Resiliency{
+		ObjectMeta: v1.ObjectMeta{Name: "resiliency"},
+		Spec: resiliencyV1alpha.ResiliencySpec{
+			Policies: resiliencyV1alpha.Policies{
+				Timeouts: map[string]string{"general": timeout},
+			},
+			Targets: resiliencyV1alpha.Targets{
+				Apps: map[string]resiliencyV1alpha.EndpointPolicyNames{
+					"app1": {Timeout: "general"},
+				},
+			},
+		},
+	}
+}
+
+func TestReloadable(t *testing.T) {
+	initial := appTimeoutConfig("1s")
+	r := NewReloadable(FromConfigurations(log, initial))
+
+	before := r.EndpointPolicy("app1", "endpoint")
+	assert.Equal(t, time.Second, before.t)
+	assert.Equal(t, []*resiliencyV1alpha.Resiliency{initial}, r.Configurations())
+	assert.True(t, r.PolicyDefined("app1", EndpointPolicy{}))
+
+	updated := appTimeoutConfig("5s")
+	r.Swap(FromConfigurations(log, updated))
+
+	assert.Equal(t, 5*time.Second, r.EndpointPolicy("app1", "endpoint").t)
+	assert.Equal(t, []*resiliencyV1alpha.Resiliency{updated}, r.Configurations())
+
+	// Policies handed out before the swap are unchanged.
+	assert.Equal(t, time.Second, before.t)
+
+	r.Swap(FromConfigurations(log))
+	assert.False(t, r.PolicyDefined("app1", EndpointPolicy{}))
+	assert.Empty(t, r.Configurations())
+}
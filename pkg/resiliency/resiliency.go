@@ -26,6 +26,7 @@ import (
 
 	grpcRetry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	lru "github.com/hashicorp/golang-lru/v2"
+	"golang.org/x/time/rate"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 
@@ -54,6 +55,8 @@ const (
 	DefaultRetryTemplate          DefaultPolicyTemplate = "Default%sRetryPolicy"
 	DefaultTimeoutTemplate        DefaultPolicyTemplate = "Default%sTimeoutPolicy"
 	DefaultCircuitBreakerTemplate DefaultPolicyTemplate = "Default%sCircuitBreakerPolicy"
+	DefaultRateLimitTemplate      DefaultPolicyTemplate = "Default%sRateLimitPolicy"
+	DefaultBulkheadTemplate       DefaultPolicyTemplate = "Default%sBulkheadPolicy"
 	Endpoint                      PolicyTypeName        = "App"
 	Component                     PolicyTypeName        = "Component"
 	Actor                         PolicyTypeName        = "Actor"
@@ -97,6 +100,8 @@ type (
 		ComponentOutboundPolicy(name string, componentType ComponentType) *PolicyDefinition
 		// ComponentInboundPolicy returns the inbound policy for a component.
 		ComponentInboundPolicy(name string, componentType ComponentType) *PolicyDefinition
+		// EndpointHedgingPolicy returns the hedging policy for service invocation to an app, if any.
+		EndpointHedgingPolicy(app string) *Hedging
 		// BuiltInPolicy are used to replace existing retries in Dapr which may not bind specifically to one of the above categories.
 		BuiltInPolicy(name BuiltInPolicyName) *PolicyDefinition
 		// PolicyDefined returns true if there's policy that applies to the target.
@@ -114,6 +119,9 @@ type (
 		timeouts        map[string]time.Duration
 		retries         map[string]*Retry
 		circuitBreakers map[string]*breaker.CircuitBreaker
+		rateLimits      map[string]RateLimit
+		bulkheads       map[string]Bulkhead
+		hedges          map[string]Hedging
 
 		actorCBCaches    map[string]*lru.Cache[string, *breaker.CircuitBreaker]
 		actorCBsCachesMu sync.RWMutex
@@ -121,10 +129,17 @@ type (
 		serviceCBsMu     sync.RWMutex
 
 		componentCBs *circuitBreakerInstances
+		limiters     *limiterInstances
 
 		apps       map[string]PolicyNames
+		appHedges  map[string]string
+		fallbacks  map[string]string
 		actors     map[string]ActorPolicies
 		components map[string]ComponentPolicyNames
+
+		// configs are the resiliency configurations this Resiliency was created
+		// from.
+		configs []*resiliencyV1alpha.Resiliency
 	}
 
 	// circuitBreakerInstances stores circuit breaker state for components
@@ -140,12 +155,14 @@ type (
 		Outbound PolicyNames
 	}
 
-	// PolicyNames contains the policy names for a timeout, retry, and circuit breaker.
+	// PolicyNames contains the policy names for a timeout, retry, circuit breaker, rate limit, and bulkhead.
 	// Empty values mean that no policy is configured.
 	PolicyNames struct {
 		Timeout        string
 		Retry          string
 		CircuitBreaker string
+		RateLimit      string
+		Bulkhead       string
 	}
 
 	// Actors have different behavior before and after locking.
@@ -159,6 +176,8 @@ type (
 		Retry               string
 		CircuitBreaker      string
 		CircuitBreakerScope ActorCircuitBreakerScope
+		RateLimit           string
+		Bulkhead            string
 	}
 
 	// Policy used after an actor is locked. It only uses timeout as retry/circuit breaker is handled before locking.
@@ -254,17 +273,30 @@ func loadLocalResiliencyPath(log logger.Logger, runtimeID string, path string) [
 
 // LoadKubernetesResiliency loads resiliency configurations from the Kubernetes operator.
 func LoadKubernetesResiliency(log logger.Logger, runtimeID, namespace string, operatorClient operatorv1pb.OperatorClient) []*resiliencyV1alpha.Resiliency {
-	resp, err := operatorClient.ListResiliency(context.Background(), &operatorv1pb.ListResiliencyRequest{
-		Namespace: namespace,
-	}, grpcRetry.WithMax(operatorRetryCount), grpcRetry.WithPerRetryTimeout(operatorTimePerRetry))
+	configs, err := ListKubernetesResiliency(context.Background(), log, runtimeID, namespace, operatorClient)
 	if err != nil {
 		log.Errorf("Error listing resiliency policies: %v", err)
 		return nil
 	}
 
+	return configs
+}
+
+// ListKubernetesResiliency lists the resiliency configurations from the
+// Kubernetes operator which are scoped to the given runtime ID. Unlike
+// LoadKubernetesResiliency, an error is returned if the operator could not be
+// reached.
+func ListKubernetesResiliency(ctx context.Context, log logger.Logger, runtimeID, namespace string, operatorClient operatorv1pb.OperatorClient) ([]*resiliencyV1alpha.Resiliency, error) {
+	resp, err := operatorClient.ListResiliency(ctx, &operatorv1pb.ListResiliencyRequest{
+		Namespace: namespace,
+	}, grpcRetry.WithMax(operatorRetryCount), grpcRetry.WithPerRetryTimeout(operatorTimePerRetry))
+	if err != nil {
+		return nil, err
+	}
+
 	if resp.GetResiliencies() == nil {
 		log.Debug("No resiliency policies found")
-		return nil
+		return nil, nil
 	}
 
 	configs := make([]*resiliencyV1alpha.Resiliency, 0, len(resp.GetResiliencies()))
@@ -279,12 +311,13 @@ func LoadKubernetesResiliency(log logger.Logger, runtimeID, namespace string, op
 		configs = append(configs, &resiliency)
 	}
 
-	return filterResiliencyConfigs(configs, runtimeID)
+	return filterResiliencyConfigs(configs, runtimeID), nil
 }
 
 // FromConfigurations creates a resiliency provider and decodes the configurations from `c`.
 func FromConfigurations(log logger.Logger, c ...*resiliencyV1alpha.Resiliency) *Resiliency {
 	r := New(log)
+	r.configs = c
 
 	// Add the default policies into the overall resiliency first. This allows customers to overwrite them if desired.
 	r.addBuiltInPolicies()
@@ -308,12 +341,18 @@ func New(log logger.Logger) *Resiliency {
 		timeouts:        make(map[string]time.Duration),
 		retries:         make(map[string]*Retry),
 		circuitBreakers: make(map[string]*breaker.CircuitBreaker),
+		rateLimits:      make(map[string]RateLimit),
+		bulkheads:       make(map[string]Bulkhead),
+		hedges:          make(map[string]Hedging),
 		actorCBCaches:   make(map[string]*lru.Cache[string, *breaker.CircuitBreaker]),
 		serviceCBs:      make(map[string]*lru.Cache[string, *breaker.CircuitBreaker]),
 		componentCBs: &circuitBreakerInstances{
 			cbs: make(map[string]*breaker.CircuitBreaker, 10),
 		},
+		limiters:   newLimiterInstances(),
 		apps:       make(map[string]PolicyNames),
+		appHedges:  make(map[string]string),
+		fallbacks:  make(map[string]string),
 		actors:     make(map[string]ActorPolicies),
 		components: make(map[string]ComponentPolicyNames),
 	}
@@ -451,6 +490,68 @@ func (r *Resiliency) decodePolicies(c *resiliencyV1alpha.Resiliency) (err error)
 		r.circuitBreakers[name] = &cb
 	}
 
+	for name, t := range policies.RateLimits {
+		if t.Rate <= 0 {
+			return fmt.Errorf("invalid rate limit configuration %q: rate must be greater than 0", name)
+		}
+		period := time.Second
+		if t.Period != "" {
+			if period, err = parseDuration(t.Period); err != nil || period <= 0 {
+				return fmt.Errorf("invalid rate limit configuration %q: invalid period %s", name, t.Period)
+			}
+		}
+		burst := t.Burst
+		if burst <= 0 {
+			burst = t.Rate
+		}
+		var maxWait time.Duration
+		if t.MaxWait != "" {
+			if maxWait, err = parseDuration(t.MaxWait); err != nil {
+				return fmt.Errorf("invalid rate limit configuration %q: invalid max wait %s: %w", name, t.MaxWait, err)
+			}
+		}
+		r.rateLimits[name] = RateLimit{
+			Limit:   rate.Limit(float64(t.Rate) / period.Seconds()),
+			Burst:   burst,
+			MaxWait: maxWait,
+		}
+	}
+
+	for name, t := range policies.Bulkheads {
+		if t.MaxConcurrency <= 0 {
+			return fmt.Errorf("invalid bulkhead configuration %q: maxConcurrency must be greater than 0", name)
+		}
+		var maxWait time.Duration
+		if t.MaxWait != "" {
+			if maxWait, err = parseDuration(t.MaxWait); err != nil {
+				return fmt.Errorf("invalid bulkhead configuration %q: invalid max wait %s: %w", name, t.MaxWait, err)
+			}
+		}
+		r.bulkheads[name] = Bulkhead{
+			MaxConcurrency: t.MaxConcurrency,
+			MaxWait:        maxWait,
+		}
+	}
+
+	for name, t := range policies.Hedges {
+		delay, err := parseDuration(t.Delay)
+		if err != nil || delay <= 0 {
+			return fmt.Errorf("invalid hedge configuration %q: invalid delay %s", name, t.Delay)
+		}
+		maxAttempts := t.MaxAttempts
+		if maxAttempts == 0 {
+			maxAttempts = defaultHedgingMaxAttempts
+		}
+		if maxAttempts < 2 {
+			return fmt.Errorf("invalid hedge configuration %q: maxAttempts must be at least 2", name)
+		}
+		r.hedges[name] = Hedging{
+			Delay:              delay,
+			MaxAttempts:        maxAttempts,
+			AllowNonIdempotent: t.AllowNonIdempotent,
+		}
+	}
+
 	return nil
 }
 
@@ -462,6 +563,17 @@ func (r *Resiliency) decodeTargets(c *resiliencyV1alpha.Resiliency) (err error)
 			Timeout:        t.Timeout,
 			Retry:          t.Retry,
 			CircuitBreaker: t.CircuitBreaker,
+			RateLimit:      t.RateLimit,
+			Bulkhead:       t.Bulkhead,
+		}
+		if t.Hedge != "" {
+			r.appHedges[name] = t.Hedge
+		}
+		if t.FallbackAppID != "" {
+			if t.FallbackAppID == name {
+				return fmt.Errorf("app %s cannot be its own fallback", name)
+			}
+			r.fallbacks[name] = t.FallbackAppID
 		}
 		if t.CircuitBreakerCacheSize == 0 {
 			t.CircuitBreakerCacheSize = defaultEndpointCacheSize
@@ -488,6 +600,8 @@ func (r *Resiliency) decodeTargets(c *resiliencyV1alpha.Resiliency) (err error)
 					Retry:               t.Retry,
 					CircuitBreaker:      t.CircuitBreaker,
 					CircuitBreakerScope: scope,
+					RateLimit:           t.RateLimit,
+					Bulkhead:            t.Bulkhead,
 				},
 				PostLockPolicies: ActorPostLockPolicyNames{
 					Timeout: t.Timeout,
@@ -498,6 +612,8 @@ func (r *Resiliency) decodeTargets(c *resiliencyV1alpha.Resiliency) (err error)
 				PreLockPolicies: ActorPreLockPolicyNames{
 					Retry:          t.Retry,
 					CircuitBreaker: "",
+					RateLimit:      t.RateLimit,
+					Bulkhead:       t.Bulkhead,
 				},
 				PostLockPolicies: ActorPostLockPolicyNames{
 					Timeout: t.Timeout,
@@ -520,11 +636,15 @@ func (r *Resiliency) decodeTargets(c *resiliencyV1alpha.Resiliency) (err error)
 				Timeout:        t.Inbound.Timeout,
 				Retry:          t.Inbound.Retry,
 				CircuitBreaker: t.Inbound.CircuitBreaker,
+				RateLimit:      t.Inbound.RateLimit,
+				Bulkhead:       t.Inbound.Bulkhead,
 			},
 			Outbound: PolicyNames{
 				Timeout:        t.Outbound.Timeout,
 				Retry:          t.Outbound.Retry,
 				CircuitBreaker: t.Outbound.CircuitBreaker,
+				RateLimit:      t.Outbound.RateLimit,
+				Bulkhead:       t.Outbound.Bulkhead,
 			},
 		}
 	}
@@ -578,6 +698,38 @@ func (r *Resiliency) addMetricsToPolicy(policyDef *PolicyDefinition, target stri
 			diag.DefaultResiliencyMonitoring.PolicyWithStatusActivated(r.name, r.namespace, diag.CircuitBreakerPolicy, direction, target, string(policyDef.cb.State()))
 		}
 	}
+	if policyDef.rl != nil {
+		diag.DefaultResiliencyMonitoring.PolicyExecuted(r.name, r.namespace, diag.RateLimitPolicy, direction, target)
+		policyDef.addRateLimitActivated = func(status string) {
+			diag.DefaultResiliencyMonitoring.PolicyWithStatusActivated(r.name, r.namespace, diag.RateLimitPolicy, direction, target, status)
+		}
+	}
+	if policyDef.fallback != "" {
+		policyDef.addFallbackActivated = func() {
+			diag.DefaultResiliencyMonitoring.PolicyActivated(r.name, r.namespace, diag.FallbackPolicy, direction, target)
+		}
+	}
+	if policyDef.bh != nil {
+		diag.DefaultResiliencyMonitoring.PolicyExecuted(r.name, r.namespace, diag.BulkheadPolicy, direction, target)
+		policyDef.addBulkheadActivated = func(status string) {
+			diag.DefaultResiliencyMonitoring.PolicyWithStatusActivated(r.name, r.namespace, diag.BulkheadPolicy, direction, target, status)
+		}
+	}
+}
+
+// addLimitersToPolicy sets the rate limiter and bulkhead of the policy for the target.
+// The limiters are shared by all the policies returned for the same target.
+func (r *Resiliency) addLimitersToPolicy(policyDef *PolicyDefinition, target string, rateLimit string, bulkhead string) {
+	if rateLimit != "" {
+		if config, ok := r.rateLimits[rateLimit]; ok {
+			policyDef.rl = r.limiters.RateLimiter(rateLimit, target, config)
+		}
+	}
+	if bulkhead != "" {
+		if config, ok := r.bulkheads[bulkhead]; ok {
+			policyDef.bh = r.limiters.Bulkhead(bulkhead, target, config)
+		}
+	}
 }
 
 // EndpointPolicy returns the policy for a service endpoint.
@@ -608,6 +760,10 @@ func (r *Resiliency) EndpointPolicy(app string, endpoint string) *PolicyDefiniti
 				}
 			}
 		}
+		r.addLimitersToPolicy(policyDef, diag.ResiliencyAppTarget(app), policyNames.RateLimit, policyNames.Bulkhead)
+		if policyDef.cb != nil {
+			policyDef.fallback = r.fallbacks[app]
+		}
 	} else {
 		if defaultNames, ok := r.getDefaultPolicy(EndpointPolicy{}); ok {
 			r.log.Debugf("Found Default Policy for Endpoint %s: %+v", app, defaultNames)
@@ -628,6 +784,7 @@ func (r *Resiliency) EndpointPolicy(app string, endpoint string) *PolicyDefiniti
 					policyDef.cb = r.getCBFromCache(serviceCBCache, endpoint, template)
 				}
 			}
+			r.addLimitersToPolicy(policyDef, diag.ResiliencyAppTarget(app), defaultNames.RateLimit, defaultNames.Bulkhead)
 		}
 	}
 	r.addMetricsToPolicy(policyDef, diag.ResiliencyAppTarget(app), diag.OutboundPolicyFlowDirection)
@@ -749,6 +906,7 @@ func (r *Resiliency) ActorPreLockPolicy(actorType string, id string) *PolicyDefi
 				}
 			}
 		}
+		r.addLimitersToPolicy(policyDef, diag.ResiliencyActorTarget(actorType), policyNames.RateLimit, policyNames.Bulkhead)
 	} else {
 		if defaultNames, ok := r.getDefaultPolicy(ActorPolicy{}); ok {
 			r.log.Debugf("Found Default Policy for Actor type %s: %+v", actorType, defaultNames)
@@ -765,6 +923,7 @@ func (r *Resiliency) ActorPreLockPolicy(actorType string, id string) *PolicyDefi
 					policyDef.cb = r.getCBFromCache(actorCBCache, actorType, template)
 				}
 			}
+			r.addLimitersToPolicy(policyDef, diag.ResiliencyActorTarget(actorType), defaultNames.RateLimit, defaultNames.Bulkhead)
 		}
 	}
 	r.addMetricsToPolicy(policyDef, diag.ResiliencyActorTarget(actorType), diag.OutboundPolicyFlowDirection)
@@ -816,6 +975,7 @@ func (r *Resiliency) ComponentOutboundPolicy(name string, componentType Componen
 			template := r.circuitBreakers[componentPolicies.Outbound.CircuitBreaker]
 			policyDef.cb = r.componentCBs.Get(r.log, name, template)
 		}
+		r.addLimitersToPolicy(policyDef, componentLimiterTarget(name, componentType, Outbound), componentPolicies.Outbound.RateLimit, componentPolicies.Outbound.Bulkhead)
 	} else {
 		if defaultPolicies, ok := r.getDefaultPolicy(ComponentPolicy{componentType: componentType, componentDirection: "Outbound"}); ok {
 			r.log.Debugf("Found Default Policy for Component: %s: %+v", name, defaultPolicies)
@@ -829,6 +989,7 @@ func (r *Resiliency) ComponentOutboundPolicy(name string, componentType Componen
 				template := r.circuitBreakers[defaultPolicies.CircuitBreaker]
 				policyDef.cb = r.componentCBs.Get(r.log, name, template)
 			}
+			r.addLimitersToPolicy(policyDef, componentLimiterTarget(name, componentType, Outbound), defaultPolicies.RateLimit, defaultPolicies.Bulkhead)
 		}
 	}
 	r.addMetricsToPolicy(policyDef, diag.ResiliencyComponentTarget(name, string(componentType)), diag.OutboundPolicyFlowDirection)
@@ -855,6 +1016,7 @@ func (r *Resiliency) ComponentInboundPolicy(name string, componentType Component
 			template := r.circuitBreakers[componentPolicies.Inbound.CircuitBreaker]
 			policyDef.cb = r.componentCBs.Get(r.log, name, template)
 		}
+		r.addLimitersToPolicy(policyDef, componentLimiterTarget(name, componentType, Inbound), componentPolicies.Inbound.RateLimit, componentPolicies.Inbound.Bulkhead)
 	} else {
 		if defaultPolicies, ok := r.getDefaultPolicy(ComponentPolicy{componentType: componentType, componentDirection: Inbound}); ok {
 			r.log.Debugf("Found Default Policy for Component: %s: %+v", name, defaultPolicies)
@@ -868,6 +1030,7 @@ func (r *Resiliency) ComponentInboundPolicy(name string, componentType Component
 				template := r.circuitBreakers[defaultPolicies.CircuitBreaker]
 				policyDef.cb = r.componentCBs.Get(r.log, name, template)
 			}
+			r.addLimitersToPolicy(policyDef, componentLimiterTarget(name, componentType, Inbound), defaultPolicies.RateLimit, defaultPolicies.Bulkhead)
 		}
 	}
 	r.addMetricsToPolicy(policyDef, diag.ResiliencyComponentTarget(name, string(componentType)), diag.InboundPolicyFlowDirection)
@@ -875,6 +1038,26 @@ func (r *Resiliency) ComponentInboundPolicy(name string, componentType Component
 	return policyDef
 }
 
+// EndpointHedgingPolicy returns the hedging policy for service invocation to an app, if any.
+func (r *Resiliency) EndpointHedgingPolicy(app string) *Hedging {
+	name, ok := r.appHedges[app]
+	if !ok {
+		return nil
+	}
+	template, ok := r.hedges[name]
+	if !ok {
+		return nil
+	}
+
+	target := diag.ResiliencyAppTarget(app)
+	diag.DefaultResiliencyMonitoring.PolicyExecuted(r.name, r.namespace, diag.HedgingPolicy, diag.OutboundPolicyFlowDirection, target)
+	hedging := template
+	hedging.addActivatedMetric = func() {
+		diag.DefaultResiliencyMonitoring.PolicyActivated(r.name, r.namespace, diag.HedgingPolicy, diag.OutboundPolicyFlowDirection, target)
+	}
+	return &hedging
+}
+
 // BuiltInPolicy returns a policy that represents a specific built-in retry scenario.
 func (r *Resiliency) BuiltInPolicy(name BuiltInPolicyName) *PolicyDefinition {
 	nameStr := string(name)
@@ -903,9 +1086,12 @@ func (r *Resiliency) getDefaultPolicy(policyType PolicyType) (PolicyNames, bool)
 		Retry:          r.getDefaultRetryPolicy(policyType),
 		Timeout:        r.getDefaultTimeoutPolicy(policyType),
 		CircuitBreaker: r.getDefaultCircuitBreakerPolicy(policyType),
+		RateLimit:      r.getDefaultRateLimitPolicy(policyType),
+		Bulkhead:       r.getDefaultBulkheadPolicy(policyType),
 	}
 
-	return policyNames, (policyNames.Retry != "" || policyNames.Timeout != "" || policyNames.CircuitBreaker != "")
+	return policyNames, (policyNames.Retry != "" || policyNames.Timeout != "" || policyNames.CircuitBreaker != "" ||
+		policyNames.RateLimit != "" || policyNames.Bulkhead != "")
 }
 
 func (r *Resiliency) getDefaultRetryPolicy(policyType PolicyType) string {
@@ -950,6 +1136,34 @@ func (r *Resiliency) getDefaultCircuitBreakerPolicy(policyType PolicyType) strin
 	return ""
 }
 
+func (r *Resiliency) getDefaultRateLimitPolicy(policyType PolicyType) string {
+	typeTemplates, topLevelTemplate := r.expandPolicyTemplate(policyType, DefaultRateLimitTemplate)
+	for _, typeTemplate := range typeTemplates {
+		if _, ok := r.rateLimits[typeTemplate]; ok {
+			return typeTemplate
+		}
+	}
+
+	if _, ok := r.rateLimits[topLevelTemplate]; ok {
+		return topLevelTemplate
+	}
+	return ""
+}
+
+func (r *Resiliency) getDefaultBulkheadPolicy(policyType PolicyType) string {
+	typeTemplates, topLevelTemplate := r.expandPolicyTemplate(policyType, DefaultBulkheadTemplate)
+	for _, typeTemplate := range typeTemplates {
+		if _, ok := r.bulkheads[typeTemplate]; ok {
+			return typeTemplate
+		}
+	}
+
+	if _, ok := r.bulkheads[topLevelTemplate]; ok {
+		return topLevelTemplate
+	}
+	return ""
+}
+
 func (r *Resiliency) expandPolicyTemplate(policyType PolicyType, template DefaultPolicyTemplate) ([]string, string) {
 	policyLevels := policyType.getPolicyLevels()
 	typeTemplates := make([]string, len(policyLevels))
@@ -993,6 +1207,12 @@ func (e *circuitBreakerInstances) Remove(name string) {
 	e.Unlock()
 }
 
+// componentLimiterTarget returns the key of the rate limiter and bulkhead
+// instances of a component, which are separate for each direction.
+func componentLimiterTarget(name string, componentType ComponentType, direction ComponentDirection) string {
+	return diag.ResiliencyComponentTarget(name, string(componentType)) + "_" + string(direction)
+}
+
 func toMap(val interface{}) (interface{}, error) {
 	jsonBytes, err := json.Marshal(val)
 	if err != nil {
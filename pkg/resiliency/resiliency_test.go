@@ -250,9 +250,9 @@ func TestLoadStandaloneResiliency(t *testing.T) {
 		configs := LoadLocalResiliency(log, "app1", "./testdata")
 		assert.NotNil(t, configs)
 		assert.Len(t, configs, 2)
-		assert.Equal(t, "Resiliency", configs[0].Kind)
+		assert.Equal(t, "Resiliency", configs[0].TypeMeta.Kind)
 		assert.Equal(t, "resiliency", configs[0].Name)
-		assert.Equal(t, "Resiliency", configs[1].Kind)
+		assert.Equal(t, "Resiliency", configs[1].TypeMeta.Kind)
 		assert.Equal(t, "resiliency", configs[1].Name)
 	})
 
@@ -166,6 +166,18 @@ func (c *Channels) EndpointChannels() map[string]channel.HTTPEndpointAppChannel
 	return c.endpChannels
 }
 
+// EndpointHTTPClient returns the HTTP client requests to the HTTP endpoint
+// with the given name are sent with, which uses the client TLS configuration
+// of the endpoint.
+func (c *Channels) EndpointHTTPClient(name string) *http.Client {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if ch, ok := c.endpChannels[name].(*channelhttp.Channel); ok {
+		return ch.HTTPClient()
+	}
+	return c.httpClient
+}
+
 func (c *Channels) AppHTTPClient() *http.Client {
 	return c.httpClient
 }
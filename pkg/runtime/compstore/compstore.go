@@ -30,6 +30,7 @@ import (
 	compsv1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	httpEndpointV1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	"github.com/dapr/dapr/pkg/config"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 )
 
@@ -60,7 +61,9 @@ type ComponentStore struct {
 		store state.Store
 	}
 
-	conversations map[string]conversation.Conversation
+	conversations         map[string]conversation.Conversation
+	conversationHistories map[string]*rtconversation.History
+	conversationCaches    map[string]*rtconversation.Cache
 
 	compPendingLock sync.Mutex
 	compPending     *compsv1alpha1.Component
@@ -88,6 +91,8 @@ func New() *ComponentStore {
 			declaratives: make(map[string]*DeclarativeSubscription),
 			streams:      make(map[string][]*DeclarativeSubscription),
 		},
-		conversations: make(map[string]conversation.Conversation),
+		conversations:         make(map[string]conversation.Conversation),
+		conversationHistories: make(map[string]*rtconversation.History),
+		conversationCaches:    make(map[string]*rtconversation.Cache),
 	}
 }
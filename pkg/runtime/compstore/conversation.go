@@ -13,7 +13,10 @@ limitations under the License.
 
 package compstore
 
-import "github.com/dapr/components-contrib/conversation"
+import (
+	"github.com/dapr/components-contrib/conversation"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
+)
 
 func (c *ComponentStore) AddConversation(name string, conversation conversation.Conversation) {
 	c.lock.Lock()
@@ -38,6 +41,34 @@ func (c *ComponentStore) DeleteConversation(name string) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	delete(c.conversations, name)
+	delete(c.conversationHistories, name)
+	delete(c.conversationCaches, name)
+}
+
+func (c *ComponentStore) AddConversationHistory(name string, history *rtconversation.History) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.conversationHistories[name] = history
+}
+
+func (c *ComponentStore) GetConversationHistory(name string) (*rtconversation.History, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	history, ok := c.conversationHistories[name]
+	return history, ok
+}
+
+func (c *ComponentStore) AddConversationCache(name string, cache *rtconversation.Cache) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.conversationCaches[name] = cache
+}
+
+func (c *ComponentStore) GetConversationCache(name string) (*rtconversation.Cache, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	cache, ok := c.conversationCaches[name]
+	return cache, ok
 }
 
 func (c *ComponentStore) ConversationsLen() int {
@@ -99,6 +99,7 @@ type Config struct {
 	DaprGracefulShutdownSeconds   int
 	DaprBlockShutdownDuration     *time.Duration
 	ActorsService                 string
+	ActorsPlacementWeight         uint
 	RemindersService              string
 	SchedulerAddress              []string
 	SchedulerStreams              uint
@@ -135,6 +136,7 @@ type internalConfig struct {
 	appConnectionConfig          config.AppConnectionConfig
 	mode                         modes.DaprMode
 	actorsService                string
+	actorsPlacementWeight        uint
 	remindersService             string
 	schedulerAddress             []string
 	schedulerStreams             uint
@@ -330,6 +332,7 @@ func (c *Config) toInternal() (*internalConfig, error) {
 		metricsExporter:           metrics.New(c.Metrics),
 		blockShutdownDuration:     c.DaprBlockShutdownDuration,
 		actorsService:             c.ActorsService,
+		actorsPlacementWeight:     c.ActorsPlacementWeight,
 		remindersService:          c.RemindersService,
 		schedulerAddress:          c.SchedulerAddress,
 		schedulerStreams:          c.SchedulerStreams,
@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversation
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// SidecarBudget is the number of tokens the app can spend through the
+// conversation API of a single daprd in fixed time windows. The budget is
+// tracked in memory and is not shared between replicas, so an app with N
+// replicas can spend up to N times maxTokens per window. The budget is reset
+// when the sidecar restarts.
+type SidecarBudget struct {
+	maxTokens int64
+	window    time.Duration
+	clock     clock.Clock
+
+	lock  sync.Mutex
+	start time.Time
+	used  int64
+}
+
+// NewSidecarBudget returns a budget of maxTokens per window for this sidecar.
+func NewSidecarBudget(maxTokens int64, window time.Duration, clock clock.Clock) (*SidecarBudget, error) {
+	if maxTokens <= 0 {
+		return nil, errors.New("the maximum number of tokens must be greater than 0")
+	}
+	if window <= 0 {
+		return nil, errors.New("the window must be greater than 0")
+	}
+	return &SidecarBudget{
+		maxTokens: maxTokens,
+		window:    window,
+		clock:     clock,
+		start:     clock.Now(),
+	}, nil
+}
+
+// MaxTokens returns the number of tokens which can be spent per window.
+func (b *SidecarBudget) MaxTokens() int64 {
+	return b.maxTokens
+}
+
+// Window returns the duration of the budget window.
+func (b *SidecarBudget) Window() time.Duration {
+	return b.window
+}
+
+// Exhausted returns true if all tokens of the current window have been spent,
+// along with the time the next window starts.
+// Requests in flight when the budget runs out are not cancelled, so the
+// tokens spent in a window can exceed the budget.
+func (b *SidecarBudget) Exhausted() (bool, time.Time) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.roll()
+	return b.used >= b.maxTokens, b.start.Add(b.window)
+}
+
+// Spend records the given number of tokens as spent in the current window.
+func (b *SidecarBudget) Spend(tokens int64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.roll()
+	b.used += tokens
+}
+
+// roll starts a new window if the current one has ended.
+func (b *SidecarBudget) roll() {
+	now := b.clock.Now()
+	if elapsed := now.Sub(b.start); elapsed >= b.window {
+		b.start = b.start.Add(elapsed.Truncate(b.window))
+		b.used = 0
+	}
+}
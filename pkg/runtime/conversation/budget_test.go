@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestBudget(t *testing.T) {
+	_, err := NewSidecarBudget(0, time.Hour, clocktesting.NewFakeClock(time.Now()))
+	require.Error(t, err)
+	_, err = NewSidecarBudget(10, 0, clocktesting.NewFakeClock(time.Now()))
+	require.Error(t, err)
+
+	start := time.Now()
+	clock := clocktesting.NewFakeClock(start)
+	b, err := NewSidecarBudget(10, time.Hour, clock)
+	require.NoError(t, err)
+
+	exhausted, reset := b.Exhausted()
+	assert.False(t, exhausted)
+	assert.Equal(t, start.Add(time.Hour), reset)
+
+	b.Spend(6)
+	exhausted, _ = b.Exhausted()
+	assert.False(t, exhausted)
+
+	b.Spend(6)
+	exhausted, _ = b.Exhausted()
+	assert.True(t, exhausted)
+
+	// The budget is reset at the start of the next window.
+	clock.Step(90 * time.Minute)
+	exhausted, reset = b.Exhausted()
+	assert.False(t, exhausted)
+	assert.Equal(t, start.Add(2*time.Hour), reset)
+
+	// Windows without requests are skipped.
+	b.Spend(10)
+	clock.Step(3 * time.Hour)
+	exhausted, reset = b.Exhausted()
+	assert.False(t, exhausted)
+	assert.Equal(t, start.Add(5*time.Hour), reset)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/protobuf/proto"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	kmeta "github.com/dapr/kit/metadata"
+)
+
+// defaultCacheTTL is the time after which cached responses expire when no
+// TTL is configured.
+const defaultCacheTTL = time.Hour
+
+// Cache is the configuration of the cache of the responses of a conversation
+// component, which daprd keeps in a state store.
+type Cache struct {
+	// StateStore is the name of the state store the responses are cached in.
+	StateStore string
+	// TTL is the time after which a cached response expires.
+	TTL time.Duration
+}
+
+type cacheMetadata struct {
+	ResponseCacheStateStore string        `mapstructure:"responseCacheStateStore"`
+	ResponseCacheTTL        time.Duration `mapstructure:"responseCacheTTL"`
+}
+
+// CacheFromMetadata returns the response cache configuration in the metadata
+// of a conversation component, or nil if no cache state store is configured.
+func CacheFromMetadata(props map[string]string) (*Cache, error) {
+	var md cacheMetadata
+	if err := kmeta.DecodeMetadata(props, &md); err != nil {
+		return nil, fmt.Errorf("failed to decode conversation response cache metadata: %w", err)
+	}
+	if md.ResponseCacheStateStore == "" {
+		return nil, nil
+	}
+
+	c := &Cache{
+		StateStore: md.ResponseCacheStateStore,
+		TTL:        md.ResponseCacheTTL,
+	}
+	switch {
+	case c.TTL == 0:
+		c.TTL = defaultCacheTTL
+	case c.TTL < time.Second:
+		return nil, errors.New("responseCacheTTL must be at least 1s")
+	}
+
+	return c, nil
+}
+
+// CacheKey returns the key of the cached response to a request. The key is a
+// hash of the inputs, tools and parameters of the request, so requests which
+// only differ in their context ID share a response. The text of the messages
+// is normalized, so prompts which only differ in whitespace, case, trailing
+// punctuation or Unicode composition share a response too.
+func CacheKey(req *runtimev1pb.ConversationRequestAlpha2) (string, error) {
+	req = proto.CloneOf(req)
+	req.ContextId = nil
+	req.BypassCache = nil
+	for _, input := range req.GetInputs() {
+		for _, msg := range input.GetMessages() {
+			for _, c := range MessageContent(msg) {
+				c.Text = NormalizeText(c.GetText())
+			}
+		}
+	}
+
+	// Deterministic marshaling orders the entries of the maps of parameters
+	// and metadata.
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// NormalizeText returns the text in Unicode NFC and case-folded, with
+// trailing punctuation removed and all runs of whitespace collapsed to a
+// single space.
+func NormalizeText(text string) string {
+	text = norm.NFC.String(cases.Fold().String(text))
+	text = strings.Join(strings.Fields(text), " ")
+	return strings.TrimRightFunc(text, func(r rune) bool {
+		return unicode.IsPunct(r) || unicode.IsSpace(r)
+	})
+}
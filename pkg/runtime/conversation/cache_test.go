@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+func TestCacheFromMetadata(t *testing.T) {
+	t.Run("no state store", func(t *testing.T) {
+		c, err := CacheFromMetadata(map[string]string{"historyStateStore": "mystore"})
+		require.NoError(t, err)
+		assert.Nil(t, c)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		c, err := CacheFromMetadata(map[string]string{"responseCacheStateStore": "mystore"})
+		require.NoError(t, err)
+		assert.Equal(t, &Cache{StateStore: "mystore", TTL: time.Hour}, c)
+	})
+
+	t.Run("all fields", func(t *testing.T) {
+		c, err := CacheFromMetadata(map[string]string{
+			"responseCacheStateStore": "mystore",
+			"responseCacheTTL":        "10m",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &Cache{StateStore: "mystore", TTL: 10 * time.Minute}, c)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, ttl := range []string{"10ms", "-1h", "forever"} {
+			_, err := CacheFromMetadata(map[string]string{
+				"responseCacheStateStore": "mystore",
+				"responseCacheTTL":        ttl,
+			})
+			assert.Error(t, err, ttl)
+		}
+	})
+}
+
+func TestCacheKey(t *testing.T) {
+	request := func(text string) *runtimev1pb.ConversationRequestAlpha2 {
+		return &runtimev1pb.ConversationRequestAlpha2{
+			Name: "llm",
+			Inputs: []*runtimev1pb.ConversationInputAlpha2{{
+				Messages: []*runtimev1pb.ConversationMessage{{
+					MessageTypes: &runtimev1pb.ConversationMessage_OfUser{
+						OfUser: &runtimev1pb.ConversationMessageOfUser{
+							Content: []*runtimev1pb.ConversationMessageContent{{Text: text}},
+						},
+					},
+				}},
+			}},
+			Metadata: map[string]string{"a": "1", "b": "2", "c": "3"},
+		}
+	}
+
+	key, err := CacheKey(request("What is  Dapr?"))
+	require.NoError(t, err)
+
+	t.Run("same key", func(t *testing.T) {
+		for name, req := range map[string]*runtimev1pb.ConversationRequestAlpha2{
+			"whitespace":  request(" What is Dapr?\n"),
+			"case":        request("what is DAPR?"),
+			"punctuation": request("What is Dapr ?!"),
+			"context ID": func() *runtimev1pb.ConversationRequestAlpha2 {
+				req := request("What is Dapr?")
+				req.ContextId = proto.String("ctx")
+				req.BypassCache = proto.Bool(true)
+				return req
+			}(),
+		} {
+			t.Run(name, func(t *testing.T) {
+				got, err := CacheKey(req)
+				require.NoError(t, err)
+				assert.Equal(t, key, got)
+			})
+		}
+	})
+
+	t.Run("different key", func(t *testing.T) {
+		for name, req := range map[string]*runtimev1pb.ConversationRequestAlpha2{
+			"text": request("What is Go?"),
+			"temperature": func() *runtimev1pb.ConversationRequestAlpha2 {
+				req := request("What is Dapr?")
+				req.Temperature = proto.Float64(0.5)
+				return req
+			}(),
+			"tools": func() *runtimev1pb.ConversationRequestAlpha2 {
+				req := request("What is Dapr?")
+				req.Tools = []*runtimev1pb.ConversationTools{{
+					ToolTypes: &runtimev1pb.ConversationTools_Function{
+						Function: &runtimev1pb.ConversationToolsFunction{Name: "search"},
+					},
+				}}
+				return req
+			}(),
+			"metadata": func() *runtimev1pb.ConversationRequestAlpha2 {
+				req := request("What is Dapr?")
+				req.Metadata["model"] = "gpt"
+				return req
+			}(),
+		} {
+			t.Run(name, func(t *testing.T) {
+				got, err := CacheKey(req)
+				require.NoError(t, err)
+				assert.NotEqual(t, key, got)
+			})
+		}
+	})
+}
+
+func TestNormalizeText(t *testing.T) {
+	for name, tc := range map[string]struct {
+		text string
+		want string
+	}{
+		"empty":                {text: "", want: ""},
+		"whitespace":           {text: " What  is\tDapr?\n", want: "what is dapr"},
+		"case":                 {text: "WHAT IS Straße", want: "what is strasse"},
+		"trailing punctuation": {text: "What is Dapr?!...", want: "what is dapr"},
+		"inner punctuation":    {text: "Dapr, or not?", want: "dapr, or not"},
+		"only punctuation":     {text: "?!", want: ""},
+		"decomposed":           {text: "Cafe\u0301", want: "caf\u00e9"},
+		"composed":             {text: "Caf\u00e9", want: "caf\u00e9"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, NormalizeText(tc.text))
+		})
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	kmeta "github.com/dapr/kit/metadata"
+)
+
+// HistoryStrategy is how messages which no longer fit in the history window
+// are handled.
+type HistoryStrategy string
+
+const (
+	// HistoryStrategyTruncate drops the oldest messages.
+	HistoryStrategyTruncate HistoryStrategy = "truncate"
+	// HistoryStrategySummarize replaces the oldest messages with a summary
+	// generated by the conversation component.
+	HistoryStrategySummarize HistoryStrategy = "summarize"
+)
+
+// charsPerToken is the approximate number of characters per token, used to
+// estimate the number of tokens of a message without a model tokenizer.
+const charsPerToken = 4
+
+// History is the configuration of the message history which daprd keeps for
+// each context of a conversation component.
+type History struct {
+	// StateStore is the name of the state store the history is persisted in.
+	StateStore string
+	// MaxMessages is the maximum number of messages kept, or 0 for no limit.
+	MaxMessages int
+	// MaxTokens is the maximum estimated number of tokens of the messages
+	// kept, or 0 for no limit.
+	MaxTokens int
+	// Strategy is how messages which no longer fit are handled.
+	Strategy HistoryStrategy
+	// TTL is the time after which the history of a context expires since it
+	// was last updated, or 0 to never expire.
+	TTL time.Duration
+}
+
+type historyMetadata struct {
+	HistoryStateStore  string        `mapstructure:"historyStateStore"`
+	HistoryMaxMessages int           `mapstructure:"historyMaxMessages"`
+	HistoryMaxTokens   int           `mapstructure:"historyMaxTokens"`
+	HistoryStrategy    string        `mapstructure:"historyStrategy"`
+	HistoryTTL         time.Duration `mapstructure:"historyTTL"`
+}
+
+// HistoryFromMetadata returns the history configuration in the metadata of a
+// conversation component, or nil if no history state store is configured.
+func HistoryFromMetadata(props map[string]string) (*History, error) {
+	var md historyMetadata
+	if err := kmeta.DecodeMetadata(props, &md); err != nil {
+		return nil, fmt.Errorf("failed to decode conversation history metadata: %w", err)
+	}
+	if md.HistoryStateStore == "" {
+		return nil, nil
+	}
+
+	h := &History{
+		StateStore:  md.HistoryStateStore,
+		MaxMessages: md.HistoryMaxMessages,
+		MaxTokens:   md.HistoryMaxTokens,
+		Strategy:    HistoryStrategy(md.HistoryStrategy),
+		TTL:         md.HistoryTTL,
+	}
+
+	var errs []error
+	if h.MaxMessages < 0 {
+		errs = append(errs, errors.New("historyMaxMessages must not be negative"))
+	}
+	if h.MaxTokens < 0 {
+		errs = append(errs, errors.New("historyMaxTokens must not be negative"))
+	}
+	if h.TTL < 0 || (h.TTL > 0 && h.TTL < time.Second) {
+		errs = append(errs, errors.New("historyTTL must be at least 1s"))
+	}
+	switch h.Strategy {
+	case "":
+		h.Strategy = HistoryStrategyTruncate
+	case HistoryStrategyTruncate, HistoryStrategySummarize:
+	default:
+		errs = append(errs, fmt.Errorf("historyStrategy must be %q or %q", HistoryStrategyTruncate, HistoryStrategySummarize))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// Window returns the index of the oldest of the given messages which fits in
+// the history window. The messages before it are to be truncated or
+// summarized.
+func (h *History) Window(msgs []*runtimev1pb.ConversationMessage) int {
+	start := 0
+	if h.MaxMessages > 0 && len(msgs) > h.MaxMessages {
+		start = len(msgs) - h.MaxMessages
+	}
+
+	if h.MaxTokens > 0 {
+		var tokens int
+		for i := len(msgs) - 1; i >= start; i-- {
+			tokens += EstimateTokens(msgs[i])
+			if tokens > h.MaxTokens {
+				start = i + 1
+				break
+			}
+		}
+	}
+
+	// A tool result can't be sent to the model without the tool call which
+	// requested it.
+	for start < len(msgs) && msgs[start].GetOfTool() != nil {
+		start++
+	}
+
+	return start
+}
+
+// EstimateTokens returns the approximate number of tokens of a message.
+func EstimateTokens(msg *runtimev1pb.ConversationMessage) int {
+	var chars int
+	for _, c := range MessageContent(msg) {
+		chars += len(c.GetText())
+	}
+	for _, tc := range msg.GetOfAssistant().GetToolCalls() {
+		chars += len(tc.GetFunction().GetName()) + len(tc.GetFunction().GetArguments())
+	}
+	return (chars + charsPerToken - 1) / charsPerToken
+}
+
+// MessageRole returns the role of the author of a message.
+func MessageRole(msg *runtimev1pb.ConversationMessage) string {
+	switch msg.GetMessageTypes().(type) {
+	case *runtimev1pb.ConversationMessage_OfDeveloper:
+		return "developer"
+	case *runtimev1pb.ConversationMessage_OfSystem:
+		return "system"
+	case *runtimev1pb.ConversationMessage_OfUser:
+		return "user"
+	case *runtimev1pb.ConversationMessage_OfAssistant:
+		return "assistant"
+	case *runtimev1pb.ConversationMessage_OfTool:
+		return "tool"
+	default:
+		return ""
+	}
+}
+
+// MessageContent returns the content of a message, whatever its type.
+func MessageContent(msg *runtimev1pb.ConversationMessage) []*runtimev1pb.ConversationMessageContent {
+	switch m := msg.GetMessageTypes().(type) {
+	case *runtimev1pb.ConversationMessage_OfDeveloper:
+		return m.OfDeveloper.GetContent()
+	case *runtimev1pb.ConversationMessage_OfSystem:
+		return m.OfSystem.GetContent()
+	case *runtimev1pb.ConversationMessage_OfUser:
+		return m.OfUser.GetContent()
+	case *runtimev1pb.ConversationMessage_OfAssistant:
+		return m.OfAssistant.GetContent()
+	case *runtimev1pb.ConversationMessage_OfTool:
+		return m.OfTool.GetContent()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversation
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+func TestHistoryFromMetadata(t *testing.T) {
+	t.Run("no state store", func(t *testing.T) {
+		h, err := HistoryFromMetadata(map[string]string{"key": "value"})
+		require.NoError(t, err)
+		assert.Nil(t, h)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		h, err := HistoryFromMetadata(map[string]string{"historyStateStore": "mystore"})
+		require.NoError(t, err)
+		assert.Equal(t, &History{StateStore: "mystore", Strategy: HistoryStrategyTruncate}, h)
+	})
+
+	t.Run("all fields", func(t *testing.T) {
+		h, err := HistoryFromMetadata(map[string]string{
+			"historyStateStore":  "mystore",
+			"historyMaxMessages": "20",
+			"historyMaxTokens":   "1000",
+			"historyStrategy":    "summarize",
+			"historyTTL":         "1h",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &History{
+			StateStore:  "mystore",
+			MaxMessages: 20,
+			MaxTokens:   1000,
+			Strategy:    HistoryStrategySummarize,
+			TTL:         time.Hour,
+		}, h)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, props := range []map[string]string{
+			{"historyMaxMessages": "-1"},
+			{"historyMaxTokens": "-1"},
+			{"historyMaxTokens": "many"},
+			{"historyStrategy": "forget"},
+			{"historyTTL": "10ms"},
+		} {
+			props["historyStateStore"] = "mystore"
+			_, err := HistoryFromMetadata(props)
+			assert.Error(t, err, props)
+		}
+	})
+}
+
+func TestHistoryWindow(t *testing.T) {
+	user := func(text string) *runtimev1pb.ConversationMessage {
+		return &runtimev1pb.ConversationMessage{MessageTypes: &runtimev1pb.ConversationMessage_OfUser{
+			OfUser: &runtimev1pb.ConversationMessageOfUser{
+				Content: []*runtimev1pb.ConversationMessageContent{{Text: text}},
+			},
+		}}
+	}
+	tool := &runtimev1pb.ConversationMessage{MessageTypes: &runtimev1pb.ConversationMessage_OfTool{
+		OfTool: &runtimev1pb.ConversationMessageOfTool{
+			Content: []*runtimev1pb.ConversationMessageContent{{Text: "sunny"}},
+		},
+	}}
+
+	msgs := []*runtimev1pb.ConversationMessage{
+		user(strings.Repeat("a", 40)),
+		user(strings.Repeat("b", 40)),
+		tool,
+		user(strings.Repeat("c", 40)),
+	}
+
+	tests := map[string]struct {
+		history History
+		exp     int
+	}{
+		"no limits":                  {History{}, 0},
+		"within limits":              {History{MaxMessages: 4, MaxTokens: 100}, 0},
+		"message limit":              {History{MaxMessages: 3}, 1},
+		"token limit":                {History{MaxTokens: 25}, 1},
+		"token limit with tool":      {History{MaxTokens: 15}, 3},
+		"tool result is not leading": {History{MaxMessages: 2}, 3},
+		"nothing fits":               {History{MaxTokens: 5}, 4},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.exp, test.history.Window(msgs))
+		})
+	}
+}
+
+func TestEstimateTokens(t *testing.T) {
+	msg := &runtimev1pb.ConversationMessage{MessageTypes: &runtimev1pb.ConversationMessage_OfAssistant{
+		OfAssistant: &runtimev1pb.ConversationMessageOfAssistant{
+			Content: []*runtimev1pb.ConversationMessageContent{{Text: "hello"}},
+			ToolCalls: []*runtimev1pb.ConversationToolCalls{{
+				ToolTypes: &runtimev1pb.ConversationToolCalls_Function{
+					Function: &runtimev1pb.ConversationToolCallsOfFunction{Name: "fn", Arguments: "{}"},
+				},
+			}},
+		},
+	}}
+	assert.Equal(t, 3, EstimateTokens(msg))
+	assert.Equal(t, "assistant", MessageRole(msg))
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversation
+
+import (
+	"context"
+
+	"github.com/tmc/langchaingo/llms"
+
+	"github.com/dapr/components-contrib/conversation"
+)
+
+// GenerateContent calls the langchaingo model wrapped by a conversation
+// component with the request, returning the response and the number of tokens
+// spent. Conversation components drop the usage reported by the model, so
+// they are called through the model instead. The options mirror those the
+// component passes when conversing; the given options, such as a streaming
+// function, are passed in addition. The usage is estimated if the model
+// doesn't report it.
+func GenerateContent(ctx context.Context, model llms.Model, r *conversation.Request, opts ...llms.CallOption) (*conversation.Response, Usage, error) {
+	if r.Temperature > 0 {
+		opts = append(opts, llms.WithTemperature(r.Temperature))
+	}
+	if r.Tools != nil {
+		opts = append(opts, llms.WithTools(*r.Tools))
+	}
+	if r.ToolChoice != nil {
+		opts = append(opts, llms.WithToolChoice(r.ToolChoice))
+	}
+
+	var msgs []llms.MessageContent
+	if r.Message != nil {
+		msgs = *r.Message
+	}
+
+	genResp, err := model.GenerateContent(ctx, msgs, opts...)
+	if err != nil {
+		return nil, Usage{}, err
+	}
+
+	outputs := make([]conversation.Result, 0, len(genResp.Choices))
+	var (
+		usage    Usage
+		reported bool
+	)
+	for i, c := range genResp.Choices {
+		choice := conversation.Choice{
+			FinishReason: c.StopReason,
+			Index:        int64(i),
+			Message:      conversation.Message{Content: c.Content},
+		}
+		if c.ToolCalls != nil {
+			choice.Message.ToolCallRequest = &c.ToolCalls
+		}
+		outputs = append(outputs, conversation.Result{
+			StopReason: c.StopReason,
+			Choices:    []conversation.Choice{choice},
+		})
+
+		// Models report the usage of the whole request, on the first or on
+		// every choice.
+		if !reported {
+			usage, reported = UsageFromGenerationInfo(c.GenerationInfo)
+		}
+	}
+
+	resp := &conversation.Response{
+		ConversationContext: r.ConversationContext,
+		Outputs:             outputs,
+	}
+	if !reported {
+		usage = EstimateUsage(r, resp)
+	}
+	return resp, usage, nil
+}
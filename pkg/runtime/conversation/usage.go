@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversation
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/tmc/langchaingo/llms"
+
+	"github.com/dapr/components-contrib/conversation"
+)
+
+// Usage is the number of tokens spent by a call to a model.
+type Usage struct {
+	PromptTokens     int64
+	CompletionTokens int64
+}
+
+// Total returns the number of prompt and completion tokens.
+func (u Usage) Total() int64 {
+	return u.PromptTokens + u.CompletionTokens
+}
+
+// Add returns the sum of both usages.
+func (u Usage) Add(o Usage) Usage {
+	return Usage{
+		PromptTokens:     u.PromptTokens + o.PromptTokens,
+		CompletionTokens: u.CompletionTokens + o.CompletionTokens,
+	}
+}
+
+// UsageReporter is implemented by conversation components which report the
+// number of tokens spent when conversing, such as pluggable components.
+type UsageReporter interface {
+	ConverseWithUsage(ctx context.Context, req *conversation.Request) (*conversation.Response, Usage, error)
+}
+
+// Keys of the generation info of langchaingo models which hold the number of
+// prompt and completion tokens. They differ between providers.
+var (
+	promptTokensKeys     = []string{"PromptTokens", "InputTokens", "input_tokens"}
+	completionTokensKeys = []string{"CompletionTokens", "OutputTokens", "output_tokens"}
+)
+
+// UsageFromGenerationInfo returns the usage reported by a langchaingo model
+// in the generation info of a choice, and false if the model reported none.
+func UsageFromGenerationInfo(info map[string]any) (Usage, bool) {
+	prompt, promptOK := generationInfoInt(info, promptTokensKeys)
+	completion, completionOK := generationInfoInt(info, completionTokensKeys)
+	if promptOK || completionOK {
+		return Usage{PromptTokens: prompt, CompletionTokens: completion}, true
+	}
+
+	// Some models report the usage of the provider SDK as is.
+	if usage, ok := info["usage"]; ok && usage != nil {
+		b, err := json.Marshal(usage)
+		if err != nil {
+			return Usage{}, false
+		}
+		var u struct {
+			PromptTokens     int64 `json:"prompt_tokens"`
+			CompletionTokens int64 `json:"completion_tokens"`
+		}
+		if err = json.Unmarshal(b, &u); err != nil || (u.PromptTokens == 0 && u.CompletionTokens == 0) {
+			return Usage{}, false
+		}
+		return Usage{PromptTokens: u.PromptTokens, CompletionTokens: u.CompletionTokens}, true
+	}
+
+	return Usage{}, false
+}
+
+// EstimateUsage estimates the number of tokens of the messages of
+// a request and of its response.
+func EstimateUsage(r *conversation.Request, resp *conversation.Response) Usage {
+	var usage Usage
+	if r.Message != nil {
+		for _, msg := range *r.Message {
+			for _, part := range msg.Parts {
+				switch p := part.(type) {
+				case llms.TextContent:
+					usage.PromptTokens += EstimateTextTokens(p.Text)
+				case llms.ToolCall:
+					if p.FunctionCall != nil {
+						usage.PromptTokens += EstimateTextTokens(p.FunctionCall.Name + p.FunctionCall.Arguments)
+					}
+				case llms.ToolCallResponse:
+					usage.PromptTokens += EstimateTextTokens(p.Content)
+				}
+			}
+		}
+	}
+	if resp != nil {
+		for _, o := range resp.Outputs {
+			for _, choice := range o.Choices {
+				usage.CompletionTokens += EstimateTextTokens(choice.Message.Content)
+				if choice.Message.ToolCallRequest != nil {
+					for _, tc := range *choice.Message.ToolCallRequest {
+						if tc.FunctionCall != nil {
+							usage.CompletionTokens += EstimateTextTokens(tc.FunctionCall.Name + tc.FunctionCall.Arguments)
+						}
+					}
+				}
+			}
+		}
+	}
+	return usage
+}
+
+// EstimateTextTokens returns the approximate number of tokens of a text.
+func EstimateTextTokens(text string) int64 {
+	return int64((len(text) + charsPerToken - 1) / charsPerToken)
+}
+
+func generationInfoInt(info map[string]any, keys []string) (int64, bool) {
+	for _, key := range keys {
+		switch v := info[key].(type) {
+		case int:
+			return int64(v), true
+		case int32:
+			return int64(v), true
+		case int64:
+			return v, true
+		case float64:
+			return int64(v), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageFromGenerationInfo(t *testing.T) {
+	for name, tc := range map[string]struct {
+		info  map[string]any
+		usage Usage
+		ok    bool
+	}{
+		"openai": {
+			info:  map[string]any{"PromptTokens": 10, "CompletionTokens": 5, "TotalTokens": 15},
+			usage: Usage{PromptTokens: 10, CompletionTokens: 5},
+			ok:    true,
+		},
+		"anthropic": {
+			info:  map[string]any{"InputTokens": 10, "OutputTokens": 5},
+			usage: Usage{PromptTokens: 10, CompletionTokens: 5},
+			ok:    true,
+		},
+		"googleai": {
+			info:  map[string]any{"input_tokens": int32(10), "output_tokens": int32(5)},
+			usage: Usage{PromptTokens: 10, CompletionTokens: 5},
+			ok:    true,
+		},
+		"mistral": {
+			info: map[string]any{"usage": struct {
+				PromptTokens     int `json:"prompt_tokens"`
+				CompletionTokens int `json:"completion_tokens"`
+			}{10, 5}},
+			usage: Usage{PromptTokens: 10, CompletionTokens: 5},
+			ok:    true,
+		},
+		"none": {
+			info: map[string]any{"model": "gpt"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			usage, ok := UsageFromGenerationInfo(tc.info)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.usage, usage)
+		})
+	}
+}
@@ -17,10 +17,13 @@ import (
 	"context"
 
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/healthz"
 	operatorv1 "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/authorizer"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	"github.com/dapr/dapr/pkg/runtime/hotreload/loader"
@@ -35,24 +38,37 @@ import (
 var log = logger.NewLogger("dapr.runtime.hotreload")
 
 type OptionsReloaderDisk struct {
-	Config         *config.Configuration
-	AppID          string
-	Dirs           []string
-	ComponentStore *compstore.ComponentStore
-	Authorizer     *authorizer.Authorizer
-	Processor      *processor.Processor
-	Healthz        healthz.Healthz
+	Config            *config.Configuration
+	AppID             string
+	Dirs              []string
+	ConfigPaths       []string
+	IsHTTP            bool
+	ComponentStore    *compstore.ComponentStore
+	Authorizer        *authorizer.Authorizer
+	Processor         *processor.Processor
+	Resiliency        *resiliency.Reloadable
+	AccessControlList *config.Reloadable[config.AccessControlList]
+	APISpec           *config.Reloadable[config.APISpec]
+	TraceSampler      *diag.DaprTraceSampler
+	Healthz           healthz.Healthz
 }
 
 type OptionsReloaderOperator struct {
-	PodName        string
-	Namespace      string
-	Client         operatorv1.OperatorClient
-	Config         *config.Configuration
-	ComponentStore *compstore.ComponentStore
-	Authorizer     *authorizer.Authorizer
-	Processor      *processor.Processor
-	Healthz        healthz.Healthz
+	AppID             string
+	PodName           string
+	Namespace         string
+	ConfigName        string
+	IsHTTP            bool
+	Client            operatorv1.OperatorClient
+	Config            *config.Configuration
+	ComponentStore    *compstore.ComponentStore
+	Authorizer        *authorizer.Authorizer
+	Processor         *processor.Processor
+	Resiliency        *resiliency.Reloadable
+	AccessControlList *config.Reloadable[config.AccessControlList]
+	APISpec           *config.Reloadable[config.APISpec]
+	TraceSampler      *diag.DaprTraceSampler
+	Healthz           healthz.Healthz
 }
 
 type Reloader struct {
@@ -60,6 +76,8 @@ type Reloader struct {
 	loader                  loader.Interface
 	componentsReconciler    *reconciler.Reconciler[compapi.Component]
 	subscriptionsReconciler *reconciler.Reconciler[subapi.Subscription]
+	resiliencyReconciler    *reconciler.Policy[[]*resiliencyapi.Resiliency]
+	configurationReconciler *reconciler.Policy[*config.Configuration]
 }
 
 func NewDisk(opts OptionsReloaderDisk) (*Reloader, error) {
@@ -71,6 +89,7 @@ func NewDisk(opts OptionsReloaderDisk) (*Reloader, error) {
 	loader, err := disk.New(disk.Options{
 		AppID:          opts.AppID,
 		Dirs:           opts.Dirs,
+		ConfigPaths:    opts.ConfigPaths,
 		ComponentStore: opts.ComponentStore,
 	})
 	if err != nil {
@@ -94,6 +113,20 @@ func NewDisk(opts OptionsReloaderDisk) (*Reloader, error) {
 			Authorizer: opts.Authorizer,
 			Healthz:    opts.Healthz,
 		}),
+		resiliencyReconciler: reconciler.NewResiliency(reconciler.OptionsResiliency{
+			Loader:     loader,
+			Resiliency: opts.Resiliency,
+			Healthz:    opts.Healthz,
+		}),
+		configurationReconciler: reconciler.NewConfiguration(reconciler.OptionsConfiguration{
+			Loader:            loader,
+			Config:            opts.Config,
+			IsHTTP:            opts.IsHTTP,
+			AccessControlList: opts.AccessControlList,
+			APISpec:           opts.APISpec,
+			TraceSampler:      opts.TraceSampler,
+			Healthz:           opts.Healthz,
+		}),
 	}, nil
 }
 
@@ -104,8 +137,10 @@ func NewOperator(opts OptionsReloaderOperator) *Reloader {
 	}
 
 	loader := operator.New(operator.Options{
+		AppID:          opts.AppID,
 		PodName:        opts.PodName,
 		Namespace:      opts.Namespace,
+		ConfigName:     opts.ConfigName,
 		ComponentStore: opts.ComponentStore,
 		OperatorClient: opts.Client,
 	})
@@ -127,6 +162,20 @@ func NewOperator(opts OptionsReloaderOperator) *Reloader {
 			Authorizer: opts.Authorizer,
 			Healthz:    opts.Healthz,
 		}),
+		resiliencyReconciler: reconciler.NewResiliency(reconciler.OptionsResiliency{
+			Loader:     loader,
+			Resiliency: opts.Resiliency,
+			Healthz:    opts.Healthz,
+		}),
+		configurationReconciler: reconciler.NewConfiguration(reconciler.OptionsConfiguration{
+			Loader:            loader,
+			Config:            opts.Config,
+			IsHTTP:            opts.IsHTTP,
+			AccessControlList: opts.AccessControlList,
+			APISpec:           opts.APISpec,
+			TraceSampler:      opts.TraceSampler,
+			Healthz:           opts.Healthz,
+		}),
 	}
 }
 
@@ -137,11 +186,13 @@ func (r *Reloader) Run(ctx context.Context) error {
 		return nil
 	}
 
-	log.Info("Hot reloading enabled. Daprd will reload 'Component' and 'Subscription' resources on change.")
+	log.Info("Hot reloading enabled. Daprd will reload 'Component', 'Subscription', 'Resiliency' and 'Configuration' resources on change.")
 
 	return concurrency.NewRunnerManager(
 		r.loader.Run,
 		r.componentsReconciler.Run,
 		r.subscriptionsReconciler.Run,
+		r.resiliencyReconciler.Run,
+		r.configurationReconciler.Run,
 	).Run(ctx)
 }
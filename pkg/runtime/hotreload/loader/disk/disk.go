@@ -16,12 +16,17 @@ package disk
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	"github.com/dapr/dapr/pkg/config"
 	loaderdisk "github.com/dapr/dapr/pkg/internal/loader/disk"
+	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	"github.com/dapr/dapr/pkg/runtime/hotreload/loader"
 	"github.com/dapr/dapr/pkg/runtime/hotreload/loader/store"
@@ -37,21 +42,34 @@ var log = logger.NewLogger("dapr.runtime.hotreload.loader.disk")
 type Options struct {
 	AppID          string
 	Dirs           []string
+	ConfigPaths    []string
 	ComponentStore *compstore.ComponentStore
 }
 
 type disk struct {
 	components    *resource[compapi.Component]
 	subscriptions *resource[subapi.Subscription]
+	resiliency    *watcher[[]*resiliencyapi.Resiliency]
+	configuration *watcher[*config.Configuration]
 	fs            *fswatcher.FSWatcher
 	batcher       *batcher.Batcher[int, struct{}]
 }
 
 func New(opts Options) (loader.Interface, error) {
-	log.Infof("Watching directories: [%s]", strings.Join(opts.Dirs, ", "))
+	// Configuration files may live outside of the resource directories. Their
+	// parent directories are watched, rather than the files themselves, so
+	// that files which are replaced on write are still tracked.
+	targets := slices.Clone(opts.Dirs)
+	for _, path := range opts.ConfigPaths {
+		if dir := filepath.Dir(path); !slices.Contains(targets, dir) {
+			targets = append(targets, dir)
+		}
+	}
+
+	log.Infof("Watching directories: [%s]", strings.Join(targets, ", "))
 
 	fs, err := fswatcher.New(fswatcher.Options{
-		Targets:  opts.Dirs,
+		Targets:  targets,
 		Interval: ptr.Of(time.Millisecond * 200),
 	})
 	if err != nil {
@@ -84,6 +102,21 @@ func New(opts Options) (loader.Interface, error) {
 				batcher: batcher,
 			},
 		),
+		resiliency: &watcher[[]*resiliencyapi.Resiliency]{
+			batcher: batcher,
+			load: func(context.Context) ([]*resiliencyapi.Resiliency, error) {
+				return resiliency.LoadLocalResiliency(log, opts.AppID, opts.Dirs...), nil
+			},
+		},
+		configuration: &watcher[*config.Configuration]{
+			batcher: batcher,
+			load: func(context.Context) (*config.Configuration, error) {
+				if len(opts.ConfigPaths) == 0 {
+					return config.LoadDefaultConfiguration(), nil
+				}
+				return config.LoadStandaloneConfiguration(opts.ConfigPaths...)
+			},
+		},
 		batcher: batcher,
 	}, nil
 }
@@ -123,3 +156,11 @@ func (d *disk) Components() loader.Loader[compapi.Component] {
 func (d *disk) Subscriptions() loader.Loader[subapi.Subscription] {
 	return d.subscriptions
 }
+
+func (d *disk) Resiliency() loader.Watcher[[]*resiliencyapi.Resiliency] {
+	return d.resiliency
+}
+
+func (d *disk) Configuration() loader.Watcher[*config.Configuration] {
+	return d.configuration
+}
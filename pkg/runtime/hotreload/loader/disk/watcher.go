@@ -0,0 +1,38 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"context"
+
+	"github.com/dapr/kit/events/batcher"
+)
+
+// watcher is a generic implementation of a disk watcher for resources which
+// are loaded as a whole. Every change to the watched files is reported, and
+// it is up to the reconciler to determine whether the resources changed.
+type watcher[T any] struct {
+	batcher *batcher.Batcher[int, struct{}]
+	load    func(context.Context) (T, error)
+}
+
+func (w *watcher[T]) Load(ctx context.Context) (T, error) {
+	return w.load(ctx)
+}
+
+func (w *watcher[T]) Watch(ctx context.Context) (<-chan struct{}, error) {
+	ch := make(chan struct{})
+	w.batcher.Subscribe(ctx, ch)
+	return ch, nil
+}
@@ -17,7 +17,9 @@ import (
 	"context"
 
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/runtime/hotreload/differ"
 	"github.com/dapr/dapr/pkg/runtime/hotreload/loader"
 )
@@ -26,6 +28,8 @@ type FakeT struct {
 	runFn         func(context.Context) error
 	components    *Fake[compapi.Component]
 	subscriptions *Fake[subapi.Subscription]
+	resiliency    *FakeWatcher[[]*resiliencyapi.Resiliency]
+	configuration *FakeWatcher[*config.Configuration]
 	startFn       func(context.Context) error
 }
 
@@ -37,6 +41,8 @@ func New() *FakeT {
 		},
 		components:    NewFake[compapi.Component](),
 		subscriptions: NewFake[subapi.Subscription](),
+		resiliency:    NewFakeWatcher[[]*resiliencyapi.Resiliency](),
+		configuration: NewFakeWatcher[*config.Configuration](),
 		startFn: func(ctx context.Context) error {
 			<-ctx.Done()
 			return nil
@@ -56,6 +62,24 @@ func (f *FakeT) Subscriptions() loader.Loader[subapi.Subscription] {
 	return f.subscriptions
 }
 
+func (f *FakeT) Resiliency() loader.Watcher[[]*resiliencyapi.Resiliency] {
+	return f.resiliency
+}
+
+func (f *FakeT) Configuration() loader.Watcher[*config.Configuration] {
+	return f.configuration
+}
+
+func (f *FakeT) WithResiliency(fake *FakeWatcher[[]*resiliencyapi.Resiliency]) *FakeT {
+	f.resiliency = fake
+	return f
+}
+
+func (f *FakeT) WithConfiguration(fake *FakeWatcher[*config.Configuration]) *FakeT {
+	f.configuration = fake
+	return f
+}
+
 func (f *FakeT) WithComponents(fake *Fake[compapi.Component]) *FakeT {
 	f.components = fake
 	return f
@@ -102,3 +126,38 @@ func (f *Fake[T]) List(ctx context.Context) (*differ.LocalRemoteResources[T], er
 func (f *Fake[T]) Stream(ctx context.Context) (*loader.StreamConn[T], error) {
 	return f.streamFn(ctx)
 }
+
+type FakeWatcher[T any] struct {
+	loadFn  func(context.Context) (T, error)
+	watchFn func(context.Context) (<-chan struct{}, error)
+}
+
+func NewFakeWatcher[T any]() *FakeWatcher[T] {
+	return &FakeWatcher[T]{
+		loadFn: func(context.Context) (T, error) {
+			var zero T
+			return zero, nil
+		},
+		watchFn: func(context.Context) (<-chan struct{}, error) {
+			return nil, nil
+		},
+	}
+}
+
+func (f *FakeWatcher[T]) WithLoad(fn func(context.Context) (T, error)) *FakeWatcher[T] {
+	f.loadFn = fn
+	return f
+}
+
+func (f *FakeWatcher[T]) WithWatch(fn func(context.Context) (<-chan struct{}, error)) *FakeWatcher[T] {
+	f.watchFn = fn
+	return f
+}
+
+func (f *FakeWatcher[T]) Load(ctx context.Context) (T, error) {
+	return f.loadFn(ctx)
+}
+
+func (f *FakeWatcher[T]) Watch(ctx context.Context) (<-chan struct{}, error) {
+	return f.watchFn(ctx)
+}
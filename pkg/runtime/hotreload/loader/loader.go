@@ -17,7 +17,9 @@ import (
 	"context"
 
 	compapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	"github.com/dapr/dapr/pkg/config"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 	"github.com/dapr/dapr/pkg/runtime/hotreload/differ"
 )
@@ -28,6 +30,8 @@ type Interface interface {
 	Run(context.Context) error
 	Components() Loader[compapi.Component]
 	Subscriptions() Loader[subapi.Subscription]
+	Resiliency() Watcher[[]*resiliencyapi.Resiliency]
+	Configuration() Watcher[*config.Configuration]
 }
 
 type StreamConn[T differ.Resource] struct {
@@ -42,6 +46,19 @@ type Loader[T differ.Resource] interface {
 	Stream(context.Context) (*StreamConn[T], error)
 }
 
+// Watcher is an interface for loading and watching for changes to resources
+// which are applied as a whole rather than individually, such as Resiliency
+// policies or the Configuration.
+type Watcher[T any] interface {
+	// Load returns the current resources from the source.
+	Load(context.Context) (T, error)
+	// Watch returns a channel which is sent to when the resources may have
+	// changed at the source. The channel may be nil if the source does not
+	// report changes, in which case the resources are only periodically
+	// loaded.
+	Watch(context.Context) (<-chan struct{}, error)
+}
+
 // Event is a component event.
 type Event[T differ.Resource] struct {
 	Type     operatorv1pb.ResourceEventType
@@ -19,8 +19,11 @@ import (
 	"sync/atomic"
 
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	"github.com/dapr/dapr/pkg/config"
 	operatorpb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
 	"github.com/dapr/dapr/pkg/runtime/hotreload/loader"
 	loadercompstore "github.com/dapr/dapr/pkg/runtime/hotreload/loader/store"
@@ -30,8 +33,10 @@ import (
 var log = logger.NewLogger("dapr.runtime.hotreload.loader.operator")
 
 type Options struct {
+	AppID          string
 	PodName        string
 	Namespace      string
+	ConfigName     string
 	ComponentStore *compstore.ComponentStore
 	OperatorClient operatorpb.OperatorClient
 }
@@ -39,6 +44,8 @@ type Options struct {
 type operator struct {
 	components    *resource[componentsapi.Component]
 	subscriptions *resource[subapi.Subscription]
+	resiliency    *watcher[[]*resiliencyapi.Resiliency]
+	configuration *watcher[*config.Configuration]
 
 	running atomic.Bool
 }
@@ -47,6 +54,56 @@ func New(opts Options) loader.Interface {
 	return &operator{
 		components:    newResource[componentsapi.Component](opts, loadercompstore.NewComponents(opts.ComponentStore), new(components)),
 		subscriptions: newResource[subapi.Subscription](opts, loadercompstore.NewSubscriptions(opts.ComponentStore), new(subscriptions)),
+		resiliency: &watcher[[]*resiliencyapi.Resiliency]{
+			load: func(ctx context.Context) ([]*resiliencyapi.Resiliency, error) {
+				return resiliency.ListKubernetesResiliency(ctx, log, opts.AppID, opts.Namespace, opts.OperatorClient)
+			},
+			establish: func(ctx context.Context) (func() error, error) {
+				stream, err := opts.OperatorClient.ResiliencyUpdate(ctx, &operatorpb.ResiliencyUpdateRequest{
+					Namespace: opts.Namespace,
+					PodName:   opts.PodName,
+				})
+				if err != nil {
+					return nil, err
+				}
+				return func() error {
+					_, err := stream.Recv()
+					return err
+				}, nil
+			},
+		},
+		configuration: newConfigurationWatcher(opts),
+	}
+}
+
+func newConfigurationWatcher(opts Options) *watcher[*config.Configuration] {
+	if len(opts.ConfigName) == 0 {
+		// Without a Configuration there is nothing to watch.
+		return &watcher[*config.Configuration]{
+			load: func(context.Context) (*config.Configuration, error) {
+				return config.LoadDefaultConfiguration(), nil
+			},
+		}
+	}
+
+	return &watcher[*config.Configuration]{
+		load: func(context.Context) (*config.Configuration, error) {
+			return config.LoadKubernetesConfiguration(opts.ConfigName, opts.Namespace, opts.PodName, opts.OperatorClient)
+		},
+		establish: func(ctx context.Context) (func() error, error) {
+			stream, err := opts.OperatorClient.ConfigurationUpdate(ctx, &operatorpb.ConfigurationUpdateRequest{
+				Name:      opts.ConfigName,
+				Namespace: opts.Namespace,
+				PodName:   opts.PodName,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return func() error {
+				_, err := stream.Recv()
+				return err
+			}, nil
+		},
 	}
 }
 
@@ -66,3 +123,11 @@ func (o *operator) Components() loader.Loader[componentsapi.Component] {
 func (o *operator) Subscriptions() loader.Loader[subapi.Subscription] {
 	return o.subscriptions
 }
+
+func (o *operator) Resiliency() loader.Watcher[[]*resiliencyapi.Resiliency] {
+	return o.resiliency
+}
+
+func (o *operator) Configuration() loader.Watcher[*config.Configuration] {
+	return o.configuration
+}
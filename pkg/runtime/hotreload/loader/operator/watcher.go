@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"context"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watcher is a generic implementation of an operator watcher for resources
+// which are loaded as a whole. The operator streams an event whenever one of
+// the resources changes, and it is up to the reconciler to load the resources
+// and determine whether they changed.
+type watcher[T any] struct {
+	load func(context.Context) (T, error)
+
+	// establish opens a stream of update events from the operator, returning
+	// a function which blocks until the next event is received.
+	establish func(context.Context) (func() error, error)
+}
+
+func (w *watcher[T]) Load(ctx context.Context) (T, error) {
+	return w.load(ctx)
+}
+
+// Watch returns a channel which is sent to whenever the operator reports a
+// change. The channel is closed if the operator does not support streaming
+// updates, in which case the resources are only periodically loaded.
+func (w *watcher[T]) Watch(ctx context.Context) (<-chan struct{}, error) {
+	if w.establish == nil {
+		return nil, nil
+	}
+
+	ch := make(chan struct{}, 1)
+	go w.watch(ctx, ch)
+	return ch, nil
+}
+
+func (w *watcher[T]) watch(ctx context.Context, ch chan<- struct{}) {
+	defer close(ch)
+
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxElapsedTime = 0
+
+	var reconnected bool
+	for {
+		recv, err := w.establish(ctx)
+		for err == nil {
+			if reconnected {
+				// Updates may have been missed while disconnected.
+				log.Info("Reconnected to operator")
+				reconnected = false
+				notify(ch)
+			}
+			if err = recv(); err == nil {
+				bo.Reset()
+				notify(ch)
+			}
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+		if status.Code(err) == codes.Unimplemented {
+			log.Warnf("Operator does not support streaming updates, falling back to periodic reconciliation: %s", err)
+			return
+		}
+
+		log.Errorf("Error from operator stream: %s", err)
+		reconnected = true
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(bo.NextBackOff()):
+		}
+	}
+}
+
+// notify signals a change, without blocking if a change is already pending.
+func notify(ch chan<- struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_watcher(t *testing.T) {
+	t.Run("no stream returns nil channel", func(t *testing.T) {
+		w := &watcher[int]{}
+		ch, err := w.Watch(t.Context())
+		require.NoError(t, err)
+		assert.Nil(t, ch)
+	})
+
+	t.Run("events are reported and channel is closed if unimplemented", func(t *testing.T) {
+		events := make(chan error)
+		w := &watcher[int]{
+			establish: func(context.Context) (func() error, error) {
+				return func() error { return <-events }, nil
+			},
+		}
+
+		ch, err := w.Watch(t.Context())
+		require.NoError(t, err)
+
+		events <- nil
+		select {
+		case <-ch:
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "expected change to be reported")
+		}
+
+		events <- status.Error(codes.Unimplemented, "unimplemented")
+		select {
+		case _, ok := <-ch:
+			assert.False(t, ok)
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "expected channel to be closed")
+		}
+	})
+
+	t.Run("reconnects on stream error and reports a change", func(t *testing.T) {
+		var established atomic.Int32
+		w := &watcher[int]{
+			establish: func(ctx context.Context) (func() error, error) {
+				if established.Add(1) == 1 {
+					return func() error { return errors.New("stream error") }, nil
+				}
+				return func() error {
+					<-ctx.Done()
+					return ctx.Err()
+				}, nil
+			},
+		}
+
+		ctx, cancel := context.WithCancel(t.Context())
+		ch, err := w.Watch(ctx)
+		require.NoError(t, err)
+
+		select {
+		case <-ch:
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "expected change to be reported after reconnect")
+		}
+		assert.Equal(t, int32(2), established.Load())
+
+		cancel()
+		select {
+		case _, ok := <-ch:
+			assert.False(t, ok)
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "expected channel to be closed")
+		}
+	})
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"cmp"
+	"context"
+	"reflect"
+	"slices"
+
+	"github.com/dapr/dapr/pkg/acl"
+	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	diagUtils "github.com/dapr/dapr/pkg/diagnostics/utils"
+	"github.com/dapr/kit/ptr"
+)
+
+// configuration applies the sections of a Configuration which can be updated
+// while daprd is running: the access control list, the API allow and deny
+// lists, the tracing sampling rate and the metrics rules. Changes to any other
+// section are only applied when daprd is restarted.
+type configuration struct {
+	// current is the applied Configuration. Sections which failed to apply
+	// keep their previous value, so they are applied again on the next
+	// update.
+	current *config.Configuration
+	isHTTP  bool
+	acl     *config.Reloadable[config.AccessControlList]
+	apiSpec *config.Reloadable[config.APISpec]
+	sampler *diag.DaprTraceSampler
+}
+
+var _ policyManager[*config.Configuration] = (*configuration)(nil)
+
+func (c *configuration) apply(_ context.Context, conf *config.Configuration) {
+	// Apply the same defaults as when daprd started.
+	if err := config.SetTracingSpecFromEnv(conf); err != nil {
+		log.Errorf("Error setting tracing spec from env, ignoring Configuration update: %s", err)
+		return
+	}
+	conf.SetDefaultFeatures()
+	conf.LoadFeatures()
+
+	old := c.current
+	applied := *conf
+
+	if !reflect.DeepEqual(old.Spec.AccessControlSpec, conf.Spec.AccessControlSpec) {
+		accessControlList, err := acl.ParseAccessControlSpec(conf.Spec.AccessControlSpec, c.isHTTP)
+		if err != nil {
+			log.Errorf("Error parsing updated access control list, keeping existing: %s", err)
+			applied.Spec.AccessControlSpec = old.Spec.AccessControlSpec
+		} else {
+			log.Info("Access control list updated")
+			c.acl.Store(accessControlList)
+		}
+	}
+
+	if !reflect.DeepEqual(old.GetAPISpec(), conf.GetAPISpec()) {
+		log.Info("API allow and deny lists updated")
+		c.apiSpec.Store(ptr.Of(conf.GetAPISpec()))
+	}
+
+	if rate := conf.GetTracingSpec().SamplingRate; rate != old.GetTracingSpec().SamplingRate {
+		log.Infof("Tracing sampling rate updated: %s", rate)
+		c.sampler.SetSamplingRate(rate)
+	}
+
+	if rules := conf.GetMetricsSpec().Rules; !reflect.DeepEqual(rules, old.GetMetricsSpec().Rules) {
+		if err := diagUtils.CreateRulesMap(rules); err != nil {
+			log.Errorf("Error creating updated metrics rules, keeping existing: %s", err)
+			metrics := applied.GetMetricsSpec()
+			metrics.Rules = old.GetMetricsSpec().Rules
+			applied.Spec.MetricSpec = &metrics
+		} else {
+			log.Info("Metrics rules updated")
+		}
+	}
+
+	if !reflect.DeepEqual(restartRequiredSpec(old.Spec), restartRequiredSpec(conf.Spec)) {
+		log.Warn("Configuration has changes which will only take effect when daprd is restarted")
+	}
+
+	c.current = &applied
+}
+
+// restartRequiredSpec returns a copy of the spec with the sections which are
+// updated while daprd is running removed.
+func restartRequiredSpec(spec config.ConfigurationSpec) config.ConfigurationSpec {
+	spec.AccessControlSpec = nil
+	spec.APISpec = nil
+
+	if spec.TracingSpec != nil {
+		tracing := *spec.TracingSpec
+		tracing.SamplingRate = ""
+		spec.TracingSpec = &tracing
+	}
+
+	for _, metrics := range []**config.MetricSpec{&spec.MetricSpec, &spec.MetricsSpec} {
+		if *metrics != nil {
+			m := **metrics
+			m.Rules = nil
+			*metrics = &m
+		}
+	}
+
+	// Default features are appended in no particular order.
+	spec.Features = slices.SortedFunc(slices.Values(spec.Features), func(a, b config.FeatureSpec) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return spec
+}
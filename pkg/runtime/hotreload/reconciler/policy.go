@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/hotreload/loader"
+)
+
+type OptionsResiliency struct {
+	Loader     loader.Interface
+	Resiliency *resiliency.Reloadable
+	Healthz    healthz.Healthz
+}
+
+type OptionsConfiguration struct {
+	Loader loader.Interface
+	// Config is the Configuration daprd was started with.
+	Config            *config.Configuration
+	IsHTTP            bool
+	AccessControlList *config.Reloadable[config.AccessControlList]
+	APISpec           *config.Reloadable[config.APISpec]
+	TraceSampler      *diag.DaprTraceSampler
+	Healthz           healthz.Healthz
+}
+
+// Policy reconciles resources which are applied as a whole rather than
+// individually, such as Resiliency policies and the Configuration. Resources
+// are loaded when the source reports a change, as well as periodically, and
+// the manager decides whether they need to be applied.
+type Policy[T any] struct {
+	kind    string
+	watcher loader.Watcher[T]
+	manager policyManager[T]
+	htarget healthz.Target
+
+	clock clock.WithTicker
+}
+
+type policyManager[T any] interface {
+	apply(context.Context, T)
+}
+
+func NewResiliency(opts OptionsResiliency) *Policy[[]*resiliencyapi.Resiliency] {
+	return &Policy[[]*resiliencyapi.Resiliency]{
+		clock:   clock.RealClock{},
+		kind:    "Resiliency",
+		htarget: opts.Healthz.AddTarget("resiliency-reconciler"),
+		watcher: opts.Loader.Resiliency(),
+		manager: &resiliencyPolicies{
+			provider: opts.Resiliency,
+		},
+	}
+}
+
+func NewConfiguration(opts OptionsConfiguration) *Policy[*config.Configuration] {
+	return &Policy[*config.Configuration]{
+		clock:   clock.RealClock{},
+		kind:    "Configuration",
+		htarget: opts.Healthz.AddTarget("configuration-reconciler"),
+		watcher: opts.Loader.Configuration(),
+		manager: &configuration{
+			current: opts.Config,
+			isHTTP:  opts.IsHTTP,
+			acl:     opts.AccessControlList,
+			apiSpec: opts.APISpec,
+			sampler: opts.TraceSampler,
+		},
+	}
+}
+
+func (p *Policy[T]) Run(ctx context.Context) error {
+	watchCh, err := p.watcher.Watch(ctx)
+	if err != nil {
+		return fmt.Errorf("error watching %s: %w", p.kind, err)
+	}
+
+	p.htarget.Ready()
+
+	log.Infof("Starting to watch %s updates", p.kind)
+
+	ticker := p.clock.NewTicker(time.Second * 60)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+			log.Debugf("Running scheduled %s reconcile", p.kind)
+		case _, ok := <-watchCh:
+			if !ok {
+				// Source has stopped reporting changes, so rely on the ticker.
+				watchCh = nil
+				continue
+			}
+			log.Debugf("Reconciling %s", p.kind)
+		}
+
+		resources, err := p.watcher.Load(ctx)
+		if err != nil {
+			log.Errorf("Error loading %s: %s", p.kind, err)
+			continue
+		}
+
+		p.manager.apply(ctx, resources)
+	}
+}
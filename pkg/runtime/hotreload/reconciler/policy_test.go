@@ -0,0 +1,220 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/resiliency"
+	"github.com/dapr/dapr/pkg/runtime/hotreload/loader/fake"
+	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/ptr"
+)
+
+func Test_Policy_Run(t *testing.T) {
+	t.Run("should load when ticker reaches 60 seconds", func(t *testing.T) {
+		var loadCalled atomic.Int32
+		watcher := fake.NewFakeWatcher[[]*resiliencyapi.Resiliency]().
+			WithLoad(func(context.Context) ([]*resiliencyapi.Resiliency, error) {
+				loadCalled.Add(1)
+				return nil, nil
+			})
+
+		r := NewResiliency(OptionsResiliency{
+			Loader:     fake.New().WithResiliency(watcher),
+			Resiliency: resiliency.NewReloadable(resiliency.New(logger.NewLogger("test"))),
+			Healthz:    healthz.New(),
+		})
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		r.clock = fakeClock
+
+		errCh := make(chan error)
+		ctx, cancel := context.WithCancel(t.Context())
+		go func() {
+			errCh <- r.Run(ctx)
+		}()
+
+		assert.Eventually(t, fakeClock.HasWaiters, time.Second*3, time.Millisecond*100)
+		assert.Equal(t, int32(0), loadCalled.Load())
+
+		fakeClock.Step(time.Second * 60)
+
+		assert.Eventually(t, func() bool {
+			return loadCalled.Load() == 1
+		}, time.Second*3, time.Millisecond*100)
+
+		cancel()
+		select {
+		case err := <-errCh:
+			require.NoError(t, err)
+		case <-time.After(time.Second * 3):
+			t.Error("reconciler did not return in time")
+		}
+	})
+
+	t.Run("should swap resiliency only when configurations change", func(t *testing.T) {
+		watchCh := make(chan struct{})
+		configsCh := make(chan []*resiliencyapi.Resiliency)
+		watcher := fake.NewFakeWatcher[[]*resiliencyapi.Resiliency]().
+			WithWatch(func(context.Context) (<-chan struct{}, error) {
+				return watchCh, nil
+			}).
+			WithLoad(func(context.Context) ([]*resiliencyapi.Resiliency, error) {
+				return <-configsCh, nil
+			})
+
+		initial := resiliencyConfig("1s")
+		provider := resiliency.NewReloadable(resiliency.FromConfigurations(logger.NewLogger("test"), initial))
+
+		r := NewResiliency(OptionsResiliency{
+			Loader:     fake.New().WithResiliency(watcher),
+			Resiliency: provider,
+			Healthz:    healthz.New(),
+		})
+
+		errCh := make(chan error)
+		ctx, cancel := context.WithCancel(t.Context())
+		go func() {
+			errCh <- r.Run(ctx)
+		}()
+
+		// An identical configuration with new object metadata is not applied.
+		unchanged := resiliencyConfig("1s")
+		unchanged.ResourceVersion = "2"
+		watchCh <- struct{}{}
+		configsCh <- []*resiliencyapi.Resiliency{unchanged}
+
+		updated := resiliencyConfig("5s")
+		watchCh <- struct{}{}
+		configsCh <- []*resiliencyapi.Resiliency{updated}
+
+		// Wait for the second load to be applied.
+		watchCh <- struct{}{}
+		configsCh <- []*resiliencyapi.Resiliency{updated}
+
+		assert.Equal(t, []*resiliencyapi.Resiliency{updated}, provider.Configurations())
+
+		cancel()
+		select {
+		case err := <-errCh:
+			require.NoError(t, err)
+		case <-time.After(time.Second * 3):
+			t.Error("reconciler did not return in time")
+		}
+	})
+}
+
+func Test_configuration_apply(t *testing.T) {
+	started := config.LoadDefaultConfiguration()
+	started.LoadFeatures()
+
+	aclHolder := config.NewReloadable[config.AccessControlList](nil)
+	apiSpec := config.NewReloadable(ptr.Of(started.GetAPISpec()))
+	sampler := diag.NewDaprTraceSampler("1")
+
+	c := &configuration{
+		current: started,
+		isHTTP:  true,
+		acl:     aclHolder,
+		apiSpec: apiSpec,
+		sampler: sampler,
+	}
+
+	t.Run("unchanged configuration is not applied", func(t *testing.T) {
+		before := apiSpec.Load()
+		c.apply(t.Context(), config.LoadDefaultConfiguration())
+		assert.Nil(t, aclHolder.Load())
+		assert.Same(t, before, apiSpec.Load())
+	})
+
+	t.Run("reloadable sections are updated", func(t *testing.T) {
+		updated := config.LoadDefaultConfiguration()
+		updated.Spec.TracingSpec.SamplingRate = "0.5"
+		updated.Spec.AccessControlSpec = &config.AccessControlSpec{
+			DefaultAction: config.DenyAccess,
+			TrustDomain:   "public",
+		}
+		updated.Spec.APISpec = &config.APISpec{
+			Allowed: config.APIAccessRules{
+				{Name: "state", Version: "v1.0", Protocol: config.APIAccessRuleProtocolHTTP},
+			},
+		}
+		c.apply(t.Context(), updated)
+
+		accessControlList := aclHolder.Load()
+		require.NotNil(t, accessControlList)
+		assert.Equal(t, config.DenyAccess, accessControlList.DefaultAction)
+		assert.Equal(t, "public", accessControlList.TrustDomain)
+
+		assert.Len(t, apiSpec.Load().Allowed, 1)
+		assert.Contains(t, sampler.Description(), "TraceIDRatioBased{0.5}")
+	})
+
+	t.Run("sections which failed to apply are applied again", func(t *testing.T) {
+		before := aclHolder.Load()
+		invalid := config.LoadDefaultConfiguration()
+		invalid.Spec.TracingSpec.SamplingRate = "0.5"
+		invalid.Spec.AccessControlSpec = &config.AccessControlSpec{
+			DefaultAction: config.AllowAccess,
+			TrustDomain:   "public",
+			AppPolicies:   []config.AppPolicySpec{{AppName: "app1", DefaultAction: "invalid"}},
+		}
+		c.apply(t.Context(), invalid)
+		assert.Same(t, before, aclHolder.Load())
+
+		// The same invalid list is parsed again, rather than being treated as
+		// applied.
+		c.apply(t.Context(), invalid)
+		assert.Same(t, before, aclHolder.Load())
+		assert.Equal(t, config.DenyAccess, c.current.Spec.AccessControlSpec.DefaultAction)
+
+		valid := config.LoadDefaultConfiguration()
+		valid.Spec.TracingSpec.SamplingRate = "0.5"
+		valid.Spec.AccessControlSpec = &config.AccessControlSpec{
+			DefaultAction: config.AllowAccess,
+			TrustDomain:   "public",
+		}
+		c.apply(t.Context(), valid)
+		require.NotNil(t, aclHolder.Load())
+		assert.Equal(t, config.AllowAccess, aclHolder.Load().DefaultAction)
+	})
+}
+
+func resiliencyConfig(timeout string) *resiliencyapi.Resiliency {
+	return &resiliencyapi.Resiliency{
+		ObjectMeta: metav1.ObjectMeta{Name: "resiliency", Namespace: "default"},
+		Spec: resiliencyapi.ResiliencySpec{
+			Policies: resiliencyapi.Policies{
+				Timeouts: map[string]string{"general": timeout},
+			},
+			Targets: resiliencyapi.Targets{
+				Apps: map[string]resiliencyapi.EndpointPolicyNames{
+					"app1": {Timeout: "general"},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"reflect"
+
+	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/resiliency"
+)
+
+var _ policyManager[[]*resiliencyapi.Resiliency] = (*resiliencyPolicies)(nil)
+
+type resiliencyPolicies struct {
+	provider *resiliency.Reloadable
+}
+
+func (r *resiliencyPolicies) apply(_ context.Context, configs []*resiliencyapi.Resiliency) {
+	if resiliencyConfigsEqual(r.provider.Configurations(), configs) {
+		return
+	}
+
+	// Policies are rebuilt from all configurations, since policies in one
+	// configuration may be targeted by another.
+	log.Infof("Resiliency configurations changed, reloading %d configuration(s)", len(configs))
+	r.provider.Swap(resiliency.FromConfigurations(log, configs...))
+}
+
+// resiliencyConfigsEqual returns true if both sets contain the same
+// resiliency configurations, ignoring order and object metadata other than
+// the name and namespace.
+func resiliencyConfigsEqual(a, b []*resiliencyapi.Resiliency) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	type key struct{ namespace, name string }
+	index := make(map[key]*resiliencyapi.Resiliency, len(a))
+	for _, c := range a {
+		index[key{c.Namespace, c.Name}] = c
+	}
+
+	for _, c := range b {
+		existing, ok := index[key{c.Namespace, c.Name}]
+		if !ok ||
+			!reflect.DeepEqual(existing.Spec, c.Spec) ||
+			!reflect.DeepEqual(existing.Scopes, c.Scopes) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -20,14 +20,12 @@ import (
 	"strings"
 	"time"
 
-	"google.golang.org/protobuf/types/known/timestamppb"
-
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	"github.com/dapr/dapr/pkg/components"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/encryption"
 	operatorv1 "github.com/dapr/dapr/pkg/proto/operator/v1"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
-	"github.com/dapr/kit/ptr"
 )
 
 // Init initializes a component of a category and reports the result.
@@ -35,25 +33,10 @@ func (p *Processor) Init(ctx context.Context, comp componentsapi.Component) erro
 	initerr := p.init(ctx, comp)
 
 	// after performing the initialization, report the result
-	condition := operatorv1.ResourceConditionStatus_STATUS_SUCCESS
-	var reason, message *string
-	if initerr != nil {
-		condition = operatorv1.ResourceConditionStatus_STATUS_FAILURE
-		reason = ptr.Of("ERROR")
-		message = ptr.Of(initerr.Error())
-	}
-
-	if err := p.reporter(ctx, comp,
-		&operatorv1.ResourceResult{
-			ResourceType:        operatorv1.ResourceType_RESOURCE_COMPONENT,
-			EventType:           operatorv1.EventType_EVENT_INIT,
-			Name:                comp.GetName(),
-			Condition:           condition,
-			Reason:              reason,
-			Message:             message,
-			ObservedGeneration:  comp.GetGeneration(),
-			LastTransactionTime: timestamppb.New(time.Now()),
-		}); err != nil {
+	result := newResourceResult(operatorv1.ResourceType_RESOURCE_COMPONENT,
+		operatorv1.EventType_EVENT_INIT, comp.GetName(), comp.GetGeneration(), initerr)
+	p.reportStatus(result)
+	if err := p.reporter(ctx, comp, result); err != nil {
 		return errors.Join(initerr, fmt.Errorf("error reporting component init result: %w", err), p.Close(comp))
 	}
 
@@ -89,28 +72,14 @@ func (p *Processor) init(ctx context.Context, comp componentsapi.Component) erro
 func (p *Processor) Close(comp componentsapi.Component) error {
 	closeErr := p.internalClose(comp)
 
-	// after performing the initialization, report the result
-	condition := operatorv1.ResourceConditionStatus_STATUS_SUCCESS
-	var reason, message *string
-	if closeErr != nil {
-		condition = operatorv1.ResourceConditionStatus_STATUS_FAILURE
-		reason = ptr.Of("ERROR")
-		message = ptr.Of(closeErr.Error())
-	}
+	// after performing the close, report the result
+	result := newResourceResult(operatorv1.ResourceType_RESOURCE_COMPONENT,
+		operatorv1.EventType_EVENT_CLOSE, comp.GetName(), comp.GetGeneration(), closeErr)
+	p.reportStatus(result)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	if err := p.reporter(ctx, comp,
-		&operatorv1.ResourceResult{
-			ResourceType:        operatorv1.ResourceType_RESOURCE_COMPONENT,
-			EventType:           operatorv1.EventType_EVENT_CLOSE,
-			Name:                comp.GetName(),
-			Condition:           condition,
-			Reason:              reason,
-			Message:             message,
-			ObservedGeneration:  comp.GetGeneration(),
-			LastTransactionTime: timestamppb.New(time.Now()),
-		}); err != nil {
+	if err := p.reporter(ctx, comp, result); err != nil {
 		return errors.Join(closeErr, fmt.Errorf("error reporting component close result: %w", err))
 	}
 
@@ -253,6 +222,19 @@ func (p *Processor) preprocessOneComponent(ctx context.Context, comp *components
 			unreadyDependency: componentDependency(components.CategorySecretStore, unreadySecretsStore),
 		}
 	}
+
+	// State stores with data keys wrapped by a crypto component can only be
+	// initialized once that component is loaded.
+	if p.category(*comp) == components.CategoryStateStore {
+		if provider := encryption.EncryptionKeyProvider(*comp); provider != "" {
+			if _, ok := p.compStore.GetCryptoProvider(provider); !ok {
+				return componentPreprocessRes{
+					unreadyDependency: componentDependency(components.CategoryCryptoProvider, provider),
+				}
+			}
+		}
+	}
+
 	return componentPreprocessRes{}
 }
 
@@ -24,6 +24,7 @@ import (
 	compconversation "github.com/dapr/dapr/pkg/components/conversation"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/runtime/compstore"
+	rtconversation "github.com/dapr/dapr/pkg/runtime/conversation"
 	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
 	"github.com/dapr/dapr/pkg/runtime/meta"
 )
@@ -68,6 +69,21 @@ func (c *conversation) Init(ctx context.Context, comp compapi.Component) error {
 		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
 	}
 
+	// The message history of conversation contexts is kept by daprd, rather
+	// than the component.
+	history, err := rtconversation.HistoryFromMetadata(meta.Properties)
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+
+	// The response cache is kept by daprd as well.
+	cache, err := rtconversation.CacheFromMetadata(meta.Properties)
+	if err != nil {
+		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
+		return rterrors.NewInit(rterrors.InitComponentFailure, fName, err)
+	}
+
 	err = conversate.Init(ctx, contribconversation.Metadata{Base: meta})
 	if err != nil {
 		diag.DefaultMonitoring.ComponentInitFailed(comp.Spec.Type, "init", comp.ObjectMeta.Name)
@@ -75,6 +91,12 @@ func (c *conversation) Init(ctx context.Context, comp compapi.Component) error {
 	}
 
 	c.store.AddConversation(comp.ObjectMeta.Name, conversate)
+	if history != nil {
+		c.store.AddConversationHistory(comp.ObjectMeta.Name, history)
+	}
+	if cache != nil {
+		c.store.AddConversationCache(comp.ObjectMeta.Name, cache)
+	}
 
 	diag.DefaultMonitoring.ComponentInitialized(comp.Spec.Type)
 
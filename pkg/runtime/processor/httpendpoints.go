@@ -21,6 +21,7 @@ import (
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	"github.com/dapr/dapr/pkg/internal/apis"
+	operatorv1 "github.com/dapr/dapr/pkg/proto/operator/v1"
 )
 
 func (p *Processor) AddPendingEndpoint(ctx context.Context, endpoint httpendpointsapi.HTTPEndpoint) bool {
@@ -47,6 +48,8 @@ func (p *Processor) processHTTPEndpoints(ctx context.Context) error {
 		}
 		p.processHTTPEndpointSecrets(ctx, &endpoint)
 		p.compStore.AddHTTPEndpoint(endpoint)
+		p.reportStatus(newResourceResult(operatorv1.ResourceType_RESOURCE_HTTPENDPOINT,
+			operatorv1.EventType_EVENT_INIT, endpoint.Name, endpoint.GetGeneration(), nil))
 	}
 
 	return nil
@@ -104,6 +107,8 @@ func (p *Processor) processHTTPEndpointSecrets(ctx context.Context, endpoint *ht
 
 	tlsResource.Pairs = append(tlsResource.Pairs, cKey)
 
+	p.processHTTPEndpointOAuth2Secrets(ctx, endpoint)
+
 	updated, _ := p.secret.ProcessResource(ctx, tlsResource)
 	if updated {
 		for _, np := range tlsResource.Pairs {
@@ -133,3 +138,44 @@ func (p *Processor) processHTTPEndpointSecrets(ctx context.Context, endpoint *ht
 		}
 	}
 }
+
+func (p *Processor) processHTTPEndpointOAuth2Secrets(ctx context.Context, endpoint *httpendpointsapi.HTTPEndpoint) {
+	if !endpoint.HasOAuth2() {
+		return
+	}
+
+	oauth2 := endpoint.Spec.Auth.OAuth2
+	values := map[string]*httpendpointsapi.SecretValue{
+		"clientId":     &oauth2.ClientID,
+		"clientSecret": &oauth2.ClientSecret,
+	}
+
+	resource := apis.GenericNameValueResource{
+		Name:        endpoint.ObjectMeta.Name,
+		Namespace:   endpoint.ObjectMeta.Namespace,
+		SecretStore: endpoint.Auth.SecretStore,
+		Pairs:       make([]commonapi.NameValuePair, 0, len(values)),
+	}
+	for name, sv := range values {
+		pair := commonapi.NameValuePair{Name: name}
+		if sv.Value != nil {
+			pair.Value = *sv.Value
+		}
+		if sv.HasSecret() {
+			pair.SecretKeyRef = *sv.SecretKeyRef
+		}
+		resource.Pairs = append(resource.Pairs, pair)
+	}
+
+	if updated, _ := p.secret.ProcessResource(ctx, resource); !updated {
+		return
+	}
+
+	for _, np := range resource.Pairs {
+		values[np.Name].Value = &commonapi.DynamicValue{
+			JSON: apiextapi.JSON{
+				Raw: np.Value.Raw,
+			},
+		}
+	}
+}
@@ -109,6 +109,10 @@ type Options struct {
 
 	// Reporter is the reporter for the operator.
 	Reporter registry.Reporter
+
+	// StatusReporter reports the results of loading components, subscriptions
+	// and HTTP endpoints to the operator. Optional.
+	StatusReporter StatusReporter
 }
 
 // Processor manages the lifecycle of all components categories.
@@ -123,6 +127,7 @@ type Processor struct {
 	security        security.Handler
 	subscriber      *subscriber.Subscriber
 	reporter        registry.Reporter
+	statusReporter  StatusReporter
 
 	pendingHTTPEndpoints       chan httpendpointsapi.HTTPEndpoint
 	pendingComponents          chan componentsapi.Component
@@ -149,6 +154,7 @@ func New(opts Options) *Processor {
 		CompStore:       opts.ComponentStore,
 		Adapter:         opts.Adapter,
 		AdapterStreamer: opts.AdapterStreamer,
+		Actors:          opts.Actors,
 	})
 
 	state := state.New(state.Options{
@@ -197,6 +203,7 @@ func New(opts Options) *Processor {
 		security:                   opts.Security,
 		subscriber:                 subscriber,
 		reporter:                   reporter,
+		statusReporter:             opts.StatusReporter,
 		managers: map[components.Category]manager{
 			components.CategoryBindings: binding,
 			components.CategoryConfiguration: configuration.New(configuration.Options{
@@ -32,6 +32,7 @@ import (
 	"github.com/dapr/components-contrib/secretstores"
 	commonapi "github.com/dapr/dapr/pkg/apis/common"
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	"github.com/dapr/dapr/pkg/components"
 	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/modes"
@@ -68,6 +69,20 @@ func withReporter(r registry.Reporter) newTestProcOptions {
 	}
 }
 
+func withStatusReporter(r StatusReporter) newTestProcOptions {
+	return func(o *Options) {
+		o.StatusReporter = r
+	}
+}
+
+type fakeStatusReporter struct {
+	results chan *operatorv1.ResourceResult
+}
+
+func (f *fakeStatusReporter) Report(result *operatorv1.ResourceResult) {
+	f.results <- result
+}
+
 func newTestProc(setters ...newTestProcOptions) (*Processor, *registry.Registry) {
 	reg := registry.New(registry.NewOptions())
 	opts := Options{
@@ -719,3 +734,79 @@ func TestProcessorWaitGroupError(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestStatusReporter(t *testing.T) {
+	pubsubComponent := componentsapi.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "testpubsub",
+			Generation: 3,
+		},
+		Spec: componentsapi.ComponentSpec{
+			Type:     "pubsub.mockPubSub",
+			Version:  "v1",
+			Metadata: daprt.GetFakeMetadataItems(),
+		},
+	}
+
+	t.Run("component init and close are reported", func(t *testing.T) {
+		reporter := &fakeStatusReporter{results: make(chan *operatorv1.ResourceResult, 2)}
+		proc, reg := newTestProc(withStatusReporter(reporter))
+
+		mockPubSub := new(daprt.MockPubSub)
+		reg.PubSubs().RegisterComponent(
+			func(_ logger.Logger) pubsub.PubSub {
+				return mockPubSub
+			},
+			"mockPubSub",
+		)
+		mockPubSub.On("Init", mock.Anything).Return(nil)
+		mockPubSub.On("Close").Return(nil)
+
+		require.NoError(t, proc.Init(t.Context(), pubsubComponent))
+		require.NoError(t, proc.Close(pubsubComponent))
+
+		result := <-reporter.results
+		assert.Equal(t, operatorv1.ResourceType_RESOURCE_COMPONENT, result.GetResourceType())
+		assert.Equal(t, operatorv1.EventType_EVENT_INIT, result.GetEventType())
+		assert.Equal(t, operatorv1.ResourceConditionStatus_STATUS_SUCCESS, result.GetCondition())
+		assert.Equal(t, "testpubsub", result.GetName())
+		assert.Equal(t, int64(3), result.GetObservedGeneration())
+
+		result = <-reporter.results
+		assert.Equal(t, operatorv1.EventType_EVENT_CLOSE, result.GetEventType())
+	})
+
+	t.Run("component init failure is reported", func(t *testing.T) {
+		reporter := &fakeStatusReporter{results: make(chan *operatorv1.ResourceResult, 1)}
+		proc, reg := newTestProc(withStatusReporter(reporter))
+
+		mockPubSub := new(daprt.MockPubSub)
+		reg.PubSubs().RegisterComponent(
+			func(_ logger.Logger) pubsub.PubSub {
+				return mockPubSub
+			},
+			"mockPubSub",
+		)
+		mockPubSub.On("Init", mock.Anything).Return(assert.AnError)
+
+		require.Error(t, proc.Init(t.Context(), pubsubComponent))
+
+		result := <-reporter.results
+		assert.Equal(t, operatorv1.ResourceConditionStatus_STATUS_FAILURE, result.GetCondition())
+		assert.Contains(t, result.GetMessage(), assert.AnError.Error())
+	})
+
+	t.Run("subscription close is reported", func(t *testing.T) {
+		reporter := &fakeStatusReporter{results: make(chan *operatorv1.ResourceResult, 1)}
+		proc, _ := newTestProc(withStatusReporter(reporter))
+
+		require.NoError(t, proc.CloseSubscription(t.Context(), &subapi.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Name: "sub1"},
+		}))
+
+		result := <-reporter.results
+		assert.Equal(t, operatorv1.ResourceType_RESOURCE_SUBSCRIPTION, result.GetResourceType())
+		assert.Equal(t, operatorv1.EventType_EVENT_CLOSE, result.GetEventType())
+		assert.Equal(t, "sub1", result.GetName())
+	})
+}
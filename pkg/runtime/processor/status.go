@@ -0,0 +1,55 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package processor
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	operatorv1 "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/kit/ptr"
+)
+
+// StatusReporter reports the results of loading resources, so they can be
+// surfaced in the status of the resources.
+type StatusReporter interface {
+	Report(*operatorv1.ResourceResult)
+}
+
+// reportStatus reports the given result to the status reporter, if any.
+func (p *Processor) reportStatus(result *operatorv1.ResourceResult) {
+	if p.statusReporter != nil {
+		p.statusReporter.Report(result)
+	}
+}
+
+// newResourceResult returns the result of an event on a resource, which is
+// a failure if err is non-nil.
+func newResourceResult(resourceType operatorv1.ResourceType, eventType operatorv1.EventType, name string, generation int64, err error) *operatorv1.ResourceResult {
+	result := &operatorv1.ResourceResult{
+		ResourceType:        resourceType,
+		EventType:           eventType,
+		Name:                name,
+		Condition:           operatorv1.ResourceConditionStatus_STATUS_SUCCESS,
+		ObservedGeneration:  generation,
+		LastTransactionTime: timestamppb.New(time.Now()),
+	}
+	if err != nil {
+		result.Condition = operatorv1.ResourceConditionStatus_STATUS_FAILURE
+		result.Reason = ptr.Of("ERROR")
+		result.Message = ptr.Of(err.Error())
+	}
+	return result
+}
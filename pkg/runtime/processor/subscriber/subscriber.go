@@ -24,6 +24,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/actors"
 	apierrors "github.com/dapr/dapr/pkg/api/errors"
 	"github.com/dapr/dapr/pkg/api/grpc/manager"
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
@@ -35,6 +36,7 @@ import (
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/subscription"
 	"github.com/dapr/dapr/pkg/runtime/subscription/postman"
+	postmanactors "github.com/dapr/dapr/pkg/runtime/subscription/postman/actors"
 	postmangrpc "github.com/dapr/dapr/pkg/runtime/subscription/postman/grpc"
 	"github.com/dapr/dapr/pkg/runtime/subscription/postman/http"
 	"github.com/dapr/dapr/pkg/runtime/subscription/postman/streaming"
@@ -52,6 +54,7 @@ type Options struct {
 	CompStore       *compstore.ComponentStore
 	Adapter         rtpubsub.Adapter
 	AdapterStreamer rtpubsub.AdapterStreamer
+	Actors          actors.Interface
 }
 
 type Subscriber struct {
@@ -65,6 +68,7 @@ type Subscriber struct {
 	compStore       *compstore.ComponentStore
 	adapter         rtpubsub.Adapter
 	adapterStreamer rtpubsub.AdapterStreamer
+	actors          actors.Interface
 
 	appSubs      map[string][]*namedSubscription
 	streamSubs   map[string]map[rtpubsub.ConnectionID]*namedSubscription
@@ -96,6 +100,7 @@ func New(opts Options) *Subscriber {
 		compStore:       opts.CompStore,
 		adapter:         opts.Adapter,
 		adapterStreamer: opts.AdapterStreamer,
+		actors:          opts.Actors,
 		appSubs:         make(map[string][]*namedSubscription),
 		streamSubs:      make(map[string]map[rtpubsub.ConnectionID]*namedSubscription),
 		retryCtx:        make(map[string]context.Context),
@@ -570,13 +575,21 @@ func (s *Subscriber) startSubscription(pubsub *rtpubsub.PubsubItem, comp *compst
 			Channel: s.adapterStreamer,
 		})
 	} else {
-		if s.isHTTP {
+		switch {
+		case comp.Actor != nil:
+			postman = postmanactors.New(postmanactors.Options{
+				Actors:     s.actors,
+				Resiliency: s.resiliency,
+				Tracing:    s.tracingSpec,
+				Target:     comp.Actor,
+			})
+		case s.isHTTP:
 			postman = http.New(http.Options{
 				Channels: s.channels,
 				Tracing:  s.tracingSpec,
 				Adapter:  s.adapter,
 			})
-		} else {
+		default:
 			postman = postmangrpc.New(postmangrpc.Options{
 				Channel: s.grpc,
 				Tracing: s.tracingSpec,
@@ -15,8 +15,11 @@ package processor
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	subapi "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
+	operatorv1 "github.com/dapr/dapr/pkg/proto/operator/v1"
 	rtpubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/utils"
 )
@@ -50,6 +53,7 @@ func (p *Processor) AddPendingSubscription(ctx context.Context, subscriptions ..
 		for _, rule := range comp.Spec.Routes.Rules {
 			erule, err := rtpubsub.CreateRoutingRule(rule.Match, rule.Path)
 			if err != nil {
+				p.reportSubscriptionStatus(&comp, operatorv1.EventType_EVENT_INIT, err)
 				p.errorSubscriptions(ctx, err)
 				return false
 			}
@@ -60,13 +64,28 @@ func (p *Processor) AddPendingSubscription(ctx context.Context, subscriptions ..
 				Path: comp.Spec.Routes.Default,
 			})
 		}
+		if actor := comp.Spec.Actor; actor != nil {
+			target, err := rtpubsub.CreateActorTarget(actor.Type, actor.IDAttribute, actor.IDExpression)
+			if err == nil && comp.Spec.BulkSubscribe.Enabled {
+				err = errors.New("bulk subscribe is not supported when delivering to an actor")
+			}
+			if err != nil {
+				err = fmt.Errorf("invalid actor target of subscription %s: %w", comp.Name, err)
+				p.reportSubscriptionStatus(&comp, operatorv1.EventType_EVENT_INIT, err)
+				p.errorSubscriptions(ctx, err)
+				return false
+			}
+			sub.Actor = target
+		}
 
 		p.compStore.AddDeclarativeSubscription(&comp, sub)
 		if err := p.subscriber.ReloadDeclaredAppSubscription(comp.Name, comp.Spec.Pubsubname); err != nil {
 			p.compStore.DeleteDeclarativeSubscription(comp.Name)
+			p.reportSubscriptionStatus(&comp, operatorv1.EventType_EVENT_INIT, err)
 			p.errorSubscriptions(ctx, err)
 			return false
 		}
+		p.reportSubscriptionStatus(&comp, operatorv1.EventType_EVENT_INIT, nil)
 	}
 
 	return true
@@ -86,6 +105,11 @@ func (p *Processor) scopeFilterSubscriptions(subs []subapi.Subscription) []subap
 func (p *Processor) CloseSubscription(ctx context.Context, sub *subapi.Subscription) error {
 	p.lock.Lock()
 	defer p.lock.Unlock()
+
+	// Subscriptions which failed to load are not in the store, but their
+	// result was still reported.
+	p.reportSubscriptionStatus(sub, operatorv1.EventType_EVENT_CLOSE, nil)
+
 	if _, ok := p.compStore.GetDeclarativeSubscription(sub.Name); !ok {
 		return nil
 	}
@@ -96,6 +120,11 @@ func (p *Processor) CloseSubscription(ctx context.Context, sub *subapi.Subscript
 	return nil
 }
 
+func (p *Processor) reportSubscriptionStatus(sub *subapi.Subscription, eventType operatorv1.EventType, err error) {
+	p.reportStatus(newResourceResult(operatorv1.ResourceType_RESOURCE_SUBSCRIPTION,
+		eventType, sub.Name, sub.GetGeneration(), err))
+}
+
 func (p *Processor) processSubscriptions(ctx context.Context) error {
 	select {
 	case <-ctx.Done():
@@ -10,6 +10,7 @@ type Subscription struct {
 	Rules           []*Rule           `json:"rules,omitempty"`
 	Scopes          []string          `json:"scopes"`
 	BulkSubscribe   *BulkSubscribe    `json:"bulkSubscribe"`
+	Actor           *ActorTarget      `json:"actor,omitempty"`
 }
 
 type BulkSubscribe struct {
@@ -23,6 +24,15 @@ type Rule struct {
 	Path  string `json:"path"`
 }
 
+// ActorTarget is the actor which the messages of a subscription are delivered
+// to. The actor ID is read from the IDAttribute of the CloudEvent, or is the
+// result of IDExpr if set.
+type ActorTarget struct {
+	Type        string `json:"type"`
+	IDAttribute string `json:"idAttribute,omitempty"`
+	IDExpr      Expr   `json:"idExpression,omitempty"`
+}
+
 type Expr interface {
 	fmt.Stringer
 
@@ -319,6 +319,31 @@ func CreateRoutingRule(match, path string) (*Rule, error) {
 	}, nil
 }
 
+func CreateActorTarget(actorType, idAttribute, idExpression string) (*ActorTarget, error) {
+	if len(actorType) == 0 {
+		return nil, errors.New("actor type is required")
+	}
+
+	idExpression = strings.TrimSpace(idExpression)
+	if (len(idAttribute) == 0) == (len(idExpression) == 0) {
+		return nil, errors.New("exactly one of actor ID attribute or expression is required")
+	}
+
+	target := &ActorTarget{
+		Type:        actorType,
+		IDAttribute: idAttribute,
+	}
+	if len(idExpression) > 0 {
+		e := &expr.Expr{}
+		if err := e.DecodeString(idExpression); err != nil {
+			return nil, err
+		}
+		target.IDExpr = e
+	}
+
+	return target, nil
+}
+
 func GRPCEnvelopeFromSubscriptionMessage(ctx context.Context, msg *SubscribedMessage, log logger.Logger, tracingSpec *config.TracingSpec) (*runtimev1pb.TopicEventRequest, trace.Span, error) {
 	cloudEvent := msg.CloudEvent
 
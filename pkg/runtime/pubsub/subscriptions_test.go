@@ -398,3 +398,24 @@ func TestGetRuleMatchString(t *testing.T) {
 		assert.Equal(t, v.Match, rule.Match.String())
 	}
 }
+
+func TestCreateActorTarget(t *testing.T) {
+	target, err := CreateActorTarget("myactor", "subject", "")
+	require.NoError(t, err)
+	assert.Equal(t, "myactor", target.Type)
+	assert.Equal(t, "subject", target.IDAttribute)
+	assert.Nil(t, target.IDExpr)
+
+	target, err = CreateActorTarget("myactor", "", " event.data.id ")
+	require.NoError(t, err)
+	assert.Equal(t, "event.data.id", target.IDExpr.String())
+
+	_, err = CreateActorTarget("", "subject", "")
+	require.Error(t, err)
+	_, err = CreateActorTarget("myactor", "", "")
+	require.Error(t, err)
+	_, err = CreateActorTarget("myactor", "subject", "event.subject")
+	require.Error(t, err)
+	_, err = CreateActorTarget("myactor", "", "event.data.")
+	require.Error(t, err)
+}
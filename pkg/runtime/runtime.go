@@ -41,6 +41,7 @@ import (
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/kit/concurrency"
 	"github.com/dapr/kit/logger"
+	"github.com/dapr/kit/ptr"
 
 	"github.com/dapr/dapr/pkg/actors"
 	"github.com/dapr/dapr/pkg/actors/hostconfig"
@@ -64,6 +65,7 @@ import (
 	"github.com/dapr/dapr/pkg/internal/loader/disk"
 	"github.com/dapr/dapr/pkg/internal/loader/kubernetes"
 	"github.com/dapr/dapr/pkg/messaging"
+	"github.com/dapr/dapr/pkg/messaging/httpendpoints"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	middlewarehttp "github.com/dapr/dapr/pkg/middleware/http"
 	"github.com/dapr/dapr/pkg/modes"
@@ -94,11 +96,13 @@ var log = logger.NewLogger("dapr.runtime")
 type DaprRuntime struct {
 	runtimeConfig     *internalConfig
 	globalConfig      *config.Configuration
-	accessControlList *config.AccessControlList
+	accessControlList *config.Reloadable[config.AccessControlList]
+	apiSpec           *config.Reloadable[config.APISpec]
 	grpc              *manager.Manager
 	channels          *channels.Channels
 	appConfig         config.ApplicationConfig
 	directMessaging   invokev1.DirectMessaging
+	httpEndpoints     *httpendpoints.Balancer
 	actors            actors.Interface
 	wfengine          wfengine.Interface
 
@@ -139,6 +143,7 @@ type DaprRuntime struct {
 	resiliency resiliency.Provider
 
 	tracerProvider *sdktrace.TracerProvider
+	traceSampler   *diag.DaprTraceSampler
 
 	wg sync.WaitGroup
 }
@@ -149,7 +154,7 @@ func newDaprRuntime(ctx context.Context,
 	runtimeConfig *internalConfig,
 	globalConfig *config.Configuration,
 	accessControlList *config.AccessControlList,
-	resiliencyProvider resiliency.Provider,
+	resiliencyConfig *resiliency.Resiliency,
 ) (*DaprRuntime, error) {
 	// TODO: @joshvanl: find a solution for this:
 	// We need to register our custom proxy codec in the global registrar, but
@@ -171,6 +176,13 @@ func newDaprRuntime(ctx context.Context,
 
 	compStore := compstore.New()
 
+	// Resiliency policies, the access control list, API allow lists and the
+	// trace sampling rate can be updated by the hot reloader while running.
+	resiliencyProvider := resiliency.NewReloadable(resiliencyConfig)
+	reloadableACL := config.NewReloadable(accessControlList)
+	apiSpec := config.NewReloadable(ptr.Of(globalConfig.GetAPISpec()))
+	traceSampler := diag.NewDaprTraceSampler(globalConfig.GetTracingSpec().SamplingRate)
+
 	namespace := security.CurrentNamespace()
 	podName := getPodName()
 
@@ -232,6 +244,8 @@ func newDaprRuntime(ctx context.Context,
 		Port:      runtimeConfig.internalGRPCPort,
 		// TODO: @joshvanl
 		PlacementAddresses: strings.Split(strings.TrimPrefix(runtimeConfig.actorsService, "placement:"), ","),
+		//nolint:gosec
+		PlacementWeight:    uint32(runtimeConfig.actorsPlacementWeight),
 		SchedulerReminders: globalConfig.IsFeatureEnabled(config.SchedulerReminders),
 		HealthEndpoint:     channels.AppHTTPEndpoint(),
 		Resiliency:         resiliencyProvider,
@@ -243,6 +257,21 @@ func newDaprRuntime(ctx context.Context,
 		Mode:               runtimeConfig.mode,
 	})
 
+	// The results of loading resources are reported to the operator, which
+	// surfaces them in the status of the resources.
+	var (
+		statusReporter    processor.StatusReporter
+		runStatusReporter concurrency.Runner
+	)
+	if runtimeConfig.mode == modes.KubernetesMode {
+		sr := client.NewStatusReporter(client.StatusReporterOptions{
+			Client:    operatorClient,
+			Namespace: namespace,
+			PodName:   podName,
+		})
+		statusReporter, runStatusReporter = sr, sr.Run
+	}
+
 	processor := processor.New(processor.Options{
 		ID:              runtimeConfig.id,
 		Namespace:       namespace,
@@ -265,30 +294,48 @@ func newDaprRuntime(ctx context.Context,
 		Adapter:         pubsubAdapter,
 		AdapterStreamer: pubsubAdapterStreamer,
 		Reporter:        runtimeConfig.registry.Reporter(),
+		StatusReporter:  statusReporter,
 	})
 
 	var reloader *hotreload.Reloader
 	switch runtimeConfig.mode {
 	case modes.KubernetesMode:
+		var configName string
+		if len(runtimeConfig.config) > 0 {
+			configName = runtimeConfig.config[0]
+		}
 		reloader = hotreload.NewOperator(hotreload.OptionsReloaderOperator{
-			PodName:        podName,
-			Namespace:      namespace,
-			Client:         operatorClient,
-			Config:         globalConfig,
-			ComponentStore: compStore,
-			Authorizer:     authz,
-			Processor:      processor,
-			Healthz:        runtimeConfig.healthz,
+			AppID:             runtimeConfig.id,
+			PodName:           podName,
+			Namespace:         namespace,
+			ConfigName:        configName,
+			Client:            operatorClient,
+			Config:            globalConfig,
+			IsHTTP:            runtimeConfig.appConnectionConfig.Protocol.IsHTTP(),
+			ComponentStore:    compStore,
+			Authorizer:        authz,
+			Processor:         processor,
+			Resiliency:        resiliencyProvider,
+			AccessControlList: reloadableACL,
+			APISpec:           apiSpec,
+			TraceSampler:      traceSampler,
+			Healthz:           runtimeConfig.healthz,
 		})
 	case modes.StandaloneMode:
 		reloader, err = hotreload.NewDisk(hotreload.OptionsReloaderDisk{
-			Config:         globalConfig,
-			Dirs:           runtimeConfig.standalone.ResourcesPath,
-			ComponentStore: compStore,
-			Authorizer:     authz,
-			Processor:      processor,
-			AppID:          runtimeConfig.id,
-			Healthz:        runtimeConfig.healthz,
+			Config:            globalConfig,
+			Dirs:              runtimeConfig.standalone.ResourcesPath,
+			ConfigPaths:       runtimeConfig.config,
+			IsHTTP:            runtimeConfig.appConnectionConfig.Protocol.IsHTTP(),
+			ComponentStore:    compStore,
+			Authorizer:        authz,
+			Processor:         processor,
+			AppID:             runtimeConfig.id,
+			Resiliency:        resiliencyProvider,
+			AccessControlList: reloadableACL,
+			APISpec:           apiSpec,
+			TraceSampler:      traceSampler,
+			Healthz:           runtimeConfig.healthz,
 		})
 		if err != nil {
 			return nil, err
@@ -312,9 +359,11 @@ func newDaprRuntime(ctx context.Context,
 	rt := &DaprRuntime{
 		runtimeConfig:         runtimeConfig,
 		globalConfig:          globalConfig,
-		accessControlList:     accessControlList,
+		accessControlList:     reloadableACL,
+		apiSpec:               apiSpec,
 		grpc:                  grpc,
 		tracerProvider:        nil,
+		traceSampler:          traceSampler,
 		resiliency:            resiliencyProvider,
 		appHealthReady:        nil,
 		compStore:             compStore,
@@ -348,6 +397,10 @@ func newDaprRuntime(ctx context.Context,
 		httpMiddleware: httpMiddleware,
 		actors:         actors,
 		wfengine:       wfe,
+		httpEndpoints: httpendpoints.New(httpendpoints.Options{
+			CompStore: compStore,
+			Channels:  channels,
+		}),
 	}
 	close(rt.isAppHealthy)
 
@@ -364,6 +417,7 @@ func newDaprRuntime(ctx context.Context,
 		rt.actors.Run,
 		rt.wfengine.Run,
 		rt.jobsManager.Run,
+		rt.httpEndpoints.Run,
 		func(ctx context.Context) error {
 			start := time.Now()
 			log.Infof("%s mode configured", rt.runtimeConfig.mode)
@@ -399,6 +453,12 @@ func newDaprRuntime(ctx context.Context,
 		},
 	)
 
+	if runStatusReporter != nil {
+		if err := rt.runnerCloser.Add(runStatusReporter); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := rt.runnerCloser.AddCloser(
 		func() error {
 			log.Info("Dapr is shutting down")
@@ -562,7 +622,12 @@ func (a *DaprRuntime) setupTracing(ctx context.Context, hostAddress string, tpSt
 	tpStore.RegisterResource(r)
 
 	// Register a trace sampler based on Sampling settings
-	daprTraceSampler := diag.NewDaprTraceSampler(tracingSpec.SamplingRate)
+	if a.traceSampler == nil {
+		a.traceSampler = diag.NewDaprTraceSampler(tracingSpec.SamplingRate)
+	} else {
+		a.traceSampler.SetSamplingRate(tracingSpec.SamplingRate)
+	}
+	daprTraceSampler := a.traceSampler
 	log.Infof("Dapr trace sampler initialized: %s", daprTraceSampler.Description())
 
 	tpStore.RegisterSampler(daprTraceSampler)
@@ -641,6 +706,10 @@ func (a *DaprRuntime) initRuntime(ctx context.Context) error {
 		Scheduler:                   a.jobsManager.Client(),
 		Actors:                      a.actors,
 		WorkflowEngine:              a.wfengine,
+		Security:                    a.sec,
+		HTTPEndpoints:               a.httpEndpoints,
+		DirectMessaging:             a.directMessaging,
+		SendToOutputBindingFn:       a.processor.Binding().SendToOutputBinding,
 	})
 
 	// Create and start internal and external gRPC servers
@@ -856,6 +925,7 @@ func (a *DaprRuntime) initDirectMessaging(resolver nr.Resolver) {
 		ReadBufferSize:     a.runtimeConfig.readBufferSize,
 		Resiliency:         a.resiliency,
 		CompStore:          a.compStore,
+		HTTPEndpoints:      a.httpEndpoints,
 	})
 	a.runnerCloser.AddCloser(a.directMessaging)
 }
@@ -911,7 +981,7 @@ func (a *DaprRuntime) startHTTPServer() error {
 		TracingSpec: a.globalConfig.GetTracingSpec(),
 		MetricSpec:  a.globalConfig.GetMetricsSpec(),
 		Middleware:  a.httpMiddleware.BuildPipelineFromSpec("server", a.globalConfig.Spec.HTTPPipelineSpec),
-		APISpec:     a.globalConfig.GetAPISpec(),
+		APISpec:     a.apiSpec,
 	})
 	if err := server.StartNonBlocking(); err != nil {
 		return err
@@ -950,7 +1020,7 @@ func (a *DaprRuntime) startGRPCAPIServer(api grpc.API, port int) error {
 		Config:         serverConf,
 		TracingSpec:    a.globalConfig.GetTracingSpec(),
 		MetricSpec:     a.globalConfig.GetMetricsSpec(),
-		APISpec:        a.globalConfig.GetAPISpec(),
+		APISpec:        a.apiSpec,
 		Proxy:          a.proxy,
 		WorkflowEngine: a.wfengine,
 		Healthz:        a.runtimeConfig.healthz,
@@ -967,8 +1037,8 @@ func (a *DaprRuntime) getNewServerConfig(apiListenAddresses []string, port int)
 	// Use the trust domain value from the access control policy spec to generate the cert
 	// If no access control policy has been specified, use a default value
 	trustDomain := config.DefaultTrustDomain
-	if a.accessControlList != nil {
-		trustDomain = a.accessControlList.TrustDomain
+	if accessControlList := a.accessControlList.Load(); accessControlList != nil {
+		trustDomain = accessControlList.TrustDomain
 	}
 	return grpc.ServerConfig{
 		AppID:              a.runtimeConfig.id,
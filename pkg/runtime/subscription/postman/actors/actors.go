@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dapr/components-contrib/contenttype"
+	contribpubsub "github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/actors"
+	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
+	"github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/subscription/postman"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.processor.pubsub.subscription.actors")
+
+type Options struct {
+	Actors     actors.Interface
+	Resiliency resiliency.Provider
+	Tracing    *config.TracingSpec
+	Target     *pubsub.ActorTarget
+}
+
+// postmanActors delivers subscribed messages to an actor through the actor
+// router, rather than to the app. The path of the matched route is used as
+// the actor method.
+type postmanActors struct {
+	actors      actors.Interface
+	resiliency  resiliency.Provider
+	tracingSpec *config.TracingSpec
+	target      *pubsub.ActorTarget
+}
+
+func New(opts Options) postman.Interface {
+	return &postmanActors{
+		actors:      opts.Actors,
+		resiliency:  opts.Resiliency,
+		tracingSpec: opts.Tracing,
+		target:      opts.Target,
+	}
+}
+
+func (p *postmanActors) Deliver(ctx context.Context, msg *pubsub.SubscribedMessage) error {
+	cloudEvent := msg.CloudEvent
+
+	actorID, err := p.actorID(cloudEvent)
+	if err != nil {
+		// The message can never be delivered, so there is no point retrying it.
+		log.Warnf("dropping pub/sub event %v as the ID of actor type %s could not be determined: %s", cloudEvent[contribpubsub.IDField], p.target.Type, err)
+		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Drop)), "", msg.Topic, 0)
+		return pubsub.ErrMessageDropped
+	}
+
+	router, err := p.actors.Router(ctx)
+	if err != nil {
+		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Retry)), "", msg.Topic, 0)
+		return fmt.Errorf("error getting actor router while sending pub/sub event to actor: %w", rterrors.NewRetriable(err))
+	}
+
+	md := make(map[string][]string, len(msg.Metadata))
+	for k, v := range msg.Metadata {
+		md[k] = []string{v}
+	}
+
+	req := internalv1pb.NewInternalInvokeRequest(strings.TrimPrefix(msg.Path, "/")).
+		WithActor(p.target.Type, actorID).
+		WithData(msg.Data).
+		WithContentType(contenttype.CloudEventContentType).
+		WithMetadata(md)
+
+	var span trace.Span
+	iTraceID := cloudEvent[contribpubsub.TraceParentField]
+	if iTraceID == nil {
+		iTraceID = cloudEvent[contribpubsub.TraceIDField]
+	}
+	if traceID, ok := iTraceID.(string); ok {
+		sc, _ := diag.SpanContextFromW3CString(traceID)
+		ctx, span = diag.StartInternalCallbackSpan(ctx, "pubsub/"+msg.Topic, sc, p.tracingSpec)
+	}
+
+	// Actor invocations are retried before the actor is locked, as the
+	// actor may move between hosts.
+	policyDef := p.resiliency.ActorPreLockPolicy(p.target.Type, actorID)
+	policyRunner := resiliency.NewRunner[*internalv1pb.InternalInvokeResponse](ctx, policyDef)
+
+	start := time.Now()
+	resp, err := policyRunner(func(ctx context.Context) (*internalv1pb.InternalInvokeResponse, error) {
+		return router.Call(ctx, req)
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	if span != nil {
+		diag.AddAttributesToSpan(span, diag.ConstructSubscriptionSpanAttributes(msg.Topic))
+		diag.UpdateSpanStatusFromGRPCError(span, err)
+		span.End()
+	}
+
+	if err != nil {
+		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Retry)), "", msg.Topic, elapsed)
+		return fmt.Errorf("error returned from actor %s/%s while processing pub/sub event %v: %w", p.target.Type, actorID, cloudEvent[contribpubsub.IDField], rterrors.NewRetriable(err))
+	}
+
+	var appResponse contribpubsub.AppResponse
+	if data := resp.GetMessage().GetData().GetValue(); len(data) > 0 {
+		if err := json.Unmarshal(data, &appResponse); err != nil {
+			log.Debugf("skipping status check due to error parsing result from pub/sub event %v: %s", cloudEvent[contribpubsub.IDField], err)
+		}
+	}
+
+	switch appResponse.Status {
+	case "":
+		// Consider empty status field as success
+		fallthrough
+	case contribpubsub.Success:
+		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Success)), "", msg.Topic, elapsed)
+		return nil
+	case contribpubsub.Retry:
+		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Retry)), "", msg.Topic, elapsed)
+		return fmt.Errorf("RETRY status returned from actor while processing pub/sub event %v: %w", cloudEvent[contribpubsub.IDField], rterrors.NewRetriable(nil))
+	case contribpubsub.Drop:
+		diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Drop)), strings.ToLower(string(contribpubsub.Success)), msg.Topic, elapsed)
+		log.Warnf("DROP status returned from actor while processing pub/sub event %v", cloudEvent[contribpubsub.IDField])
+		return pubsub.ErrMessageDropped
+	}
+
+	// Consider unknown status field as error and retry
+	diag.DefaultComponentMonitoring.PubsubIngressEvent(ctx, msg.PubSub, strings.ToLower(string(contribpubsub.Retry)), "", msg.Topic, elapsed)
+	return fmt.Errorf("unknown status returned from actor while processing pub/sub event %v, status: %v, err: %w", cloudEvent[contribpubsub.IDField], appResponse.Status, rterrors.NewRetriable(nil))
+}
+
+func (p *postmanActors) DeliverBulk(context.Context, *postman.DeliverBulkRequest) error {
+	return errors.New("bulk subscribe is not supported when delivering to an actor")
+}
+
+// actorID returns the ID of the actor which the given event is delivered to.
+func (p *postmanActors) actorID(cloudEvent map[string]any) (string, error) {
+	if p.target.IDExpr == nil {
+		id := pubsub.ExtractCloudEventProperty(cloudEvent, p.target.IDAttribute)
+		if len(id) == 0 {
+			return "", fmt.Errorf("attribute %q is missing or is not a string", p.target.IDAttribute)
+		}
+		return id, nil
+	}
+
+	res, err := p.target.IDExpr.Eval(map[string]any{"event": cloudEvent})
+	if err != nil {
+		return "", fmt.Errorf("failed to evaluate expression %s: %w", p.target.IDExpr, err)
+	}
+	id, ok := res.(string)
+	if !ok || len(id) == 0 {
+		return "", fmt.Errorf("expression %s did not return a non-empty string", p.target.IDExpr)
+	}
+	return id, nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/dapr/components-contrib/contenttype"
+	actorsfake "github.com/dapr/dapr/pkg/actors/fake"
+	"github.com/dapr/dapr/pkg/actors/router"
+	routerfake "github.com/dapr/dapr/pkg/actors/router/fake"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/resiliency"
+	rterrors "github.com/dapr/dapr/pkg/runtime/errors"
+	"github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/dapr/dapr/pkg/runtime/subscription/postman"
+)
+
+func Test_Deliver(t *testing.T) {
+	var _ postman.Interface = New(Options{})
+
+	msg := func() *pubsub.SubscribedMessage {
+		return &pubsub.SubscribedMessage{
+			CloudEvent: map[string]any{
+				"id":      "event-1",
+				"subject": "order-1",
+				"data":    map[string]any{"customer": "cust-1"},
+			},
+			Data:     []byte(`{"id":"event-1"}`),
+			Topic:    "orders",
+			PubSub:   "mypubsub",
+			Path:     "/onorder",
+			Metadata: map[string]string{"pubsubName": "mypubsub"},
+		}
+	}
+
+	respond := func(data string) *internalv1pb.InternalInvokeResponse {
+		return &internalv1pb.InternalInvokeResponse{
+			Status:  &internalv1pb.Status{Code: 200},
+			Message: &commonv1pb.InvokeResponse{Data: &anypb.Any{Value: []byte(data)}},
+		}
+	}
+
+	newPostman := func(t *testing.T, target *pubsub.ActorTarget, fn func(context.Context, *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error)) postman.Interface {
+		t.Helper()
+		return New(Options{
+			Actors: actorsfake.New().WithRouter(func(context.Context) (router.Interface, error) {
+				return routerfake.New().WithCallFn(fn), nil
+			}),
+			Resiliency: resiliency.NoOp{},
+			Target:     target,
+		})
+	}
+
+	t.Run("actor ID from attribute", func(t *testing.T) {
+		target, err := pubsub.CreateActorTarget("order", "subject", "")
+		require.NoError(t, err)
+
+		var got *internalv1pb.InternalInvokeRequest
+		p := newPostman(t, target, func(_ context.Context, req *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
+			got = req
+			return respond(""), nil
+		})
+
+		require.NoError(t, p.Deliver(t.Context(), msg()))
+		require.NotNil(t, got)
+		assert.Equal(t, "order", got.GetActor().GetActorType())
+		assert.Equal(t, "order-1", got.GetActor().GetActorId())
+		assert.Equal(t, "onorder", got.GetMessage().GetMethod())
+		assert.Equal(t, contenttype.CloudEventContentType, got.GetMessage().GetContentType())
+		assert.Equal(t, `{"id":"event-1"}`, string(got.GetMessage().GetData().GetValue()))
+		assert.Equal(t, []string{"mypubsub"}, got.GetMetadata()["pubsubName"].GetValues())
+	})
+
+	t.Run("actor ID from expression", func(t *testing.T) {
+		target, err := pubsub.CreateActorTarget("customer", "", "event.data.customer")
+		require.NoError(t, err)
+
+		var got *internalv1pb.InternalInvokeRequest
+		p := newPostman(t, target, func(_ context.Context, req *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
+			got = req
+			return respond(""), nil
+		})
+
+		require.NoError(t, p.Deliver(t.Context(), msg()))
+		require.NotNil(t, got)
+		assert.Equal(t, "customer", got.GetActor().GetActorType())
+		assert.Equal(t, "cust-1", got.GetActor().GetActorId())
+	})
+
+	t.Run("missing actor ID drops the message", func(t *testing.T) {
+		for _, target := range []*pubsub.ActorTarget{
+			must(pubsub.CreateActorTarget("order", "missing", "")),
+			must(pubsub.CreateActorTarget("order", "", "event.data")),
+		} {
+			p := newPostman(t, target, func(context.Context, *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
+				assert.Fail(t, "actor must not be called")
+				return nil, nil
+			})
+			require.ErrorIs(t, p.Deliver(t.Context(), msg()), pubsub.ErrMessageDropped)
+		}
+	})
+
+	t.Run("app response status", func(t *testing.T) {
+		target := must(pubsub.CreateActorTarget("order", "subject", ""))
+		for body, expErr := range map[string]error{
+			`{"status":"SUCCESS"}`: nil,
+			`not json`:             nil,
+			`{"status":"DROP"}`:    pubsub.ErrMessageDropped,
+		} {
+			p := newPostman(t, target, func(context.Context, *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
+				return respond(body), nil
+			})
+			assert.ErrorIs(t, p.Deliver(t.Context(), msg()), expErr, body)
+		}
+
+		for _, body := range []string{`{"status":"RETRY"}`, `{"status":"UNKNOWN"}`} {
+			p := newPostman(t, target, func(context.Context, *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
+				return respond(body), nil
+			})
+			var rErr *rterrors.RetriableError
+			require.ErrorAs(t, p.Deliver(t.Context(), msg()), &rErr, body)
+		}
+	})
+
+	t.Run("actor errors are retriable", func(t *testing.T) {
+		p := newPostman(t, must(pubsub.CreateActorTarget("order", "subject", "")), func(context.Context, *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
+			return nil, errors.New("boom")
+		})
+		err := p.Deliver(t.Context(), msg())
+		var rErr *rterrors.RetriableError
+		require.ErrorAs(t, err, &rErr)
+		require.ErrorContains(t, err, "boom")
+	})
+}
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
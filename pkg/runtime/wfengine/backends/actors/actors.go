@@ -36,6 +36,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors/targets/workflow"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/activity"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/common"
+	"github.com/dapr/dapr/pkg/actors/targets/workflow/index"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/orchestrator"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
@@ -60,6 +61,7 @@ const (
 	WorkflowNameLabelKey = "workflow"
 	ActivityNameLabelKey = "activity"
 	ExecutorNameLabelKey = "executor"
+	IndexNameLabelKey    = "workflowindex"
 	ActorTypePrefix      = "dapr.internal."
 )
 
@@ -83,6 +85,7 @@ type Actors struct {
 	workflowActorType string
 	activityActorType string
 	executorActorType string
+	indexActorType    string
 
 	enableClusteredDeployment bool
 	pendingTasksBackend       PendingTasksBackend
@@ -110,6 +113,7 @@ func New(opts Options) *Actors {
 		workflowActorType:         ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + WorkflowNameLabelKey,
 		activityActorType:         ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + ActivityNameLabelKey,
 		executorActorType:         ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + ExecutorNameLabelKey,
+		indexActorType:            ActorTypePrefix + opts.Namespace + utils.DotDelimiter + opts.AppID + utils.DotDelimiter + IndexNameLabelKey,
 		actors:                    opts.Actors,
 		resiliency:                opts.Resiliency,
 		schedulerReminders:        opts.SchedulerReminders,
@@ -132,6 +136,7 @@ func (abe *Actors) RegisterActors(ctx context.Context) error {
 		AppID:             abe.appID,
 		WorkflowActorType: abe.workflowActorType,
 		ActivityActorType: abe.activityActorType,
+		IndexActorType:    abe.indexActorType,
 		ReminderInterval:  abe.defaultReminderInterval,
 		Resiliency:        abe.resiliency,
 		Actors:            abe.actors,
@@ -177,6 +182,14 @@ func (abe *Actors) RegisterActors(ctx context.Context) error {
 		return err
 	}
 
+	indexFactory, err := index.New(ctx, index.Options{
+		ActorType: abe.indexActorType,
+		Actors:    abe.actors,
+	})
+	if err != nil {
+		return err
+	}
+
 	factories := []table.ActorTypeFactory{
 		{
 			Factory: workflowFactory,
@@ -186,6 +199,10 @@ func (abe *Actors) RegisterActors(ctx context.Context) error {
 			Factory: activityFactory,
 			Type:    abe.activityActorType,
 		},
+		{
+			Factory: indexFactory,
+			Type:    abe.indexActorType,
+		},
 	}
 
 	if abe.enableClusteredDeployment {
@@ -215,7 +232,7 @@ func (abe *Actors) UnRegisterActors(ctx context.Context) error {
 		return err
 	}
 
-	actorTypes := []string{abe.workflowActorType, abe.activityActorType}
+	actorTypes := []string{abe.workflowActorType, abe.activityActorType, abe.indexActorType}
 	if abe.enableClusteredDeployment {
 		actorTypes = append(actorTypes, abe.executorActorType)
 	}
@@ -0,0 +1,297 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	actorapi "github.com/dapr/dapr/pkg/actors/api"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/durabletask-go/backend/runtimestate"
+)
+
+const (
+	// ReminderActorKindWorkflow is the kind of the reminders owned by a
+	// workflow actor.
+	ReminderActorKindWorkflow = "workflow"
+	// ReminderActorKindActivity is the kind of the reminders owned by an
+	// activity actor.
+	ReminderActorKindActivity = "activity"
+)
+
+// ErrInstanceExists is returned when importing a workflow instance which
+// already exists in the workflow state store.
+var ErrInstanceExists = errors.New("workflow instance already exists")
+
+// ExportedInstance is a portable representation of a workflow instance. It
+// refers to actors by kind rather than actor type, so it can be imported by an
+// app with a different app ID or namespace.
+type ExportedInstance struct {
+	InstanceID string
+	State      *backend.WorkflowState
+	Reminders  []*ExportedReminder
+}
+
+// ExportedReminder is a pending reminder of a workflow instance or of one of
+// its activities.
+type ExportedReminder struct {
+	ActorKind string
+	ActorID   string
+	Name      string
+	DueTime   string
+	Period    string
+	Data      *anypb.Any
+}
+
+// ExportInstances calls fn with every workflow instance which matches the
+// query, ordered by creation time, then instance ID. The instances are found
+// in the workflow index. Instances which were not saved since the workflow
+// index was introduced have no index entry; those with pending reminders are
+// found from their reminders. The index entries are read first, then the state
+// of each instance is loaded from the workflow state store one at a time.
+func (abe *Actors) ExportInstances(ctx context.Context, query *internalsv1pb.WorkflowIndexQuery, fn func(*ExportedInstance) error) error {
+	indexed, err := abe.queryIndex(ctx, new(internalsv1pb.WorkflowIndexQuery))
+	if err != nil {
+		return err
+	}
+
+	unindexed, err := abe.unindexedInstances(ctx, indexed)
+	if err != nil {
+		return err
+	}
+
+	var entries []*internalsv1pb.WorkflowIndexEntry
+	for _, e := range slices.Concat(indexed, unindexed) {
+		if state.IndexEntryMatches(e, query) {
+			entries = append(entries, e)
+		}
+	}
+	slices.SortFunc(entries, state.CompareIndexEntries)
+
+	for _, e := range entries {
+		wState, err := abe.loadInternalState(ctx, api.InstanceID(e.GetInstanceId()))
+		if err != nil {
+			return fmt.Errorf("failed to load state of workflow instance '%s': %w", e.GetInstanceId(), err)
+		}
+		if wState == nil {
+			// The instance was purged after the index was queried.
+			continue
+		}
+
+		reminders, err := abe.pendingReminders(ctx, e.GetInstanceId())
+		if err != nil {
+			return err
+		}
+
+		err = fn(&ExportedInstance{
+			InstanceID: e.GetInstanceId(),
+			State:      wState.ToWorkflowState(),
+			Reminders:  reminders,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unindexedInstances returns index entries for the instances which have
+// pending reminders but are not in the given index entries.
+func (abe *Actors) unindexedInstances(ctx context.Context, indexed []*internalsv1pb.WorkflowIndexEntry) ([]*internalsv1pb.WorkflowIndexEntry, error) {
+	reminders, err := abe.actors.Reminders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]struct{}, len(indexed))
+	for _, e := range indexed {
+		known[e.GetInstanceId()] = struct{}{}
+	}
+
+	var entries []*internalsv1pb.WorkflowIndexEntry
+	for _, actorType := range []string{abe.workflowActorType, abe.activityActorType} {
+		list, err := reminders.List(ctx, &actorapi.ListRemindersRequest{ActorType: actorType})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list reminders of actor type '%s': %w", actorType, err)
+		}
+
+		for _, r := range list {
+			// Activity actor IDs are prefixed with the ID of their workflow
+			// instance.
+			instanceID, _, _ := strings.Cut(r.ActorID, "::")
+			if _, ok := known[instanceID]; ok {
+				continue
+			}
+			known[instanceID] = struct{}{}
+
+			wState, err := abe.loadInternalState(ctx, api.InstanceID(instanceID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to load state of workflow instance '%s': %w", instanceID, err)
+			}
+			if wState == nil {
+				continue
+			}
+
+			rstate := runtimestate.NewOrchestrationRuntimeState(instanceID, wState.CustomStatus, wState.History)
+			name, _ := runtimestate.Name(rstate)
+			createdAt, _ := runtimestate.CreatedTime(rstate)
+			lastUpdated, _ := runtimestate.LastUpdatedTime(rstate)
+			entries = append(entries, &internalsv1pb.WorkflowIndexEntry{
+				InstanceId:    instanceID,
+				Name:          name,
+				RuntimeStatus: int32(runtimestate.RuntimeStatus(rstate)),
+				CreatedAt:     timestamppb.New(createdAt),
+				LastUpdatedAt: timestamppb.New(lastUpdated),
+			})
+		}
+	}
+
+	return entries, nil
+}
+
+// pendingReminders returns the reminders of the workflow actor of the
+// instance and of the activity actors of the instance.
+func (abe *Actors) pendingReminders(ctx context.Context, instanceID string) ([]*ExportedReminder, error) {
+	reminders, err := abe.actors.Reminders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []*ExportedReminder
+	for _, target := range []struct {
+		kind      string
+		actorType string
+		// Activity actor IDs are prefixed with the ID of their workflow
+		// instance.
+		actorIDPrefix string
+	}{
+		{kind: ReminderActorKindWorkflow, actorType: abe.workflowActorType, actorIDPrefix: instanceID},
+		{kind: ReminderActorKindActivity, actorType: abe.activityActorType, actorIDPrefix: instanceID + "::"},
+	} {
+		list, err := reminders.List(ctx, &actorapi.ListRemindersRequest{
+			ActorType:     target.actorType,
+			ActorIDPrefix: target.actorIDPrefix,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list reminders of actor type '%s': %w", target.actorType, err)
+		}
+
+		for _, r := range list {
+			// The workflow actor ID prefix also matches other instances whose
+			// ID starts with this one.
+			if target.kind == ReminderActorKindWorkflow && r.ActorID != instanceID {
+				continue
+			}
+			pending = append(pending, &ExportedReminder{
+				ActorKind: target.kind,
+				ActorID:   r.ActorID,
+				Name:      r.Name,
+				DueTime:   r.DueTime,
+				Period:    r.Period.String(),
+				Data:      r.Data,
+			})
+		}
+	}
+
+	return pending, nil
+}
+
+// ImportInstance writes an exported workflow instance to the workflow state
+// store of this app, adds it to the workflow index and re-creates its pending
+// reminders. Returns ErrInstanceExists if the instance already exists with a
+// different state.
+// The state is written by the workflow actor of the instance, so concurrent
+// imports or creations of the same instance can't overwrite each other. The
+// reminders are created after the state is written, as they would otherwise
+// fire for an instance without state. An import which failed to create the
+// reminders can be retried: an instance which exists with the same state is
+// imported again, and its reminders are re-created.
+func (abe *Actors) ImportInstance(ctx context.Context, inst *ExportedInstance) error {
+	if inst.InstanceID == "" || inst.State == nil {
+		return errors.New("exported workflow instance must have an instance ID and state")
+	}
+
+	data, err := proto.Marshal(inst.State)
+	if err != nil {
+		return fmt.Errorf("failed to marshal state of workflow instance '%s': %w", inst.InstanceID, err)
+	}
+
+	router, err := abe.actors.Router(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The workflow actor may be hosted by another instance of this app.
+	req := internalsv1pb.NewInternalInvokeRequest(todo.ImportWorkflowInstanceMethod).
+		WithActor(abe.workflowActorType, inst.InstanceID).
+		WithData(data).
+		WithContentType(invokev1.ProtobufContentType)
+	if _, err = router.Call(ctx, req); err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return ErrInstanceExists
+		}
+		return fmt.Errorf("failed to import workflow instance '%s': %w", inst.InstanceID, err)
+	}
+
+	if len(inst.Reminders) == 0 {
+		return nil
+	}
+
+	reminders, err := abe.actors.Reminders(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range inst.Reminders {
+		var actorType string
+		switch r.ActorKind {
+		case ReminderActorKindWorkflow:
+			actorType = abe.workflowActorType
+		case ReminderActorKindActivity:
+			actorType = abe.activityActorType
+		default:
+			return fmt.Errorf("unknown actor kind '%s' of reminder '%s'", r.ActorKind, r.Name)
+		}
+
+		err = reminders.Create(ctx, &actorapi.CreateReminderRequest{
+			Name:      r.Name,
+			ActorType: actorType,
+			ActorID:   r.ActorID,
+			Data:      r.Data,
+			DueTime:   r.DueTime,
+			Period:    r.Period,
+			IsOneShot: r.Period == "",
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create reminder '%s' of workflow instance '%s': %w", r.Name, inst.InstanceID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	actorapi "github.com/dapr/dapr/pkg/actors/api"
+	actorsfake "github.com/dapr/dapr/pkg/actors/fake"
+	"github.com/dapr/dapr/pkg/actors/reminders"
+	remindersfake "github.com/dapr/dapr/pkg/actors/reminders/fake"
+	"github.com/dapr/dapr/pkg/actors/router"
+	routerfake "github.com/dapr/dapr/pkg/actors/router/fake"
+	actorstate "github.com/dapr/dapr/pkg/actors/state"
+	statefake "github.com/dapr/dapr/pkg/actors/state/fake"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/durabletask-go/backend"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestImportInstance(t *testing.T) {
+	const (
+		workflowActorType = "dapr.internal.default.myapp.workflow"
+		activityActorType = "dapr.internal.default.myapp.activity"
+	)
+
+	wState := &backend.WorkflowState{
+		Inbox: []*protos.HistoryEvent{{EventId: 1}},
+	}
+
+	newBackend := func(t *testing.T, callFn func(context.Context, *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error)) (*Actors, *[]*actorapi.CreateReminderRequest) {
+		t.Helper()
+
+		var lock sync.Mutex
+		var created []*actorapi.CreateReminderRequest
+		rem := remindersfake.New().WithCreate(func(_ context.Context, req *actorapi.CreateReminderRequest) error {
+			lock.Lock()
+			defer lock.Unlock()
+			created = append(created, req)
+			return nil
+		})
+
+		rtr := routerfake.New().WithCallFn(callFn)
+		return New(Options{
+			AppID:     "myapp",
+			Namespace: "default",
+			Actors: actorsfake.New().
+				WithRouter(func(context.Context) (router.Interface, error) {
+					return rtr, nil
+				}).
+				WithReminders(func(context.Context) (reminders.Interface, error) {
+					return rem, nil
+				}),
+		}), &created
+	}
+
+	t.Run("imports the state in the workflow actor and creates reminders", func(t *testing.T) {
+		abe, created := newBackend(t, func(_ context.Context, req *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error) {
+			assert.Equal(t, workflowActorType, req.GetActor().GetActorType())
+			assert.Equal(t, "abc", req.GetActor().GetActorId())
+			assert.Equal(t, todo.ImportWorkflowInstanceMethod, req.GetMessage().GetMethod())
+
+			var got backend.WorkflowState
+			if err := proto.Unmarshal(req.GetMessage().GetData().GetValue(), &got); err != nil {
+				return nil, err
+			}
+			assert.True(t, proto.Equal(wState, &got))
+			return &internalsv1pb.InternalInvokeResponse{}, nil
+		})
+
+		err := abe.ImportInstance(t.Context(), &ExportedInstance{
+			InstanceID: "abc",
+			State:      wState,
+			Reminders: []*ExportedReminder{
+				{ActorKind: ReminderActorKindWorkflow, ActorID: "abc", Name: "new-event-0", DueTime: "1s"},
+				{ActorKind: ReminderActorKindActivity, ActorID: "abc::0::1", Name: "run-activity", DueTime: "1s", Period: "1m"},
+			},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, *created, 2)
+		assert.Equal(t, workflowActorType, (*created)[0].ActorType)
+		assert.True(t, (*created)[0].IsOneShot)
+		assert.Equal(t, activityActorType, (*created)[1].ActorType)
+		assert.Equal(t, "abc::0::1", (*created)[1].ActorID)
+		assert.False(t, (*created)[1].IsOneShot)
+	})
+
+	t.Run("existing instance is not overwritten", func(t *testing.T) {
+		abe, created := newBackend(t, func(context.Context, *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error) {
+			return nil, fmt.Errorf("actor error: %w", status.Error(codes.AlreadyExists, "exists"))
+		})
+
+		err := abe.ImportInstance(t.Context(), &ExportedInstance{
+			InstanceID: "abc",
+			State:      wState,
+			Reminders: []*ExportedReminder{
+				{ActorKind: ReminderActorKindWorkflow, ActorID: "abc", Name: "new-event-0", DueTime: "1s"},
+			},
+		})
+		require.ErrorIs(t, err, ErrInstanceExists)
+		assert.Empty(t, *created)
+	})
+
+	t.Run("actor error", func(t *testing.T) {
+		abe, created := newBackend(t, func(context.Context, *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error) {
+			return nil, errors.New("boom")
+		})
+
+		err := abe.ImportInstance(t.Context(), &ExportedInstance{InstanceID: "abc", State: wState})
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrInstanceExists)
+		assert.Empty(t, *created)
+	})
+}
+
+func TestExportInstances(t *testing.T) {
+	const (
+		workflowActorType = "dapr.internal.default.myapp.workflow"
+		activityActorType = "dapr.internal.default.myapp.activity"
+	)
+
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	started, err := proto.Marshal(&protos.HistoryEvent{
+		EventId:   -1,
+		Timestamp: timestamppb.New(created),
+		EventType: &protos.HistoryEvent_ExecutionStarted{
+			ExecutionStarted: &protos.ExecutionStartedEvent{Name: "wf"},
+		},
+	})
+	require.NoError(t, err)
+	metadata, err := proto.Marshal(&backend.WorkflowStateMetadata{HistoryLength: 1})
+	require.NoError(t, err)
+
+	// Only "indexed" is in the workflow index; "old" was last saved before the
+	// index was introduced and is found from its reminders.
+	rtr := routerfake.New().WithCallFn(func(_ context.Context, req *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error) {
+		var entries []*internalsv1pb.WorkflowIndexEntry
+		if req.GetActor().GetActorId() == state.IndexShardID("indexed") {
+			entries = append(entries, &internalsv1pb.WorkflowIndexEntry{
+				InstanceId:    "indexed",
+				Name:          "wf",
+				RuntimeStatus: int32(protos.OrchestrationStatus_ORCHESTRATION_STATUS_RUNNING),
+				CreatedAt:     timestamppb.New(created.Add(time.Minute)),
+			})
+		}
+		data, err := proto.Marshal(&internalsv1pb.WorkflowIndex{Entries: entries})
+		if err != nil {
+			return nil, err
+		}
+		return &internalsv1pb.InternalInvokeResponse{
+			Message: &commonv1pb.InvokeResponse{Data: &anypb.Any{Value: data}},
+		}, nil
+	})
+
+	all := []*actorapi.Reminder{
+		{ActorType: workflowActorType, ActorID: "indexed", Name: "new-event-0"},
+		{ActorType: workflowActorType, ActorID: "old", Name: "new-event-0"},
+		{ActorType: activityActorType, ActorID: "old::0::1", Name: "run-activity"},
+		{ActorType: activityActorType, ActorID: "purged::0::1", Name: "run-activity"},
+	}
+	rem := remindersfake.New().WithList(func(_ context.Context, req *actorapi.ListRemindersRequest) ([]*actorapi.Reminder, error) {
+		var list []*actorapi.Reminder
+		for _, r := range all {
+			if r.ActorType == req.ActorType && strings.HasPrefix(r.ActorID, req.ActorIDPrefix) {
+				list = append(list, r)
+			}
+		}
+		return list, nil
+	})
+
+	astate := statefake.New().
+		WithGetFn(func(_ context.Context, req *actorapi.GetStateRequest, _ bool) (*actorapi.StateResponse, error) {
+			if req.ActorID == "purged" {
+				return &actorapi.StateResponse{}, nil
+			}
+			return &actorapi.StateResponse{Data: metadata}, nil
+		}).
+		WithGetBulkFn(func(context.Context, *actorapi.GetBulkStateRequest, bool) (actorapi.BulkStateResponse, error) {
+			return actorapi.BulkStateResponse{"history-000000": started}, nil
+		})
+
+	abe := New(Options{
+		AppID:     "myapp",
+		Namespace: "default",
+		Actors: actorsfake.New().
+			WithRouter(func(context.Context) (router.Interface, error) {
+				return rtr, nil
+			}).
+			WithReminders(func(context.Context) (reminders.Interface, error) {
+				return rem, nil
+			}).
+			WithState(func(context.Context) (actorstate.Interface, error) {
+				return astate, nil
+			}),
+	})
+
+	export := func(t *testing.T, query *internalsv1pb.WorkflowIndexQuery) []*ExportedInstance {
+		t.Helper()
+		var got []*ExportedInstance
+		require.NoError(t, abe.ExportInstances(t.Context(), query, func(inst *ExportedInstance) error {
+			got = append(got, inst)
+			return nil
+		}))
+		return got
+	}
+
+	t.Run("instances without index entry are found from their reminders", func(t *testing.T) {
+		got := export(t, nil)
+		require.Len(t, got, 2)
+		assert.Equal(t, "old", got[0].InstanceID)
+		assert.Len(t, got[0].State.GetHistory(), 1)
+		assert.Len(t, got[0].Reminders, 2)
+		assert.Equal(t, "indexed", got[1].InstanceID)
+	})
+
+	t.Run("instances without index entry are filtered", func(t *testing.T) {
+		got := export(t, &internalsv1pb.WorkflowIndexQuery{Name: ptr.Of("wf")})
+		require.Len(t, got, 2)
+		got = export(t, &internalsv1pb.WorkflowIndexQuery{Name: ptr.Of("other")})
+		assert.Empty(t, got)
+	})
+}
+
+func TestPendingReminders(t *testing.T) {
+	const (
+		workflowActorType = "dapr.internal.default.myapp.workflow"
+		activityActorType = "dapr.internal.default.myapp.activity"
+	)
+
+	all := []*actorapi.Reminder{
+		{ActorType: workflowActorType, ActorID: "abc", Name: "new-event-0"},
+		{ActorType: workflowActorType, ActorID: "abcd", Name: "new-event-0"},
+		{ActorType: workflowActorType, ActorID: "xyz", Name: "new-event-0"},
+		{ActorType: activityActorType, ActorID: "abc::0::1", Name: "run-activity"},
+		{ActorType: activityActorType, ActorID: "abcd::0::1", Name: "run-activity"},
+		{ActorType: "other", ActorID: "abc", Name: "other"},
+	}
+
+	rem := remindersfake.New().WithList(func(_ context.Context, req *actorapi.ListRemindersRequest) ([]*actorapi.Reminder, error) {
+		assert.NotEmpty(t, req.ActorIDPrefix)
+		var list []*actorapi.Reminder
+		for _, r := range all {
+			if r.ActorType == req.ActorType && strings.HasPrefix(r.ActorID, req.ActorIDPrefix) {
+				list = append(list, r)
+			}
+		}
+		return list, nil
+	})
+
+	abe := New(Options{
+		AppID:     "myapp",
+		Namespace: "default",
+		Actors: actorsfake.New().WithReminders(func(context.Context) (reminders.Interface, error) {
+			return rem, nil
+		}),
+	})
+
+	pending, err := abe.pendingReminders(t.Context(), "abc")
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, ReminderActorKindWorkflow, pending[0].ActorKind)
+	assert.Equal(t, "abc", pending[0].ActorID)
+	assert.Equal(t, ReminderActorKindActivity, pending[1].ActorKind)
+	assert.Equal(t, "abc::0::1", pending[1].ActorID)
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+)
+
+// DefaultListInstancesPageSize is the page size used when a list request does
+// not specify one.
+const DefaultListInstancesPageSize = 100
+
+// MaxListInstancesPageSize is the largest page size of a list request. Larger
+// page sizes are capped to it.
+const MaxListInstancesPageSize = 1000
+
+// ErrInvalidContinuationToken is returned when a list request carries a
+// continuation token which was not produced by ListInstances.
+var ErrInvalidContinuationToken = errors.New("invalid continuation token")
+
+// ListInstancesRequest is the request for ListInstances.
+type ListInstancesRequest struct {
+	Query             *internalsv1pb.WorkflowIndexQuery
+	PageSize          uint32
+	ContinuationToken string
+}
+
+// ListInstancesResponse is the response for ListInstances.
+type ListInstancesResponse struct {
+	Instances         []*internalsv1pb.WorkflowIndexEntry
+	ContinuationToken string
+}
+
+// ListInstances lists the workflow instances of this app which match the
+// request query. Instances are read from the workflow index shards and
+// returned ordered by creation time, then instance ID.
+// As instances are spread across the shards by instance ID, every shard is
+// queried for each page. Each shard only returns its first entries after the
+// continuation token, so a page reads at most one page from each shard.
+// Instances which were not saved since the workflow index was introduced are
+// not listed, as they have no index entry.
+func (abe *Actors) ListInstances(ctx context.Context, req *ListInstancesRequest) (*ListInstancesResponse, error) {
+	query := proto.CloneOf(req.Query)
+	if query == nil {
+		query = new(internalsv1pb.WorkflowIndexQuery)
+	}
+	if req.ContinuationToken != "" {
+		var err error
+		query.After, err = decodeContinuationToken(req.ContinuationToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pageSize := int(min(req.PageSize, MaxListInstancesPageSize))
+	if pageSize == 0 {
+		pageSize = DefaultListInstancesPageSize
+	}
+	// One more entry than the page size is requested to tell whether there is
+	// a next page.
+	query.Limit = uint32(pageSize) + 1
+
+	entries, err := abe.queryIndex(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(entries, state.CompareIndexEntries)
+
+	resp := new(ListInstancesResponse)
+	if len(entries) > pageSize {
+		entries = entries[:pageSize]
+		resp.ContinuationToken = encodeContinuationToken(entries[pageSize-1])
+	}
+	resp.Instances = entries
+
+	return resp, nil
+}
+
+// queryIndex sends the query to every index shard concurrently and returns
+// the merged results.
+func (abe *Actors) queryIndex(ctx context.Context, query *internalsv1pb.WorkflowIndexQuery) ([]*internalsv1pb.WorkflowIndexEntry, error) {
+	router, err := abe.actors.Router(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := proto.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+
+	shardIDs := state.IndexShardIDs()
+	results := make([][]*internalsv1pb.WorkflowIndexEntry, len(shardIDs))
+	errs := make([]error, len(shardIDs))
+
+	var wg sync.WaitGroup
+	wg.Add(len(shardIDs))
+	for i, shardID := range shardIDs {
+		go func(i int, shardID string) {
+			defer wg.Done()
+
+			req := internalsv1pb.NewInternalInvokeRequest(todo.QueryWorkflowIndexMethod).
+				WithActor(abe.indexActorType, shardID).
+				WithData(data).
+				WithContentType(invokev1.ProtobufContentType)
+
+			res, err := router.Call(ctx, req)
+			if err != nil {
+				errs[i] = fmt.Errorf("failed to query workflow index shard '%s': %w", shardID, err)
+				return
+			}
+
+			var shard internalsv1pb.WorkflowIndex
+			if err = proto.Unmarshal(res.GetMessage().GetData().GetValue(), &shard); err != nil {
+				errs[i] = fmt.Errorf("failed to unmarshal workflow index shard '%s': %w", shardID, err)
+				return
+			}
+			results[i] = shard.GetEntries()
+		}(i, shardID)
+	}
+	wg.Wait()
+
+	if err = errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	var entries []*internalsv1pb.WorkflowIndexEntry
+	for _, r := range results {
+		entries = append(entries, r...)
+	}
+	return entries, nil
+}
+
+// encodeContinuationToken returns an opaque token which resumes listing after
+// the given entry.
+func encodeContinuationToken(last *internalsv1pb.WorkflowIndexEntry) string {
+	raw, _ := proto.Marshal(&internalsv1pb.WorkflowIndexEntry{
+		InstanceId: last.GetInstanceId(),
+		CreatedAt:  last.GetCreatedAt(),
+	})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeContinuationToken(token string) (*internalsv1pb.WorkflowIndexEntry, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidContinuationToken
+	}
+
+	var last internalsv1pb.WorkflowIndexEntry
+	if err = proto.Unmarshal(raw, &last); err != nil || last.GetInstanceId() == "" {
+		return nil, ErrInvalidContinuationToken
+	}
+
+	return &last, nil
+}
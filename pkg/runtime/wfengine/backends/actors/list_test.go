@@ -0,0 +1,172 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actors
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	actorsfake "github.com/dapr/dapr/pkg/actors/fake"
+	"github.com/dapr/dapr/pkg/actors/router"
+	routerfake "github.com/dapr/dapr/pkg/actors/router/fake"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/state"
+	"github.com/dapr/dapr/pkg/runtime/wfengine/todo"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestListInstances(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	const indexActorType = "dapr.internal.default.myapp.workflowindex"
+	shards := make(map[string]*state.Index)
+	for _, shardID := range state.IndexShardIDs() {
+		shards[shardID] = state.NewIndex(indexActorType, shardID)
+	}
+
+	var all []*internalsv1pb.WorkflowIndexEntry
+	for i := range 10 {
+		name := "wf1"
+		if i%3 == 0 {
+			name = "wf2"
+		}
+		entry := &internalsv1pb.WorkflowIndexEntry{
+			InstanceId: fmt.Sprintf("instance-%02d", i),
+			Name:       name,
+			CreatedAt:  timestamppb.New(base.Add(time.Duration(i/2) * time.Minute)),
+		}
+		shards[state.IndexShardID(entry.GetInstanceId())].Upsert(entry)
+		all = append(all, entry)
+	}
+
+	var (
+		calls atomic.Int32
+		limit atomic.Uint32
+	)
+	rtr := routerfake.New().WithCallFn(func(_ context.Context, req *internalsv1pb.InternalInvokeRequest) (*internalsv1pb.InternalInvokeResponse, error) {
+		calls.Add(1)
+		assert.Equal(t, indexActorType, req.GetActor().GetActorType())
+		assert.Equal(t, todo.QueryWorkflowIndexMethod, req.GetMessage().GetMethod())
+
+		var query internalsv1pb.WorkflowIndexQuery
+		if err := proto.Unmarshal(req.GetMessage().GetData().GetValue(), &query); err != nil {
+			return nil, err
+		}
+		limit.Store(query.GetLimit())
+		shard, ok := shards[req.GetActor().GetActorId()]
+		if !ok {
+			return nil, fmt.Errorf("unknown shard %q", req.GetActor().GetActorId())
+		}
+
+		data, err := proto.Marshal(&internalsv1pb.WorkflowIndex{Entries: shard.Query(&query)})
+		if err != nil {
+			return nil, err
+		}
+		return &internalsv1pb.InternalInvokeResponse{
+			Message: &commonv1pb.InvokeResponse{Data: &anypb.Any{Value: data}},
+		}, nil
+	})
+
+	abe := New(Options{
+		AppID:     "myapp",
+		Namespace: "default",
+		Actors: actorsfake.New().WithRouter(func(context.Context) (router.Interface, error) {
+			return rtr, nil
+		}),
+	})
+
+	t.Run("pages through all instances in order", func(t *testing.T) {
+		calls.Store(0)
+		var got []*internalsv1pb.WorkflowIndexEntry
+		var pages int
+		req := &ListInstancesRequest{PageSize: 3}
+		for {
+			resp, err := abe.ListInstances(t.Context(), req)
+			require.NoError(t, err)
+			pages++
+			got = append(got, resp.Instances...)
+			if resp.ContinuationToken == "" {
+				break
+			}
+			assert.Len(t, resp.Instances, 3)
+			req.ContinuationToken = resp.ContinuationToken
+		}
+
+		assert.Equal(t, 4, pages)
+		assert.Equal(t, int32(4*len(state.IndexShardIDs())), calls.Load())
+		require.Len(t, got, len(all))
+		for i := range all {
+			assert.True(t, proto.Equal(all[i], got[i]), "entry %d: %v != %v", i, all[i], got[i])
+		}
+	})
+
+	t.Run("last page which is exactly full has no continuation token", func(t *testing.T) {
+		resp, err := abe.ListInstances(t.Context(), &ListInstancesRequest{PageSize: 10})
+		require.NoError(t, err)
+		assert.Len(t, resp.Instances, 10)
+		assert.Empty(t, resp.ContinuationToken)
+	})
+
+	t.Run("page size is capped", func(t *testing.T) {
+		resp, err := abe.ListInstances(t.Context(), &ListInstancesRequest{PageSize: math.MaxUint32})
+		require.NoError(t, err)
+		assert.Len(t, resp.Instances, len(all))
+		assert.Empty(t, resp.ContinuationToken)
+		assert.Equal(t, uint32(MaxListInstancesPageSize+1), limit.Load())
+	})
+
+	t.Run("filters by query", func(t *testing.T) {
+		resp, err := abe.ListInstances(t.Context(), &ListInstancesRequest{
+			Query:    &internalsv1pb.WorkflowIndexQuery{Name: ptr.Of("wf2")},
+			PageSize: 2,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Instances, 2)
+		assert.Equal(t, "instance-00", resp.Instances[0].GetInstanceId())
+		assert.Equal(t, "instance-03", resp.Instances[1].GetInstanceId())
+		require.NotEmpty(t, resp.ContinuationToken)
+
+		resp, err = abe.ListInstances(t.Context(), &ListInstancesRequest{
+			Query:             &internalsv1pb.WorkflowIndexQuery{Name: ptr.Of("wf2")},
+			PageSize:          2,
+			ContinuationToken: resp.ContinuationToken,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Instances, 2)
+		assert.Equal(t, "instance-06", resp.Instances[0].GetInstanceId())
+		assert.Equal(t, "instance-09", resp.Instances[1].GetInstanceId())
+		assert.Empty(t, resp.ContinuationToken)
+	})
+
+	t.Run("invalid continuation token", func(t *testing.T) {
+		_, err := abe.ListInstances(t.Context(), &ListInstancesRequest{ContinuationToken: "!!"})
+		require.ErrorIs(t, err, ErrInvalidContinuationToken)
+
+		_, err = abe.ListInstances(t.Context(), &ListInstancesRequest{ContinuationToken: "AA"})
+		require.ErrorIs(t, err, ErrInvalidContinuationToken)
+	})
+}
@@ -251,6 +251,23 @@ func getStatusString(status int32) string {
 	return "UNKNOWN"
 }
 
+// StatusString returns the name of the given workflow runtime status, for
+// example "RUNNING".
+func StatusString(status int32) string {
+	return getStatusString(status)
+}
+
+// ParseStatus returns the workflow runtime status with the given name. Returns
+// false if the name is not a known status.
+func ParseStatus(name string) (int32, bool) {
+	for status, statusStr := range statusMap {
+		if statusStr == name {
+			return status, true
+		}
+	}
+	return 0, false
+}
+
 func (c *client) Close() error {
 	return nil
 }
@@ -21,6 +21,8 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/dapr/components-contrib/workflows"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	backendactors "github.com/dapr/dapr/pkg/runtime/wfengine/backends/actors"
 )
 
 type Fake struct {
@@ -29,6 +31,9 @@ type Fake struct {
 	registerGrpcServerFn func(*grpc.Server)
 	waitForReadyFn       func(context.Context) error
 	clientFn             func() workflows.Workflow
+	listInstancesFn      func(context.Context, *backendactors.ListInstancesRequest) (*backendactors.ListInstancesResponse, error)
+	exportInstancesFn    func(context.Context, *internalsv1pb.WorkflowIndexQuery, func(*backendactors.ExportedInstance) error) error
+	importInstanceFn     func(context.Context, *backendactors.ExportedInstance) error
 }
 
 func New() *Fake {
@@ -38,6 +43,15 @@ func New() *Fake {
 		registerGrpcServerFn: func(*grpc.Server) {},
 		waitForReadyFn:       func(context.Context) error { return nil },
 		clientFn:             func() workflows.Workflow { return NewClient() },
+		listInstancesFn: func(context.Context, *backendactors.ListInstancesRequest) (*backendactors.ListInstancesResponse, error) {
+			return new(backendactors.ListInstancesResponse), nil
+		},
+		exportInstancesFn: func(context.Context, *internalsv1pb.WorkflowIndexQuery, func(*backendactors.ExportedInstance) error) error {
+			return nil
+		},
+		importInstanceFn: func(context.Context, *backendactors.ExportedInstance) error {
+			return nil
+		},
 	}
 }
 
@@ -66,6 +80,21 @@ func (f *Fake) WithClient(clientFn func() workflows.Workflow) *Fake {
 	return f
 }
 
+func (f *Fake) WithListInstances(listInstancesFn func(context.Context, *backendactors.ListInstancesRequest) (*backendactors.ListInstancesResponse, error)) *Fake {
+	f.listInstancesFn = listInstancesFn
+	return f
+}
+
+func (f *Fake) WithExportInstances(exportInstancesFn func(context.Context, *internalsv1pb.WorkflowIndexQuery, func(*backendactors.ExportedInstance) error) error) *Fake {
+	f.exportInstancesFn = exportInstancesFn
+	return f
+}
+
+func (f *Fake) WithImportInstance(importInstanceFn func(context.Context, *backendactors.ExportedInstance) error) *Fake {
+	f.importInstanceFn = importInstanceFn
+	return f
+}
+
 func (f *Fake) Run(ctx context.Context) error {
 	return f.runFn(ctx)
 }
@@ -86,6 +115,18 @@ func (f *Fake) Client() workflows.Workflow {
 	return f.clientFn()
 }
 
+func (f *Fake) ListInstances(ctx context.Context, req *backendactors.ListInstancesRequest) (*backendactors.ListInstancesResponse, error) {
+	return f.listInstancesFn(ctx, req)
+}
+
+func (f *Fake) ExportInstances(ctx context.Context, query *internalsv1pb.WorkflowIndexQuery, fn func(*backendactors.ExportedInstance) error) error {
+	return f.exportInstancesFn(ctx, query, fn)
+}
+
+func (f *Fake) ImportInstance(ctx context.Context, inst *backendactors.ExportedInstance) error {
+	return f.importInstanceFn(ctx, inst)
+}
+
 func (f *Fake) ActivityActorType() string {
 	return ""
 }
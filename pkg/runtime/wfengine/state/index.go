@@ -0,0 +1,212 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	"github.com/dapr/dapr/pkg/actors/state"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+const (
+	indexKey = "index"
+
+	// IndexShardCount is the number of shards the workflow index is split
+	// across. Each shard is owned by a single index actor, so that updates to
+	// the index are serialized without requiring ETag support or queries from
+	// the actor state store.
+	IndexShardCount = 16
+)
+
+// IndexShardID returns the ID of the index shard (and index actor) which holds
+// the entry of the given workflow instance.
+func IndexShardID(instanceID string) string {
+	h := fnv.New32a()
+	h.Write([]byte(instanceID))
+	return strconv.FormatUint(uint64(h.Sum32()%IndexShardCount), 10)
+}
+
+// IndexShardIDs returns the IDs of all index shards.
+func IndexShardIDs() []string {
+	ids := make([]string, IndexShardCount)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i)
+	}
+	return ids
+}
+
+// Index is a single shard of the workflow instance index.
+type Index struct {
+	actorType string
+	shardID   string
+	entries   map[string]*internalsv1pb.WorkflowIndexEntry
+}
+
+// NewIndex returns an empty index shard with the given ID.
+func NewIndex(actorType, shardID string) *Index {
+	return &Index{
+		actorType: actorType,
+		shardID:   shardID,
+		entries:   make(map[string]*internalsv1pb.WorkflowIndexEntry),
+	}
+}
+
+// LoadIndex loads the index shard with the given ID from the actor state
+// store. An empty index is returned if the shard has not been saved yet.
+func LoadIndex(ctx context.Context, state state.Interface, actorType, shardID string) (*Index, error) {
+	res, err := state.Get(ctx, &api.GetStateRequest{
+		ActorType: actorType,
+		ActorID:   shardID,
+		Key:       indexKey,
+	}, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load workflow index shard '%s': %w", shardID, err)
+	}
+
+	idx := NewIndex(actorType, shardID)
+	if len(res.Data) == 0 {
+		return idx, nil
+	}
+
+	var stored internalsv1pb.WorkflowIndex
+	if err = proto.Unmarshal(res.Data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal workflow index shard '%s': %w", shardID, err)
+	}
+	for _, e := range stored.GetEntries() {
+		idx.entries[e.GetInstanceId()] = e
+	}
+
+	return idx, nil
+}
+
+// Upsert adds or replaces the entry of a workflow instance. Returns true if the
+// index was changed.
+func (i *Index) Upsert(entry *internalsv1pb.WorkflowIndexEntry) bool {
+	if existing, ok := i.entries[entry.GetInstanceId()]; ok && proto.Equal(existing, entry) {
+		return false
+	}
+	i.entries[entry.GetInstanceId()] = entry
+	return true
+}
+
+// Delete removes the entry of a workflow instance. Returns true if the index
+// was changed.
+func (i *Index) Delete(instanceID string) bool {
+	if _, ok := i.entries[instanceID]; !ok {
+		return false
+	}
+	delete(i.entries, instanceID)
+	return true
+}
+
+// Query returns the entries of this shard which match the given query,
+// ordered by creation time then instance ID. Only the entries after the
+// query cursor are returned, up to the query limit.
+func (i *Index) Query(q *internalsv1pb.WorkflowIndexQuery) []*internalsv1pb.WorkflowIndexEntry {
+	entries := make([]*internalsv1pb.WorkflowIndexEntry, 0, len(i.entries))
+	for _, e := range i.entries {
+		if IndexEntryMatches(e, q) && (q.GetAfter() == nil || CompareIndexEntries(e, q.GetAfter()) > 0) {
+			entries = append(entries, e)
+		}
+	}
+	slices.SortFunc(entries, CompareIndexEntries)
+	if limit := int(q.GetLimit()); limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
+// GetSaveRequest returns the transactional request which persists the index
+// shard.
+func (i *Index) GetSaveRequest() (*api.TransactionalRequest, error) {
+	stored := &internalsv1pb.WorkflowIndex{
+		Entries: make([]*internalsv1pb.WorkflowIndexEntry, 0, len(i.entries)),
+	}
+	for _, e := range i.entries {
+		stored.Entries = append(stored.Entries, e)
+	}
+	// Sort so the persisted value is stable between saves.
+	slices.SortFunc(stored.Entries, CompareIndexEntries)
+
+	data, err := proto.Marshal(stored)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.TransactionalRequest{
+		ActorType: i.actorType,
+		ActorID:   i.shardID,
+		Operations: []api.TransactionalOperation{{
+			Operation: api.Upsert,
+			Request:   api.TransactionalUpsert{Key: indexKey, Value: data},
+		}},
+	}, nil
+}
+
+// IndexEntryMatches returns true if the given entry matches all the filters of
+// the query. A nil query matches every entry.
+func IndexEntryMatches(e *internalsv1pb.WorkflowIndexEntry, q *internalsv1pb.WorkflowIndexQuery) bool {
+	if q == nil {
+		return true
+	}
+	if q.Name != nil && e.GetName() != q.GetName() {
+		return false
+	}
+	if len(q.GetRuntimeStatuses()) > 0 && !slices.Contains(q.GetRuntimeStatuses(), e.GetRuntimeStatus()) {
+		return false
+	}
+
+	createdAt := e.GetCreatedAt().AsTime()
+	if q.GetCreatedFrom() != nil && createdAt.Before(q.GetCreatedFrom().AsTime()) {
+		return false
+	}
+	if q.GetCreatedTo() != nil && createdAt.After(q.GetCreatedTo().AsTime()) {
+		return false
+	}
+
+	lastUpdatedAt := e.GetLastUpdatedAt().AsTime()
+	if q.GetLastUpdatedFrom() != nil && lastUpdatedAt.Before(q.GetLastUpdatedFrom().AsTime()) {
+		return false
+	}
+	if q.GetLastUpdatedTo() != nil && lastUpdatedAt.After(q.GetLastUpdatedTo().AsTime()) {
+		return false
+	}
+
+	return true
+}
+
+// CompareIndexEntries orders index entries by creation time, then by instance
+// ID.
+func CompareIndexEntries(a, b *internalsv1pb.WorkflowIndexEntry) int {
+	if c := a.GetCreatedAt().AsTime().Compare(b.GetCreatedAt().AsTime()); c != 0 {
+		return c
+	}
+	if a.GetInstanceId() < b.GetInstanceId() {
+		return -1
+	}
+	if a.GetInstanceId() > b.GetInstanceId() {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dapr/dapr/pkg/actors/api"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestIndexShardID(t *testing.T) {
+	ids := IndexShardIDs()
+	require.Len(t, ids, IndexShardCount)
+
+	for i := range 100 {
+		id := IndexShardID("instance-" + strconv.Itoa(i))
+		assert.Contains(t, ids, id)
+		assert.Equal(t, id, IndexShardID("instance-"+strconv.Itoa(i)))
+	}
+}
+
+func TestIndexEntryMatches(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	entry := &internalsv1pb.WorkflowIndexEntry{
+		InstanceId:    "abc",
+		Name:          "myworkflow",
+		RuntimeStatus: 1,
+		CreatedAt:     timestamppb.New(base),
+		LastUpdatedAt: timestamppb.New(base.Add(time.Hour)),
+	}
+
+	tests := map[string]struct {
+		query *internalsv1pb.WorkflowIndexQuery
+		match bool
+	}{
+		"nil query": {
+			query: nil,
+			match: true,
+		},
+		"empty query": {
+			query: new(internalsv1pb.WorkflowIndexQuery),
+			match: true,
+		},
+		"name matches": {
+			query: &internalsv1pb.WorkflowIndexQuery{Name: ptr.Of("myworkflow")},
+			match: true,
+		},
+		"name does not match": {
+			query: &internalsv1pb.WorkflowIndexQuery{Name: ptr.Of("other")},
+			match: false,
+		},
+		"status matches": {
+			query: &internalsv1pb.WorkflowIndexQuery{RuntimeStatuses: []int32{0, 1}},
+			match: true,
+		},
+		"status does not match": {
+			query: &internalsv1pb.WorkflowIndexQuery{RuntimeStatuses: []int32{0, 3}},
+			match: false,
+		},
+		"created in range": {
+			query: &internalsv1pb.WorkflowIndexQuery{
+				CreatedFrom: timestamppb.New(base),
+				CreatedTo:   timestamppb.New(base),
+			},
+			match: true,
+		},
+		"created before range": {
+			query: &internalsv1pb.WorkflowIndexQuery{CreatedFrom: timestamppb.New(base.Add(time.Second))},
+			match: false,
+		},
+		"last updated after range": {
+			query: &internalsv1pb.WorkflowIndexQuery{LastUpdatedTo: timestamppb.New(base)},
+			match: false,
+		},
+		"last updated in range": {
+			query: &internalsv1pb.WorkflowIndexQuery{
+				LastUpdatedFrom: timestamppb.New(base),
+				LastUpdatedTo:   timestamppb.New(base.Add(2 * time.Hour)),
+			},
+			match: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.match, IndexEntryMatches(entry, test.query))
+		})
+	}
+}
+
+func TestIndex(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	idx := NewIndex("dapr.internal.default.myapp.workflowindex", "3")
+
+	e1 := &internalsv1pb.WorkflowIndexEntry{InstanceId: "b", Name: "wf1", CreatedAt: timestamppb.New(base)}
+	e2 := &internalsv1pb.WorkflowIndexEntry{InstanceId: "a", Name: "wf2", CreatedAt: timestamppb.New(base)}
+	e3 := &internalsv1pb.WorkflowIndexEntry{InstanceId: "c", Name: "wf1", CreatedAt: timestamppb.New(base.Add(-time.Minute))}
+
+	assert.True(t, idx.Upsert(e1))
+	assert.False(t, idx.Upsert(proto.Clone(e1).(*internalsv1pb.WorkflowIndexEntry)))
+	assert.True(t, idx.Upsert(e2))
+	assert.True(t, idx.Upsert(e3))
+
+	got := idx.Query(&internalsv1pb.WorkflowIndexQuery{Name: ptr.Of("wf1")})
+	assert.Equal(t, []*internalsv1pb.WorkflowIndexEntry{e3, e1}, got)
+
+	// Entries are returned in order, after the cursor and up to the limit.
+	assert.Equal(t, []*internalsv1pb.WorkflowIndexEntry{e3, e2}, idx.Query(&internalsv1pb.WorkflowIndexQuery{Limit: 2}))
+	assert.Equal(t, []*internalsv1pb.WorkflowIndexEntry{e1}, idx.Query(&internalsv1pb.WorkflowIndexQuery{
+		After: &internalsv1pb.WorkflowIndexEntry{InstanceId: "a", CreatedAt: timestamppb.New(base)},
+	}))
+
+	req, err := idx.GetSaveRequest()
+	require.NoError(t, err)
+	assert.Equal(t, "dapr.internal.default.myapp.workflowindex", req.ActorType)
+	assert.Equal(t, "3", req.ActorID)
+	require.Len(t, req.Operations, 1)
+	upsert, ok := req.Operations[0].Request.(api.TransactionalUpsert)
+	require.True(t, ok)
+	assert.Equal(t, indexKey, upsert.Key)
+
+	var stored internalsv1pb.WorkflowIndex
+	require.NoError(t, proto.Unmarshal(upsert.Value.([]byte), &stored))
+	require.Len(t, stored.GetEntries(), 3)
+	assert.Equal(t, "c", stored.GetEntries()[0].GetInstanceId())
+	assert.Equal(t, "a", stored.GetEntries()[1].GetInstanceId())
+	assert.Equal(t, "b", stored.GetEntries()[2].GetInstanceId())
+
+	assert.True(t, idx.Delete("a"))
+	assert.False(t, idx.Delete("a"))
+	assert.Len(t, idx.Query(nil), 2)
+}
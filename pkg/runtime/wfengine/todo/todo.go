@@ -30,6 +30,11 @@ const (
 	WaitForRuntimeStatus         = "WaitForRuntimeStatus"
 	ForkWorkflowHistory          = "ForkWorkflowHistory"
 	RerunWorkflowInstance        = "RerunWorkflowInstance"
+	ImportWorkflowInstanceMethod = "ImportWorkflowInstance"
+
+	UpsertWorkflowIndexEntryMethod = "UpsertWorkflowIndexEntry"
+	DeleteWorkflowIndexEntryMethod = "DeleteWorkflowIndexEntry"
+	QueryWorkflowIndexMethod       = "QueryWorkflowIndex"
 )
 
 var (
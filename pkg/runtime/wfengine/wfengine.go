@@ -27,6 +27,7 @@ import (
 	"github.com/dapr/dapr/pkg/actors"
 	"github.com/dapr/dapr/pkg/actors/targets/workflow/orchestrator"
 	"github.com/dapr/dapr/pkg/config"
+	internalsv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/runtime/processor"
 	backendactors "github.com/dapr/dapr/pkg/runtime/wfengine/backends/actors"
@@ -43,6 +44,9 @@ type Interface interface {
 	Run(context.Context) error
 	RegisterGrpcServer(*grpc.Server)
 	Client() workflows.Workflow
+	ListInstances(context.Context, *backendactors.ListInstancesRequest) (*backendactors.ListInstancesResponse, error)
+	ExportInstances(context.Context, *internalsv1pb.WorkflowIndexQuery, func(*backendactors.ExportedInstance) error) error
+	ImportInstance(context.Context, *backendactors.ExportedInstance) error
 
 	ActivityActorType() string
 }
@@ -175,6 +179,18 @@ func (wfe *engine) Client() workflows.Workflow {
 	return wfe.client
 }
 
+func (wfe *engine) ListInstances(ctx context.Context, req *backendactors.ListInstancesRequest) (*backendactors.ListInstancesResponse, error) {
+	return wfe.backend.ListInstances(ctx, req)
+}
+
+func (wfe *engine) ExportInstances(ctx context.Context, query *internalsv1pb.WorkflowIndexQuery, fn func(*backendactors.ExportedInstance) error) error {
+	return wfe.backend.ExportInstances(ctx, query, fn)
+}
+
+func (wfe *engine) ImportInstance(ctx context.Context, inst *backendactors.ExportedInstance) error {
+	return wfe.backend.ImportInstance(ctx, inst)
+}
+
 func (wfe *engine) ActivityActorType() string {
 	return wfe.backend.ActivityActorType()
 }
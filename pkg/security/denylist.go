@@ -0,0 +1,72 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/spiffetls/tlsconfig"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/dapr/pkg/security/denylist"
+)
+
+// denyListRetryInterval is the interval at which the deny list stream from
+// Sentry is re-established after it closes.
+const denyListRetryInterval = time.Second * 5
+
+// watchDenyListFn streams the deny list, calling the given function with every
+// update to it.
+type watchDenyListFn func(ctx context.Context, fn func(*denylist.List)) error
+
+// runDenyList keeps the deny list up to date with the one served by Sentry.
+func (s *security) runDenyList(ctx context.Context) error {
+	for {
+		err := s.watchDenyListFn(ctx, func(list *denylist.List) {
+			log.Debugf("Deny list updated with %d entries", list.Len())
+			s.denyList.Store(list)
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if status.Code(err) == codes.Unimplemented {
+			log.Info("Sentry does not serve a deny list")
+			<-ctx.Done()
+			return nil
+		}
+
+		log.Warnf("Deny list stream from Sentry closed, retrying in %s: %v", denyListRetryInterval, err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(denyListRetryInterval):
+		}
+	}
+}
+
+// authorizer wraps the given authorizer, refusing peers whose identity or
+// certificate is on the deny list.
+func (s *security) authorizer(authorizer tlsconfig.Authorizer) tlsconfig.Authorizer {
+	return func(id spiffeid.ID, verifiedChains [][]*x509.Certificate) error {
+		if err := s.denyList.Load().Authorize(id, verifiedChains); err != nil {
+			return err
+		}
+		return authorizer(id, verifiedChains)
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package denylist
+
+import (
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"sigs.k8s.io/yaml"
+
+	sentryv1pb "github.com/dapr/dapr/pkg/proto/sentry/v1"
+)
+
+// List is a list of denied app identities and certificate serial numbers.
+// Denied identities are refused certificates by Sentry, and peers presenting
+// a denied identity or certificate are refused on mTLS handshakes. A nil List
+// denies nothing.
+type List struct {
+	appIDs  map[string]struct{}
+	serials map[string]struct{}
+}
+
+// file is the format of a deny list file.
+type file struct {
+	// AppIDs are the denied app identities, in the form
+	// `<namespace>/<app-id>`.
+	AppIDs []string `json:"appIDs"`
+	// Serials are the denied certificate serial numbers, hex encoded.
+	Serials []string `json:"serials"`
+}
+
+// New returns a deny list of the given app identities, in the form
+// `<namespace>/<app-id>`, and hex encoded certificate serial numbers.
+func New(appIDs, serials []string) (*List, error) {
+	l := &List{
+		appIDs:  make(map[string]struct{}, len(appIDs)),
+		serials: make(map[string]struct{}, len(serials)),
+	}
+
+	for _, id := range appIDs {
+		ns, appID, ok := strings.Cut(id, "/")
+		if !ok || len(ns) == 0 || len(appID) == 0 || strings.Contains(appID, "/") {
+			return nil, fmt.Errorf("invalid app identity %q: must be in the form <namespace>/<app-id>", id)
+		}
+		l.appIDs[id] = struct{}{}
+	}
+
+	for _, serial := range serials {
+		n, ok := new(big.Int).SetString(strings.ReplaceAll(serial, ":", ""), 16)
+		if !ok {
+			return nil, fmt.Errorf("invalid certificate serial number %q: must be hex encoded", serial)
+		}
+		l.serials[n.Text(16)] = struct{}{}
+	}
+
+	return l, nil
+}
+
+// FromFile reads a deny list from the given YAML or JSON file.
+func FromFile(path string) (*List, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f file
+	if err = yaml.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("failed to decode deny list %s: %w", path, err)
+	}
+
+	return New(f.AppIDs, f.Serials)
+}
+
+// FromProto returns the deny list of the given proto.
+func FromProto(pb *sentryv1pb.DenyList) (*List, error) {
+	return New(pb.GetAppIds(), pb.GetSerials())
+}
+
+// Proto returns the deny list as a proto.
+func (l *List) Proto() *sentryv1pb.DenyList {
+	if l == nil {
+		return new(sentryv1pb.DenyList)
+	}
+
+	pb := &sentryv1pb.DenyList{
+		AppIds:  make([]string, 0, len(l.appIDs)),
+		Serials: make([]string, 0, len(l.serials)),
+	}
+	for id := range l.appIDs {
+		pb.AppIds = append(pb.AppIds, id)
+	}
+	for serial := range l.serials {
+		pb.Serials = append(pb.Serials, serial)
+	}
+	slices.Sort(pb.AppIds)
+	slices.Sort(pb.Serials)
+
+	return pb
+}
+
+// Len returns the number of entries in the deny list.
+func (l *List) Len() int {
+	if l == nil {
+		return 0
+	}
+	return len(l.appIDs) + len(l.serials)
+}
+
+// AppIDDenied returns true if the given app identity is denied.
+func (l *List) AppIDDenied(namespace, appID string) bool {
+	if l == nil {
+		return false
+	}
+	_, ok := l.appIDs[namespace+"/"+appID]
+	return ok
+}
+
+// SerialDenied returns true if the given certificate serial number is denied.
+func (l *List) SerialDenied(serial *big.Int) bool {
+	if l == nil || serial == nil {
+		return false
+	}
+	_, ok := l.serials[serial.Text(16)]
+	return ok
+}
+
+// Authorize returns an error if the given peer identity, or the certificate
+// it presented, is denied.
+func (l *List) Authorize(id spiffeid.ID, verifiedChains [][]*x509.Certificate) error {
+	if l.Len() == 0 {
+		return nil
+	}
+
+	// Dapr SPIFFE IDs have the path /ns/<namespace>/<app-id>.
+	if split := strings.Split(id.Path(), "/"); len(split) >= 4 && split[1] == "ns" {
+		if l.AppIDDenied(split[2], split[3]) {
+			return fmt.Errorf("peer identity %q is denied", id)
+		}
+	}
+
+	for _, chain := range verifiedChains {
+		if len(chain) > 0 && l.SerialDenied(chain[0].SerialNumber) {
+			return fmt.Errorf("peer certificate with serial %s is denied", chain[0].SerialNumber.Text(16))
+		}
+	}
+
+	return nil
+}
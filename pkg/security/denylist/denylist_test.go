@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package denylist
+
+import (
+	"crypto/x509"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	tests := map[string]struct {
+		appIDs  []string
+		serials []string
+		expErr  bool
+	}{
+		"empty": {},
+		"valid entries": {
+			appIDs:  []string{"default/app1", "other/app2"},
+			serials: []string{"0a1b", "0A:1B:2C"},
+		},
+		"app identity without namespace": {
+			appIDs: []string{"app1"},
+			expErr: true,
+		},
+		"app identity with empty app ID": {
+			appIDs: []string{"default/"},
+			expErr: true,
+		},
+		"app identity with too many segments": {
+			appIDs: []string{"default/app1/foo"},
+			expErr: true,
+		},
+		"serial not hex encoded": {
+			serials: []string{"xyz"},
+			expErr:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			l, err := New(test.appIDs, test.serials)
+			if test.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, len(test.appIDs)+len(test.serials), l.Len())
+		})
+	}
+}
+
+func TestDenied(t *testing.T) {
+	l, err := New([]string{"default/app1"}, []string{"00:0A:1B"})
+	require.NoError(t, err)
+
+	assert.True(t, l.AppIDDenied("default", "app1"))
+	assert.False(t, l.AppIDDenied("other", "app1"))
+	assert.False(t, l.AppIDDenied("default", "app2"))
+
+	assert.True(t, l.SerialDenied(big.NewInt(0x0a1b)))
+	assert.False(t, l.SerialDenied(big.NewInt(0x0a1c)))
+	assert.False(t, l.SerialDenied(nil))
+
+	t.Run("nil list denies nothing", func(t *testing.T) {
+		var l *List
+		assert.Equal(t, 0, l.Len())
+		assert.False(t, l.AppIDDenied("default", "app1"))
+		assert.False(t, l.SerialDenied(big.NewInt(0x0a1b)))
+		require.NoError(t, l.Authorize(spiffeid.RequireFromString("spiffe://example.org/ns/default/app1"), nil))
+		assert.Empty(t, l.Proto().GetAppIds())
+	})
+}
+
+func TestAuthorize(t *testing.T) {
+	l, err := New([]string{"default/app1"}, []string{"0a1b"})
+	require.NoError(t, err)
+
+	chain := func(serial int64) [][]*x509.Certificate {
+		return [][]*x509.Certificate{{{SerialNumber: big.NewInt(serial)}}}
+	}
+
+	tests := map[string]struct {
+		id     string
+		chains [][]*x509.Certificate
+		expErr bool
+	}{
+		"allowed identity and certificate": {
+			id:     "spiffe://example.org/ns/default/app2",
+			chains: chain(0x1234),
+		},
+		"denied identity": {
+			id:     "spiffe://example.org/ns/default/app1",
+			chains: chain(0x1234),
+			expErr: true,
+		},
+		"denied certificate": {
+			id:     "spiffe://example.org/ns/default/app2",
+			chains: chain(0x0a1b),
+			expErr: true,
+		},
+		"non dapr identity": {
+			id:     "spiffe://example.org/foo/bar",
+			chains: chain(0x1234),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := l.Authorize(spiffeid.RequireFromString(test.id), test.chains)
+			assert.Equal(t, test.expErr, err != nil, "%v", err)
+		})
+	}
+}
+
+func TestFromFile(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("valid file", func(t *testing.T) {
+		path := filepath.Join(dir, "valid.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("appIDs:\n- ns2/app2\n- ns1/app1\nserials:\n- \"0A:1B\"\n"), 0o600))
+
+		l, err := FromFile(path)
+		require.NoError(t, err)
+
+		pb := l.Proto()
+		assert.Equal(t, []string{"ns1/app1", "ns2/app2"}, pb.GetAppIds())
+		assert.Equal(t, []string{"a1b"}, pb.GetSerials())
+
+		fromProto, err := FromProto(pb)
+		require.NoError(t, err)
+		assert.Equal(t, l, fromProto)
+	})
+
+	t.Run("invalid entry", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("appIDs:\n- app1\n"), 0o600))
+		_, err := FromFile(path)
+		require.Error(t, err)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := FromFile(filepath.Join(dir, "missing.yaml"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package denylist
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/dapr/kit/events/broadcaster"
+	"github.com/dapr/kit/fswatcher"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.security.denylist")
+
+// Watcher holds the deny list read from a file, reloading it when the file
+// changes.
+type Watcher struct {
+	path string
+	fs   *fswatcher.FSWatcher
+
+	lock        sync.RWMutex
+	list        *List
+	broadcaster *broadcaster.Broadcaster[*List]
+}
+
+// NewWatcher returns a watcher of the deny list at the given path. If the path
+// is empty, or the file doesn't exist, the deny list is empty.
+func NewWatcher(path string) (*Watcher, error) {
+	w := &Watcher{
+		path:        path,
+		broadcaster: broadcaster.New[*List](),
+	}
+
+	if len(path) > 0 {
+		// Start watching before the initial load so that no change is missed.
+		fs, err := fswatcher.New(fswatcher.Options{
+			Targets: []string{filepath.Dir(path)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		w.fs = fs
+
+		list, err := w.load()
+		if err != nil {
+			return nil, err
+		}
+		w.list = list
+	}
+
+	return w, nil
+}
+
+func (w *Watcher) load() (*List, error) {
+	list, err := FromFile(w.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return list, err
+}
+
+// Run watches the deny list file for changes. Blocks until the context is
+// cancelled.
+func (w *Watcher) Run(ctx context.Context) error {
+	defer w.broadcaster.Close()
+
+	if w.fs == nil {
+		<-ctx.Done()
+		return nil
+	}
+
+	eventCh := make(chan struct{})
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- w.fs.Run(ctx, eventCh)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return <-errCh
+		case <-eventCh:
+			list, err := w.load()
+			if err != nil {
+				log.Errorf("Failed to reload deny list, keeping previous deny list: %s", err)
+				continue
+			}
+
+			log.Infof("Deny list reloaded with %d entries", list.Len())
+			w.lock.Lock()
+			w.list = list
+			w.lock.Unlock()
+			w.broadcaster.Broadcast(list)
+		}
+	}
+}
+
+// Current returns the current deny list.
+func (w *Watcher) Current() *List {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	return w.list
+}
+
+// Watch subscribes the given channel to every change of the deny list, until
+// the given context is cancelled.
+func (w *Watcher) Watch(ctx context.Context, ch chan<- *List) {
+	w.broadcaster.Subscribe(ctx, ch)
+}
@@ -44,6 +44,8 @@ type Fake struct {
 	grpcDialOptionUnknownTrustDomainFn func(ns, appID string) grpc.DialOption
 	grpcServerOptionMTLSFn             func() grpc.ServerOption
 	grpcServerOptionNoClientAuthFn     func() grpc.ServerOption
+
+	jwtSVIDFn func(context.Context, string) (string, time.Time, error)
 }
 
 func New() *Fake {
@@ -88,6 +90,9 @@ func New() *Fake {
 		netDialerIDFn: func(context.Context, spiffeid.ID, time.Duration) func(network, addr string) (net.Conn, error) {
 			return net.Dial
 		},
+		jwtSVIDFn: func(context.Context, string) (string, time.Time, error) {
+			return "", time.Time{}, security.ErrJWTSVIDUnavailable
+		},
 		mtls: false,
 	}
 }
@@ -142,6 +147,11 @@ func (f *Fake) WithMTLSEnabled(mtls bool) *Fake {
 	return f
 }
 
+func (f *Fake) WithJWTSVIDFn(fn func(context.Context, string) (string, time.Time, error)) *Fake {
+	f.jwtSVIDFn = fn
+	return f
+}
+
 func (f *Fake) ControlPlaneTrustDomain() spiffeid.TrustDomain {
 	return f.controlPlaneTrustDomainFn()
 }
@@ -219,6 +229,10 @@ func (f *Fake) IdentityDir() *string {
 	return nil
 }
 
+func (f *Fake) JWTSVID(ctx context.Context, audience string) (string, time.Time, error) {
+	return f.jwtSVIDFn(ctx, audience)
+}
+
 func (f *Fake) GRPCDialOption(id spiffeid.ID) grpc.DialOption {
 	return f.grpcDialOptionFn(id)
 }
@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// ErrJWTSVIDUnavailable is returned when JWT-SVIDs are requested but
+// identities are not issued by Sentry.
+var ErrJWTSVIDUnavailable = errors.New("JWT-SVIDs are only available when identities are issued by Sentry")
+
+// jwtRequestFn requests a JWT-SVID for the given audience, returning the token
+// and its expiry.
+type jwtRequestFn func(ctx context.Context, audience string) (string, time.Time, error)
+
+type jwtSVID struct {
+	token    string
+	issuedAt time.Time
+	expiry   time.Time
+}
+
+// jwtSource caches the JWT-SVIDs of this workload per audience. A JWT-SVID is
+// renewed once half of its lifetime has elapsed.
+type jwtSource struct {
+	requestFn jwtRequestFn
+	clock     clock.Clock
+
+	lock  sync.Mutex
+	cache map[string]jwtSVID
+}
+
+func newJWTSource(requestFn jwtRequestFn) *jwtSource {
+	return &jwtSource{
+		requestFn: requestFn,
+		clock:     clock.RealClock{},
+		cache:     make(map[string]jwtSVID),
+	}
+}
+
+func (j *jwtSource) get(ctx context.Context, audience string) (string, time.Time, error) {
+	if len(audience) == 0 {
+		return "", time.Time{}, errors.New("audience is required")
+	}
+
+	now := j.clock.Now()
+
+	j.lock.Lock()
+	svid, ok := j.cache[audience]
+	j.lock.Unlock()
+	if ok && now.Before(svid.issuedAt.Add(svid.expiry.Sub(svid.issuedAt)/2)) {
+		return svid.token, svid.expiry, nil
+	}
+
+	token, expiry, err := j.requestFn(ctx, audience)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	// Drop expired tokens so that the cache doesn't grow with audiences that
+	// are no longer requested.
+	for aud, cached := range j.cache {
+		if !now.Before(cached.expiry) {
+			delete(j.cache, aud)
+		}
+	}
+	j.cache[audience] = jwtSVID{token: token, issuedAt: now, expiry: expiry}
+
+	return token, expiry, nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestJWTSource(t *testing.T) {
+	newSource := func(t *testing.T) (*jwtSource, *clocktesting.FakeClock, *int) {
+		t.Helper()
+		clock := clocktesting.NewFakeClock(time.Now())
+		var calls int
+		j := newJWTSource(func(_ context.Context, audience string) (string, time.Time, error) {
+			calls++
+			if audience == "error" {
+				return "", time.Time{}, errors.New("sentry error")
+			}
+			return audience + "-" + strconv.Itoa(calls), clock.Now().Add(time.Hour), nil
+		})
+		j.clock = clock
+		return j, clock, &calls
+	}
+
+	t.Run("empty audience should error", func(t *testing.T) {
+		j, _, calls := newSource(t)
+		_, _, err := j.get(t.Context(), "")
+		require.Error(t, err)
+		assert.Equal(t, 0, *calls)
+	})
+
+	t.Run("token should be cached per audience until half of its lifetime", func(t *testing.T) {
+		j, clock, calls := newSource(t)
+
+		token, expiry, err := j.get(t.Context(), "aud1")
+		require.NoError(t, err)
+		assert.Equal(t, "aud1-1", token)
+		assert.Equal(t, clock.Now().Add(time.Hour), expiry)
+
+		token, _, err = j.get(t.Context(), "aud2")
+		require.NoError(t, err)
+		assert.Equal(t, "aud2-2", token)
+
+		clock.Step(29 * time.Minute)
+		token, _, err = j.get(t.Context(), "aud1")
+		require.NoError(t, err)
+		assert.Equal(t, "aud1-1", token)
+		assert.Equal(t, 2, *calls)
+
+		clock.Step(time.Minute)
+		token, _, err = j.get(t.Context(), "aud1")
+		require.NoError(t, err)
+		assert.Equal(t, "aud1-3", token)
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("expired tokens should be dropped from the cache", func(t *testing.T) {
+		j, clock, _ := newSource(t)
+
+		_, _, err := j.get(t.Context(), "aud1")
+		require.NoError(t, err)
+		clock.Step(2 * time.Hour)
+		_, _, err = j.get(t.Context(), "aud2")
+		require.NoError(t, err)
+
+		j.lock.Lock()
+		defer j.lock.Unlock()
+		assert.Len(t, j.cache, 1)
+		assert.Contains(t, j.cache, "aud2")
+	})
+
+	t.Run("errors should be returned and not cached", func(t *testing.T) {
+		j, _, calls := newSource(t)
+
+		_, _, err := j.get(t.Context(), "error")
+		require.Error(t, err)
+		_, _, err = j.get(t.Context(), "error")
+		require.Error(t, err)
+		assert.Equal(t, 2, *calls)
+	})
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/healthz"
 	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/security/denylist"
 	"github.com/dapr/kit/concurrency"
 	"github.com/dapr/kit/crypto/spiffe"
 	spiffecontext "github.com/dapr/kit/crypto/spiffe/context"
@@ -67,6 +68,10 @@ type Handler interface {
 	ID() spiffeid.ID
 	WatchTrustAnchors(context.Context, chan<- []byte)
 	IdentityDir() *string
+
+	// JWTSVID returns a JWT-SVID of this workload for the given audience, along
+	// with its expiry.
+	JWTSVID(ctx context.Context, audience string) (string, time.Time, error)
 }
 
 // Provider is the security provider.
@@ -149,6 +154,11 @@ type security struct {
 
 	identityDir      *string
 	trustAnchorsFile *string
+
+	jwt *jwtSource
+
+	watchDenyListFn watchDenyListFn
+	denyList        atomic.Pointer[denylist.List]
 }
 
 func New(ctx context.Context, opts Options) (Provider, error) {
@@ -172,6 +182,8 @@ func New(ctx context.Context, opts Options) (Provider, error) {
 	// plane.
 	var spf *spiffe.SPIFFE
 	var trustAnchors trustanchors.Interface
+	var jwt *jwtSource
+	var watchDenyList watchDenyListFn
 	if opts.MTLSEnabled || opts.Mode == modes.KubernetesMode {
 		trustAnchors = opts.OverrideTrustAnchors
 		if trustAnchors == nil {
@@ -203,10 +215,13 @@ func New(ctx context.Context, opts Options) (Provider, error) {
 		if opts.OverrideCertRequestFn != nil {
 			reqFn = opts.OverrideCertRequestFn
 		} else {
-			reqFn, err = newRequestFn(opts, trustAnchors, cptd)
+			client, err := newSentryClient(opts, trustAnchors, cptd)
 			if err != nil {
 				return nil, err
 			}
+			reqFn = client.requestSVID
+			jwt = newJWTSource(client.requestJWTSVID)
+			watchDenyList = client.watchDenyList
 		}
 		spf = spiffe.New(spiffe.Options{
 			Log:                 log,
@@ -229,6 +244,8 @@ func New(ctx context.Context, opts Options) (Provider, error) {
 			controlPlaneNamespace:   opts.ControlPlaneNamespace,
 			identityDir:             opts.WriteIdentityToFile,
 			trustAnchorsFile:        opts.TrustAnchorsFile,
+			jwt:                     jwt,
+			watchDenyListFn:         watchDenyList,
 		},
 	}, nil
 }
@@ -249,7 +266,7 @@ func (p *provider) Run(ctx context.Context) error {
 		return nil
 	}
 
-	return concurrency.NewRunnerManager(
+	runners := []concurrency.Runner{
 		p.sec.spiffe.Run,
 		p.sec.trustAnchors.Run,
 		func(ctx context.Context) error {
@@ -267,7 +284,12 @@ func (p *provider) Run(ctx context.Context) error {
 			<-ctx.Done()
 			return nil
 		},
-	).Run(ctx)
+	}
+	if p.sec.watchDenyListFn != nil {
+		runners = append(runners, p.sec.runDenyList)
+	}
+
+	return concurrency.NewRunnerManager(runners...).Run(ctx)
 }
 
 // Handler returns a ready handler from the security provider. Blocks until
@@ -293,7 +315,7 @@ func (s *security) GRPCDialOptionMTLS(appID spiffeid.ID) grpc.DialOption {
 	}
 
 	return grpc.WithTransportCredentials(
-		grpccredentials.MTLSClientCredentials(s.spiffe.X509SVIDSource(), s.trustAnchors, tlsconfig.AuthorizeID(appID)),
+		grpccredentials.MTLSClientCredentials(s.spiffe.X509SVIDSource(), s.trustAnchors, s.authorizer(tlsconfig.AuthorizeID(appID))),
 	)
 }
 
@@ -307,7 +329,7 @@ func (s *security) GRPCServerOptionMTLS() grpc.ServerOption {
 	return grpc.Creds(
 		// TODO: It would be better if we could give a subset of trust domains in
 		// which this server authorizes.
-		grpccredentials.MTLSServerCredentials(s.spiffe.X509SVIDSource(), s.trustAnchors, tlsconfig.AuthorizeAny()),
+		grpccredentials.MTLSServerCredentials(s.spiffe.X509SVIDSource(), s.trustAnchors, s.authorizer(tlsconfig.AuthorizeAny())),
 	)
 }
 
@@ -337,7 +359,7 @@ func (s *security) GRPCDialOptionMTLSUnknownTrustDomain(ns, appID string) grpc.D
 	}
 
 	return grpc.WithTransportCredentials(
-		grpccredentials.MTLSClientCredentials(s.spiffe.X509SVIDSource(), s.trustAnchors, tlsconfig.AdaptMatcher(matcher)),
+		grpccredentials.MTLSClientCredentials(s.spiffe.X509SVIDSource(), s.trustAnchors, s.authorizer(tlsconfig.AdaptMatcher(matcher))),
 	)
 }
 
@@ -383,7 +405,7 @@ func (s *security) NetListenerID(lis net.Listener, id spiffeid.ID) net.Listener
 		return lis
 	}
 	return tls.NewListener(lis,
-		tlsconfig.MTLSServerConfig(s.spiffe.X509SVIDSource(), s.trustAnchors, tlsconfig.AuthorizeID(id)),
+		tlsconfig.MTLSServerConfig(s.spiffe.X509SVIDSource(), s.trustAnchors, s.authorizer(tlsconfig.AuthorizeID(id))),
 	)
 }
 
@@ -396,7 +418,7 @@ func (s *security) NetDialerID(ctx context.Context, spiffeID spiffeid.ID, timeou
 	}
 	return (&tls.Dialer{
 		NetDialer: (&net.Dialer{Timeout: timeout, Cancel: ctx.Done()}),
-		Config:    tlsconfig.MTLSClientConfig(s.spiffe.X509SVIDSource(), s.trustAnchors, tlsconfig.AuthorizeID(spiffeID)),
+		Config:    tlsconfig.MTLSClientConfig(s.spiffe.X509SVIDSource(), s.trustAnchors, s.authorizer(tlsconfig.AuthorizeID(spiffeID))),
 	}).Dial
 }
 
@@ -411,7 +433,7 @@ func (s *security) MTLSClientConfig(id spiffeid.ID) *tls.Config {
 		return nil
 	}
 
-	return tlsconfig.MTLSClientConfig(s.spiffe.X509SVIDSource(), s.trustAnchors, tlsconfig.AuthorizeID(id))
+	return tlsconfig.MTLSClientConfig(s.spiffe.X509SVIDSource(), s.trustAnchors, s.authorizer(tlsconfig.AuthorizeID(id)))
 }
 
 // CurrentNamespace returns the namespace of this workload.
@@ -463,3 +485,10 @@ func (s *security) IdentityDir() *string {
 func (s *security) ID() spiffeid.ID {
 	return s.id
 }
+
+func (s *security) JWTSVID(ctx context.Context, audience string) (string, time.Time, error) {
+	if s.jwt == nil {
+		return "", time.Time{}, ErrJWTSVIDUnavailable
+	}
+	return s.jwt.get(ctx, audience)
+}
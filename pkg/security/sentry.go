@@ -30,6 +30,7 @@ import (
 	"github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/modes"
 	sentryv1pb "github.com/dapr/dapr/pkg/proto/sentry/v1"
+	"github.com/dapr/dapr/pkg/security/denylist"
 	sentryToken "github.com/dapr/dapr/pkg/security/token"
 	cryptopem "github.com/dapr/kit/crypto/pem"
 	"github.com/dapr/kit/crypto/spiffe"
@@ -41,7 +42,19 @@ const (
 	sentryMaxRetries  = 5
 )
 
-func newRequestFn(opts Options, trustAnchors trustanchors.Interface, cptd spiffeid.TrustDomain) (spiffe.RequestSVIDFn, error) {
+// sentryClient requests identities for this workload from Sentry.
+type sentryClient struct {
+	sentryID       spiffeid.ID
+	sentryAddress  string
+	trustAnchors   trustanchors.Interface
+	trustDomain    *string
+	namespace      string
+	identifier     string
+	tokenFile      *string
+	kubernetesMode bool
+}
+
+func newSentryClient(opts Options, trustAnchors trustanchors.Interface, cptd spiffeid.TrustDomain) (*sentryClient, error) {
 	sentryID, err := SentryID(cptd, opts.ControlPlaneNamespace)
 	if err != nil {
 		return nil, err
@@ -62,86 +75,179 @@ func newRequestFn(opts Options, trustAnchors trustanchors.Interface, cptd spiffe
 		sentryIdentifier = opts.AppID
 	}
 
-	sentryAddress := opts.SentryAddress
-	sentryTokenFile := opts.SentryTokenFile
-	kubernetesMode := opts.Mode == modes.KubernetesMode
+	return &sentryClient{
+		sentryID:       sentryID,
+		sentryAddress:  opts.SentryAddress,
+		trustAnchors:   trustAnchors,
+		trustDomain:    trustDomain,
+		namespace:      ns,
+		identifier:     sentryIdentifier,
+		tokenFile:      opts.SentryTokenFile,
+		kubernetesMode: opts.Mode == modes.KubernetesMode,
+	}, nil
+}
 
-	fn := func(ctx context.Context, csrDER []byte) (*spiffe.SVIDResponse, error) {
-		unaryClientInterceptor := retry.UnaryClientInterceptor(
-			retry.WithMax(sentryMaxRetries),
-			retry.WithPerRetryTimeout(sentrySignTimeout),
+// dial returns a connection to Sentry.
+func (c *sentryClient) dial(ctx context.Context) (*grpc.ClientConn, error) {
+	unaryClientInterceptor := retry.UnaryClientInterceptor(
+		retry.WithMax(sentryMaxRetries),
+		retry.WithPerRetryTimeout(sentrySignTimeout),
+	)
+	if diagnostics.DefaultGRPCMonitoring.IsEnabled() {
+		unaryClientInterceptor = middleware.ChainUnaryClient(
+			unaryClientInterceptor,
+			diagnostics.DefaultGRPCMonitoring.UnaryClientInterceptor(),
 		)
-		if diagnostics.DefaultGRPCMonitoring.IsEnabled() {
-			unaryClientInterceptor = middleware.ChainUnaryClient(
-				unaryClientInterceptor,
-				diagnostics.DefaultGRPCMonitoring.UnaryClientInterceptor(),
-			)
-		}
+	}
 
-		conn, err := grpc.DialContext(ctx, //nolint:staticcheck
-			sentryAddress,
-			grpc.WithTransportCredentials(
-				grpccredentials.TLSClientCredentials(trustAnchors, tlsconfig.AuthorizeID(sentryID)),
-			),
-			grpc.WithUnaryInterceptor(unaryClientInterceptor),
-			grpc.WithReturnConnectionError(), //nolint:staticcheck
-		)
-		if err != nil {
-			diagnostics.DefaultMonitoring.MTLSWorkLoadCertRotationFailed("sentry_conn")
-			return nil, fmt.Errorf("error establishing connection to sentry: %w", err)
-		}
+	return grpc.DialContext(ctx, //nolint:staticcheck
+		c.sentryAddress,
+		grpc.WithTransportCredentials(
+			grpccredentials.TLSClientCredentials(c.trustAnchors, tlsconfig.AuthorizeID(c.sentryID)),
+		),
+		grpc.WithUnaryInterceptor(unaryClientInterceptor),
+		grpc.WithReturnConnectionError(), //nolint:staticcheck
+	)
+}
 
-		defer conn.Close()
+// token returns the token authenticating this workload to Sentry.
+func (c *sentryClient) token() (string, sentryv1pb.SignCertificateRequest_TokenValidator, error) {
+	if c.tokenFile != nil {
+		return sentryToken.GetSentryTokenFromFile(*c.tokenFile)
+	}
+	return sentryToken.GetSentryToken(c.kubernetesMode)
+}
 
-		var token string
-		var tokenValidator sentryv1pb.SignCertificateRequest_TokenValidator
-		if sentryTokenFile != nil {
-			token, tokenValidator, err = sentryToken.GetSentryTokenFromFile(*sentryTokenFile)
-		} else {
-			token, tokenValidator, err = sentryToken.GetSentryToken(kubernetesMode)
-		}
+func (c *sentryClient) requestSVID(ctx context.Context, csrDER []byte) (*spiffe.SVIDResponse, error) {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		diagnostics.DefaultMonitoring.MTLSWorkLoadCertRotationFailed("sentry_conn")
+		return nil, fmt.Errorf("error establishing connection to sentry: %w", err)
+	}
 
-		if err != nil {
-			diagnostics.DefaultMonitoring.MTLSWorkLoadCertRotationFailed("sentry_token")
-			return nil, fmt.Errorf("error obtaining token: %w", err)
-		}
+	defer conn.Close()
 
-		req := &sentryv1pb.SignCertificateRequest{
-			CertificateSigningRequest: pem.EncodeToMemory(&pem.Block{
-				Type: "CERTIFICATE REQUEST", Bytes: csrDER,
-			}),
-			Id:             sentryIdentifier,
-			Token:          token,
-			Namespace:      ns,
-			TokenValidator: tokenValidator,
-		}
+	token, tokenValidator, err := c.token()
+	if err != nil {
+		diagnostics.DefaultMonitoring.MTLSWorkLoadCertRotationFailed("sentry_token")
+		return nil, fmt.Errorf("error obtaining token: %w", err)
+	}
 
-		if trustDomain != nil {
-			req.TrustDomain = *trustDomain
-		}
+	req := &sentryv1pb.SignCertificateRequest{
+		CertificateSigningRequest: pem.EncodeToMemory(&pem.Block{
+			Type: "CERTIFICATE REQUEST", Bytes: csrDER,
+		}),
+		Id:             c.identifier,
+		Token:          token,
+		Namespace:      c.namespace,
+		TokenValidator: tokenValidator,
+	}
 
-		resp, err := sentryv1pb.NewCAClient(conn).SignCertificate(ctx, req)
-		if err != nil {
-			diagnostics.DefaultMonitoring.MTLSWorkLoadCertRotationFailed("sign")
-			return nil, fmt.Errorf("error from sentry SignCertificate: %w", err)
-		}
+	if c.trustDomain != nil {
+		req.TrustDomain = *c.trustDomain
+	}
 
-		if err = resp.GetValidUntil().CheckValid(); err != nil {
-			diagnostics.DefaultMonitoring.MTLSWorkLoadCertRotationFailed("invalid_ts")
-			return nil, fmt.Errorf("error parsing ValidUntil: %w", err)
-		}
+	resp, err := sentryv1pb.NewCAClient(conn).SignCertificate(ctx, req)
+	if err != nil {
+		diagnostics.DefaultMonitoring.MTLSWorkLoadCertRotationFailed("sign")
+		return nil, fmt.Errorf("error from sentry SignCertificate: %w", err)
+	}
 
-		workloadcert, err := cryptopem.DecodePEMCertificates(resp.GetWorkloadCertificate())
+	if err = resp.GetValidUntil().CheckValid(); err != nil {
+		diagnostics.DefaultMonitoring.MTLSWorkLoadCertRotationFailed("invalid_ts")
+		return nil, fmt.Errorf("error parsing ValidUntil: %w", err)
+	}
+
+	workloadcert, err := cryptopem.DecodePEMCertificates(resp.GetWorkloadCertificate())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing newly signed certificate: %w", err)
+	}
+
+	return &spiffe.SVIDResponse{
+		X509Certificates: workloadcert,
+	}, nil
+}
+
+func (c *sentryClient) requestJWTSVID(ctx context.Context, audience string) (string, time.Time, error) {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("error establishing connection to sentry: %w", err)
+	}
+
+	defer conn.Close()
+
+	token, tokenValidator, err := c.token()
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("error obtaining token: %w", err)
+	}
+
+	req := &sentryv1pb.SignJWTSVIDRequest{
+		Id:             c.identifier,
+		Token:          token,
+		Namespace:      c.namespace,
+		TokenValidator: tokenValidator,
+		Audiences:      []string{audience},
+	}
+
+	if c.trustDomain != nil {
+		req.TrustDomain = *c.trustDomain
+	}
+
+	resp, err := sentryv1pb.NewCAClient(conn).SignJWTSVID(ctx, req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("error from sentry SignJWTSVID: %w", err)
+	}
+
+	if err = resp.GetExpiry().CheckValid(); err != nil {
+		return "", time.Time{}, fmt.Errorf("error parsing Expiry: %w", err)
+	}
+
+	return resp.GetToken(), resp.GetExpiry().AsTime(), nil
+}
+
+// watchDenyList streams the deny list from Sentry, calling the given function
+// with every update to it. Returns when the stream ends.
+func (c *sentryClient) watchDenyList(ctx context.Context, fn func(*denylist.List)) error {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("error establishing connection to sentry: %w", err)
+	}
+
+	defer conn.Close()
+
+	token, tokenValidator, err := c.token()
+	if err != nil {
+		return fmt.Errorf("error obtaining token: %w", err)
+	}
+
+	req := &sentryv1pb.WatchDenyListRequest{
+		Id:             c.identifier,
+		Token:          token,
+		Namespace:      c.namespace,
+		TokenValidator: tokenValidator,
+	}
+
+	if c.trustDomain != nil {
+		req.TrustDomain = *c.trustDomain
+	}
+
+	stream, err := sentryv1pb.NewCAClient(conn).WatchDenyList(ctx, req)
+	if err != nil {
+		return fmt.Errorf("error from sentry WatchDenyList: %w", err)
+	}
+
+	for {
+		resp, err := stream.Recv()
 		if err != nil {
-			return nil, fmt.Errorf("error parsing newly signed certificate: %w", err)
+			return err
 		}
 
-		return &spiffe.SVIDResponse{
-			X509Certificates: workloadcert,
-		}, nil
+		list, err := denylist.FromProto(resp)
+		if err != nil {
+			return fmt.Errorf("error parsing deny list: %w", err)
+		}
+		fn(list)
 	}
-
-	return fn, nil
 }
 
 // isControlPlaneService returns true if the app ID corresponds to a Dapr
@@ -45,6 +45,25 @@ const (
 
 	DefaultPort = 50001
 
+	// DefaultJWTTTL is the default lifetime of JWT-SVIDs.
+	DefaultJWTTTL = time.Hour
+
+	// DefaultIssuerTTL is the default lifetime of issuer certificates minted
+	// when rotating the issuer.
+	DefaultIssuerTTL = time.Hour * 24 * 90
+
+	// DefaultIssuerRotateBefore is the default remaining lifetime of the issuer
+	// certificate at which it is rotated.
+	DefaultIssuerRotateBefore = time.Hour * 24 * 30
+
+	// DefaultRootRolloverBefore is the default remaining lifetime of the root
+	// certificate at which a root rollover is started.
+	DefaultRootRolloverBefore = time.Hour * 24 * 90
+
+	// DefaultAnchorPropagationPeriod is the default time a new trust anchor is
+	// published for before it is used for signing.
+	DefaultAnchorPropagationPeriod = time.Hour * 24 * 7
+
 	// Default RootCertFilename is the filename that holds the root certificate.
 	DefaultRootCertFilename = "ca.crt"
 
@@ -53,6 +72,14 @@ const (
 
 	// DefaultIssuerKeyFilename is the filename that holds the issuer key.
 	DefaultIssuerKeyFilename = "issuer.key"
+
+	// DefaultRootKeyFilename is the filename that holds the root key of a
+	// Sentry generated trust bundle.
+	DefaultRootKeyFilename = "ca.key"
+
+	// DefaultRotationStateFilename is the filename that holds the state of an
+	// in progress trust bundle rotation.
+	DefaultRotationStateFilename = "rotation.json"
 )
 
 // Config holds the configuration for the Certificate Authority.
@@ -70,6 +97,42 @@ type Config struct {
 	Validators       map[sentryv1pb.SignCertificateRequest_TokenValidator]map[string]string
 	DefaultValidator sentryv1pb.SignCertificateRequest_TokenValidator
 	Features         []daprGlobalConfig.FeatureSpec
+
+	// JWTIssuer is the value of the `iss` claim of signed JWT-SVIDs. If empty,
+	// the claim is omitted.
+	JWTIssuer string
+	// JWTTTL is the lifetime of signed JWT-SVIDs.
+	JWTTTL time.Duration
+	// JWKSPort is the port the JWKS server listens on. The server is disabled
+	// if 0.
+	JWKSPort int
+	// JWKSListenAddress is the address the JWKS server listens on.
+	JWKSListenAddress string
+
+	// RootKeyPath is the path to the root key of a Sentry generated trust
+	// bundle. The root key is only persisted if set.
+	RootKeyPath string
+	// RotationStatePath is the path to the state of an in progress trust
+	// bundle rotation.
+	RotationStatePath string
+	// RotationEnabled enables automatic rotation of Sentry generated trust
+	// bundles.
+	RotationEnabled bool
+	// IssuerTTL is the lifetime of issuer certificates minted on rotation.
+	IssuerTTL time.Duration
+	// IssuerRotateBefore is the remaining lifetime of the issuer certificate at
+	// which it is rotated.
+	IssuerRotateBefore time.Duration
+	// RootRolloverBefore is the remaining lifetime of the root certificate at
+	// which a root rollover is started.
+	RootRolloverBefore time.Duration
+	// AnchorPropagationPeriod is the time a new trust anchor is published for
+	// before it is used for signing.
+	AnchorPropagationPeriod time.Duration
+
+	// DenyListPath is the path to the list of app identities and certificate
+	// serial numbers which are denied. Optional.
+	DenyListPath string
 }
 
 // FromConfigName returns a Sentry configuration based on a configuration spec.
@@ -108,6 +171,13 @@ func getDefaultConfig() Config {
 		WorkloadCertTTL:  defaultWorkloadCertTTL,
 		AllowedClockSkew: defaultAllowedClockSkew,
 		TrustDomain:      defaultTrustDomain,
+		JWTTTL:           DefaultJWTTTL,
+
+		RotationEnabled:         true,
+		IssuerTTL:               DefaultIssuerTTL,
+		IssuerRotateBefore:      DefaultIssuerRotateBefore,
+		RootRolloverBefore:      DefaultRootRolloverBefore,
+		AnchorPropagationPeriod: DefaultAnchorPropagationPeriod,
 	}
 }
 
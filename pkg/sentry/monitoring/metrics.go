@@ -50,6 +50,14 @@ var (
 		"sentry/issuercert/expiry_timestamp",
 		"The unix timestamp, in seconds, when issuer/root cert will expire.",
 		stats.UnitDimensionless)
+	jwtSignSuccessTotal = stats.Int64(
+		"sentry/jwt/sign/success_total",
+		"The number of JWT-SVID issuances that have succeeded.",
+		stats.UnitDimensionless)
+	jwtSignFailedTotal = stats.Int64(
+		"sentry/jwt/sign/failure_total",
+		"The number of errors occurred when signing JWT-SVIDs.",
+		stats.UnitDimensionless)
 
 	// Metrics Tags.
 	failedReasonKey = tag.MustNewKey("reason")
@@ -74,6 +82,19 @@ func CertSignFailed(reason string) {
 		certSignFailedTotal.M(1))
 }
 
+// JWTSignSucceed counts succeeded JWT-SVID issuance.
+func JWTSignSucceed() {
+	stats.Record(context.Background(), jwtSignSuccessTotal.M(1))
+}
+
+// JWTSignFailed counts failed JWT-SVID issuance.
+func JWTSignFailed(reason string) {
+	stats.RecordWithTags(
+		context.Background(),
+		diagUtils.WithTags(jwtSignFailedTotal.Name(), failedReasonKey, reason),
+		jwtSignFailedTotal.M(1))
+}
+
 // ServerCertIssueFailed records server cert issue failure.
 func ServerCertIssueFailed(reason string) {
 	stats.Record(context.Background(), serverTLSCertIssueFailedTotal.M(1))
@@ -98,5 +119,7 @@ func InitMetrics() error {
 		diagUtils.NewMeasureView(serverTLSCertIssueFailedTotal, []tag.Key{failedReasonKey}, view.Count()),
 		diagUtils.NewMeasureView(issuerCertChangedTotal, noKeys, view.Count()),
 		diagUtils.NewMeasureView(issuerCertExpiryTimestamp, noKeys, view.LastValue()),
+		diagUtils.NewMeasureView(jwtSignSuccessTotal, noKeys, view.Count()),
+		diagUtils.NewMeasureView(jwtSignFailedTotal, []tag.Key{failedReasonKey}, view.Count()),
 	)
 }
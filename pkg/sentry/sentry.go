@@ -27,10 +27,12 @@ import (
 	"github.com/dapr/dapr/pkg/healthz"
 	sentryv1pb "github.com/dapr/dapr/pkg/proto/sentry/v1"
 	"github.com/dapr/dapr/pkg/security"
+	"github.com/dapr/dapr/pkg/security/denylist"
 	"github.com/dapr/dapr/pkg/sentry/config"
 	"github.com/dapr/dapr/pkg/sentry/monitoring"
 	"github.com/dapr/dapr/pkg/sentry/server"
 	"github.com/dapr/dapr/pkg/sentry/server/ca"
+	"github.com/dapr/dapr/pkg/sentry/server/oidc"
 	"github.com/dapr/dapr/pkg/sentry/server/validator"
 	validatorInsecure "github.com/dapr/dapr/pkg/sentry/server/validator/insecure"
 	validatorJWKS "github.com/dapr/dapr/pkg/sentry/server/validator/jwks"
@@ -108,9 +110,16 @@ func New(ctx context.Context, opts Options) (CertificateAuthority, error) {
 		return nil, fmt.Errorf("error creating security: %s", err)
 	}
 
+	denyList, err := denylist.NewWatcher(opts.Config.DenyListPath)
+	if err != nil {
+		return nil, fmt.Errorf("error loading deny list: %w", err)
+	}
+
 	// Start all background processes
 	runners := concurrency.NewRunnerManager(
 		sec.Run,
+		camngr.Run,
+		denyList.Run,
 		server.New(server.Options{
 			Port:             opts.Config.Port,
 			Security:         sec,
@@ -119,8 +128,24 @@ func New(ctx context.Context, opts Options) (CertificateAuthority, error) {
 			CA:               camngr,
 			Healthz:          opts.Healthz,
 			ListenAddress:    opts.Config.ListenAddress,
+			DenyList:         denyList,
 		}).Start,
 	)
+	if opts.Config.JWKSPort > 0 {
+		oidcSrv, err := oidc.New(oidc.Options{
+			Port:          opts.Config.JWKSPort,
+			ListenAddress: opts.Config.JWKSListenAddress,
+			Issuer:        opts.Config.JWTIssuer,
+			CA:            camngr,
+			Healthz:       opts.Healthz,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating JWKS server: %w", err)
+		}
+		if err := runners.Add(oidcSrv.Start); err != nil {
+			return nil, err
+		}
+	}
 	for name, val := range vals {
 		log.Infof("Using validator '%s'", strings.ToLower(name.String()))
 		if err := runners.Add(val.Start); err != nil {
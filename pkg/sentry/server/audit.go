@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"maps"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/peer"
+
+	sentryv1pb "github.com/dapr/dapr/pkg/proto/sentry/v1"
+	"github.com/dapr/kit/logger"
+)
+
+// auditLog is the structured audit log of every credential issued, or refused,
+// by Sentry. Its output level is reset by enableAuditLog, so audit records
+// are logged regardless of the configured log level.
+var auditLog = logger.NewLogger("dapr.sentry.audit")
+
+// enableAuditLog ensures that audit records are logged, even if the configured
+// log level is above the level of audit records. The log level is applied to
+// all loggers on startup, so this must be called after it.
+func enableAuditLog() {
+	auditLog.SetOutputLevel(logger.InfoLevel)
+}
+
+// auditRecord describes an authenticated request, and is logged to the audit
+// log once the request has been served.
+type auditRecord struct {
+	fields map[string]any
+}
+
+func newAuditRecord(ctx context.Context, method, namespace, appID string, val sentryv1pb.SignCertificateRequest_TokenValidator, requester string) *auditRecord {
+	fields := map[string]any{
+		"method":    method,
+		"namespace": namespace,
+		"appID":     appID,
+		"validator": strings.ToLower(val.String()),
+	}
+	if len(requester) > 0 {
+		fields["requester"] = requester
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		fields["peer"] = p.Addr.String()
+	}
+	return &auditRecord{fields: fields}
+}
+
+// certificateIssued logs the issuance of the given workload certificate.
+func (a *auditRecord) certificateIssued(cert *x509.Certificate) {
+	fields := a.with(map[string]any{
+		"serial":    cert.SerialNumber.Text(16),
+		"notBefore": cert.NotBefore.UTC().Format(time.RFC3339),
+		"notAfter":  cert.NotAfter.UTC().Format(time.RFC3339),
+	})
+	if len(cert.URIs) > 0 {
+		fields["spiffeID"] = cert.URIs[0].String()
+	}
+	auditLog.WithFields(fields).Info("Workload certificate issued")
+}
+
+// jwtIssued logs the issuance of a JWT-SVID for the given audiences.
+func (a *auditRecord) jwtIssued(audiences []string, expiry time.Time) {
+	auditLog.WithFields(a.with(map[string]any{
+		"audiences": strings.Join(audiences, ","),
+		"notAfter":  expiry.UTC().Format(time.RFC3339),
+	})).Info("JWT-SVID issued")
+}
+
+// denied logs that the request was refused for the given reason.
+func (a *auditRecord) denied(reason string) {
+	auditLog.WithFields(a.with(map[string]any{
+		"reason": reason,
+	})).Warn("Credential request denied")
+}
+
+func (a *auditRecord) with(fields map[string]any) map[string]any {
+	maps.Copy(fields, a.fields)
+	return fields
+}
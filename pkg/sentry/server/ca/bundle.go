@@ -19,9 +19,11 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/x509"
-	"encoding/pem"
+	stdpem "encoding/pem"
 	"fmt"
 	"time"
+
+	"github.com/dapr/kit/crypto/pem"
 )
 
 // Bundle is the bundle of certificates and keys used by the CA.
@@ -31,50 +33,92 @@ type Bundle struct {
 	IssKeyPEM    []byte
 	IssChain     []*x509.Certificate
 	IssKey       any
+
+	// RootKeyPEM and RootKey are the private key of the trust anchor which
+	// signs issuers. They are only known if the trust bundle was generated by
+	// Sentry, in which case Sentry rotates the bundle itself.
+	RootKeyPEM []byte
+	RootKey    crypto.Signer
+
+	// Rotation is the state of an in progress rotation of the bundle.
+	Rotation RotationState
 }
 
 func GenerateBundle(rootKey crypto.Signer, trustDomain string, allowedClockSkew time.Duration, overrideCATTL *time.Duration) (Bundle, error) {
-	rootCert, err := generateRootCert(trustDomain, allowedClockSkew, overrideCATTL)
+	rootCert, trustAnchors, err := newRootCert(rootKey, trustDomain, allowedClockSkew, overrideCATTL)
+	if err != nil {
+		return Bundle{}, err
+	}
+
+	rootKeyPEM, err := pem.EncodePrivateKey(rootKey)
+	if err != nil {
+		return Bundle{}, err
+	}
+
+	issCert, issCertPEM, issKey, issKeyPEM, err := newIssuer(rootCert, rootKey, trustDomain, allowedClockSkew, overrideCATTL)
 	if err != nil {
-		return Bundle{}, fmt.Errorf("failed to generate root cert: %w", err)
+		return Bundle{}, err
+	}
+
+	return Bundle{
+		TrustAnchors: trustAnchors,
+		IssChainPEM:  issCertPEM,
+		IssKeyPEM:    issKeyPEM,
+		IssChain:     []*x509.Certificate{issCert},
+		IssKey:       issKey,
+		RootKeyPEM:   rootKeyPEM,
+		RootKey:      rootKey,
+	}, nil
+}
+
+// newRootCert returns a self-signed root certificate for the given key, in
+// both parsed and PEM form.
+func newRootCert(rootKey crypto.Signer, trustDomain string, allowedClockSkew time.Duration, ttl *time.Duration) (*x509.Certificate, []byte, error) {
+	rootCert, err := generateRootCert(trustDomain, allowedClockSkew, ttl)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate root cert: %w", err)
 	}
 
 	rootCertDER, err := x509.CreateCertificate(rand.Reader, rootCert, rootCert, rootKey.Public(), rootKey)
 	if err != nil {
-		return Bundle{}, fmt.Errorf("failed to sign root certificate: %w", err)
+		return nil, nil, fmt.Errorf("failed to sign root certificate: %w", err)
+	}
+
+	rootCert, err = x509.ParseCertificate(rootCertDER)
+	if err != nil {
+		return nil, nil, err
 	}
-	trustAnchors := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootCertDER})
 
+	return rootCert, stdpem.EncodeToMemory(&stdpem.Block{Type: "CERTIFICATE", Bytes: rootCertDER}), nil
+}
+
+// newIssuer generates a new issuer key and certificate signed by the given
+// root, returning the certificate and key in both parsed and PEM form.
+func newIssuer(rootCert *x509.Certificate, rootKey crypto.Signer, trustDomain string, allowedClockSkew time.Duration, ttl *time.Duration) (*x509.Certificate, []byte, *ecdsa.PrivateKey, []byte, error) {
 	issKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
-		return Bundle{}, err
+		return nil, nil, nil, nil, err
 	}
 	issKeyDer, err := x509.MarshalPKCS8PrivateKey(issKey)
 	if err != nil {
-		return Bundle{}, err
+		return nil, nil, nil, nil, err
 	}
-	issKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: issKeyDer})
+	issKeyPEM := stdpem.EncodeToMemory(&stdpem.Block{Type: "PRIVATE KEY", Bytes: issKeyDer})
 
-	issCert, err := generateIssuerCert(trustDomain, allowedClockSkew, overrideCATTL)
+	issCert, err := generateIssuerCert(trustDomain, allowedClockSkew, ttl)
 	if err != nil {
-		return Bundle{}, fmt.Errorf("failed to generate issuer cert: %w", err)
+		return nil, nil, nil, nil, fmt.Errorf("failed to generate issuer cert: %w", err)
 	}
 	issCertDER, err := x509.CreateCertificate(rand.Reader, issCert, rootCert, &issKey.PublicKey, rootKey)
 	if err != nil {
-		return Bundle{}, fmt.Errorf("failed to sign issuer cert: %w", err)
+		return nil, nil, nil, nil, fmt.Errorf("failed to sign issuer cert: %w", err)
 	}
-	issCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: issCertDER})
+	issCertPEM := stdpem.EncodeToMemory(&stdpem.Block{Type: "CERTIFICATE", Bytes: issCertDER})
 
 	issCert, err = x509.ParseCertificate(issCertDER)
 	if err != nil {
-		return Bundle{}, err
+		return nil, nil, nil, nil, err
 	}
 
-	return Bundle{
-		TrustAnchors: trustAnchors,
-		IssChainPEM:  issCertPEM,
-		IssKeyPEM:    issKeyPEM,
-		IssChain:     []*x509.Certificate{issCert},
-		IssKey:       issKey,
-	}, nil
+	return issCert, issCertPEM, issKey, issKeyPEM, nil
 }
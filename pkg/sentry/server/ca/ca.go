@@ -20,9 +20,12 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/x509"
+	"fmt"
+	"time"
 
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/clock"
 
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/security"
@@ -68,6 +71,19 @@ type Signer interface {
 
 	// TrustAnchors returns the trust anchors for the CA in PEM format.
 	TrustAnchors() []byte
+
+	// SignJWT signs a JWT-SVID for the given identity and audiences with the
+	// issuer key, returning the token and its expiry. As with SignIdentity, no
+	// authz is performed on the request.
+	SignJWT(context.Context, *JWTRequest) (string, time.Time, error)
+
+	// JWKS returns the JSON Web Key Set which verifies the JWT-SVIDs signed by
+	// the CA.
+	JWKS() []byte
+
+	// Run rotates the trust bundle as it becomes due. Returns once a rotated
+	// bundle has been persisted, at which point the CA should be reloaded.
+	Run(context.Context) error
 }
 
 // store is the interface for the trust bundle backend store.
@@ -80,6 +96,9 @@ type store interface {
 type ca struct {
 	bundle Bundle
 	config config.Config
+	jwt    *jwtSigner
+	store  store
+	clock  clock.WithTicker
 }
 
 func New(ctx context.Context, conf config.Config) (Signer, error) {
@@ -133,9 +152,17 @@ func New(ctx context.Context, conf config.Config) (Signer, error) {
 	}
 	monitoring.IssuerCertExpiry(bundle.IssChain[0].NotAfter)
 
+	jwt, err := newJWTSigner(bundle.IssKey, previousJWTKeys(time.Now(), bundle.Rotation.PreviousJWTKeys))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JWT signer: %w", err)
+	}
+
 	return &ca{
 		bundle: bundle,
 		config: conf,
+		jwt:    jwt,
+		store:  castore,
+		clock:  clock.RealClock{},
 	}, nil
 }
 
@@ -19,6 +19,7 @@ package fake
 import (
 	"context"
 	"crypto/x509"
+	"time"
 
 	"github.com/dapr/dapr/pkg/sentry/server/ca"
 )
@@ -26,6 +27,9 @@ import (
 type Fake struct {
 	signIdentityFn func(context.Context, *ca.SignRequest) ([]*x509.Certificate, error)
 	trustAnchorsFn func() []byte
+	signJWTFn      func(context.Context, *ca.JWTRequest) (string, time.Time, error)
+	jwksFn         func() []byte
+	runFn          func(context.Context) error
 }
 
 func New() *Fake {
@@ -36,6 +40,16 @@ func New() *Fake {
 		trustAnchorsFn: func() []byte {
 			return nil
 		},
+		signJWTFn: func(context.Context, *ca.JWTRequest) (string, time.Time, error) {
+			return "", time.Time{}, nil
+		},
+		jwksFn: func() []byte {
+			return nil
+		},
+		runFn: func(ctx context.Context) error {
+			<-ctx.Done()
+			return nil
+		},
 	}
 }
 
@@ -49,6 +63,21 @@ func (f *Fake) WithTrustAnchors(fn func() []byte) *Fake {
 	return f
 }
 
+func (f *Fake) WithSignJWT(fn func(context.Context, *ca.JWTRequest) (string, time.Time, error)) *Fake {
+	f.signJWTFn = fn
+	return f
+}
+
+func (f *Fake) WithJWKS(fn func() []byte) *Fake {
+	f.jwksFn = fn
+	return f
+}
+
+func (f *Fake) WithRun(fn func(context.Context) error) *Fake {
+	f.runFn = fn
+	return f
+}
+
 func (f *Fake) SignIdentity(ctx context.Context, req *ca.SignRequest) ([]*x509.Certificate, error) {
 	return f.signIdentityFn(ctx, req)
 }
@@ -56,3 +85,15 @@ func (f *Fake) SignIdentity(ctx context.Context, req *ca.SignRequest) ([]*x509.C
 func (f *Fake) TrustAnchors() []byte {
 	return f.trustAnchorsFn()
 }
+
+func (f *Fake) SignJWT(ctx context.Context, req *ca.JWTRequest) (string, time.Time, error) {
+	return f.signJWTFn(ctx, req)
+}
+
+func (f *Fake) JWKS() []byte {
+	return f.jwksFn()
+}
+
+func (f *Fake) Run(ctx context.Context) error {
+	return f.runFn(ctx)
+}
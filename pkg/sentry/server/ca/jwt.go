@@ -0,0 +1,200 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+
+	"github.com/dapr/dapr/pkg/security/spiffe"
+)
+
+// JWTRequest is a request for a JWT-SVID to be signed with the issuer key.
+type JWTRequest struct {
+	// TrustDomain is the trust domain of the client.
+	TrustDomain string
+
+	// Namespace is the namespace of the client.
+	Namespace string
+
+	// AppID is the app id of the client.
+	AppID string
+
+	// Audiences is the list of audiences the JWT-SVID is issued for.
+	Audiences []string
+}
+
+// jwtSigner signs JWT-SVIDs with the issuer key.
+type jwtSigner struct {
+	key  jwk.Key
+	pub  jwk.Key
+	alg  jwa.SignatureAlgorithm
+	jwks []byte
+}
+
+// newJWTSigner returns a JWT signer for the given issuer private key. The key
+// ID is the JWK thumbprint of the public key, so it is stable for as long as
+// the issuer key doesn't change. The JWKS also contains the given keys of
+// previous issuers, so that JWT-SVIDs signed before the issuer was rotated
+// can still be verified.
+func newJWTSigner(issKey any, previous []PreviousJWTKey) (*jwtSigner, error) {
+	signer, ok := issKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("issuer key of type %T is not a signer", issKey)
+	}
+
+	alg, err := jwtAlgorithm(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := jwk.FromRaw(issKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import issuer key as JWK: %w", err)
+	}
+	pub, err := key.PublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public JWK of issuer key: %w", err)
+	}
+
+	thumbprint, err := pub.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute thumbprint of issuer key: %w", err)
+	}
+	kid := base64.RawURLEncoding.EncodeToString(thumbprint)
+
+	for _, k := range []jwk.Key{key, pub} {
+		if err = errors.Join(
+			k.Set(jwk.KeyIDKey, kid),
+			k.Set(jwk.AlgorithmKey, alg),
+			k.Set(jwk.KeyUsageKey, jwk.ForSignature),
+		); err != nil {
+			return nil, fmt.Errorf("failed to set JWK headers: %w", err)
+		}
+	}
+
+	set := jwk.NewSet()
+	if err = set.AddKey(pub); err != nil {
+		return nil, err
+	}
+	for _, prev := range previous {
+		prevKey, err := jwk.ParseKey(prev.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse previous issuer JWK: %w", err)
+		}
+		if prevKey.KeyID() == kid {
+			continue
+		}
+		if err = set.AddKey(prevKey); err != nil {
+			return nil, err
+		}
+	}
+	jwks, err := json.Marshal(set)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JWKS: %w", err)
+	}
+
+	return &jwtSigner{
+		key:  key,
+		pub:  pub,
+		alg:  alg,
+		jwks: jwks,
+	}, nil
+}
+
+// jwtAlgorithm returns the JWS algorithm to sign with for the given public
+// key.
+func jwtAlgorithm(pub crypto.PublicKey) (jwa.SignatureAlgorithm, error) {
+	switch k := pub.(type) {
+	case *ecdsa.PublicKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return jwa.ES256, nil
+		case elliptic.P384():
+			return jwa.ES384, nil
+		case elliptic.P521():
+			return jwa.ES512, nil
+		default:
+			return "", fmt.Errorf("unsupported issuer key curve %s", k.Curve.Params().Name)
+		}
+	case *rsa.PublicKey:
+		return jwa.RS256, nil
+	case ed25519.PublicKey:
+		return jwa.EdDSA, nil
+	default:
+		return "", fmt.Errorf("unsupported issuer key type %T", pub)
+	}
+}
+
+func (c *ca) SignJWT(_ context.Context, req *JWTRequest) (string, time.Time, error) {
+	if len(req.Audiences) == 0 {
+		return "", time.Time{}, errors.New("at least one audience is required")
+	}
+
+	td, err := spiffeid.TrustDomainFromString(req.TrustDomain)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	spiffeID, err := spiffe.FromStrings(td, req.Namespace, req.AppID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	// The token must not outlive the key it is signed with.
+	now := time.Now().UTC()
+	expiry := now.Add(c.config.JWTTTL)
+	if notAfter := c.bundle.IssChain[0].NotAfter; notAfter.Before(expiry) {
+		expiry = notAfter
+	}
+
+	builder := jwt.NewBuilder().
+		Subject(spiffeID.URL().String()).
+		Audience(req.Audiences).
+		IssuedAt(now).
+		Expiration(expiry)
+	if len(c.config.JWTIssuer) > 0 {
+		builder = builder.Issuer(c.config.JWTIssuer)
+	}
+
+	token, err := builder.Build()
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to build JWT: %w", err)
+	}
+
+	signed, err := jwt.Sign(token, jwt.WithKey(c.jwt.alg, c.jwt.key))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign JWT: %w", err)
+	}
+
+	return string(signed), expiry, nil
+}
+
+func (c *ca) JWKS() []byte {
+	return c.jwt.jwks
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/sentry/config"
+)
+
+func TestNewJWTSigner(t *testing.T) {
+	ecP256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecP384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		key    any
+		expAlg jwa.SignatureAlgorithm
+		expErr bool
+	}{
+		"ecdsa P256": {key: ecP256, expAlg: jwa.ES256},
+		"ecdsa P384": {key: ecP384, expAlg: jwa.ES384},
+		"rsa":        {key: rsaKey, expAlg: jwa.RS256},
+		"ed25519":    {key: edKey, expAlg: jwa.EdDSA},
+		"not a key":  {key: "foo", expErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			signer, err := newJWTSigner(test.key, nil)
+			if test.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expAlg, signer.alg)
+
+			set, err := jwk.Parse(signer.jwks)
+			require.NoError(t, err)
+			require.Equal(t, 1, set.Len())
+			key, _ := set.Key(0)
+			assert.Equal(t, test.expAlg, key.Algorithm())
+			assert.Equal(t, signer.key.KeyID(), key.KeyID())
+			assert.NotEmpty(t, key.KeyID())
+
+			// The JWKS must only contain the public key.
+			_, isPrivate := key.(interface{ D() []byte })
+			assert.False(t, isPrivate)
+		})
+	}
+}
+
+func TestSignJWT(t *testing.T) {
+	issKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := newJWTSigner(issKey, nil)
+	require.NoError(t, err)
+
+	newCA := func(issuer string, ttl time.Duration, notAfter time.Time) *ca {
+		return &ca{
+			bundle: Bundle{
+				IssChain: []*x509.Certificate{{NotAfter: notAfter}},
+				IssKey:   issKey,
+			},
+			config: config.Config{JWTIssuer: issuer, JWTTTL: ttl},
+			jwt:    signer,
+		}
+	}
+
+	t.Run("signed token should be verifiable with the JWKS", func(t *testing.T) {
+		c := newCA("https://sentry.example.com", time.Hour, time.Now().Add(24*time.Hour))
+
+		token, expiry, err := c.SignJWT(t.Context(), &JWTRequest{
+			TrustDomain: "example.test.dapr.io",
+			Namespace:   "my-test-namespace",
+			AppID:       "my-app-id",
+			Audiences:   []string{"aud1", "aud2"},
+		})
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), expiry, time.Minute)
+
+		set, err := jwk.Parse(c.JWKS())
+		require.NoError(t, err)
+
+		parsed, err := jwt.Parse([]byte(token),
+			jwt.WithKeySet(set),
+			jwt.WithAudience("aud2"),
+			jwt.WithIssuer("https://sentry.example.com"),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "spiffe://example.test.dapr.io/ns/my-test-namespace/my-app-id", parsed.Subject())
+		assert.Equal(t, []string{"aud1", "aud2"}, parsed.Audience())
+		assert.Equal(t, expiry.Unix(), parsed.Expiration().Unix())
+	})
+
+	t.Run("issuer claim should be omitted if no issuer is configured", func(t *testing.T) {
+		c := newCA("", time.Hour, time.Now().Add(24*time.Hour))
+
+		token, _, err := c.SignJWT(t.Context(), &JWTRequest{
+			TrustDomain: "example.test.dapr.io",
+			Namespace:   "my-test-namespace",
+			AppID:       "my-app-id",
+			Audiences:   []string{"aud1"},
+		})
+		require.NoError(t, err)
+
+		parsed, err := jwt.Parse([]byte(token), jwt.WithVerify(false))
+		require.NoError(t, err)
+		assert.Empty(t, parsed.Issuer())
+	})
+
+	t.Run("expiry should not exceed the issuer certificate expiry", func(t *testing.T) {
+		notAfter := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+		c := newCA("", time.Hour, notAfter)
+
+		_, expiry, err := c.SignJWT(t.Context(), &JWTRequest{
+			TrustDomain: "example.test.dapr.io",
+			Namespace:   "my-test-namespace",
+			AppID:       "my-app-id",
+			Audiences:   []string{"aud1"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, notAfter, expiry)
+	})
+
+	t.Run("no audience should error", func(t *testing.T) {
+		c := newCA("", time.Hour, time.Now().Add(24*time.Hour))
+
+		_, _, err := c.SignJWT(t.Context(), &JWTRequest{
+			TrustDomain: "example.test.dapr.io",
+			Namespace:   "my-test-namespace",
+			AppID:       "my-app-id",
+		})
+		require.Error(t, err)
+	})
+}
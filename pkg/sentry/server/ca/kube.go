@@ -15,6 +15,7 @@ package ca
 
 import (
 	"context"
+	"encoding/json"
 	"path/filepath"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -72,6 +73,18 @@ func (k *kube) get(ctx context.Context) (Bundle, bool, error) {
 		return Bundle{}, false, err
 	}
 
+	var rootKeyPEM, state []byte
+	if len(k.config.RootKeyPath) > 0 {
+		rootKeyPEM = s.Data[filepath.Base(k.config.RootKeyPath)]
+	}
+	if len(k.config.RotationStatePath) > 0 {
+		state = s.Data[filepath.Base(k.config.RotationStatePath)]
+	}
+	bundle, err = loadRotation(bundle, rootKeyPEM, state)
+	if err != nil {
+		return Bundle{}, false, err
+	}
+
 	return bundle, true, nil
 }
 
@@ -86,6 +99,16 @@ func (k *kube) store(ctx context.Context, bundle Bundle) error {
 		filepath.Base(k.config.IssuerCertPath): bundle.IssChainPEM,
 		filepath.Base(k.config.IssuerKeyPath):  bundle.IssKeyPEM,
 	}
+	if len(k.config.RootKeyPath) > 0 && len(bundle.RootKeyPEM) > 0 {
+		s.Data[filepath.Base(k.config.RootKeyPath)] = bundle.RootKeyPEM
+	}
+	if len(k.config.RotationStatePath) > 0 && !bundle.Rotation.isEmpty() {
+		state, err := json.Marshal(bundle.Rotation)
+		if err != nil {
+			return err
+		}
+		s.Data[filepath.Base(k.config.RotationStatePath)] = state
+	}
 
 	_, err = k.client.CoreV1().Secrets(k.namespace).Update(ctx, s, metav1.UpdateOptions{})
 	if err != nil {
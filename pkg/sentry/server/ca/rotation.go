@@ -0,0 +1,439 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/dapr/dapr/pkg/sentry/config"
+	"github.com/dapr/dapr/pkg/sentry/monitoring"
+	"github.com/dapr/kit/crypto/pem"
+)
+
+// rotationCheckInterval is the interval at which the trust bundle is checked
+// for rotation.
+const rotationCheckInterval = time.Minute
+
+// RotationPhase is the phase of a root rollover.
+type RotationPhase string
+
+const (
+	// RotationPhaseNone is the phase when no root rollover is in progress.
+	RotationPhaseNone RotationPhase = ""
+
+	// RotationPhasePublishing is the phase when the new root has been added to
+	// the trust anchors, and is waiting to be propagated to all workloads
+	// before it is used for signing.
+	RotationPhasePublishing RotationPhase = "Publishing"
+
+	// RotationPhaseRetiring is the phase when signing has switched to the new
+	// root, and the old root is kept in the trust anchors until all
+	// certificates signed under it have expired.
+	RotationPhaseRetiring RotationPhase = "Retiring"
+)
+
+// RotationState is the persisted state of a root rollover, and of the keys of
+// previous issuers.
+type RotationState struct {
+	Phase RotationPhase `json:"phase,omitempty"`
+	// Since is the time the current phase was entered.
+	Since time.Time `json:"since,omitempty"`
+
+	// NextRootKeyPEM, NextIssuerChainPEM and NextIssuerKeyPEM are the
+	// credentials which are switched to once the new root has been published.
+	NextRootKeyPEM     []byte `json:"nextRootKey,omitempty"`
+	NextIssuerChainPEM []byte `json:"nextIssuerChain,omitempty"`
+	NextIssuerKeyPEM   []byte `json:"nextIssuerKey,omitempty"`
+
+	// RetiringAnchorsPEM are the trust anchors which are removed once the
+	// certificates signed under them have expired.
+	RetiringAnchorsPEM []byte `json:"retiringAnchors,omitempty"`
+
+	// PreviousJWTKeys are the public keys of previous issuers, which are kept
+	// in the JWKS until the JWT-SVIDs signed with them have expired.
+	PreviousJWTKeys []PreviousJWTKey `json:"previousJWTKeys,omitempty"`
+}
+
+// PreviousJWTKey is the public JWK of a previous issuer.
+type PreviousJWTKey struct {
+	Key json.RawMessage `json:"key"`
+	// Until is the time after which no JWT-SVID signed with the key is valid.
+	Until time.Time `json:"until"`
+}
+
+// isEmpty returns true if there is no rotation state to persist.
+func (r RotationState) isEmpty() bool {
+	return r.Phase == RotationPhaseNone && len(r.PreviousJWTKeys) == 0
+}
+
+// previousJWTKeys returns the given keys of previous issuers which are still
+// needed to verify JWT-SVIDs at the given time.
+func previousJWTKeys(now time.Time, keys []PreviousJWTKey) []PreviousJWTKey {
+	var valid []PreviousJWTKey
+	for _, key := range keys {
+		if !now.After(key.Until) {
+			valid = append(valid, key)
+		}
+	}
+	return valid
+}
+
+// retireJWTKey returns the keys of previous issuers once the issuer of the
+// bundle has been replaced at the given time. The public key of the replaced
+// issuer is kept until the JWT-SVIDs it signed have expired.
+func retireJWTKey(now time.Time, conf config.Config, bundle Bundle) ([]PreviousJWTKey, error) {
+	signer, err := newJWTSigner(bundle.IssKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	key, err := json.Marshal(signer.pub)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal issuer JWK: %w", err)
+	}
+
+	return append(previousJWTKeys(now, bundle.Rotation.PreviousJWTKeys), PreviousJWTKey{
+		Key:   key,
+		Until: now.Add(conf.JWTTTL + conf.AllowedClockSkew),
+	}), nil
+}
+
+// loadRotation returns the bundle with the given persisted root key and
+// rotation state. A root key which doesn't belong to any of the trust anchors
+// is ignored, disabling rotation.
+func loadRotation(bundle Bundle, rootKeyPEM, state []byte) (Bundle, error) {
+	if len(state) > 0 {
+		if err := json.Unmarshal(state, &bundle.Rotation); err != nil {
+			return Bundle{}, fmt.Errorf("failed to decode rotation state: %w", err)
+		}
+	}
+
+	if len(rootKeyPEM) > 0 {
+		if err := setRootKey(&bundle, rootKeyPEM); err != nil {
+			log.Warnf("Ignoring root key; automatic rotation is disabled: %s", err)
+			bundle.RootKey, bundle.RootKeyPEM = nil, nil
+			bundle.Rotation = RotationState{}
+		}
+	}
+
+	return bundle, nil
+}
+
+// setRootKey sets the root key of the bundle, ensuring it belongs to one of
+// its trust anchors.
+func setRootKey(bundle *Bundle, rootKeyPEM []byte) error {
+	rootKey, err := pem.DecodePEMPrivateKey(rootKeyPEM)
+	if err != nil {
+		return fmt.Errorf("failed to decode root key: %w", err)
+	}
+
+	bundle.RootKey = rootKey
+	if _, err = rootCert(*bundle); err != nil {
+		bundle.RootKey = nil
+		return err
+	}
+
+	bundle.RootKeyPEM, err = pem.EncodePrivateKey(rootKey)
+	return err
+}
+
+// rootCert returns the trust anchor belonging to the root key of the bundle.
+func rootCert(bundle Bundle) (*x509.Certificate, error) {
+	anchors, err := pem.DecodePEMCertificates(bundle.TrustAnchors)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, anchor := range anchors {
+		ok, err := pem.PublicKeysEqual(anchor.PublicKey, bundle.RootKey.Public())
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return anchor, nil
+		}
+	}
+
+	return nil, errors.New("root key does not belong to any trust anchor")
+}
+
+// rotate returns the next state of the bundle at the given time, and whether
+// it changed. Rotation only happens if the root key of the bundle is known.
+// Outside of a root rollover, the issuer is rotated with the current root once
+// it is due to expire. Once the root itself is due to expire, a root rollover
+// is started:
+//
+//  1. A new root and issuer are generated, and the new root is published
+//     alongside the current trust anchors.
+//  2. After the anchor propagation period, signing switches to the new issuer.
+//  3. After all workload certificates signed by the old issuer have expired,
+//     the old root is removed from the trust anchors.
+func rotate(now time.Time, conf config.Config, bundle Bundle) (Bundle, bool, error) {
+	if bundle.RootKey == nil {
+		return bundle, false, nil
+	}
+
+	switch bundle.Rotation.Phase {
+	case RotationPhaseNone:
+	case RotationPhasePublishing:
+		if now.Before(bundle.Rotation.Since.Add(conf.AnchorPropagationPeriod)) {
+			return bundle, false, nil
+		}
+		return switchRoot(now, conf, bundle)
+	case RotationPhaseRetiring:
+		if now.Before(bundle.Rotation.Since.Add(conf.WorkloadCertTTL + conf.AllowedClockSkew)) {
+			return bundle, false, nil
+		}
+		return retireAnchors(now, bundle)
+	default:
+		return bundle, false, fmt.Errorf("unknown rotation phase %q", bundle.Rotation.Phase)
+	}
+
+	root, err := rootCert(bundle)
+	if err != nil {
+		return bundle, false, err
+	}
+
+	if root.NotAfter.Sub(now) < conf.RootRolloverBefore {
+		return publishRoot(now, conf, bundle)
+	}
+
+	if bundle.IssChain[0].NotAfter.Sub(now) < conf.IssuerRotateBefore {
+		return rotateIssuer(now, conf, bundle, root)
+	}
+
+	return bundle, false, nil
+}
+
+// publishRoot generates a new root and issuer, and publishes the new root
+// alongside the current trust anchors.
+func publishRoot(now time.Time, conf config.Config, bundle Bundle) (Bundle, bool, error) {
+	log.Info("Root certificate is due to expire: publishing new trust anchor")
+
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return bundle, false, err
+	}
+	rootKeyPEM, err := pem.EncodePrivateKey(rootKey)
+	if err != nil {
+		return bundle, false, err
+	}
+
+	root, rootPEM, err := newRootCert(rootKey, conf.TrustDomain, conf.AllowedClockSkew, nil)
+	if err != nil {
+		return bundle, false, err
+	}
+
+	issTTL := conf.IssuerTTL
+	_, issChainPEM, _, issKeyPEM, err := newIssuer(root, rootKey, conf.TrustDomain, conf.AllowedClockSkew, &issTTL)
+	if err != nil {
+		return bundle, false, err
+	}
+
+	next := bundle
+	next.TrustAnchors = append(slices.Clone(bundle.TrustAnchors), rootPEM...)
+	next.Rotation = RotationState{
+		Phase:              RotationPhasePublishing,
+		Since:              now,
+		NextRootKeyPEM:     rootKeyPEM,
+		NextIssuerChainPEM: issChainPEM,
+		NextIssuerKeyPEM:   issKeyPEM,
+		PreviousJWTKeys:    previousJWTKeys(now, bundle.Rotation.PreviousJWTKeys),
+	}
+
+	return rebuild(next)
+}
+
+// switchRoot switches signing to the issuer of the published root, and marks
+// the old root for retirement.
+func switchRoot(now time.Time, conf config.Config, bundle Bundle) (Bundle, bool, error) {
+	log.Info("New trust anchor has been propagated: switching to new issuer")
+
+	old, err := rootCert(bundle)
+	if err != nil {
+		return bundle, false, err
+	}
+	oldPEM, err := pem.EncodeX509(old)
+	if err != nil {
+		return bundle, false, err
+	}
+	jwtKeys, err := retireJWTKey(now, conf, bundle)
+	if err != nil {
+		return bundle, false, err
+	}
+
+	next := bundle
+	next.IssChainPEM = bundle.Rotation.NextIssuerChainPEM
+	next.IssKeyPEM = bundle.Rotation.NextIssuerKeyPEM
+	next.RootKeyPEM = bundle.Rotation.NextRootKeyPEM
+	next.Rotation = RotationState{
+		Phase:              RotationPhaseRetiring,
+		Since:              now,
+		RetiringAnchorsPEM: oldPEM,
+		PreviousJWTKeys:    jwtKeys,
+	}
+
+	return rebuild(next)
+}
+
+// retireAnchors removes the retiring roots from the trust anchors, completing
+// the root rollover.
+func retireAnchors(now time.Time, bundle Bundle) (Bundle, bool, error) {
+	log.Info("Certificates signed by the old issuer have expired: retiring old trust anchor")
+
+	retiring, err := pem.DecodePEMCertificates(bundle.Rotation.RetiringAnchorsPEM)
+	if err != nil {
+		return bundle, false, fmt.Errorf("failed to decode retiring trust anchors: %w", err)
+	}
+	anchors, err := pem.DecodePEMCertificates(bundle.TrustAnchors)
+	if err != nil {
+		return bundle, false, err
+	}
+
+	var trustAnchors []byte
+	for _, anchor := range anchors {
+		if slices.ContainsFunc(retiring, anchor.Equal) {
+			continue
+		}
+		anchorPEM, err := pem.EncodeX509(anchor)
+		if err != nil {
+			return bundle, false, err
+		}
+		trustAnchors = append(trustAnchors, anchorPEM...)
+	}
+
+	next := bundle
+	next.TrustAnchors = trustAnchors
+	next.Rotation = RotationState{
+		PreviousJWTKeys: previousJWTKeys(now, bundle.Rotation.PreviousJWTKeys),
+	}
+
+	return rebuild(next)
+}
+
+// rotateIssuer replaces the issuer with a new one signed by the current root.
+// The new issuer never outlives the root. The key of the replaced issuer is
+// kept in the JWKS until the JWT-SVIDs it signed have expired.
+func rotateIssuer(now time.Time, conf config.Config, bundle Bundle, root *x509.Certificate) (Bundle, bool, error) {
+	ttl := min(conf.IssuerTTL, root.NotAfter.Sub(now))
+	if ttl <= conf.IssuerRotateBefore {
+		// Wait for the root rollover to replace the issuer.
+		return bundle, false, nil
+	}
+
+	log.Info("Issuer certificate is due to expire: rotating issuer")
+
+	_, issChainPEM, _, issKeyPEM, err := newIssuer(root, bundle.RootKey, conf.TrustDomain, conf.AllowedClockSkew, &ttl)
+	if err != nil {
+		return bundle, false, err
+	}
+	jwtKeys, err := retireJWTKey(now, conf, bundle)
+	if err != nil {
+		return bundle, false, err
+	}
+
+	next := bundle
+	next.IssChainPEM = issChainPEM
+	next.IssKeyPEM = issKeyPEM
+	next.Rotation.PreviousJWTKeys = jwtKeys
+
+	return rebuild(next)
+}
+
+// rebuild verifies the given rotated bundle, returning it ready for Sentry.
+func rebuild(bundle Bundle) (Bundle, bool, error) {
+	next, err := verifyBundle(bundle.TrustAnchors, bundle.IssChainPEM, bundle.IssKeyPEM)
+	if err != nil {
+		return bundle, false, fmt.Errorf("rotated bundle is invalid: %w", err)
+	}
+
+	if err = setRootKey(&next, bundle.RootKeyPEM); err != nil {
+		return bundle, false, fmt.Errorf("rotated bundle is invalid: %w", err)
+	}
+	next.Rotation = bundle.Rotation
+
+	return next, true, nil
+}
+
+// Run rotates the trust bundle as it becomes due. Returns once a rotated
+// bundle has been persisted, so that Sentry is reloaded with it.
+func (c *ca) Run(ctx context.Context) error {
+	if !c.config.RotationEnabled {
+		<-ctx.Done()
+		return nil
+	}
+	if c.bundle.RootKey == nil {
+		log.Info("Root key of trust bundle is not known to Sentry: automatic rotation is disabled")
+		<-ctx.Done()
+		return nil
+	}
+
+	ticker := c.clock.NewTicker(rotationCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		next, changed, err := rotate(c.clock.Now(), c.config, c.bundle)
+		if err != nil {
+			log.Errorf("Failed to rotate trust bundle: %s", err)
+		} else if changed {
+			reload, err := c.persist(ctx, next)
+			if err != nil {
+				log.Errorf("Failed to persist rotated trust bundle: %s", err)
+			}
+			if reload {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+		}
+	}
+}
+
+// persist stores the rotated bundle, returning true if Sentry should be
+// reloaded. The bundle is only stored if the stored bundle is still the one
+// Sentry was loaded with, so that concurrent Sentry instances don't overwrite
+// each other's rotation.
+func (c *ca) persist(ctx context.Context, next Bundle) (bool, error) {
+	current, ok, err := c.store.get(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !ok || !bytes.Equal(current.TrustAnchors, c.bundle.TrustAnchors) ||
+		!bytes.Equal(current.IssChainPEM, c.bundle.IssChainPEM) ||
+		current.Rotation.Phase != c.bundle.Rotation.Phase {
+		log.Info("Stored trust bundle has changed; reloading")
+		return true, nil
+	}
+
+	if err := c.store.store(ctx, next); err != nil {
+		return false, err
+	}
+
+	monitoring.IssuerCertChanged()
+	log.Info("Rotated trust bundle persisted; reloading")
+	return true, nil
+}
@@ -0,0 +1,349 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/dapr/dapr/pkg/sentry/config"
+	"github.com/dapr/kit/crypto/pem"
+)
+
+func rotationConfig(t *testing.T) config.Config {
+	t.Helper()
+	dir := t.TempDir()
+	return config.Config{
+		TrustDomain:             "example.test.dapr.io",
+		RootCertPath:            filepath.Join(dir, "ca.crt"),
+		IssuerCertPath:          filepath.Join(dir, "issuer.crt"),
+		IssuerKeyPath:           filepath.Join(dir, "issuer.key"),
+		RootKeyPath:             filepath.Join(dir, "ca.key"),
+		RotationStatePath:       filepath.Join(dir, "rotation.json"),
+		WorkloadCertTTL:         24 * time.Hour,
+		AllowedClockSkew:        15 * time.Minute,
+		JWTTTL:                  config.DefaultJWTTTL,
+		RotationEnabled:         true,
+		IssuerTTL:               config.DefaultIssuerTTL,
+		IssuerRotateBefore:      config.DefaultIssuerRotateBefore,
+		RootRolloverBefore:      config.DefaultRootRolloverBefore,
+		AnchorPropagationPeriod: config.DefaultAnchorPropagationPeriod,
+	}
+}
+
+func newRotationBundle(t *testing.T, conf config.Config, rootTTL, issTTL time.Duration) Bundle {
+	t.Helper()
+
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rootKeyPEM, err := pem.EncodePrivateKey(rootKey)
+	require.NoError(t, err)
+
+	root, rootPEM, err := newRootCert(rootKey, conf.TrustDomain, conf.AllowedClockSkew, &rootTTL)
+	require.NoError(t, err)
+	_, issChainPEM, _, issKeyPEM, err := newIssuer(root, rootKey, conf.TrustDomain, conf.AllowedClockSkew, &issTTL)
+	require.NoError(t, err)
+
+	bundle, changed, err := rebuild(Bundle{
+		TrustAnchors: rootPEM,
+		IssChainPEM:  issChainPEM,
+		IssKeyPEM:    issKeyPEM,
+		RootKeyPEM:   rootKeyPEM,
+	})
+	require.NoError(t, err)
+	require.True(t, changed)
+	return bundle
+}
+
+func TestRotate(t *testing.T) {
+	t.Run("bundle should not change if nothing is due", func(t *testing.T) {
+		conf := rotationConfig(t)
+		bundle := newRotationBundle(t, conf, 365*24*time.Hour, 90*24*time.Hour)
+
+		next, changed, err := rotate(time.Now(), conf, bundle)
+		require.NoError(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, bundle, next)
+	})
+
+	t.Run("bundle should not be rotated if the root key is not known", func(t *testing.T) {
+		conf := rotationConfig(t)
+		bundle := newRotationBundle(t, conf, 365*24*time.Hour, 90*24*time.Hour)
+		bundle.RootKey = nil
+		bundle.RootKeyPEM = nil
+
+		_, changed, err := rotate(time.Now().Add(364*24*time.Hour), conf, bundle)
+		require.NoError(t, err)
+		assert.False(t, changed)
+	})
+
+	t.Run("issuer should be rotated with the current root when due", func(t *testing.T) {
+		conf := rotationConfig(t)
+		bundle := newRotationBundle(t, conf, 365*24*time.Hour, 40*24*time.Hour)
+
+		next, changed, err := rotate(time.Now().Add(15*24*time.Hour), conf, bundle)
+		require.NoError(t, err)
+		require.True(t, changed)
+
+		assert.Equal(t, bundle.TrustAnchors, next.TrustAnchors)
+		assert.Equal(t, bundle.RootKeyPEM, next.RootKeyPEM)
+		assert.NotEqual(t, bundle.IssChainPEM, next.IssChainPEM)
+		assert.NotEqual(t, bundle.IssKeyPEM, next.IssKeyPEM)
+		assert.Equal(t, RotationPhaseNone, next.Rotation.Phase)
+		assert.Len(t, next.Rotation.PreviousJWTKeys, 1)
+
+		root, err := rootCert(next)
+		require.NoError(t, err)
+		require.NoError(t, next.IssChain[0].CheckSignatureFrom(root))
+		assert.WithinDuration(t, time.Now().Add(conf.IssuerTTL), next.IssChain[0].NotAfter, time.Minute)
+	})
+
+	t.Run("rotated issuer should not outlive the root", func(t *testing.T) {
+		conf := rotationConfig(t)
+		conf.RootRolloverBefore = 24 * time.Hour
+		bundle := newRotationBundle(t, conf, 60*24*time.Hour, 40*24*time.Hour)
+
+		next, changed, err := rotate(time.Now().Add(15*24*time.Hour), conf, bundle)
+		require.NoError(t, err)
+		require.True(t, changed)
+
+		root, err := rootCert(next)
+		require.NoError(t, err)
+		assert.False(t, next.IssChain[0].NotAfter.After(root.NotAfter))
+	})
+
+	t.Run("root should be rolled over through all phases", func(t *testing.T) {
+		conf := rotationConfig(t)
+		bundle := newRotationBundle(t, conf, 365*24*time.Hour, 365*24*time.Hour)
+		oldRoot, err := rootCert(bundle)
+		require.NoError(t, err)
+
+		// Publish the new root alongside the old one.
+		now := time.Now().Add(300 * 24 * time.Hour)
+		published, changed, err := rotate(now, conf, bundle)
+		require.NoError(t, err)
+		require.True(t, changed)
+		assert.Equal(t, RotationPhasePublishing, published.Rotation.Phase)
+		assert.Equal(t, now, published.Rotation.Since)
+		assert.Equal(t, bundle.IssChainPEM, published.IssChainPEM)
+		assert.Equal(t, bundle.RootKeyPEM, published.RootKeyPEM)
+		anchors, err := pem.DecodePEMCertificates(published.TrustAnchors)
+		require.NoError(t, err)
+		require.Len(t, anchors, 2)
+		assert.True(t, anchors[0].Equal(oldRoot))
+		newRoot := anchors[1]
+
+		// Wait for the new root to propagate.
+		_, changed, err = rotate(now.Add(conf.AnchorPropagationPeriod-time.Minute), conf, published)
+		require.NoError(t, err)
+		assert.False(t, changed)
+
+		// Switch signing to the new root.
+		now = now.Add(conf.AnchorPropagationPeriod)
+		switched, changed, err := rotate(now, conf, published)
+		require.NoError(t, err)
+		require.True(t, changed)
+		assert.Equal(t, RotationPhaseRetiring, switched.Rotation.Phase)
+		assert.Equal(t, published.TrustAnchors, switched.TrustAnchors)
+		require.NoError(t, switched.IssChain[0].CheckSignatureFrom(newRoot))
+		root, err := rootCert(switched)
+		require.NoError(t, err)
+		assert.True(t, root.Equal(newRoot))
+
+		// Wait for workload certificates of the old issuer to expire.
+		_, changed, err = rotate(now.Add(conf.WorkloadCertTTL), conf, switched)
+		require.NoError(t, err)
+		assert.False(t, changed)
+
+		// Retire the old root.
+		now = now.Add(conf.WorkloadCertTTL + conf.AllowedClockSkew)
+		retired, changed, err := rotate(now, conf, switched)
+		require.NoError(t, err)
+		require.True(t, changed)
+		assert.Equal(t, RotationState{}, retired.Rotation)
+		assert.Equal(t, switched.IssChainPEM, retired.IssChainPEM)
+		anchors, err = pem.DecodePEMCertificates(retired.TrustAnchors)
+		require.NoError(t, err)
+		require.Len(t, anchors, 1)
+		assert.True(t, anchors[0].Equal(newRoot))
+	})
+
+	t.Run("JWT-SVIDs signed before the issuer is rotated should be verifiable after it", func(t *testing.T) {
+		conf := rotationConfig(t)
+		bundle := newRotationBundle(t, conf, 365*24*time.Hour, 40*24*time.Hour)
+
+		signer, err := newJWTSigner(bundle.IssKey, nil)
+		require.NoError(t, err)
+		c := &ca{bundle: bundle, config: conf, jwt: signer}
+		token, _, err := c.SignJWT(t.Context(), &JWTRequest{
+			TrustDomain: conf.TrustDomain,
+			Namespace:   "my-test-namespace",
+			AppID:       "my-app-id",
+			Audiences:   []string{"aud"},
+		})
+		require.NoError(t, err)
+
+		now := time.Now().Add(15 * 24 * time.Hour)
+		next, changed, err := rotate(now, conf, bundle)
+		require.NoError(t, err)
+		require.True(t, changed)
+		require.Len(t, next.Rotation.PreviousJWTKeys, 1)
+		assert.Equal(t, now.Add(conf.JWTTTL+conf.AllowedClockSkew), next.Rotation.PreviousJWTKeys[0].Until)
+
+		nextSigner, err := newJWTSigner(next.IssKey, previousJWTKeys(now, next.Rotation.PreviousJWTKeys))
+		require.NoError(t, err)
+		set, err := jwk.Parse(nextSigner.jwks)
+		require.NoError(t, err)
+		assert.Equal(t, 2, set.Len())
+		_, err = jwt.Parse([]byte(token), jwt.WithKeySet(set), jwt.WithValidate(false))
+		require.NoError(t, err)
+
+		// The key of the previous issuer is dropped once the JWT-SVIDs it
+		// signed have expired.
+		later := now.Add(conf.JWTTTL + conf.AllowedClockSkew + time.Second)
+		assert.Empty(t, previousJWTKeys(later, next.Rotation.PreviousJWTKeys))
+		laterSigner, err := newJWTSigner(next.IssKey, previousJWTKeys(later, next.Rotation.PreviousJWTKeys))
+		require.NoError(t, err)
+		set, err = jwk.Parse(laterSigner.jwks)
+		require.NoError(t, err)
+		_, err = jwt.Parse([]byte(token), jwt.WithKeySet(set), jwt.WithValidate(false))
+		require.Error(t, err)
+
+		// The key of the previous issuer is persisted with the bundle.
+		s := &selfhosted{config: conf}
+		require.NoError(t, s.store(t.Context(), next))
+		got, ok, err := s.get(t.Context())
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Len(t, got.Rotation.PreviousJWTKeys, 1)
+		assert.JSONEq(t, string(next.Rotation.PreviousJWTKeys[0].Key), string(got.Rotation.PreviousJWTKeys[0].Key))
+	})
+
+	t.Run("unknown phase should error", func(t *testing.T) {
+		conf := rotationConfig(t)
+		bundle := newRotationBundle(t, conf, 365*24*time.Hour, 90*24*time.Hour)
+		bundle.Rotation.Phase = "foo"
+
+		_, _, err := rotate(time.Now(), conf, bundle)
+		require.Error(t, err)
+	})
+}
+
+func TestRotationStore(t *testing.T) {
+	t.Run("root key and rotation state should be persisted by the self-hosted store", func(t *testing.T) {
+		conf := rotationConfig(t)
+		bundle := newRotationBundle(t, conf, 365*24*time.Hour, 365*24*time.Hour)
+		published, changed, err := rotate(time.Now().Add(300*24*time.Hour).Truncate(time.Second).UTC(), conf, bundle)
+		require.NoError(t, err)
+		require.True(t, changed)
+
+		s := &selfhosted{config: conf}
+		require.NoError(t, s.store(t.Context(), published))
+		got, ok, err := s.get(t.Context())
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, published, got)
+
+		retired := published
+		retired.Rotation = RotationState{}
+		require.NoError(t, s.store(t.Context(), retired))
+		assert.NoFileExists(t, conf.RotationStatePath)
+	})
+
+	t.Run("root key not belonging to the trust anchors should be ignored", func(t *testing.T) {
+		conf := rotationConfig(t)
+		bundle := newRotationBundle(t, conf, 365*24*time.Hour, 365*24*time.Hour)
+		other := newRotationBundle(t, conf, 365*24*time.Hour, 365*24*time.Hour)
+
+		loaded, err := loadRotation(bundle, other.RootKeyPEM, nil)
+		require.NoError(t, err)
+		assert.Nil(t, loaded.RootKey)
+		assert.Nil(t, loaded.RootKeyPEM)
+	})
+}
+
+func TestRun(t *testing.T) {
+	t.Run("rotated bundle should be persisted and Run should return", func(t *testing.T) {
+		conf := rotationConfig(t)
+		bundle := newRotationBundle(t, conf, 365*24*time.Hour, 20*24*time.Hour)
+		s := &selfhosted{config: conf}
+		require.NoError(t, s.store(t.Context(), bundle))
+
+		c := &ca{
+			bundle: bundle,
+			config: conf,
+			store:  s,
+			clock:  clocktesting.NewFakeClock(time.Now()),
+		}
+
+		errCh := make(chan error)
+		go func() { errCh <- c.Run(t.Context()) }()
+		select {
+		case err := <-errCh:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "Run did not return after rotating")
+		}
+
+		got, ok, err := s.get(t.Context())
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.NotEqual(t, bundle.IssChainPEM, got.IssChainPEM)
+	})
+
+	t.Run("rotated bundle should not be persisted if the store changed", func(t *testing.T) {
+		conf := rotationConfig(t)
+		bundle := newRotationBundle(t, conf, 365*24*time.Hour, 20*24*time.Hour)
+		stored := newRotationBundle(t, conf, 365*24*time.Hour, 365*24*time.Hour)
+		s := &selfhosted{config: conf}
+		require.NoError(t, s.store(t.Context(), stored))
+
+		c := &ca{
+			bundle: bundle,
+			config: conf,
+			store:  s,
+			clock:  clocktesting.NewFakeClock(time.Now()),
+		}
+		require.NoError(t, c.Run(t.Context()))
+
+		got, ok, err := s.get(t.Context())
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, stored.IssChainPEM, got.IssChainPEM)
+	})
+
+	t.Run("Run should block if rotation is disabled", func(t *testing.T) {
+		conf := rotationConfig(t)
+		conf.RotationEnabled = false
+		bundle := newRotationBundle(t, conf, 365*24*time.Hour, 20*24*time.Hour)
+
+		c := &ca{bundle: bundle, config: conf, clock: clocktesting.NewFakeClock(time.Now())}
+
+		ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+		defer cancel()
+		require.NoError(t, c.Run(ctx))
+		assert.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+	})
+}
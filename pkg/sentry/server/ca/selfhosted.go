@@ -15,6 +15,7 @@ package ca
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 
@@ -40,6 +41,29 @@ func (s *selfhosted) store(_ context.Context, bundle Bundle) error {
 		}
 	}
 
+	if len(s.config.RootKeyPath) > 0 && len(bundle.RootKeyPEM) > 0 {
+		if err := os.WriteFile(s.config.RootKeyPath, bundle.RootKeyPEM, 0o600); err != nil {
+			return err
+		}
+	}
+
+	if len(s.config.RotationStatePath) > 0 {
+		if bundle.Rotation.isEmpty() {
+			if err := os.Remove(s.config.RotationStatePath); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			return nil
+		}
+
+		state, err := json.Marshal(bundle.Rotation)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(s.config.RotationStatePath, state, 0o600); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -73,5 +97,31 @@ func (s *selfhosted) get(_ context.Context) (Bundle, bool, error) {
 		return Bundle{}, false, fmt.Errorf("failed to verify CA bundle: %w", err)
 	}
 
+	rootKeyPEM, err := readOptional(s.config.RootKeyPath)
+	if err != nil {
+		return Bundle{}, false, err
+	}
+	state, err := readOptional(s.config.RotationStatePath)
+	if err != nil {
+		return Bundle{}, false, err
+	}
+	bundle, err = loadRotation(bundle, rootKeyPEM, state)
+	if err != nil {
+		return Bundle{}, false, err
+	}
+
 	return bundle, true, nil
 }
+
+// readOptional reads the file at the given path, returning nil if the path is
+// empty or the file doesn't exist.
+func readOptional(path string) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, nil
+	}
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return b, err
+}
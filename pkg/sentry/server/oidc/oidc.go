@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+
+	"github.com/dapr/dapr/pkg/healthz"
+	"github.com/dapr/dapr/pkg/sentry/server/ca"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.sentry.server.oidc")
+
+const (
+	// JWKSPath is the path the JSON Web Key Set is served on.
+	JWKSPath = "/.well-known/jwks.json"
+
+	// DiscoveryPath is the path the OpenID Provider configuration is served on.
+	DiscoveryPath = "/.well-known/openid-configuration"
+)
+
+// Options is the configuration for the server.
+type Options struct {
+	// Port is the port that the server will listen on.
+	Port int
+
+	// ListenAddress is the address that the server will listen on.
+	ListenAddress string
+
+	// Issuer is the issuer of the JWT-SVIDs signed by the CA. The OpenID
+	// Provider configuration is only served if an issuer is set.
+	Issuer string
+
+	// CA is the certificate authority which signs JWT-SVIDs.
+	CA ca.Signer
+
+	// Healthz is the healthz handler for the server.
+	Healthz healthz.Healthz
+}
+
+// Server is the HTTP server which publishes the keys verifying the JWT-SVIDs
+// signed by Sentry, so that they can be verified by any party.
+type Server struct {
+	port          int
+	listenAddress string
+	handler       http.Handler
+	htarget       healthz.Target
+}
+
+func New(opts Options) (*Server, error) {
+	jwks := opts.CA.JWKS()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+JWKSPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/jwk-set+json")
+		w.Write(jwks)
+	})
+
+	if len(opts.Issuer) > 0 {
+		discovery, err := discoveryDocument(opts.Issuer, jwks)
+		if err != nil {
+			return nil, err
+		}
+		mux.HandleFunc("GET "+DiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(discovery)
+		})
+	}
+
+	return &Server{
+		port:          opts.Port,
+		listenAddress: opts.ListenAddress,
+		handler:       mux,
+		htarget:       opts.Healthz.AddTarget("sentry-oidc-server"),
+	}, nil
+}
+
+// discoveryDocument returns the OpenID Provider configuration for the given
+// issuer, advertising the signing algorithms of the given JWKS.
+func discoveryDocument(issuer string, jwks []byte) ([]byte, error) {
+	set, err := jwk.Parse(jwks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	algs := make([]string, 0, set.Len())
+	for i := range set.Len() {
+		key, _ := set.Key(i)
+		algs = append(algs, key.Algorithm().String())
+	}
+
+	return json.Marshal(map[string]any{
+		"issuer":                                issuer,
+		"jwks_uri":                              strings.TrimSuffix(issuer, "/") + JWKSPath,
+		"response_types_supported":              []string{"id_token"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": algs,
+	})
+}
+
+// Start starts the server. Blocks until the context is cancelled.
+func (s *Server) Start(ctx context.Context) error {
+	ln, err := net.Listen("tcp", fmt.Sprintf("%s:%d", s.listenAddress, s.port))
+	if err != nil {
+		return fmt.Errorf("could not listen on port %d: %w", s.port, err)
+	}
+
+	srv := &http.Server{
+		Handler:           s.handler,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(_ net.Listener) context.Context { return ctx },
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Infof("Running JWKS server on %s", ln.Addr())
+		if err := srv.Serve(ln); !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- err
+			return
+		}
+		serveErr <- nil
+	}()
+
+	s.htarget.Ready()
+
+	select {
+	case err = <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	s.htarget.NotReady()
+	log.Info("Shutting down JWKS server")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return errors.Join(srv.Shutdown(shutdownCtx), <-serveErr)
+}
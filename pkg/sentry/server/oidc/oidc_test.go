@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/healthz"
+	cafake "github.com/dapr/dapr/pkg/sentry/server/ca/fake"
+)
+
+const testJWKS = `{"keys":[{"kty":"EC","crv":"P-256","alg":"ES256","kid":"mykey","use":"sig","x":"f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU","y":"x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0"}]}`
+
+func TestServer(t *testing.T) {
+	ca := cafake.New().WithJWKS(func() []byte {
+		return []byte(testJWKS)
+	})
+
+	t.Run("JWKS should be served", func(t *testing.T) {
+		s, err := New(Options{CA: ca, Healthz: healthz.New()})
+		require.NoError(t, err)
+
+		rec := httptest.NewRecorder()
+		s.handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, JWKSPath, nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/jwk-set+json", rec.Header().Get("Content-Type"))
+		assert.JSONEq(t, testJWKS, rec.Body.String())
+	})
+
+	t.Run("discovery document should not be served without an issuer", func(t *testing.T) {
+		s, err := New(Options{CA: ca, Healthz: healthz.New()})
+		require.NoError(t, err)
+
+		rec := httptest.NewRecorder()
+		s.handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, DiscoveryPath, nil))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("discovery document should be served with an issuer", func(t *testing.T) {
+		s, err := New(Options{CA: ca, Issuer: "https://sentry.example.com/", Healthz: healthz.New()})
+		require.NoError(t, err)
+
+		rec := httptest.NewRecorder()
+		s.handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, DiscoveryPath, nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var doc map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+		assert.Equal(t, "https://sentry.example.com/", doc["issuer"])
+		assert.Equal(t, "https://sentry.example.com/.well-known/jwks.json", doc["jwks_uri"])
+		assert.Equal(t, []any{"ES256"}, doc["id_token_signing_alg_values_supported"])
+	})
+
+	t.Run("invalid JWKS should error with an issuer", func(t *testing.T) {
+		_, err := New(Options{
+			CA:      cafake.New().WithJWKS(func() []byte { return []byte("foo") }),
+			Issuer:  "https://sentry.example.com",
+			Healthz: healthz.New(),
+		})
+		require.Error(t, err)
+	})
+}
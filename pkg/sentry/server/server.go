@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -28,6 +29,7 @@ import (
 	"github.com/dapr/dapr/pkg/healthz"
 	sentryv1pb "github.com/dapr/dapr/pkg/proto/sentry/v1"
 	"github.com/dapr/dapr/pkg/security"
+	"github.com/dapr/dapr/pkg/security/denylist"
 	"github.com/dapr/dapr/pkg/sentry/monitoring"
 	"github.com/dapr/dapr/pkg/sentry/server/ca"
 	"github.com/dapr/dapr/pkg/sentry/server/validator"
@@ -59,6 +61,10 @@ type Options struct {
 
 	// Healthz is the healthz handler for the server.
 	Healthz healthz.Healthz
+
+	// DenyList is the list of identities which are refused certificates, and
+	// which is streamed to clients. Optional.
+	DenyList *denylist.Watcher
 }
 
 // Server is the gRPC server for the Sentry service.
@@ -70,6 +76,8 @@ type Server struct {
 	defaultValidator sentryv1pb.SignCertificateRequest_TokenValidator
 	ca               ca.Signer
 	htarget          healthz.Target
+	denyList         *denylist.Watcher
+	closeCh          chan struct{}
 }
 
 func New(opts Options) *Server {
@@ -81,11 +89,15 @@ func New(opts Options) *Server {
 		defaultValidator: opts.DefaultValidator,
 		ca:               opts.CA,
 		htarget:          opts.Healthz.AddTarget("sentry-server"),
+		denyList:         opts.DenyList,
+		closeCh:          make(chan struct{}),
 	}
 }
 
 // Start starts the server. Blocks until the context is cancelled.
 func (s *Server) Start(ctx context.Context) error {
+	enableAuditLog()
+
 	sec, err := s.sec.Handler(ctx)
 	if err != nil {
 		return err
@@ -115,6 +127,8 @@ func (s *Server) Start(ctx context.Context) error {
 	<-ctx.Done()
 	s.htarget.NotReady()
 	log.Info("Shutting down gRPC server")
+	// Close deny list streams so that the server can gracefully stop.
+	close(s.closeCh)
 	srv.GracefulStop()
 	return <-errCh
 }
@@ -131,27 +145,51 @@ func (s *Server) SignCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 	return resp, nil
 }
 
-func (s *Server) signCertificate(ctx context.Context, req *sentryv1pb.SignCertificateRequest) (*sentryv1pb.SignCertificateResponse, error) {
-	validator := s.defaultValidator
+// validate authenticates the request with the requested validator, or the
+// default validator if none is requested, returning the trust domain of the
+// client and the audit record of the request. Identities on the deny list are
+// refused.
+func (s *Server) validate(ctx context.Context, method string, req *sentryv1pb.SignCertificateRequest) (spiffeid.TrustDomain, *auditRecord, error) {
+	ctx, requester := validator.WithRequester(ctx)
+
+	val := s.defaultValidator
 	if req.GetTokenValidator() != sentryv1pb.SignCertificateRequest_UNKNOWN && req.GetTokenValidator().String() != "" {
-		validator = req.GetTokenValidator()
+		val = req.GetTokenValidator()
 	}
 	namespace := req.GetNamespace()
-	if validator == sentryv1pb.SignCertificateRequest_UNKNOWN {
-		log.Debugf("Validator '%s' is not known for %s/%s", validator.String(), namespace, req.GetId())
-		return nil, status.Error(codes.InvalidArgument, "a validator name must be specified in this environment")
+	if val == sentryv1pb.SignCertificateRequest_UNKNOWN {
+		log.Debugf("Validator '%s' is not known for %s/%s", val.String(), namespace, req.GetId())
+		return spiffeid.TrustDomain{}, nil, status.Error(codes.InvalidArgument, "a validator name must be specified in this environment")
 	}
-	if _, ok := s.vals[validator]; !ok {
-		log.Debugf("Validator '%s' is not enabled for %s/%s", validator.String(), namespace, req.GetId())
-		return nil, status.Error(codes.InvalidArgument, "the requested validator is not enabled")
+	if _, ok := s.vals[val]; !ok {
+		log.Debugf("Validator '%s' is not enabled for %s/%s", val.String(), namespace, req.GetId())
+		return spiffeid.TrustDomain{}, nil, status.Error(codes.InvalidArgument, "the requested validator is not enabled")
 	}
 
-	log.Debugf("Processing SignCertificate request for %s/%s (validator: %s)", namespace, req.GetId(), validator.String())
+	log.Debugf("Processing %s request for %s/%s (validator: %s)", method, namespace, req.GetId(), val.String())
 
-	trustDomain, err := s.vals[validator].Validate(ctx, req)
+	trustDomain, err := s.vals[val].Validate(ctx, req)
+	audit := newAuditRecord(ctx, method, namespace, req.GetId(), val, requester())
 	if err != nil {
 		log.Debugf("Failed to validate request for %s/%s: %s", namespace, req.GetId(), err)
-		return nil, status.Error(codes.PermissionDenied, err.Error())
+		audit.denied("validation failed: " + err.Error())
+		return spiffeid.TrustDomain{}, nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.currentDenyList().AppIDDenied(namespace, req.GetId()) {
+		log.Debugf("Refusing %s request for denied identity %s/%s", method, namespace, req.GetId())
+		audit.denied("identity is on the deny list")
+		return spiffeid.TrustDomain{}, nil, status.Error(codes.PermissionDenied, "identity is denied")
+	}
+
+	return trustDomain, audit, nil
+}
+
+func (s *Server) signCertificate(ctx context.Context, req *sentryv1pb.SignCertificateRequest) (*sentryv1pb.SignCertificateResponse, error) {
+	namespace := req.GetNamespace()
+	trustDomain, audit, err := s.validate(ctx, "SignCertificate", req)
+	if err != nil {
+		return nil, err
 	}
 
 	der, _ := pem.Decode(req.GetCertificateSigningRequest())
@@ -212,6 +250,7 @@ func (s *Server) signCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 	}
 
 	log.Debugf("Successfully signed certificate for %s/%s", namespace, req.GetId())
+	audit.certificateIssued(chain[0])
 
 	return &sentryv1pb.SignCertificateResponse{
 		WorkloadCertificate: chainPEM,
@@ -221,3 +260,106 @@ func (s *Server) signCertificate(ctx context.Context, req *sentryv1pb.SignCertif
 		ValidUntil:             timestamppb.New(chain[0].NotAfter),
 	}, nil
 }
+
+// SignJWTSVID implements the SignJWTSVID gRPC method.
+func (s *Server) SignJWTSVID(ctx context.Context, req *sentryv1pb.SignJWTSVIDRequest) (*sentryv1pb.SignJWTSVIDResponse, error) {
+	resp, err := s.signJWTSVID(ctx, req)
+	if err != nil {
+		monitoring.JWTSignFailed(status.Code(err).String())
+		return nil, err
+	}
+	monitoring.JWTSignSucceed()
+	return resp, nil
+}
+
+func (s *Server) signJWTSVID(ctx context.Context, req *sentryv1pb.SignJWTSVIDRequest) (*sentryv1pb.SignJWTSVIDResponse, error) {
+	namespace := req.GetNamespace()
+	if len(req.GetAudiences()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one audience is required")
+	}
+	for _, aud := range req.GetAudiences() {
+		if len(aud) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "audiences must not be empty")
+		}
+	}
+
+	// Clients are authenticated in the same way as when requesting a
+	// certificate.
+	trustDomain, audit, err := s.validate(ctx, "SignJWTSVID", &sentryv1pb.SignCertificateRequest{
+		Id:             req.GetId(),
+		Token:          req.GetToken(),
+		TrustDomain:    req.GetTrustDomain(),
+		Namespace:      namespace,
+		TokenValidator: req.GetTokenValidator(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	token, expiry, err := s.ca.SignJWT(ctx, &ca.JWTRequest{
+		TrustDomain: trustDomain.String(),
+		Namespace:   namespace,
+		AppID:       req.GetId(),
+		Audiences:   req.GetAudiences(),
+	})
+	if err != nil {
+		log.Errorf("Error signing JWT-SVID: %v", err)
+		return nil, status.Error(codes.Internal, "failed to sign JWT-SVID")
+	}
+
+	log.Debugf("Successfully signed JWT-SVID for %s/%s", namespace, req.GetId())
+	audit.jwtIssued(req.GetAudiences(), expiry)
+
+	return &sentryv1pb.SignJWTSVIDResponse{
+		Token:  token,
+		Expiry: timestamppb.New(expiry),
+	}, nil
+}
+
+// WatchDenyList implements the WatchDenyList gRPC method.
+func (s *Server) WatchDenyList(req *sentryv1pb.WatchDenyListRequest, stream sentryv1pb.CA_WatchDenyListServer) error {
+	ctx := stream.Context()
+
+	// Clients are authenticated in the same way as when requesting a
+	// certificate.
+	if _, _, err := s.validate(ctx, "WatchDenyList", &sentryv1pb.SignCertificateRequest{
+		Id:             req.GetId(),
+		Token:          req.GetToken(),
+		TrustDomain:    req.GetTrustDomain(),
+		Namespace:      req.GetNamespace(),
+		TokenValidator: req.GetTokenValidator(),
+	}); err != nil {
+		return err
+	}
+
+	var listCh chan *denylist.List
+	if s.denyList != nil {
+		listCh = make(chan *denylist.List)
+		s.denyList.Watch(ctx, listCh)
+	}
+
+	if err := stream.Send(s.currentDenyList().Proto()); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.closeCh:
+			return nil
+		case list := <-listCh:
+			if err := stream.Send(list.Proto()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// currentDenyList returns the current deny list, or nil if there is none.
+func (s *Server) currentDenyList() *denylist.List {
+	if s.denyList == nil {
+		return nil
+	}
+	return s.denyList.Current()
+}
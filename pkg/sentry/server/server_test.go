@@ -25,6 +25,8 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -40,11 +42,13 @@ import (
 
 	"github.com/dapr/dapr/pkg/healthz"
 	sentryv1pb "github.com/dapr/dapr/pkg/proto/sentry/v1"
+	"github.com/dapr/dapr/pkg/security/denylist"
 	securityfake "github.com/dapr/dapr/pkg/security/fake"
 	"github.com/dapr/dapr/pkg/sentry/server/ca"
 	cafake "github.com/dapr/dapr/pkg/sentry/server/ca/fake"
 	"github.com/dapr/dapr/pkg/sentry/server/validator"
 	validatorfake "github.com/dapr/dapr/pkg/sentry/server/validator/fake"
+	"github.com/dapr/kit/logger"
 )
 
 func TestRun(t *testing.T) {
@@ -324,3 +328,223 @@ func TestRun(t *testing.T) {
 		})
 	}
 }
+
+func TestSignJWTSVID(t *testing.T) {
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		val validator.Validator
+		ca  ca.Signer
+
+		req     *sentryv1pb.SignJWTSVIDRequest
+		expResp *sentryv1pb.SignJWTSVIDResponse
+		expCode codes.Code
+	}{
+		"request without audiences should error": {
+			val: validatorfake.New(),
+			ca:  cafake.New(),
+			req: &sentryv1pb.SignJWTSVIDRequest{
+				Id:        "my-id",
+				Namespace: "my-namespace",
+			},
+			expCode: codes.InvalidArgument,
+		},
+		"request with empty audience should error": {
+			val: validatorfake.New(),
+			ca:  cafake.New(),
+			req: &sentryv1pb.SignJWTSVIDRequest{
+				Id:        "my-id",
+				Namespace: "my-namespace",
+				Audiences: []string{"aud", ""},
+			},
+			expCode: codes.InvalidArgument,
+		},
+		"request which fails validation should error": {
+			val: validatorfake.New().WithValidateFn(func(ctx context.Context, req *sentryv1pb.SignCertificateRequest) (spiffeid.TrustDomain, error) {
+				return spiffeid.TrustDomain{}, errors.New("invalid token")
+			}),
+			ca: cafake.New(),
+			req: &sentryv1pb.SignJWTSVIDRequest{
+				Id:        "my-id",
+				Namespace: "my-namespace",
+				Audiences: []string{"aud"},
+			},
+			expCode: codes.PermissionDenied,
+		},
+		"request which fails signing should error": {
+			val: validatorfake.New().WithValidateFn(func(ctx context.Context, req *sentryv1pb.SignCertificateRequest) (spiffeid.TrustDomain, error) {
+				return spiffeid.RequireTrustDomainFromString("test"), nil
+			}),
+			ca: cafake.New().WithSignJWT(func(context.Context, *ca.JWTRequest) (string, time.Time, error) {
+				return "", time.Time{}, errors.New("signing error")
+			}),
+			req: &sentryv1pb.SignJWTSVIDRequest{
+				Id:        "my-id",
+				Namespace: "my-namespace",
+				Audiences: []string{"aud"},
+			},
+			expCode: codes.Internal,
+		},
+		"valid request should return a signed token": {
+			val: validatorfake.New().WithValidateFn(func(ctx context.Context, req *sentryv1pb.SignCertificateRequest) (spiffeid.TrustDomain, error) {
+				if req.GetId() != "my-id" || req.GetNamespace() != "my-namespace" || req.GetToken() != "my-token" {
+					return spiffeid.TrustDomain{}, errors.New("unexpected request")
+				}
+				return spiffeid.RequireTrustDomainFromString("test"), nil
+			}),
+			ca: cafake.New().WithSignJWT(func(_ context.Context, req *ca.JWTRequest) (string, time.Time, error) {
+				if req.TrustDomain != "test" || req.Namespace != "my-namespace" || req.AppID != "my-id" {
+					return "", time.Time{}, errors.New("unexpected request")
+				}
+				return "my-token:" + req.Audiences[0], expiry, nil
+			}),
+			req: &sentryv1pb.SignJWTSVIDRequest{
+				Id:        "my-id",
+				Token:     "my-token",
+				Namespace: "my-namespace",
+				Audiences: []string{"aud"},
+			},
+			expResp: &sentryv1pb.SignJWTSVIDResponse{
+				Token:  "my-token:aud",
+				Expiry: timestamppb.New(expiry),
+			},
+			expCode: codes.OK,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := New(Options{
+				Validators: map[sentryv1pb.SignCertificateRequest_TokenValidator]validator.Validator{
+					sentryv1pb.SignCertificateRequest_INSECURE: test.val,
+				},
+				DefaultValidator: sentryv1pb.SignCertificateRequest_INSECURE,
+				CA:               test.ca,
+				Healthz:          healthz.New(),
+			})
+
+			resp, err := s.SignJWTSVID(t.Context(), test.req)
+			assert.Equalf(t, test.expCode, status.Code(err), "unexpected error code: %v", err)
+			if test.expResp == nil {
+				assert.Nil(t, resp)
+				return
+			}
+			assert.Equal(t, test.expResp.GetToken(), resp.GetToken())
+			assert.Equal(t, test.expResp.GetExpiry().AsTime(), resp.GetExpiry().AsTime())
+		})
+	}
+}
+
+type fakeDenyListStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	ch  chan *sentryv1pb.DenyList
+}
+
+func (f *fakeDenyListStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeDenyListStream) Send(list *sentryv1pb.DenyList) error {
+	select {
+	case f.ch <- list:
+		return nil
+	case <-f.ctx.Done():
+		return f.ctx.Err()
+	}
+}
+
+func TestDenyList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "denylist.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("appIDs:\n- my-namespace/denied-id\n"), 0o600))
+
+	denyList, err := denylist.NewWatcher(path)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	watcherClosed := make(chan struct{})
+	t.Cleanup(func() {
+		cancel()
+		<-watcherClosed
+	})
+	go func() {
+		defer close(watcherClosed)
+		assert.NoError(t, denyList.Run(ctx))
+	}()
+
+	s := New(Options{
+		Validators: map[sentryv1pb.SignCertificateRequest_TokenValidator]validator.Validator{
+			sentryv1pb.SignCertificateRequest_INSECURE: validatorfake.New().WithValidateFn(func(_ context.Context, req *sentryv1pb.SignCertificateRequest) (spiffeid.TrustDomain, error) {
+				if req.GetToken() != "valid-token" {
+					return spiffeid.TrustDomain{}, errors.New("invalid token")
+				}
+				return spiffeid.RequireTrustDomainFromString("test"), nil
+			}),
+		},
+		DefaultValidator: sentryv1pb.SignCertificateRequest_INSECURE,
+		CA: cafake.New().WithSignJWT(func(context.Context, *ca.JWTRequest) (string, time.Time, error) {
+			return "my-token", time.Now().Add(time.Hour), nil
+		}),
+		Healthz:  healthz.New(),
+		DenyList: denyList,
+	})
+
+	t.Run("denied identity should be refused credentials", func(t *testing.T) {
+		_, err := s.SignJWTSVID(t.Context(), &sentryv1pb.SignJWTSVIDRequest{
+			Id:        "denied-id",
+			Token:     "valid-token",
+			Namespace: "my-namespace",
+			Audiences: []string{"aud"},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = s.SignJWTSVID(t.Context(), &sentryv1pb.SignJWTSVIDRequest{
+			Id:        "my-id",
+			Token:     "valid-token",
+			Namespace: "my-namespace",
+			Audiences: []string{"aud"},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("unauthenticated clients should not watch the deny list", func(t *testing.T) {
+		stream := &fakeDenyListStream{ctx: t.Context(), ch: make(chan *sentryv1pb.DenyList)}
+		err := s.WatchDenyList(&sentryv1pb.WatchDenyListRequest{Id: "my-id", Namespace: "my-namespace"}, stream)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("deny list should be streamed with its updates", func(t *testing.T) {
+		stream := &fakeDenyListStream{ctx: t.Context(), ch: make(chan *sentryv1pb.DenyList)}
+		go s.WatchDenyList(&sentryv1pb.WatchDenyListRequest{
+			Id:        "my-id",
+			Token:     "valid-token",
+			Namespace: "my-namespace",
+		}, stream)
+
+		select {
+		case list := <-stream.ch:
+			assert.Equal(t, []string{"my-namespace/denied-id"}, list.GetAppIds())
+			assert.Empty(t, list.GetSerials())
+		case <-time.After(3 * time.Second):
+			require.Fail(t, "deny list was not sent")
+		}
+
+		require.NoError(t, os.WriteFile(path, []byte("appIDs:\n- my-namespace/denied-id\nserials:\n- 0A:1B\n"), 0o600))
+
+		select {
+		case list := <-stream.ch:
+			assert.Equal(t, []string{"my-namespace/denied-id"}, list.GetAppIds())
+			assert.Equal(t, []string{"a1b"}, list.GetSerials())
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "deny list update was not sent")
+		}
+	})
+}
+
+func TestEnableAuditLog(t *testing.T) {
+	auditLog.SetOutputLevel(logger.ErrorLevel)
+	t.Cleanup(func() { auditLog.SetOutputLevel(logger.InfoLevel) })
+
+	enableAuditLog()
+	assert.True(t, auditLog.IsOutputLevelEnabled(logger.InfoLevel))
+}
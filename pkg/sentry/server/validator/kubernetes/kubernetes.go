@@ -176,6 +176,7 @@ func (k *kubernetes) Validate(ctx context.Context, req *sentryv1pb.SignCertifica
 		log.Errorf("Failed to get pod %s/%s for requested identity: %s", saNamespace, claims.Pod.Name, err)
 		return spiffeid.TrustDomain{}, errors.New("failed to get pod of identity")
 	}
+	validator.SetRequester(ctx, "pod/"+saNamespace+"/"+claims.Pod.Name)
 
 	if saNamespace != req.GetNamespace() {
 		return spiffeid.TrustDomain{}, fmt.Errorf("namespace mismatch; received namespace: %s", req.GetNamespace())
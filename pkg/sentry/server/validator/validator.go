@@ -34,3 +34,23 @@ type Validator interface {
 	// permitted by the singing certificate.
 	Validate(context.Context, *sentryv1pb.SignCertificateRequest) (spiffeid.TrustDomain, error)
 }
+
+type requesterKey struct{}
+
+// WithRequester returns a context in which validators can record the workload
+// which made the request, such as the Kubernetes pod, for auditing. The
+// returned function returns the recorded requester, if any.
+func WithRequester(ctx context.Context) (context.Context, func() string) {
+	requester := new(string)
+	return context.WithValue(ctx, requesterKey{}, requester), func() string {
+		return *requester
+	}
+}
+
+// SetRequester records the workload which made the request, if the context
+// was created with WithRequester.
+func SetRequester(ctx context.Context, requester string) {
+	if r, ok := ctx.Value(requesterKey{}).(*string); ok {
+		*r = requester
+	}
+}
@@ -186,16 +186,19 @@ func New(t *testing.T, fopts ...Option) *Operator {
 		}),
 		procgrpc.WithRegister(func(s *grpc.Server) {
 			srv := &server{
-				componentUpdateFn:     opts.componentUpdateFn,
-				getConfigurationFn:    opts.getConfigurationFn,
-				getResiliencyFn:       opts.getResiliencyFn,
-				httpEndpointUpdateFn:  opts.httpEndpointUpdateFn,
-				listComponentsFn:      opts.listComponentsFn,
-				listHTTPEndpointsFn:   opts.listHTTPEndpointsFn,
-				listResiliencyFn:      opts.listResiliencyFn,
-				listSubscriptionsFn:   opts.listSubscriptionsFn,
-				listSubscriptionsV2Fn: opts.listSubscriptionsV2Fn,
-				subscriptionUpdateFn:  opts.subscriptionUpdateFn,
+				componentUpdateFn:      opts.componentUpdateFn,
+				getConfigurationFn:     opts.getConfigurationFn,
+				getResiliencyFn:        opts.getResiliencyFn,
+				httpEndpointUpdateFn:   opts.httpEndpointUpdateFn,
+				listComponentsFn:       opts.listComponentsFn,
+				listHTTPEndpointsFn:    opts.listHTTPEndpointsFn,
+				listResiliencyFn:       opts.listResiliencyFn,
+				listSubscriptionsFn:    opts.listSubscriptionsFn,
+				listSubscriptionsV2Fn:  opts.listSubscriptionsV2Fn,
+				subscriptionUpdateFn:   opts.subscriptionUpdateFn,
+				resiliencyUpdateFn:     opts.resiliencyUpdateFn,
+				configurationUpdateFn:  opts.configurationUpdateFn,
+				reportResourceStatusFn: opts.reportResourceStatusFn,
 			}
 
 			operatorv1.RegisterOperatorServer(s, srv)
@@ -16,22 +16,27 @@ package operator
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	operatorv1 "github.com/dapr/dapr/pkg/proto/operator/v1"
 )
 
 type server struct {
-	componentUpdateFn     func(*operatorv1.ComponentUpdateRequest, operatorv1.Operator_ComponentUpdateServer) error
-	getConfigurationFn    func(context.Context, *operatorv1.GetConfigurationRequest) (*operatorv1.GetConfigurationResponse, error)
-	getResiliencyFn       func(context.Context, *operatorv1.GetResiliencyRequest) (*operatorv1.GetResiliencyResponse, error)
-	httpEndpointUpdateFn  func(*operatorv1.HTTPEndpointUpdateRequest, operatorv1.Operator_HTTPEndpointUpdateServer) error
-	listComponentsFn      func(context.Context, *operatorv1.ListComponentsRequest) (*operatorv1.ListComponentResponse, error)
-	listHTTPEndpointsFn   func(context.Context, *operatorv1.ListHTTPEndpointsRequest) (*operatorv1.ListHTTPEndpointsResponse, error)
-	listResiliencyFn      func(context.Context, *operatorv1.ListResiliencyRequest) (*operatorv1.ListResiliencyResponse, error)
-	listSubscriptionsFn   func(context.Context, *emptypb.Empty) (*operatorv1.ListSubscriptionsResponse, error)
-	listSubscriptionsV2Fn func(context.Context, *operatorv1.ListSubscriptionsRequest) (*operatorv1.ListSubscriptionsResponse, error)
-	subscriptionUpdateFn  func(*operatorv1.SubscriptionUpdateRequest, operatorv1.Operator_SubscriptionUpdateServer) error
+	componentUpdateFn      func(*operatorv1.ComponentUpdateRequest, operatorv1.Operator_ComponentUpdateServer) error
+	getConfigurationFn     func(context.Context, *operatorv1.GetConfigurationRequest) (*operatorv1.GetConfigurationResponse, error)
+	getResiliencyFn        func(context.Context, *operatorv1.GetResiliencyRequest) (*operatorv1.GetResiliencyResponse, error)
+	httpEndpointUpdateFn   func(*operatorv1.HTTPEndpointUpdateRequest, operatorv1.Operator_HTTPEndpointUpdateServer) error
+	listComponentsFn       func(context.Context, *operatorv1.ListComponentsRequest) (*operatorv1.ListComponentResponse, error)
+	listHTTPEndpointsFn    func(context.Context, *operatorv1.ListHTTPEndpointsRequest) (*operatorv1.ListHTTPEndpointsResponse, error)
+	listResiliencyFn       func(context.Context, *operatorv1.ListResiliencyRequest) (*operatorv1.ListResiliencyResponse, error)
+	listSubscriptionsFn    func(context.Context, *emptypb.Empty) (*operatorv1.ListSubscriptionsResponse, error)
+	listSubscriptionsV2Fn  func(context.Context, *operatorv1.ListSubscriptionsRequest) (*operatorv1.ListSubscriptionsResponse, error)
+	subscriptionUpdateFn   func(*operatorv1.SubscriptionUpdateRequest, operatorv1.Operator_SubscriptionUpdateServer) error
+	resiliencyUpdateFn     func(*operatorv1.ResiliencyUpdateRequest, operatorv1.Operator_ResiliencyUpdateServer) error
+	configurationUpdateFn  func(*operatorv1.ConfigurationUpdateRequest, operatorv1.Operator_ConfigurationUpdateServer) error
+	reportResourceStatusFn func(operatorv1.Operator_ReportResourceStatusServer) error
 }
 
 func (s *server) ComponentUpdate(req *operatorv1.ComponentUpdateRequest, srv operatorv1.Operator_ComponentUpdateServer) error {
@@ -103,3 +108,26 @@ func (s *server) SubscriptionUpdate(req *operatorv1.SubscriptionUpdateRequest, s
 	}
 	return nil
 }
+
+func (s *server) ResiliencyUpdate(req *operatorv1.ResiliencyUpdateRequest, srv operatorv1.Operator_ResiliencyUpdateServer) error {
+	if s.resiliencyUpdateFn != nil {
+		return s.resiliencyUpdateFn(req, srv)
+	}
+	// Behave like an operator which does not stream resiliency updates, so
+	// daprd falls back to periodically loading them.
+	return status.Error(codes.Unimplemented, "method ResiliencyUpdate not implemented")
+}
+
+func (s *server) ConfigurationUpdate(req *operatorv1.ConfigurationUpdateRequest, srv operatorv1.Operator_ConfigurationUpdateServer) error {
+	if s.configurationUpdateFn != nil {
+		return s.configurationUpdateFn(req, srv)
+	}
+	return status.Error(codes.Unimplemented, "method ConfigurationUpdate not implemented")
+}
+
+func (s *server) ReportResourceStatus(srv operatorv1.Operator_ReportResourceStatusServer) error {
+	if s.reportResourceStatusFn != nil {
+		return s.reportResourceStatusFn(srv)
+	}
+	return status.Error(codes.Unimplemented, "method ReportResourceStatus not implemented")
+}
@@ -21,6 +21,8 @@ import (
 
 type options struct {
 	signCertificateFn func(context.Context, *sentryv1pb.SignCertificateRequest) (*sentryv1pb.SignCertificateResponse, error)
+	signJWTSVIDFn     func(context.Context, *sentryv1pb.SignJWTSVIDRequest) (*sentryv1pb.SignJWTSVIDResponse, error)
+	watchDenyListFn   func(*sentryv1pb.WatchDenyListRequest, sentryv1pb.CA_WatchDenyListServer) error
 }
 
 func WithSignCertificateFn(fn func(context.Context, *sentryv1pb.SignCertificateRequest) (*sentryv1pb.SignCertificateResponse, error)) func(*options) {
@@ -28,3 +30,15 @@ func WithSignCertificateFn(fn func(context.Context, *sentryv1pb.SignCertificateR
 		o.signCertificateFn = fn
 	}
 }
+
+func WithSignJWTSVIDFn(fn func(context.Context, *sentryv1pb.SignJWTSVIDRequest) (*sentryv1pb.SignJWTSVIDResponse, error)) func(*options) {
+	return func(o *options) {
+		o.signJWTSVIDFn = fn
+	}
+}
+
+func WithWatchDenyListFn(fn func(*sentryv1pb.WatchDenyListRequest, sentryv1pb.CA_WatchDenyListServer) error) func(*options) {
+	return func(o *options) {
+		o.watchDenyListFn = fn
+	}
+}
@@ -28,7 +28,9 @@ import (
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 
 	sentryv1pb "github.com/dapr/dapr/pkg/proto/sentry/v1"
 	"github.com/dapr/dapr/pkg/sentry/server/ca"
@@ -80,6 +82,12 @@ func New(t *testing.T, fopts ...Option) *Sentry {
 		signCertificateFn: func(context.Context, *sentryv1pb.SignCertificateRequest) (*sentryv1pb.SignCertificateResponse, error) {
 			return nil, nil
 		},
+		signJWTSVIDFn: func(context.Context, *sentryv1pb.SignJWTSVIDRequest) (*sentryv1pb.SignJWTSVIDResponse, error) {
+			return nil, status.Error(codes.Unimplemented, "method SignJWTSVID not implemented")
+		},
+		watchDenyListFn: func(*sentryv1pb.WatchDenyListRequest, sentryv1pb.CA_WatchDenyListServer) error {
+			return status.Error(codes.Unimplemented, "method WatchDenyList not implemented")
+		},
 	}
 
 	for _, fopt := range fopts {
@@ -94,6 +102,8 @@ func New(t *testing.T, fopts ...Option) *Sentry {
 			func(s *grpc.Server) {
 				srv := &server{
 					signCertificateFn: opts.signCertificateFn,
+					signJWTSVIDFn:     opts.signJWTSVIDFn,
+					watchDenyListFn:   opts.watchDenyListFn,
 				}
 				sentryv1pb.RegisterCAServer(s, srv)
 			},
@@ -21,8 +21,18 @@ import (
 
 type server struct {
 	signCertificateFn func(context.Context, *sentryv1pb.SignCertificateRequest) (*sentryv1pb.SignCertificateResponse, error)
+	signJWTSVIDFn     func(context.Context, *sentryv1pb.SignJWTSVIDRequest) (*sentryv1pb.SignJWTSVIDResponse, error)
+	watchDenyListFn   func(*sentryv1pb.WatchDenyListRequest, sentryv1pb.CA_WatchDenyListServer) error
 }
 
 func (s *server) SignCertificate(ctx context.Context, req *sentryv1pb.SignCertificateRequest) (*sentryv1pb.SignCertificateResponse, error) {
 	return s.signCertificateFn(ctx, req)
 }
+
+func (s *server) SignJWTSVID(ctx context.Context, req *sentryv1pb.SignJWTSVIDRequest) (*sentryv1pb.SignJWTSVIDResponse, error) {
+	return s.signJWTSVIDFn(ctx, req)
+}
+
+func (s *server) WatchDenyList(req *sentryv1pb.WatchDenyListRequest, stream sentryv1pb.CA_WatchDenyListServer) error {
+	return s.watchDenyListFn(req, stream)
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package call
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd/actors"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(stream))
+}
+
+// stream tests that the request and response bodies of actor invocations are
+// streamed to and from actors hosted locally and by another daprd.
+type stream struct {
+	app1 *actors.Actors
+	app2 *actors.Actors
+}
+
+func (s *stream) Setup(t *testing.T) []framework.Option {
+	s.app1 = actors.New(t,
+		actors.WithActorTypes("streamer"),
+		actors.WithActorTypeHandler("streamer", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			if r.Method == nethttp.MethodDelete {
+				return
+			}
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("X-Actor-Path", r.URL.Path)
+			w.WriteHeader(nethttp.StatusOK)
+			io.Copy(w, r.Body)
+		}),
+	)
+	s.app2 = actors.New(t,
+		actors.WithPeerActor(s.app1),
+	)
+
+	return []framework.Option{
+		framework.WithProcesses(s.app1, s.app2),
+	}
+}
+
+func (s *stream) Run(t *testing.T, ctx context.Context) {
+	s.app1.WaitUntilRunning(t, ctx)
+	s.app2.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+	body := bytes.Repeat([]byte("0123456789"), 1<<17)
+
+	invoke := func(c assert.TestingT, address, actorID string) {
+		url := fmt.Sprintf("http://%s/v1.0-alpha1/actors/streamer/%s/stream/echo", address, actorID)
+		req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodPost, url, bytes.NewReader(body))
+		if !assert.NoError(c, err) {
+			return
+		}
+		resp, err := httpClient.Do(req)
+		if !assert.NoError(c, err) {
+			return
+		}
+		defer resp.Body.Close()
+
+		got, err := io.ReadAll(resp.Body)
+		assert.NoError(c, err)
+		if assert.Equal(c, nethttp.StatusOK, resp.StatusCode, string(got)) {
+			assert.Equal(c, "application/octet-stream", resp.Header.Get("Content-Type"))
+			assert.Equal(c, "/actors/streamer/"+actorID+"/method/echo", resp.Header.Get("X-Actor-Path"))
+			assert.True(c, bytes.Equal(body, got), "response body does not match the request body")
+		}
+	}
+
+	t.Run("local actor", func(t *testing.T) {
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			invoke(c, s.app1.Daprd().HTTPAddress(), "local")
+		}, time.Second*10, time.Millisecond*10)
+	})
+
+	t.Run("remote actor", func(t *testing.T) {
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			invoke(c, s.app2.Daprd().HTTPAddress(), "remote")
+		}, time.Second*10, time.Millisecond*10)
+	})
+}
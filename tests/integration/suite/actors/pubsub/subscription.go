@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd/actors"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(subscription))
+}
+
+type received struct {
+	path  string
+	event map[string]any
+}
+
+// subscription tests that a declarative subscription delivers messages to an
+// actor, with the actor ID read from a CloudEvent attribute or expression.
+type subscription struct {
+	app      *actors.Actors
+	received chan received
+}
+
+func (s *subscription) Setup(t *testing.T) []framework.Option {
+	s.received = make(chan received, 10)
+
+	s.app = actors.New(t,
+		actors.WithActorTypes("order"),
+		actors.WithActorTypeHandler("order", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			if r.Method == nethttp.MethodDelete {
+				return
+			}
+			var event map[string]any
+			if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&event)) {
+				w.WriteHeader(nethttp.StatusInternalServerError)
+				return
+			}
+			s.received <- received{path: r.URL.Path, event: event}
+			w.Write([]byte(`{"status":"SUCCESS"}`))
+		}),
+		actors.WithResources(`apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: mypub
+spec:
+  type: pubsub.in-memory
+  version: v1
+---
+apiVersion: dapr.io/v2alpha1
+kind: Subscription
+metadata:
+  name: byexpression
+spec:
+  pubsubname: mypub
+  topic: orders
+  routes:
+    default: onorder
+  actor:
+    type: order
+    idExpression: event.data.id
+---
+apiVersion: dapr.io/v2alpha1
+kind: Subscription
+metadata:
+  name: byattribute
+spec:
+  pubsubname: mypub
+  topic: sources
+  routes:
+    rules:
+    - match: event.type == "com.dapr.event.sent"
+      path: /onsource
+  actor:
+    type: order
+    idAttribute: source
+`),
+	)
+
+	return []framework.Option{
+		framework.WithProcesses(s.app),
+	}
+}
+
+func (s *subscription) Run(t *testing.T, ctx context.Context) {
+	s.app.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+	publish := func(topic, data string) {
+		t.Helper()
+		url := fmt.Sprintf("http://%s/v1.0/publish/mypub/%s", s.app.Daprd().HTTPAddress(), topic)
+		req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodPost, url, strings.NewReader(data))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		io.Copy(io.Discard, resp.Body)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, nethttp.StatusNoContent, resp.StatusCode)
+	}
+
+	receive := func() received {
+		t.Helper()
+		select {
+		case r := <-s.received:
+			return r
+		case <-time.After(time.Second * 10):
+			require.Fail(t, "timed out waiting for the actor to receive the message")
+			return received{}
+		}
+	}
+
+	t.Run("actor ID from expression", func(t *testing.T) {
+		publish("orders", `{"id":"order-1"}`)
+		r := receive()
+		assert.Equal(t, "/actors/order/order-1/method/onorder", r.path)
+		assert.Equal(t, map[string]any{"id": "order-1"}, r.event["data"])
+		assert.Equal(t, "orders", r.event["topic"])
+	})
+
+	t.Run("actor ID from attribute", func(t *testing.T) {
+		publish("sources", `{"id":"order-2"}`)
+		r := receive()
+		assert.Equal(t, "/actors/order/"+s.app.AppID()+"/method/onsource", r.path)
+		assert.Equal(t, "sources", r.event["topic"])
+	})
+}
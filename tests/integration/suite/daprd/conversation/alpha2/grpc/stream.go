@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+	"github.com/dapr/kit/ptr"
+)
+
+func init() {
+	suite.Register(new(stream))
+}
+
+type stream struct {
+	daprd *daprd.Daprd
+}
+
+func (s *stream) Setup(t *testing.T) []framework.Option {
+	s.daprd = daprd.New(t, daprd.WithResourceFiles(`
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: echo
+spec:
+  type: conversation.echo
+  version: v1
+`))
+
+	return []framework.Option{
+		framework.WithProcesses(s.daprd),
+	}
+}
+
+func (s *stream) Run(t *testing.T, ctx context.Context) {
+	s.daprd.WaitUntilRunning(t, ctx)
+
+	client := s.daprd.GRPCClient(t, ctx)
+
+	t.Run("response is streamed", func(t *testing.T) {
+		st, err := client.ConverseStreamAlpha2(ctx, &rtv1.ConversationRequestAlpha2{
+			Name:      "echo",
+			ContextId: ptr.Of("ctx-1"),
+			Inputs: []*rtv1.ConversationInputAlpha2{{
+				Messages: []*rtv1.ConversationMessage{{
+					MessageTypes: &rtv1.ConversationMessage_OfUser{
+						OfUser: &rtv1.ConversationMessageOfUser{
+							Content: []*rtv1.ConversationMessageContent{{Text: "well hello there"}},
+						},
+					},
+				}},
+			}},
+		})
+		require.NoError(t, err)
+
+		var resps []*rtv1.ConversationStreamResponseAlpha2
+		for {
+			resp, err := st.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			resps = append(resps, resp)
+		}
+
+		require.Len(t, resps, 2)
+		assert.Equal(t, "well hello there", resps[0].GetChunk().GetContent())
+		assert.Equal(t, "stop", resps[0].GetChunk().GetFinishReason())
+		require.NotNil(t, resps[1].GetComplete())
+		assert.Equal(t, "ctx-1", resps[1].GetComplete().GetContextId())
+	})
+
+	t.Run("component not found", func(t *testing.T) {
+		st, err := client.ConverseStreamAlpha2(ctx, &rtv1.ConversationRequestAlpha2{
+			Name: "not-found",
+		})
+		require.NoError(t, err)
+		_, err = st.Recv()
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -169,7 +169,12 @@ func (b *basic) Run(t *testing.T, ctx context.Context) {
 						}
 					]
 				}
-			]
+			],
+			"usage": {
+				"promptTokens": "11",
+				"completionTokens": "11",
+				"totalTokens": "22"
+			}
 		}`
 		require.JSONEq(t, expectedResponse, string(respBody))
 	})
@@ -248,7 +253,12 @@ func (b *basic) Run(t *testing.T, ctx context.Context) {
 						}
 					]
 				}
-			]
+			],
+			"usage": {
+				"promptTokens": "13",
+				"completionTokens": "13",
+				"totalTokens": "26"
+			}
 		}`
 		require.JSONEq(t, expectedResponse, string(respBody))
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(cache))
+}
+
+// cache tests that daprd serves repeated conversation requests from the
+// response cache in the state store referenced by the conversation
+// component.
+type cache struct {
+	daprd *daprd.Daprd
+}
+
+func (c *cache) Setup(t *testing.T) []framework.Option {
+	c.daprd = daprd.New(t, daprd.WithResourceFiles(`
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: mystore
+spec:
+  type: state.in-memory
+  version: v1
+---
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: echo
+spec:
+  type: conversation.echo
+  version: v1
+  metadata:
+  - name: responseCacheStateStore
+    value: mystore
+  - name: responseCacheTTL
+    value: 1h
+`))
+
+	return []framework.Option{
+		framework.WithProcesses(c.daprd),
+	}
+}
+
+func (c *cache) Run(t *testing.T, ctx context.Context) {
+	c.daprd.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+	url := fmt.Sprintf("http://%s/v1.0-alpha2/conversation/echo/converse", c.daprd.HTTPAddress())
+
+	type response struct {
+		Outputs []struct {
+			Choices []struct {
+				Message struct {
+					Content string `json:"content"`
+				} `json:"message"`
+			} `json:"choices"`
+		} `json:"outputs"`
+		Usage struct {
+			TotalTokens string `json:"totalTokens"`
+		} `json:"usage"`
+		Cached bool `json:"cached"`
+	}
+
+	converse := func(t *testing.T, body string) response {
+		t.Helper()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		respBody, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(respBody))
+		var res response
+		require.NoError(t, json.Unmarshal(respBody, &res))
+		return res
+	}
+
+	resp := converse(t, `{"inputs":[{"messages":[{"ofUser":{"content":[{"text":"hello world"}]}}]}]}`)
+	assert.False(t, resp.Cached)
+	assert.Equal(t, "6", resp.Usage.TotalTokens)
+
+	// Prompts which only differ in whitespace share the cached response.
+	resp = converse(t, `{"inputs":[{"messages":[{"ofUser":{"content":[{"text":" hello  world\n"}]}}]}]}`)
+	assert.True(t, resp.Cached)
+	assert.Empty(t, resp.Usage.TotalTokens)
+	require.Len(t, resp.Outputs, 1)
+	assert.Equal(t, "hello world", resp.Outputs[0].Choices[0].Message.Content)
+
+	resp = converse(t, `{"inputs":[{"messages":[{"ofUser":{"content":[{"text":"hello world"}]}}]}],"bypassCache":true}`)
+	assert.False(t, resp.Cached)
+
+	resp = converse(t, `{"inputs":[{"messages":[{"ofUser":{"content":[{"text":"hello world"}]}}]}],"temperature":0.5}`)
+	assert.False(t, resp.Cached)
+}
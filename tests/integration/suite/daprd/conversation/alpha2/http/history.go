@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(history))
+}
+
+// history tests that daprd keeps the message history of conversation
+// contexts in the state store referenced by the conversation component.
+type history struct {
+	daprd *daprd.Daprd
+}
+
+func (h *history) Setup(t *testing.T) []framework.Option {
+	h.daprd = daprd.New(t, daprd.WithResourceFiles(`
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: mystore
+spec:
+  type: state.in-memory
+  version: v1
+---
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: echo
+spec:
+  type: conversation.echo
+  version: v1
+  metadata:
+  - name: historyStateStore
+    value: mystore
+  - name: historyMaxMessages
+    value: "3"
+`))
+
+	return []framework.Option{
+		framework.WithProcesses(h.daprd),
+	}
+}
+
+func (h *history) Run(t *testing.T, ctx context.Context) {
+	h.daprd.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+	baseURL := fmt.Sprintf("http://%s/v1.0-alpha2/conversation/echo", h.daprd.HTTPAddress())
+
+	do := func(t *testing.T, method, url, body string) (int, []byte) {
+		t.Helper()
+		req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, b
+	}
+
+	converse := func(t *testing.T, contextID, text string) map[string]any {
+		t.Helper()
+		body := fmt.Sprintf(`{"inputs":[{"messages":[{"ofUser":{"content":[{"text":%q}]}}]}]}`, text)
+		if contextID != "" {
+			body = fmt.Sprintf(`{"contextId":%q,"inputs":[{"messages":[{"ofUser":{"content":[{"text":%q}]}}]}]}`, contextID, text)
+		}
+		status, b := do(t, http.MethodPost, baseURL+"/converse", body)
+		require.Equal(t, http.StatusOK, status, string(b))
+		var resp map[string]any
+		require.NoError(t, json.Unmarshal(b, &resp))
+		return resp
+	}
+
+	resp := converse(t, "", "hello")
+	contextID, ok := resp["contextId"].(string)
+	require.True(t, ok, "a context ID must be returned")
+	require.NotEmpty(t, contextID)
+
+	resp = converse(t, contextID, "how are you")
+	assert.Equal(t, contextID, resp["contextId"])
+	assert.Len(t, resp["outputs"], 3, "the history must be prepended to the request")
+
+	status, b := do(t, http.MethodGet, baseURL+"/contexts/"+contextID, "")
+	require.Equal(t, http.StatusOK, status, string(b))
+	assert.JSONEq(t, fmt.Sprintf(`{
+		"contextId": %q,
+		"messages": [
+			{"ofAssistant": {"content": [{"text": "hello"}]}},
+			{"ofUser": {"content": [{"text": "how are you"}]}},
+			{"ofAssistant": {"content": [{"text": "how are you"}]}}
+		]
+	}`, contextID), string(b))
+
+	status, b = do(t, http.MethodDelete, baseURL+"/contexts/"+contextID, "")
+	require.Equal(t, http.StatusNoContent, status, string(b))
+
+	status, b = do(t, http.MethodGet, baseURL+"/contexts/"+contextID, "")
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Contains(t, string(b), "ERR_CONVERSATION_CONTEXT_NOT_FOUND")
+}
@@ -75,7 +75,7 @@ func (m *messagetypes) Run(t *testing.T, ctx context.Context) {
 		respBody, err := io.ReadAll(resp.Body)
 		require.NoError(t, err)
 		require.NoError(t, resp.Body.Close())
-		require.JSONEq(t, `{"outputs":[{"choices":[{"finishReason":"stop","message":{"content":"user message"}}]}]}`, string(respBody))
+		require.JSONEq(t, `{"outputs":[{"choices":[{"finishReason":"stop","message":{"content":"user message"}}]}],"usage":{"promptTokens":"3","completionTokens":"3","totalTokens":"6"}}`, string(respBody))
 	})
 
 	t.Run("of_system", func(t *testing.T) {
@@ -89,7 +89,7 @@ func (m *messagetypes) Run(t *testing.T, ctx context.Context) {
 		respBody, err := io.ReadAll(resp.Body)
 		require.NoError(t, err)
 		require.NoError(t, resp.Body.Close())
-		require.JSONEq(t, `{"outputs":[{"choices":[{"finishReason":"stop","message":{"content":"system message"}}]}]}`, string(respBody))
+		require.JSONEq(t, `{"outputs":[{"choices":[{"finishReason":"stop","message":{"content":"system message"}}]}],"usage":{"promptTokens":"4","completionTokens":"4","totalTokens":"8"}}`, string(respBody))
 	})
 
 	t.Run("of_developer", func(t *testing.T) {
@@ -103,7 +103,7 @@ func (m *messagetypes) Run(t *testing.T, ctx context.Context) {
 		respBody, err := io.ReadAll(resp.Body)
 		require.NoError(t, err)
 		require.NoError(t, resp.Body.Close())
-		require.JSONEq(t, `{"outputs":[{"choices":[{"finishReason":"stop","message":{"content":"developer message"}}]}]}`, string(respBody))
+		require.JSONEq(t, `{"outputs":[{"choices":[{"finishReason":"stop","message":{"content":"developer message"}}]}],"usage":{"promptTokens":"5","completionTokens":"5","totalTokens":"10"}}`, string(respBody))
 	})
 
 	t.Run("of_assistant", func(t *testing.T) {
@@ -118,7 +118,7 @@ func (m *messagetypes) Run(t *testing.T, ctx context.Context) {
 		require.NoError(t, err)
 		require.NoError(t, resp.Body.Close())
 		// Echo component returns the assistant message with tool calls
-		require.JSONEq(t, `{"outputs":[{"choices":[{"finishReason":"stop","message":{"content":"assistant message","toolCalls":[{"id":"call_123","function":{"name":"test_function","arguments":"test-string"}}]}}]}]}`, string(respBody))
+		require.JSONEq(t, `{"outputs":[{"choices":[{"finishReason":"stop","message":{"content":"assistant message","toolCalls":[{"id":"call_123","function":{"name":"test_function","arguments":"test-string"}}]}}]}],"usage":{"promptTokens":"11","completionTokens":"11","totalTokens":"22"}}`, string(respBody))
 	})
 
 	t.Run("of_tool", func(t *testing.T) {
@@ -132,7 +132,7 @@ func (m *messagetypes) Run(t *testing.T, ctx context.Context) {
 		respBody, err := io.ReadAll(resp.Body)
 		require.NoError(t, err)
 		require.NoError(t, resp.Body.Close())
-		require.JSONEq(t, `{"outputs":[{"choices":[{"finishReason":"stop","message":{"content":"tool message"}}]}]}`, string(respBody))
+		require.JSONEq(t, `{"outputs":[{"choices":[{"finishReason":"stop","message":{"content":"tool message"}}]}],"usage":{"promptTokens":"3","completionTokens":"3","totalTokens":"6"}}`, string(respBody))
 	})
 
 	t.Run("multiple messages in conversation", func(t *testing.T) {
@@ -238,7 +238,12 @@ func (m *messagetypes) Run(t *testing.T, ctx context.Context) {
 						}
 					]
 				}
-			]
+			],
+			"usage": {
+				"promptTokens": "21",
+				"completionTokens": "21",
+				"totalTokens": "42"
+			}
 		}`
 		require.JSONEq(t, expectedResponse, string(respBody))
 	})
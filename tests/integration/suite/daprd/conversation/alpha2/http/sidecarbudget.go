@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(sidecarBudget))
+}
+
+// sidecarBudget tests that the token usage of conversation requests is
+// returned, and that requests are rejected once the token budget of the
+// sidecar is exhausted.
+type sidecarBudget struct {
+	daprd *daprd.Daprd
+}
+
+func (b *sidecarBudget) Setup(t *testing.T) []framework.Option {
+	b.daprd = daprd.New(t,
+		daprd.WithConfigManifests(t, `
+apiVersion: dapr.io/v1alpha1
+kind: Configuration
+metadata:
+  name: sidecarbudget
+spec:
+  conversation:
+    sidecarTokenBudget:
+      maxTokens: 10
+      window: 1h
+`),
+		daprd.WithResourceFiles(`
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: echo
+spec:
+  type: conversation.echo
+  version: v1
+`))
+
+	return []framework.Option{
+		framework.WithProcesses(b.daprd),
+	}
+}
+
+func (b *sidecarBudget) Run(t *testing.T, ctx context.Context) {
+	b.daprd.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+	url := fmt.Sprintf("http://%s/v1.0-alpha2/conversation/echo/converse", b.daprd.HTTPAddress())
+
+	converse := func(t *testing.T) (int, []byte) {
+		t.Helper()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url,
+			strings.NewReader(`{"inputs":[{"messages":[{"ofUser":{"content":[{"text":"hello world"}]}}]}]}`))
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, body
+	}
+
+	// The usage of the echo component is estimated as 3 prompt and 3
+	// completion tokens.
+	for range 2 {
+		status, body := converse(t)
+		require.Equal(t, http.StatusOK, status, string(body))
+		var resp struct {
+			Usage struct {
+				PromptTokens     string `json:"promptTokens"`
+				CompletionTokens string `json:"completionTokens"`
+				TotalTokens      string `json:"totalTokens"`
+			} `json:"usage"`
+		}
+		require.NoError(t, json.Unmarshal(body, &resp))
+		assert.Equal(t, "3", resp.Usage.PromptTokens)
+		assert.Equal(t, "3", resp.Usage.CompletionTokens)
+		assert.Equal(t, "6", resp.Usage.TotalTokens)
+	}
+
+	status, body := converse(t)
+	require.Equal(t, http.StatusTooManyRequests, status, string(body))
+	var errResp map[string]any
+	require.NoError(t, json.Unmarshal(body, &errResp))
+	assert.Equal(t, "ERR_CONVERSATION_SIDECAR_TOKEN_BUDGET_EXHAUSTED", errResp["errorCode"])
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	rtv1 "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(stream))
+}
+
+type stream struct {
+	daprd *daprd.Daprd
+}
+
+func (s *stream) Setup(t *testing.T) []framework.Option {
+	s.daprd = daprd.New(t, daprd.WithResourceFiles(`
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: echo
+spec:
+  type: conversation.echo
+  version: v1
+`))
+
+	return []framework.Option{
+		framework.WithProcesses(s.daprd),
+	}
+}
+
+func (s *stream) Run(t *testing.T, ctx context.Context) {
+	s.daprd.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+
+	post := func(t *testing.T, name, body string) *http.Response {
+		t.Helper()
+		url := fmt.Sprintf("http://%s/v1.0-alpha2/conversation/%s/converse/stream", s.daprd.HTTPAddress(), name)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	t.Run("response is streamed as server-sent events", func(t *testing.T) {
+		resp := post(t, "echo", `{"contextId":"ctx-1","inputs":[{"messages":[{"ofUser":{"content":[{"text":"well hello there"}]}}]}]}`)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		events := strings.Split(strings.TrimSuffix(string(body), "\n\n"), "\n\n")
+		require.Len(t, events, 2, string(body))
+		resps := make([]*rtv1.ConversationStreamResponseAlpha2, len(events))
+		for i, event := range events {
+			data, ok := strings.CutPrefix(event, "data: ")
+			require.True(t, ok, event)
+			resps[i] = new(rtv1.ConversationStreamResponseAlpha2)
+			require.NoError(t, protojson.Unmarshal([]byte(data), resps[i]))
+		}
+
+		assert.Equal(t, "well hello there", resps[0].GetChunk().GetContent())
+		assert.Equal(t, "stop", resps[0].GetChunk().GetFinishReason())
+		assert.Equal(t, "ctx-1", resps[1].GetComplete().GetContextId())
+	})
+
+	t.Run("component not found", func(t *testing.T) {
+		resp := post(t, "not-found", `{"inputs":[{"messages":[{"ofUser":{"content":[{"text":"hi"}]}}]}]}`)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "ERR_CONVERSATION_NOT_FOUND")
+	})
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpenedpoints
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/tests/integration/framework"
+	"github.com/dapr/dapr/tests/integration/framework/client"
+	"github.com/dapr/dapr/tests/integration/framework/process/daprd"
+	prochttp "github.com/dapr/dapr/tests/integration/framework/process/http"
+	"github.com/dapr/dapr/tests/integration/suite"
+)
+
+func init() {
+	suite.Register(new(loadbalancing))
+}
+
+// loadbalancing tests that requests to an HTTP endpoint with multiple base
+// URLs are balanced between them, and that failing base URLs are ejected.
+type loadbalancing struct {
+	daprd *daprd.Daprd
+}
+
+func (l *loadbalancing) Setup(t *testing.T) []framework.Option {
+	newServer := func(name string, code int) *prochttp.HTTP {
+		return prochttp.New(t,
+			prochttp.WithHandlerFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(code)
+				w.Write([]byte(name))
+			}),
+		)
+	}
+
+	srv1 := newServer("srv1", http.StatusOK)
+	srv2 := newServer("srv2", http.StatusOK)
+	failing := newServer("failing", http.StatusServiceUnavailable)
+
+	l.daprd = daprd.New(t, daprd.WithResourceFiles(fmt.Sprintf(`
+apiVersion: dapr.io/v1alpha1
+kind: HTTPEndpoint
+metadata:
+  name: roundrobin
+spec:
+  baseUrls:
+  - http://localhost:%[1]d
+  - http://localhost:%[2]d
+`, srv1.Port(), srv2.Port()), fmt.Sprintf(`
+apiVersion: dapr.io/v1alpha1
+kind: HTTPEndpoint
+metadata:
+  name: failover
+spec:
+  baseUrl: http://localhost:%[1]d
+  baseUrls:
+  - http://localhost:%[2]d
+  loadBalancing:
+    strategy: Priority
+  healthCheck:
+    passive:
+      failureThreshold: 2
+      ejectionDuration: 1h
+`, failing.Port(), srv1.Port())))
+
+	return []framework.Option{
+		framework.WithProcesses(srv1, srv2, failing, l.daprd),
+	}
+}
+
+func (l *loadbalancing) Run(t *testing.T, ctx context.Context) {
+	l.daprd.WaitUntilRunning(t, ctx)
+
+	httpClient := client.HTTP(t)
+
+	invoke := func(endpoint string) (int, string) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+			fmt.Sprintf("http://%s/v1.0/invoke/%s/method/hello", l.daprd.HTTPAddress(), endpoint), nil)
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode, string(body)
+	}
+
+	t.Run("round robin", func(t *testing.T) {
+		got := make(map[string]int)
+		for range 4 {
+			code, body := invoke("roundrobin")
+			assert.Equal(t, http.StatusOK, code)
+			got[body]++
+		}
+		assert.Equal(t, map[string]int{"srv1": 2, "srv2": 2}, got)
+	})
+
+	t.Run("priority failover", func(t *testing.T) {
+		for range 2 {
+			code, body := invoke("failover")
+			assert.Equal(t, http.StatusServiceUnavailable, code)
+			assert.Equal(t, "failing", body)
+		}
+
+		code, body := invoke("failover")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "srv1", body)
+	})
+
+	t.Run("metadata API reports base URL health", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+			fmt.Sprintf("http://%s/v1.0/metadata", l.daprd.HTTPAddress()), nil)
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var meta struct {
+			HTTPEndpoints []struct {
+				Name     string `json:"name"`
+				BaseURLs []struct {
+					URL     string `json:"url"`
+					Healthy bool   `json:"healthy"`
+				} `json:"baseUrls"`
+				LoadBalancingStrategy string `json:"loadBalancingStrategy"`
+			} `json:"httpEndpoints"`
+		}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&meta))
+
+		var found bool
+		for _, e := range meta.HTTPEndpoints {
+			if e.Name != "failover" {
+				continue
+			}
+			found = true
+			assert.Equal(t, "Priority", e.LoadBalancingStrategy)
+			if assert.Len(t, e.BaseURLs, 2) {
+				assert.False(t, e.BaseURLs[0].Healthy)
+				assert.True(t, e.BaseURLs[1].Healthy)
+			}
+		}
+		assert.True(t, found)
+	})
+}